@@ -12,13 +12,19 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+// Package combgen enumerates the subsets of a set of n elements.
+package combgen
 
+// CombGen generates the combinations (subsets) of indexes from 0 to
+// n-1. The combinations are ordered by their size, the combinations
+// of the same size are ordered lexicographically, so the empty
+// combination comes first and the full one last.
 type CombGen struct {
 	n    int
 	idxs []int
 }
 
+// NewCombGen returns a generator of the combinations of n indexes.
 func NewCombGen(n int) *CombGen {
 	return &CombGen{
 		n:    n,
@@ -26,10 +32,13 @@ func NewCombGen(n int) *CombGen {
 	}
 }
 
+// NCombs returns the number of the combinations of n indexes.
 func NCombs(n int) uint64 {
 	return (uint64)(1) << n
 }
 
+// Next advances the generator to the next combination, it returns
+// false if there are no more combinations.
 func (g *CombGen) Next() bool {
 	if len(g.idxs) > g.n {
 		return false
@@ -62,6 +71,8 @@ func (g *CombGen) Next() bool {
 	return true
 }
 
+// Get returns the current combination. The slice is reused by the
+// generator, so it must be copied to be kept after calling Next.
 func (g *CombGen) Get() []int {
 	return g.idxs
 }
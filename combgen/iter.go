@@ -0,0 +1,35 @@
+// Copyright Krzesimir Nowak
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package combgen
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the remaining combinations of the
+// generator, for use with range. Unlike Get, it yields a copy of
+// each combination, so the combinations can be kept.
+func (g *CombGen) All() iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		for g.Next() {
+			idxs := append([]int{}, g.Get()...)
+			if !yield(idxs) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright Krzesimir Nowak
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package combgen
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	combs := slices.Collect(NewCombGen(3).All())
+	assert.Equal(t, [][]int{{}, {0}, {1}, {2}, {0, 1}, {0, 2}, {1, 2}, {0, 1, 2}}, combs)
+
+	var firstTwo [][]int
+	for idxs := range NewCombGen(2).All() {
+		if len(firstTwo) == 2 {
+			break
+		}
+		firstTwo = append(firstTwo, idxs)
+	}
+	assert.Equal(t, [][]int{{}, {0}}, firstTwo)
+}
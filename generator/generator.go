@@ -0,0 +1,7254 @@
+// Copyright Krzesimir Nowak
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package generator generates the wrappers of the interfaces. It is
+// used by the wrappergen command, but it can be also used by the
+// tools embedding the generator with Generate.
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"go/version"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/krnowak/wrappergen/combgen"
+	"github.com/krnowak/wrappergen/stringset"
+)
+
+const (
+	envInFile = "GOFILE"
+	envDebug  = "DBG"
+)
+
+// environmentVariables describes the environment variables for the
+// usage.
+var environmentVariables = []struct {
+	name        string
+	description string
+}{
+	{envInFile, "input file used when -infile is not given (the flag takes precedence), go generate sets it to the file with the directive"},
+	{envDebug, "print debugging messages when set to 1, there is no flag for it"},
+}
+
+var isDbg = os.Getenv(envDebug) == "1"
+
+type aType struct {
+	pkgName string
+	name    string
+	// pkgPath is not empty if the type was given with the import
+	// path of its package, like database/sql/driver.Conn. The
+	// pkgName is guessed from the path then, until the package
+	// is loaded.
+	pkgPath string
+	// typeArgs is not empty if the type is an instantiation of a
+	// generic type, like string, int for Map[string, int].
+	typeArgs string
+}
+
+func (at aType) String() string {
+	if at.pkgName != "" {
+		return fmt.Sprintf("%s.%s%s", at.pkgName, at.name, at.typeArgsSuffix())
+	}
+	return at.name + at.typeArgsSuffix()
+}
+
+// typeArgsSuffix returns the type arguments in square brackets or
+// an empty string if the type is not an instantiation.
+func (at aType) typeArgsSuffix() string {
+	if at.typeArgs == "" {
+		return ""
+	}
+	return fmt.Sprintf("[%s]", at.typeArgs)
+}
+
+// typeArgList returns the type arguments of the instantiation.
+func (at aType) typeArgList() []aType {
+	if at.typeArgs == "" {
+		return nil
+	}
+	var list []aType
+	for _, arg := range strings.Split(at.typeArgs, ", ") {
+		// already validated by strToAType
+		argType, _ := strToAType(arg)
+		list = append(list, argType)
+	}
+	return list
+}
+
+// expr returns the type as an expression of the generated code.
+func (at aType) expr() ast.Expr {
+	var x ast.Expr = ast.NewIdent(at.name)
+	if at.pkgName != "" {
+		x = &ast.SelectorExpr{
+			X:   ast.NewIdent(at.pkgName),
+			Sel: ast.NewIdent(at.name),
+		}
+	}
+	var indices []ast.Expr
+	for _, argType := range at.typeArgList() {
+		indices = append(indices, argType.expr())
+	}
+	switch len(indices) {
+	case 0:
+		return x
+	case 1:
+		return &ast.IndexExpr{X: x, Index: indices[0]}
+	default:
+		return &ast.IndexListExpr{X: x, Indices: indices}
+	}
+}
+
+func (at aType) StringNoDot() string {
+	var sb strings.Builder
+	sb.WriteString(at.pkgName)
+	sb.WriteString(at.name)
+	// Map[string, int] gives MapStringInt
+	for _, argType := range at.typeArgList() {
+		argNoDot := argType.StringNoDot()
+		sb.WriteString(strings.ToUpper(argNoDot[:1]))
+		sb.WriteString(argNoDot[1:])
+	}
+	return sb.String()
+}
+
+func strToAType(s string) (aType, error) {
+	if s == "" {
+		return aType{}, fmt.Errorf("empty type string")
+	}
+	if open := strings.Index(s, "["); open >= 0 {
+		if !strings.HasSuffix(s, "]") {
+			return aType{}, fmt.Errorf("malformed type arguments in %s, expected a string like Set[string]", s)
+		}
+		at, err := strToAType(s[:open])
+		if err != nil {
+			return aType{}, err
+		}
+		typeArgs, err := strToTypeArgs(s[open+1 : len(s)-1])
+		if err != nil {
+			return aType{}, fmt.Errorf("%w in %s", err, s)
+		}
+		at.typeArgs = typeArgs
+		return at, nil
+	}
+	if lastDot := strings.LastIndex(s, "."); lastDot >= 0 && strings.Contains(s[:lastDot], "/") {
+		pkgPath, name := s[:lastDot], s[lastDot+1:]
+		if name == "" {
+			return aType{}, fmt.Errorf("empty type name in %s", s)
+		}
+		if strings.HasPrefix(pkgPath, "/") || strings.HasSuffix(pkgPath, "/") || strings.Contains(pkgPath, "//") {
+			return aType{}, fmt.Errorf("malformed package path %s in %s", pkgPath, s)
+		}
+		return aType{
+			pkgName: guessPkgName(pkgPath),
+			name:    name,
+			pkgPath: pkgPath,
+		}, nil
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) == 1 {
+		return aType{
+			pkgName: "",
+			name:    s,
+		}, nil
+	} else if len(parts) == 2 {
+		if parts[0] == "" {
+			return aType{}, fmt.Errorf("empty package name in %s", s)
+		}
+		if parts[1] == "" {
+			return aType{}, fmt.Errorf("empty type name in %s", s)
+		}
+		return aType{
+			pkgName: parts[0],
+			name:    parts[1],
+		}, nil
+	} else {
+		return aType{}, fmt.Errorf("malformed type %s, expected a string like int, driver.Driver or database/sql/driver.Driver", s)
+	}
+}
+
+// strToTypeArgs validates the comma-separated type arguments and
+// returns them normalized to the form used in the generated code.
+func strToTypeArgs(s string) (string, error) {
+	var args []string
+	for _, arg := range strings.Split(s, ",") {
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			return "", errors.New("empty type argument")
+		}
+		if strings.ContainsAny(arg, "[]") {
+			return "", fmt.Errorf("unsupported type argument %s, type arguments can't be instantiations themselves", arg)
+		}
+		argType, err := strToAType(arg)
+		if err != nil {
+			return "", err
+		}
+		if argType.pkgPath != "" {
+			return "", fmt.Errorf("unsupported type argument %s, type arguments need to use the package name instead of the import path", arg)
+		}
+		args = append(args, argType.String())
+	}
+	return strings.Join(args, ", "), nil
+}
+
+// guessPkgName returns the likely name of the package with the
+// passed import path, skipping the major version suffix, so
+// example.com/foo/v2 and gopkg.in/foo.v2 give foo.
+func guessPkgName(pkgPath string) string {
+	elems := strings.Split(pkgPath, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = elems[len(elems)-2]
+	}
+	if dot := strings.Index(name, "."); dot > 0 {
+		name = name[:dot]
+	}
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+type anImport struct {
+	name string
+	path string
+}
+
+func strToAnImport(s string) (anImport, error) {
+	if s == "" {
+		return anImport{}, fmt.Errorf("empty import string")
+	}
+	parts := strings.Split(s, ",")
+	if len(parts) == 1 {
+		return anImport{
+			name: "",
+			path: s,
+		}, nil
+	} else if len(parts) == 2 {
+		if parts[0] == "" {
+			return anImport{}, fmt.Errorf("empty import name in %s", s)
+		}
+		if parts[1] == "" {
+			return anImport{}, fmt.Errorf("empty import path in %s", s)
+		}
+		return anImport{
+			name: parts[0],
+			path: parts[1],
+		}, nil
+	} else {
+		return anImport{}, fmt.Errorf("malformed import string %s, expected either an import path or a comma-separated pair of a import name and import path", s)
+	}
+}
+
+type extraField struct {
+	name    string
+	typeStr string
+	expr    ast.Expr
+}
+
+func strToExtraField(s string) (extraField, error) {
+	if s == "" {
+		return extraField{}, fmt.Errorf("empty extra field string")
+	}
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return extraField{}, fmt.Errorf("expected a comma-separated name-type pair for an extra field, got something else (%s)", s)
+	}
+	expr, err := parser.ParseExpr(parts[1])
+	if err != nil {
+		return extraField{}, fmt.Errorf("failed to get an AST for extra field %s (likely invalid Go snippet in type part): %w", s, err)
+	}
+	return extraField{
+		name:    parts[0],
+		typeStr: parts[1],
+		expr:    expr,
+	}, nil
+}
+
+// strToExtraMethod parses a method signature from -extra-methods, like
+// Flush() error.
+func strToExtraMethod(s string) (methodInfo, *ast.FuncType, error) {
+	s = strings.TrimSpace(s)
+	paren := strings.Index(s, "(")
+	if paren < 0 {
+		return methodInfo{}, nil, fmt.Errorf("expected a method signature like Flush() error, got %s", s)
+	}
+	name := strings.TrimSpace(s[:paren])
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return methodInfo{}, nil, fmt.Errorf("method name %s is not an exported identifier", name)
+	}
+	expr, err := parser.ParseExpr("func" + s[paren:])
+	if err != nil {
+		return methodInfo{}, nil, fmt.Errorf("failed to get an AST for the signature of method %s (likely invalid Go snippet): %w", name, err)
+	}
+	funcType, ok := expr.(*ast.FuncType)
+	if !ok {
+		return methodInfo{}, nil, fmt.Errorf("expected a method signature like Flush() error, got %s", s)
+	}
+	mi := methodInfo{
+		name: name,
+	}
+	for _, field := range funcType.Params.List {
+		if _, ok := field.Type.(*ast.Ellipsis); ok {
+			return methodInfo{}, nil, fmt.Errorf("variadic parameters of method %s are not supported", name)
+		}
+		typeStr := types.ExprString(field.Type)
+		if len(field.Names) == 0 {
+			mi.parameters = append(mi.parameters, parameterInfo{typeStr: typeStr})
+		}
+		for _, paramName := range field.Names {
+			mi.parameters = append(mi.parameters, parameterInfo{name: paramName.Name, typeStr: typeStr})
+		}
+	}
+	if funcType.Results != nil {
+		for _, field := range funcType.Results.List {
+			typeStr := types.ExprString(field.Type)
+			for count := max(len(field.Names), 1); count > 0; count-- {
+				mi.returnTypes = append(mi.returnTypes, typeStr)
+			}
+		}
+	}
+	return mi, funcType, nil
+}
+
+// withAny returns the extra field with all the empty interface types
+// in the field type replaced with any.
+func (ef extraField) withAny() (extraField, error) {
+	replaced := false
+	ast.Inspect(ef.expr, func(n ast.Node) bool {
+		switch t := n.(type) {
+		case *ast.FuncType:
+			replaceEmptyInterfacesInFields(t.Params, &replaced)
+			replaceEmptyInterfacesInFields(t.Results, &replaced)
+		case *ast.ArrayType:
+			replaceEmptyInterface(&t.Elt, &replaced)
+		case *ast.StarExpr:
+			replaceEmptyInterface(&t.X, &replaced)
+		case *ast.MapType:
+			replaceEmptyInterface(&t.Key, &replaced)
+			replaceEmptyInterface(&t.Value, &replaced)
+		case *ast.ChanType:
+			replaceEmptyInterface(&t.Value, &replaced)
+		case *ast.Ellipsis:
+			replaceEmptyInterface(&t.Elt, &replaced)
+		case *ast.StructType:
+			replaceEmptyInterfacesInFields(t.Fields, &replaced)
+		}
+		return true
+	})
+	replaceEmptyInterface(&ef.expr, &replaced)
+	if !replaced {
+		return ef, nil
+	}
+	sb := strings.Builder{}
+	if err := format.Node(&sb, token.NewFileSet(), ef.expr); err != nil {
+		return extraField{}, err
+	}
+	ef.typeStr = sb.String()
+	return ef, nil
+}
+
+func replaceEmptyInterfacesInFields(fields *ast.FieldList, replaced *bool) {
+	if fields == nil {
+		return
+	}
+	for _, field := range fields.List {
+		replaceEmptyInterface(&field.Type, replaced)
+	}
+}
+
+func replaceEmptyInterface(expr *ast.Expr, replaced *bool) {
+	iface, ok := (*expr).(*ast.InterfaceType)
+	if !ok || iface.Methods == nil || len(iface.Methods.List) > 0 {
+		return
+	}
+	*expr = ast.NewIdent("any")
+	*replaced = true
+}
+
+type embeddedStruct struct {
+	at        aType
+	isPointer bool
+}
+
+func strToEmbeddedStruct(s string) (*embeddedStruct, error) {
+	es := &embeddedStruct{}
+	if strings.HasPrefix(s, "*") {
+		es.isPointer = true
+		s = s[1:]
+	}
+	at, err := strToAType(s)
+	if err != nil {
+		return nil, err
+	}
+	es.at = at
+	return es, nil
+}
+
+func (es *embeddedStruct) String() string {
+	if es.isPointer {
+		return fmt.Sprintf("*%s", es.at)
+	}
+	return es.at.String()
+}
+
+// paramName returns a name of the new func parameter for the
+// embedded struct.
+func (es *embeddedStruct) paramName() string {
+	return strings.ToLower(es.at.name[:1]) + es.at.name[1:]
+}
+
+// concreteType is the type given with -wrapped-concrete, either a
+// named type or a pointer to a named type.
+type concreteType struct {
+	at        aType
+	isPointer bool
+}
+
+func strToConcreteType(s string) (*concreteType, error) {
+	ct := &concreteType{}
+	if strings.HasPrefix(s, "*") {
+		ct.isPointer = true
+		s = s[1:]
+	}
+	at, err := strToAType(s)
+	if err != nil {
+		return nil, err
+	}
+	ct.at = at
+	return ct, nil
+}
+
+func (ct *concreteType) String() string {
+	if ct.isPointer {
+		return fmt.Sprintf("*%s", ct.at)
+	}
+	return ct.at.String()
+}
+
+type resolvedType struct {
+	at          aType
+	rt          *types.Named
+	origPkgName string // empty for builtin types
+	pkgPath     string // empty for builtin types
+	// generic is true for the generic base type given without
+	// the type arguments and for the extension types instantiated
+	// with its type parameters.
+	generic bool
+}
+
+type silentFailureType struct{}
+
+var (
+	silentFailure silentFailureType
+	_             error = silentFailure
+)
+
+func (silentFailureType) Error() string {
+	return ""
+}
+
+// Exit codes of wrappergen, so scripts can tell the kinds of
+// failures apart.
+const (
+	// exitCodeFailure is for errors of no particular kind, like
+	// an outdated outfile found by the diff command or warnings
+	// in strict mode.
+	exitCodeFailure = 1
+	// exitCodeInput is for invalid flags or their values.
+	exitCodeInput = 2
+	// exitCodeResolve is for types that could not be found or
+	// loaded.
+	exitCodeResolve = 3
+	// exitCodeAnalysis is for types that could be found, but
+	// can't be wrapped as requested.
+	exitCodeAnalysis = 4
+	// exitCodeWrite is for failures to write the generated code.
+	exitCodeWrite = 5
+	// exitCodeBug is for bugs in wrappergen itself, exit codes
+	// from 70 up are reserved for them.
+	exitCodeBug = 70
+)
+
+// exitCodeError is an error with the exit code wrappergen should
+// exit with because of it.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
+}
+
+// withExitCode attaches the exit code to the error, unless it
+// already has one.
+func withExitCode(code int, err error) error {
+	var ecErr *exitCodeError
+	if errors.As(err, &ecErr) {
+		return err
+	}
+	return &exitCodeError{
+		code: code,
+		err:  err,
+	}
+}
+
+// exitCode returns the exit code for the error.
+func exitCode(err error) int {
+	var ecErr *exitCodeError
+	if errors.As(err, &ecErr) {
+		return ecErr.code
+	}
+	return exitCodeFailure
+}
+
+// Main runs wrappergen with the command and the flags from the
+// command line, and exits with a non-zero exit code on failure.
+func Main() {
+	if err := mainErr(); err != nil {
+		var bErr *bugError
+		if errors.As(err, &bErr) {
+			printWithPrefix("BUG", "%v", err)
+		} else if !errors.Is(err, silentFailure) {
+			printWithPrefix("ERROR", "%v", err)
+		}
+		os.Exit(exitCode(err))
+	}
+}
+
+const (
+	commandGenerate = "generate"
+	commandList     = "list"
+	commandDiff     = "diff"
+)
+
+func mainErr() (err error) {
+	defer recoverBug(&err)
+	command, args := splitCommand(os.Args[1:])
+	switch command {
+	case commandGenerate:
+		return generateCommand(args)
+	case commandList:
+		return listCommand(os.Stdout, args)
+	case commandDiff:
+		return diffCommand(args)
+	}
+	return withExitCode(exitCodeInput, fmt.Errorf("unknown command %s, expected one of %s, %s or %s", command, commandGenerate, commandList, commandDiff))
+}
+
+// splitCommand returns the command and its arguments. For backwards
+// compatibility, if there is no command, but flags, the generate
+// command is assumed.
+func splitCommand(args []string) (string, []string) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return commandGenerate, args
+	}
+	return args[0], args[1:]
+}
+
+func parseArgs(command string, args, environ []string) (*parsedInput, error) {
+	pis, err := parseFamilies(command, args, environ)
+	if err != nil {
+		return nil, err
+	}
+	if len(pis) != 1 {
+		return nil, withExitCode(exitCodeInput, fmt.Errorf("expected one base type, got %d", len(pis)))
+	}
+	return pis[0], nil
+}
+
+// parseFamilies parses the arguments into the inputs of the wrapper
+// families to generate. There is more than one family only with
+// -interface-pattern.
+func parseFamilies(command string, args, environ []string) ([]*parsedInput, error) {
+	flagset := flag.NewFlagSet(fmt.Sprintf("wrappergen %s", command), flag.ContinueOnError)
+	fi := &flagsInput{}
+	fi.configureFlagSet(flagset)
+	if err := fi.parseFlagsAndEnvironment(flagset, args, environ); err != nil {
+		return nil, withExitCode(exitCodeInput, err)
+	}
+	if err := fi.ensureValid(); err != nil {
+		return nil, withExitCode(exitCodeInput, err)
+	}
+	fis := []*flagsInput{fi}
+	if fi.interfacePattern != "" {
+		expanded, err := fi.expandInterfacePattern()
+		if err != nil {
+			return nil, err
+		}
+		fis = expanded
+	}
+	pis := make([]*parsedInput, 0, len(fis))
+	outFiles := make(map[string]string, len(fis))
+	for _, fi := range fis {
+		pi := &parsedInput{}
+		if err := pi.parseInput(fi); err != nil {
+			return nil, withExitCode(exitCodeInput, err)
+		}
+		if other, ok := outFiles[pi.outFile]; ok {
+			return nil, withExitCode(exitCodeInput, fmt.Errorf("both %s and %s would be generated into %s, use -outfile-template to put them into different files", other, pi.baseType, pi.outFile))
+		}
+		outFiles[pi.outFile] = pi.baseType.String()
+		pis = append(pis, pi)
+	}
+	return pis, nil
+}
+
+func generateCommand(args []string) error {
+	pis, err := parseFamilies(commandGenerate, args, os.Environ())
+	if err != nil {
+		return err
+	}
+	for _, pi := range pis {
+		if pi.missingImpls {
+			if err := listMissingImpls(os.Stdout, pi, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := generateAndWrite(pi, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateAndWrite(pi *parsedInput, args []string) error {
+	files, err := generateFiles(pi, args)
+	if err != nil {
+		var mtErr *missingTypeError
+		if pi.allowMissing && errors.As(err, &mtErr) {
+			pi.warnings.warn("not generating %s: %v", pi.outFile, err)
+			return pi.warnings.strictError()
+		}
+		return err
+	}
+	if pi.outDir != "" {
+		if err := os.MkdirAll(pi.outDir, 0755); err != nil {
+			return withExitCode(exitCodeWrite, fmt.Errorf("failed to create the directory %s from -out-dir: %w", pi.outDir, err))
+		}
+	}
+	for _, outFile := range sortedFileNames(files) {
+		if err := ioutil.WriteFile(outFile, files[outFile], 0644); err != nil {
+			return withExitCode(exitCodeWrite, fmt.Errorf("failed to write source to outfile %s: %w", outFile, err))
+		}
+	}
+	return nil
+}
+
+// listCommand prints the methods the wrappers will implement, so
+// the prefix functions can be written. If there are several wrapper
+// families, the methods of each are preceded by its base type.
+func listCommand(w io.Writer, args []string) error {
+	pis, err := parseFamilies(commandList, args, os.Environ())
+	if err != nil {
+		return err
+	}
+	for _, pi := range pis {
+		indent := ""
+		if len(pis) > 1 {
+			fmt.Fprintf(w, "%s:\n", pi.baseType)
+			indent = "\t"
+		}
+		list := listMethods
+		if pi.missingImpls {
+			list = listMissingImpls
+		}
+		if err := list(w, pi, indent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listMethods(w io.Writer, pi *parsedInput, indent string) error {
+	rt, ta, err := resolveAndAnalyze(pi)
+	if err != nil {
+		return err
+	}
+	methods := ta.allMethods(rt)
+	for _, mi := range pi.extraMethods {
+		methods[mi.name] = mi
+	}
+	for _, name := range sortedMethodNames(methods) {
+		mi := methods[name]
+		fmt.Fprintf(w, "%s%s(%s)", indent, mi.name, (parametersFull)(mi.parameters))
+		switch len(mi.returnTypes) {
+		case 0:
+			// nothing to print
+		case 1:
+			fmt.Fprintf(w, " %s", mi.returnTypes[0])
+		default:
+			fmt.Fprintf(w, " (%s)", strings.Join(mi.returnTypes, ", "))
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	return nil
+}
+
+// listMissingImpls prints the signatures of the prefix functions and
+// the result hook functions that are not in the package of the infile
+// yet.
+func listMissingImpls(w io.Writer, pi *parsedInput, indent string) error {
+	rt, ta, err := resolveAndAnalyze(pi)
+	if err != nil {
+		return err
+	}
+	for _, rf := range requiredFuncs(rt, ta, pi) {
+		if !rf.Present {
+			fmt.Fprintf(w, "%s%s\n", indent, rf.Signature)
+		}
+	}
+	return nil
+}
+
+// RequiredFunc is a function the generated code calls, which the
+// package of the infile has to provide.
+type RequiredFunc struct {
+	// Signature is the declaration of the function without the
+	// body, or the method of the hooks interface with
+	// -hooks-interface.
+	Signature string
+	// Present is true if the package of the infile (or the hooks
+	// interface) already has the function.
+	Present bool
+}
+
+// requiredFuncs returns the prefix functions, the result hook
+// functions and the other functions the generated code calls.
+func requiredFuncs(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) []RequiredFunc {
+	var funcs []RequiredFunc
+	inPkg := func(name string) bool {
+		return rt.thisPkgScope.Lookup(name) != nil
+	}
+	extraFieldTypes := make(map[string]string, len(pi.extraFields))
+	for _, ef := range pi.extraFields {
+		extraFieldTypes[ef.name] = ef.typeStr
+	}
+	// prints the extra fields and the parameters of the method
+	// in the order of -extras-position
+	printParams := func(w io.Writer, efNames []string, mi methodInfo) {
+		if len(mi.parameters) > 0 && pi.extrasPosition == extrasPositionAfter {
+			fmt.Fprintf(w, ", %s", (parametersFull)(mi.parameters))
+		}
+		for _, efName := range efNames {
+			fmt.Fprintf(w, ", %s %s", efName, extraFieldTypes[efName])
+		}
+		if len(mi.parameters) > 0 && pi.extrasPosition != extrasPositionAfter {
+			fmt.Fprintf(w, ", %s", (parametersFull)(mi.parameters))
+		}
+	}
+	results := func(mi methodInfo) string {
+		switch len(mi.returnTypes) {
+		case 0:
+			return ""
+		case 1:
+			return " " + mi.returnTypes[0]
+		default:
+			return fmt.Sprintf(" (%s)", strings.Join(mi.returnTypes, ", "))
+		}
+	}
+	if pi.metrics {
+		funcs = append(funcs, RequiredFunc{
+			Signature: fmt.Sprintf("func %s(method string)", metricsFuncName(pi)),
+			Present:   inPkg(metricsFuncName(pi)),
+		})
+	}
+	if pi.debugLog {
+		emptyIface := "interface{}"
+		if pi.useAny {
+			emptyIface = "any"
+		}
+		funcs = append(funcs, RequiredFunc{
+			Signature: fmt.Sprintf("func %s(method string, args ...%s)", debugLogFuncName(pi), emptyIface),
+			Present:   inPkg(debugLogFuncName(pi)),
+		})
+	}
+	if pi.stateTransitions != nil {
+		funcs = append(funcs, RequiredFunc{
+			Signature: fmt.Sprintf("func %s(method, state string)", invalidTransitionFuncName(pi)),
+			Present:   inPkg(invalidTransitionFuncName(pi)),
+		})
+	}
+	if pi.genSwitcher {
+		selectName := pi.prefix + "Select"
+		params := make([]string, 0, len(pi.extraFields))
+		for _, ef := range pi.extraFields {
+			params = append(params, fmt.Sprintf("%s %s", ef.name, ef.typeStr))
+		}
+		funcs = append(funcs, RequiredFunc{
+			Signature: fmt.Sprintf("func %s(%s) %s", selectName, strings.Join(params, ", "), rt.resolvedBaseType.at),
+			Present:   inPkg(selectName),
+		})
+	}
+	if pi.initFunc != "" {
+		funcs = append(funcs, RequiredFunc{
+			Signature: fmt.Sprintf("func %s()", pi.initFunc),
+			Present:   inPkg(pi.initFunc),
+		})
+	}
+	// the methods of the base type take precedence, like in the
+	// wrappers
+	type wrappedMethod struct {
+		mi      methodInfo
+		wrapped aType
+		generic bool
+	}
+	var wrappedMethods []wrappedMethod
+	seen := stringset.StringSet{}
+	for _, resType := range append([]resolvedType{rt.resolvedBaseType}, rt.resolvedExtTypes...) {
+		methods := make(map[string]methodInfo)
+		ta.collectMethods(resTypeInfo(resType), methods)
+		for _, name := range sortedMethodNames(methods) {
+			if seen.Has(name) {
+				continue
+			}
+			seen.Add(name)
+			wrappedMethods = append(wrappedMethods, wrappedMethod{mi: methods[name], wrapped: resType.at, generic: resType.generic})
+		}
+	}
+	for _, mi := range pi.extraMethods {
+		wrappedMethods = append(wrappedMethods, wrappedMethod{mi: mi, wrapped: rt.resolvedBaseType.at, generic: rt.resolvedBaseType.generic})
+	}
+	for _, wm := range wrappedMethods {
+		mi, name := wm.mi, wm.mi.name
+		funcName := pi.prefix + name
+		signature := &strings.Builder{}
+		if pi.hooksInterface != nil {
+			// the methods of the hooks interface are
+			// required instead
+			if !pi.passthrough.Has(name) {
+				fmt.Fprintf(signature, "%s(r %s", name, wm.wrapped)
+				printParams(signature, pi.hookExtraFieldNamesFor(name), mi)
+				fmt.Fprintf(signature, ")%s", results(mi))
+				funcs = append(funcs, RequiredFunc{
+					Signature: signature.String(),
+					Present:   rt.hookMethod(name) != nil,
+				})
+			}
+		} else if !pi.passthrough.Has(name) {
+			wrappedType := wm.wrapped.String()
+			if ct := pi.wrappedConcrete; ct != nil {
+				wrappedType = ct.String()
+			}
+			typeParams := ""
+			if wm.generic {
+				typeParams = pi.typeParamsDecl()
+			}
+			fmt.Fprintf(signature, "func %s%s(r %s", funcName, typeParams, wrappedType)
+			printParams(signature, pi.extraFieldNamesFor(name), mi)
+			fmt.Fprintf(signature, ")%s", results(mi))
+			funcs = append(funcs, RequiredFunc{
+				Signature: signature.String(),
+				Present:   inPkg(funcName),
+			})
+		}
+		hookName := funcName + "Result"
+		if pi.resultHooks.Has(name) {
+			funcs = append(funcs, RequiredFunc{
+				Signature: fmt.Sprintf("func %s(%s)%s", hookName, strings.Join(mi.returnTypes, ", "), results(mi)),
+				Present:   inPkg(hookName),
+			})
+		}
+	}
+	return funcs
+}
+
+// diffCommand checks if the outfile is up to date.
+func diffCommand(args []string) error {
+	pis, err := parseFamilies(commandDiff, args, os.Environ())
+	if err != nil {
+		return err
+	}
+	for _, pi := range pis {
+		files, err := generateFiles(pi, args)
+		if err != nil {
+			return err
+		}
+		for _, outFile := range sortedFileNames(files) {
+			if err := compareWithOutFile(outFile, files[outFile]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortedFileNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func compareWithOutFile(outFile string, src []byte) error {
+	current, err := ioutil.ReadFile(outFile)
+	if err != nil {
+		return fmt.Errorf("failed to read outfile %s: %w", outFile, err)
+	}
+	if !bytes.Equal(current, src) {
+		return fmt.Errorf("outfile %s is out of date", outFile)
+	}
+	return nil
+}
+
+func resolveAndAnalyze(pi *parsedInput) (*resolvedTypes, *typeAnalysis, error) {
+	rt := &resolvedTypes{}
+	if err := rt.resolveTypes(pi); err != nil {
+		return nil, nil, withExitCode(exitCodeResolve, err)
+	}
+	ta := &typeAnalysis{
+		useAny:        pi.useAny,
+		copyDoc:       pi.copyDoc,
+		exportMethods: pi.exportMethods,
+	}
+	if err := ta.analyze(rt, pi.imports); err != nil {
+		return nil, nil, withExitCode(exitCodeAnalysis, err)
+	}
+	if err := replaceConstraintBaseType(rt, ta, pi); err != nil {
+		return nil, nil, withExitCode(exitCodeAnalysis, err)
+	}
+	typeParams, err := analyzeTypeParams(rt, ta, pi)
+	if err != nil {
+		return nil, nil, withExitCode(exitCodeAnalysis, err)
+	}
+	pi.typeParams = typeParams
+	return rt, ta, nil
+}
+
+// analyzeTypeParams returns the type parameters of the generic
+// wrappers, or nil if the base type is not generic.
+func analyzeTypeParams(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) (*typeParamsInfo, error) {
+	if rt.typeParams == nil {
+		return nil, nil
+	}
+	decls := make([]string, 0, rt.typeParams.Len())
+	names := make([]string, 0, rt.typeParams.Len())
+	for idx := 0; idx < rt.typeParams.Len(); idx++ {
+		tparam := rt.typeParams.At(idx)
+		constraint := tparam.Constraint()
+		if iface, ok := constraint.(*types.Interface); ok && iface.IsImplicit() {
+			// like ~int | ~string in [T ~int | ~string]
+			constraint = iface.EmbeddedType(0)
+		}
+		constraintStr, err := ta.typeToStr(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to print the constraint of type parameter %s of %s: %w", tparam.Obj().Name(), rt.resolvedBaseType.at, err)
+		}
+		decls = append(decls, fmt.Sprintf("%s %s", tparam.Obj().Name(), constraintStr))
+		names = append(names, tparam.Obj().Name())
+	}
+	// like in the wrappers, the methods of the base type take
+	// precedence
+	methods := stringset.StringSet{}
+	seen := stringset.StringSet{}
+	for _, resType := range append([]resolvedType{rt.resolvedBaseType}, rt.resolvedExtTypes...) {
+		typeMethods := make(map[string]methodInfo)
+		ta.collectMethods(resTypeInfo(resType), typeMethods)
+		for name := range typeMethods {
+			if seen.Has(name) {
+				continue
+			}
+			seen.Add(name)
+			if resType.generic {
+				methods.Add(name)
+			}
+		}
+	}
+	for _, mi := range pi.extraMethods {
+		methods.Add(mi.name)
+	}
+	return &typeParamsInfo{
+		decl:    fmt.Sprintf("[%s]", strings.Join(decls, ", ")),
+		ref:     fmt.Sprintf("[%s]", strings.Join(names, ", ")),
+		methods: methods,
+	}, nil
+}
+
+// constraintMethodsSuffix is appended to the name of the base type
+// with type constraints to get the name of the interface with its
+// methods.
+const constraintMethodsSuffix = "Methods"
+
+// replaceConstraintBaseType replaces the base type with an interface
+// having only its methods, if the base type has type constraints.
+// Such interfaces can't be the types of values, so the wrappers
+// wrap the values of the replacement, which the generated code
+// declares.
+func replaceConstraintBaseType(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) error {
+	for _, resType := range rt.resolvedExtTypes {
+		if !resType.rt.Underlying().(*types.Interface).IsMethodSet() {
+			return fmt.Errorf("extension type %s has type constraints, only the base type can have them", resType.at)
+		}
+	}
+	orig := rt.resolvedBaseType
+	iface := orig.rt.Underlying().(*types.Interface)
+	if iface.IsMethodSet() {
+		return nil
+	}
+	if orig.generic {
+		return fmt.Errorf("generic base type %s has type constraints, it can't be wrapped without the type arguments", orig.at.name)
+	}
+	name := orig.at.name + constraintMethodsSuffix
+	// the interface from the previous generation is fine
+	if rt.handwrittenNames.Has(name) {
+		return fmt.Errorf("can't generate %s for the methods of %s, which has type constraints, the package of the infile already has it", name, orig.at)
+	}
+	pi.warnings.warn("base type %s has type constraints, they are ignored and the wrappers wrap the values of the generated %s interface with its methods", orig.at, name)
+	methods := make([]*types.Func, 0, iface.NumMethods())
+	for idx := 0; idx < iface.NumMethods(); idx++ {
+		methods = append(methods, iface.Method(idx))
+	}
+	obj := types.NewTypeName(token.NoPos, nil, name, nil)
+	replacement := resolvedType{
+		at: aType{
+			name: name,
+		},
+		rt:          types.NewNamed(obj, types.NewInterfaceType(methods, nil).Complete(), nil),
+		origPkgName: rt.thisPkgName,
+		pkgPath:     rt.thisPkgPath,
+	}
+	ta.insert(resTypeInfo(replacement), []pkgPathAndName{resTypeInfo(orig)}, nil)
+	rt.resolvedBaseType = replacement
+	rt.constraintBaseType = &orig
+	return nil
+}
+
+// printConstraintMethodsType prints the interface with the methods of
+// the base type that has type constraints.
+func printConstraintMethodsType(w io.Writer, rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) {
+	if !pi.compact {
+		fmt.Fprintf(w, "// %s has the methods of %s without its type constraints.\n", rt.resolvedBaseType.at, rt.constraintBaseType.at)
+	}
+	fmt.Fprintf(w, "type %s interface {\n", rt.resolvedBaseType.at)
+	methods := make(map[string]methodInfo)
+	ta.collectMethods(resTypeInfo(*rt.constraintBaseType), methods)
+	for _, name := range sortedMethodNames(methods) {
+		mi := methods[name]
+		fmt.Fprintf(w, "\t%s(%s)", mi.name, (parametersFull)(mi.parameters))
+		switch len(mi.returnTypes) {
+		case 0:
+			// nothing to print
+		case 1:
+			fmt.Fprintf(w, " %s", mi.returnTypes[0])
+		default:
+			fmt.Fprintf(w, " (%s)", strings.Join(mi.returnTypes, ", "))
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// generatedSections holds the parts of the generated code. Each
+// section except the header and imports starts with an empty line.
+type generatedSections struct {
+	header  bytes.Buffer
+	imports bytes.Buffer
+	types   bytes.Buffer
+	impls   bytes.Buffer
+	newFunc bytes.Buffer
+	adapter bytes.Buffer
+	init    bytes.Buffer
+	// tagged holds the complete files with the wrappers of the
+	// combinations from -combination-tags, keyed by the build
+	// tags.
+	tagged map[string]*bytes.Buffer
+	// driverConformance holds the test from
+	// -gen-driver-conformance, it goes to a separate file.
+	driverConformance bytes.Buffer
+	// combinationTest holds the test from -gen-combination-test,
+	// it goes to a separate file.
+	combinationTest bytes.Buffer
+}
+
+// Generate generates the wrappers and returns the code of the
+// outfile together with what the analysis found out while generating
+// it, so the embedding tools do not need to run the analysis
+// again. The arguments are the flags of the generate command, the
+// infile needs to be given with -infile, the GOFILE environment
+// variable is not used. The code is returned as a single file, the
+// outfile is not written, so the flags generating other files or
+// a region of the outfile can't be used. A bug in the generator is
+// returned as an error too.
+//
+// If transform is not nil, it is called with the parsed generated
+// code before it is formatted, so it can modify it, like add
+// annotations or reorder the declarations.
+func Generate(args []string, transform func(*ast.File) error) (result *Result, err error) {
+	defer recoverBug(&err)
+	pi, err := parseArgs(commandGenerate, args, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the flags producing more files than the outfile or
+	// changing the outfile in place
+	unsupportedFlags := []struct {
+		name string
+		used bool
+	}{
+		{"-combination-tags", pi.combinationTags != nil},
+		{"-region", pi.region != ""},
+		{"-gen-driver-conformance", pi.genDriverConformance},
+		{"-gen-combination-test", pi.genCombinationTest},
+	}
+	for _, unsupported := range unsupportedFlags {
+		if unsupported.used {
+			return nil, withExitCode(exitCodeInput, fmt.Errorf("%s can't be used with Generate, it returns only the code of the outfile", unsupported.name))
+		}
+	}
+	pi.astTransform = transform
+	return generateWithResult(pi, args)
+}
+
+// GenerateSource is like Generate, but it returns only the generated
+// code.
+func GenerateSource(args []string, transform func(*ast.File) error) ([]byte, error) {
+	result, err := Generate(args, transform)
+	if err != nil {
+		return nil, err
+	}
+	return result.Source, nil
+}
+
+func generate(pi *parsedInput, args []string) ([]byte, error) {
+	result, err := generateWithResult(pi, args)
+	if err != nil {
+		return nil, err
+	}
+	return result.Source, nil
+}
+
+// Result is the generated code together with what the analysis found
+// out while generating it.
+type Result struct {
+	// Source is the generated code.
+	Source []byte
+	// Funcs are the functions the generated code calls, which
+	// the package of the infile has to provide.
+	Funcs []RequiredFunc
+	// Imports are the imports of the generated code, sorted by
+	// path.
+	Imports []Import
+	// Warnings are the warnings reported while parsing the input
+	// and generating the code.
+	Warnings []string
+}
+
+// Import is an import of the generated code.
+type Import struct {
+	// Name is empty if the code uses the name of the package.
+	Name string
+	Path string
+}
+
+func generateWithResult(pi *parsedInput, args []string) (*Result, error) {
+	rt, ta, secs, err := analyzeAndGenerateSections(pi, args)
+	if err != nil {
+		return nil, err
+	}
+	return resultFromSections(rt, ta, secs, pi)
+}
+
+// resultFromSections puts the generated sections into a single file.
+func resultFromSections(rt *resolvedTypes, ta *typeAnalysis, secs *generatedSections, pi *parsedInput) (*Result, error) {
+	buf := &bytes.Buffer{}
+	for _, sec := range []*bytes.Buffer{&secs.header, &secs.imports, &secs.types, &secs.impls, &secs.newFunc, &secs.adapter, &secs.init} {
+		buf.Write(sec.Bytes())
+	}
+	var keepNames func(*token.FileSet, *ast.File) error
+	if pi.appendMode {
+		var err error
+		keepNames, err = keepCombinationNames(rt, pi)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fix := keepNames
+	if len(pi.extraImports) > 0 {
+		fix = func(fset *token.FileSet, file *ast.File) error {
+			if err := blankUnusedImports(file, extraImportPaths(pi)); err != nil {
+				return err
+			}
+			if keepNames != nil {
+				return keepNames(fset, file)
+			}
+			return nil
+		}
+	}
+	src, err := finishFile(pi, buf, fix)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{
+		Source:   src,
+		Funcs:    requiredFuncs(rt, ta, pi),
+		Warnings: append([]string(nil), pi.warnings.warnings...),
+	}
+	for pkgPath, name := range ta.imports {
+		result.Imports = append(result.Imports, Import{Name: name, Path: pkgPath})
+	}
+	sort.Slice(result.Imports, func(i, j int) bool {
+		return result.Imports[i].Path < result.Imports[j].Path
+	})
+	return result, nil
+}
+
+// generateFiles returns the generated code keyed by the paths of the
+// files it should be written to. With -self-idempotent, the code is
+// generated twice and the results are compared.
+func generateFiles(pi *parsedInput, args []string) (map[string][]byte, error) {
+	files, err := generateFilesOnce(pi, args)
+	if err != nil || !pi.selfIdempotent {
+		return files, err
+	}
+	pi.warnings.quiet = true
+	again, err := generateFilesOnce(pi, args)
+	pi.warnings.quiet = false
+	if err != nil {
+		return nil, fmt.Errorf("generating for the second time failed: %w", err)
+	}
+	if err := compareGenerations(files, again); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// compareGenerations returns an error if the two generations gave
+// different files or different contents of the files.
+func compareGenerations(first, second map[string][]byte) error {
+	allFiles := stringset.StringSet{}
+	for outFile := range first {
+		allFiles.Add(outFile)
+	}
+	for outFile := range second {
+		allFiles.Add(outFile)
+	}
+	for _, outFile := range allFiles.ToSlice() {
+		firstSrc, inFirst := first[outFile]
+		secondSrc, inSecond := second[outFile]
+		if !inFirst || !inSecond {
+			return fmt.Errorf("generation is not deterministic, only one of two generations produced %s", outFile)
+		}
+		if !bytes.Equal(firstSrc, secondSrc) {
+			return fmt.Errorf("generation is not deterministic, two generations produced different contents of %s", outFile)
+		}
+	}
+	return nil
+}
+
+func generateFilesOnce(pi *parsedInput, args []string) (map[string][]byte, error) {
+	rt, ta, secs, err := analyzeAndGenerateSections(pi, args)
+	if err != nil {
+		return nil, err
+	}
+	files, err := filesFromSections(rt, ta, secs, pi, args)
+	if err != nil {
+		return nil, err
+	}
+	if pi.genDriverConformance {
+		src, err := finishFile(pi, &secs.driverConformance, unusedImportsRemover(nil))
+		if err != nil {
+			return nil, err
+		}
+		files[driverConformanceTestFile(pi.outFile)] = src
+	}
+	if pi.genCombinationTest {
+		src, err := finishFile(pi, &secs.combinationTest, unusedImportsRemover(nil))
+		if err != nil {
+			return nil, err
+		}
+		files[combinationTestFile(pi.outFile)] = src
+	}
+	return files, nil
+}
+
+func filesFromSections(rt *resolvedTypes, ta *typeAnalysis, secs *generatedSections, pi *parsedInput, args []string) (map[string][]byte, error) {
+	if pi.region != "" {
+		src, err := generateRegion(secs, pi, args)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{
+			pi.outFile: src,
+		}, nil
+	}
+	if !pi.splitFiles && len(pi.combinationTags) == 0 {
+		result, err := resultFromSections(rt, ta, secs, pi)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{
+			pi.outFile: result.Source,
+		}, nil
+	}
+	// the extra imports are kept in the types file even if
+	// unused (as blank imports), this is what -extra-imports is
+	// for
+	extraImports := extraImportPaths(pi)
+	outFileBase := strings.TrimSuffix(pi.outFile, ".go")
+	if !pi.splitFiles {
+		// some imports may be used only by the wrappers that
+		// were moved to the tagged files
+		files := make(map[string][]byte, len(secs.tagged)+1)
+		buf := &bytes.Buffer{}
+		for _, sec := range []*bytes.Buffer{&secs.header, &secs.imports, &secs.types, &secs.impls, &secs.newFunc, &secs.adapter, &secs.init} {
+			buf.Write(sec.Bytes())
+		}
+		src, err := finishFile(pi, buf, unusedImportsRemover(extraImports))
+		if err != nil {
+			return nil, err
+		}
+		files[pi.outFile] = src
+		for tag, tagged := range secs.tagged {
+			src, err := finishFile(pi, tagged, unusedImportsRemover(nil))
+			if err != nil {
+				return nil, err
+			}
+			files[outFileBase+"_"+tag+".go"] = src
+		}
+		return files, nil
+	}
+	files := make(map[string][]byte, 3)
+	for _, part := range []struct {
+		suffix   string
+		sections []*bytes.Buffer
+		keep     stringset.StringSet
+	}{
+		{"_types.go", []*bytes.Buffer{&secs.types, &secs.adapter}, extraImports},
+		{"_impls.go", []*bytes.Buffer{&secs.impls}, nil},
+		{"_new.go", []*bytes.Buffer{&secs.newFunc, &secs.init}, nil},
+	} {
+		buf := &bytes.Buffer{}
+		buf.Write(secs.header.Bytes())
+		buf.Write(secs.imports.Bytes())
+		for _, sec := range part.sections {
+			buf.Write(sec.Bytes())
+		}
+		src, err := finishFile(pi, buf, unusedImportsRemover(part.keep))
+		if err != nil {
+			return nil, err
+		}
+		files[outFileBase+part.suffix] = src
+	}
+	return files, nil
+}
+
+const (
+	regionBeginMarker = "// wrappergen:begin"
+	regionEndMarker   = "// wrappergen:end"
+)
+
+// generateRegion generates the code into the region of the outfile
+// delimited by the wrappergen:begin and wrappergen:end comments
+// followed by the region name. The rest of the outfile is kept
+// intact, so several regions and hand-written code can live in one
+// file. The region is appended to the outfile if it has none yet
+// and the outfile is created if it does not exist.
+func generateRegion(secs *generatedSections, pi *parsedInput, args []string) ([]byte, error) {
+	region := &bytes.Buffer{}
+	fmt.Fprintf(region, "%s %s\n", regionBeginMarker, pi.region)
+	fmt.Fprintf(region, "// Code in this region is generated by \"wrappergen %s\"; DO NOT EDIT.\n", strings.Join(args, " "))
+	for _, sec := range []*bytes.Buffer{&secs.types, &secs.impls, &secs.newFunc, &secs.adapter} {
+		region.Write(sec.Bytes())
+	}
+	current, err := ioutil.ReadFile(pi.outFile)
+	if os.IsNotExist(err) {
+		region.Write(secs.init.Bytes())
+		fmt.Fprintf(region, "\n%s %s", regionEndMarker, pi.region)
+		buf := &bytes.Buffer{}
+		buf.Write(secs.header.Bytes())
+		buf.Write(secs.imports.Bytes())
+		fmt.Fprintf(buf, "\n")
+		buf.Write(region.Bytes())
+		fmt.Fprintf(buf, "\n")
+		return finishFile(pi, buf, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outfile %s: %w", pi.outFile, err)
+	}
+	begin, end, initCalled, err := findRegion(pi.outFile, current, pi.region, pi.initFunc)
+	if err != nil {
+		return nil, err
+	}
+	// other regions of the file may share the init func, it
+	// should be called only once
+	if !initCalled {
+		region.Write(secs.init.Bytes())
+	}
+	fmt.Fprintf(region, "\n%s %s", regionEndMarker, pi.region)
+	buf := &bytes.Buffer{}
+	if begin < 0 {
+		buf.Write(current)
+		if !bytes.HasSuffix(current, []byte("\n")) {
+			fmt.Fprintf(buf, "\n")
+		}
+		fmt.Fprintf(buf, "\n")
+		buf.Write(region.Bytes())
+		fmt.Fprintf(buf, "\n")
+	} else {
+		buf.Write(current[:begin])
+		buf.Write(region.Bytes())
+		buf.Write(current[end:])
+	}
+	fset := token.NewFileSet()
+	generatedImports, err := parser.ParseFile(fset, "", append(secs.header.Bytes(), secs.imports.Bytes()...), parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the generated imports: %w", err)
+	}
+	extraImports := extraImportPaths(pi)
+	return finishFile(pi, buf, func(fset *token.FileSet, file *ast.File) error {
+		for _, spec := range generatedImports.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return fmt.Errorf("failed to unquote import path %s: %w", spec.Path.Value, err)
+			}
+			name := ""
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			astutil.AddNamedImport(fset, file, name, path)
+		}
+		// drop the imports used only by the previous version
+		// of the region
+		return removeUnusedImports(file, extraImports)
+	})
+}
+
+// findRegion returns the offsets of the beginning of the begin
+// marker and of the end of the end marker of the region in the
+// outfile. The offsets are negative if there is no such region. It
+// also tells whether an init function outside of the region already
+// calls the init func.
+func findRegion(outFile string, src []byte, name, initFunc string) (int, int, bool, error) {
+	begin, end, initCalled := -1, -1, false
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, outFile, src, parser.ParseComments)
+	if err != nil {
+		return begin, end, initCalled, fmt.Errorf("failed to parse outfile %s: %w", outFile, err)
+	}
+	beginText := fmt.Sprintf("%s %s", regionBeginMarker, name)
+	endText := fmt.Sprintf("%s %s", regionEndMarker, name)
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			switch c.Text {
+			case beginText:
+				if begin >= 0 {
+					return begin, end, initCalled, fmt.Errorf("region %s begins more than once in outfile %s", name, outFile)
+				}
+				begin = fset.Position(c.Pos()).Offset
+			case endText:
+				if end >= 0 {
+					return begin, end, initCalled, fmt.Errorf("region %s ends more than once in outfile %s", name, outFile)
+				}
+				end = fset.Position(c.End()).Offset
+			}
+		}
+	}
+	switch {
+	case begin < 0 && end < 0:
+	case begin < 0:
+		return begin, end, initCalled, fmt.Errorf("region %s in outfile %s has an end, but no beginning", name, outFile)
+	case end < 0:
+		return begin, end, initCalled, fmt.Errorf("region %s in outfile %s has a beginning, but no end", name, outFile)
+	case end < begin:
+		return begin, end, initCalled, fmt.Errorf("region %s in outfile %s ends before it begins", name, outFile)
+	}
+	if initFunc == "" {
+		return begin, end, initCalled, nil
+	}
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil || fd.Name.Name != "init" || fd.Body == nil {
+			continue
+		}
+		if offset := fset.Position(fd.Pos()).Offset; offset >= begin && offset < end {
+			continue
+		}
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok {
+				if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == initFunc {
+					initCalled = true
+				}
+			}
+			return !initCalled
+		})
+	}
+	return begin, end, initCalled, nil
+}
+
+// finishFile transforms, formats and normalizes the generated
+// code. The imports of the file may be fixed up, so the split files
+// keep only the ones they use and the regions bring theirs.
+func finishFile(pi *parsedInput, buf *bytes.Buffer, fixImports func(*token.FileSet, *ast.File) error) ([]byte, error) {
+	if pi.astTransform != nil {
+		if err := transformAST(buf, func(_ *token.FileSet, file *ast.File) error {
+			return pi.astTransform(file)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if fixImports != nil {
+		if err := transformAST(buf, fixImports); err != nil {
+			return nil, err
+		}
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		pi.warnings.warn("failed to format the code, compile to see what's wrong: %v", err)
+		src = buf.Bytes()
+	} else if pi.indent == indentSpaces {
+		src, err = reprint(src, pi.printerConfig())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pi.normalizeWhitespace {
+		src = normalizeWhitespace(src)
+	}
+	if err := pi.warnings.strictError(); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+// unusedImportsRemover returns an AST transformation removing the
+// imports the file does not use, except the ones to keep.
+func unusedImportsRemover(keep stringset.StringSet) func(*token.FileSet, *ast.File) error {
+	return func(_ *token.FileSet, file *ast.File) error {
+		return removeUnusedImports(file, keep)
+	}
+}
+
+func removeUnusedImports(file *ast.File, keep stringset.StringSet) error {
+	if err := blankUnusedImports(file, keep); err != nil {
+		return err
+	}
+	used := make(map[*ast.ImportSpec]bool, len(file.Imports))
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return fmt.Errorf("failed to unquote import path %s: %w", spec.Path.Value, err)
+		}
+		// blank and dot imports can't be checked for uses
+		sideEffect := spec.Name != nil && (spec.Name.Name == "_" || spec.Name.Name == ".")
+		used[spec] = sideEffect || astutil.UsesImport(file, path)
+	}
+	imports := file.Imports[:0]
+	for _, spec := range file.Imports {
+		if used[spec] {
+			imports = append(imports, spec)
+		}
+	}
+	file.Imports = imports
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		specs := gd.Specs[:0]
+		for _, spec := range gd.Specs {
+			if used[spec.(*ast.ImportSpec)] {
+				specs = append(specs, spec)
+			}
+		}
+		gd.Specs = specs
+	}
+	return nil
+}
+
+// blankUnusedImports turns the imports from the paths the file does
+// not use into blank imports, so they are kept, but the file still
+// compiles.
+func blankUnusedImports(file *ast.File, paths stringset.StringSet) error {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return fmt.Errorf("failed to unquote import path %s: %w", spec.Path.Value, err)
+		}
+		if !paths.Has(path) || astutil.UsesImport(file, path) {
+			continue
+		}
+		spec.Name = ast.NewIdent("_")
+	}
+	return nil
+}
+
+// extraImportPaths returns the paths of the imports from
+// -extra-imports.
+func extraImportPaths(pi *parsedInput) stringset.StringSet {
+	paths := stringset.StringSet{}
+	for _, imprt := range pi.extraImports {
+		paths.Add(imprt.path)
+	}
+	return paths
+}
+
+func analyzeAndGenerateSections(pi *parsedInput, args []string) (*resolvedTypes, *typeAnalysis, *generatedSections, error) {
+	rt, ta, err := resolveAndAnalyze(pi)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	secs, err := generateAnalyzedSections(rt, ta, pi, args)
+	if err != nil {
+		// the errors come from checking whether the analyzed
+		// types can be wrapped as requested
+		return nil, nil, nil, withExitCode(exitCodeAnalysis, err)
+	}
+	return rt, ta, secs, nil
+}
+
+func generateAnalyzedSections(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput, args []string) (*generatedSections, error) {
+	if pi.embedStruct != nil {
+		if err := checkEmbeddedStructCollisions(rt, ta, pi); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkPackageName(rt, ta, pi); err != nil {
+		return nil, err
+	}
+	if err := checkFieldCollisions(rt, ta, pi); err != nil {
+		return nil, err
+	}
+	if pi.typeParams != nil {
+		if err := checkGenericWrappersFlags(rt, pi); err != nil {
+			return nil, err
+		}
+	}
+	if err := ta.addExtraImports(pi.extraImports); err != nil {
+		return nil, err
+	}
+	warnAboutNoOpExtTypes(rt, ta, pi.warnings)
+	var ifaceNames, buildTags []string
+	if pi.strategy != strategySparse {
+		ifaceNames = combinationIfaceNames(rt, pi)
+		tags, err := combinationBuildTags(rt, pi)
+		if err != nil {
+			return nil, err
+		}
+		buildTags = tags
+	}
+	if pi.forwardTemplate != nil {
+		if err := checkForwardTargets(rt, ta, pi); err != nil {
+			return nil, err
+		}
+	}
+	if len(pi.resultHooks) > 0 {
+		methods := ta.allMethods(rt)
+		for _, method := range pi.resultHooks.ToSlice() {
+			mi, ok := methods[method]
+			if !ok {
+				return nil, fmt.Errorf("method %s from -result-hook is not a method of the wrapped interfaces", method)
+			}
+			if len(mi.returnTypes) == 0 {
+				return nil, fmt.Errorf("method %s from -result-hook returns nothing", method)
+			}
+		}
+	}
+	if len(pi.passthrough) > 0 {
+		methods := ta.allMethods(rt)
+		for _, method := range pi.passthrough.ToSlice() {
+			if _, ok := methods[method]; !ok {
+				return nil, fmt.Errorf("method %s from -passthrough-methods is not a method of the wrapped interfaces", method)
+			}
+		}
+	}
+	if pi.receiverName != "" {
+		if err := checkReceiverName(rt, ta, pi); err != nil {
+			return nil, err
+		}
+	}
+	if len(pi.extraMethods) > 0 {
+		methods := ta.allMethods(rt)
+		for _, mi := range pi.extraMethods {
+			if _, ok := methods[mi.name]; ok {
+				return nil, fmt.Errorf("extra method %s is already a method of the wrapped interfaces", mi.name)
+			}
+		}
+	}
+	if pi.hooksInterface != nil {
+		methods := ta.allMethods(rt)
+		for _, name := range sortedMethodNames(methods) {
+			if pi.passthrough.Has(name) {
+				continue
+			}
+			if err := checkHookMethod(rt, pi, methods[name]); err != nil {
+				return nil, err
+			}
+		}
+		for _, mi := range pi.extraMethods {
+			if err := checkHookMethod(rt, pi, mi); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if pi.extrasOptIn != nil {
+		methods := ta.allMethods(rt)
+		optInMethods := make([]string, 0, len(pi.extrasOptIn))
+		for method := range pi.extrasOptIn {
+			optInMethods = append(optInMethods, method)
+		}
+		sort.Strings(optInMethods)
+		for _, method := range optInMethods {
+			if _, ok := methods[method]; !ok {
+				return nil, fmt.Errorf("method %s from -extras-opt-in is not a method of the wrapped interfaces", method)
+			}
+		}
+	}
+	var funcAdapterMethod methodInfo
+	if pi.genFuncAdapter {
+		mi, err := funcAdapterMethodInfo(rt, ta)
+		if err != nil {
+			return nil, err
+		}
+		funcAdapterMethod = mi
+	}
+	if pi.wrapAny != "" {
+		seen := stringset.StringSet{}
+		seen.Add(rt.resolvedBaseType.rt.String())
+		for _, resType := range rt.resolvedWrapAnyBases {
+			if seen.Has(resType.rt.String()) {
+				return nil, fmt.Errorf("base type %s is passed to %s more than once", resType.at, pi.wrapAny)
+			}
+			seen.Add(resType.rt.String())
+		}
+	}
+	if pi.accumulateErrors {
+		if _, ok := ta.allMethods(rt)["Err"]; ok {
+			return nil, errors.New("can't generate the Err method of -accumulate-errors, the wrapped interfaces already have a method with this name")
+		}
+	}
+	if pi.genCapabilityConsts {
+		if err := checkCapabilityConstNames(rt); err != nil {
+			return nil, err
+		}
+	}
+	if pi.deprecatedAlias != "" {
+		// the aliases from the previous generation are fine
+		for _, name := range deprecatedNames(rt, pi, ifaceNames) {
+			if rt.handwrittenNames.Has(name.old) {
+				return nil, fmt.Errorf("can't generate deprecated %s for %s, the package of the infile already has it", name.old, name.current)
+			}
+		}
+	}
+	if pi.metrics {
+		if _, ok := ta.allMethods(rt)["Count"]; ok && pi.forwardTemplate == nil && pi.hooksInterface == nil {
+			return nil, fmt.Errorf("can't generate the metrics calls, %s would be both the prefix function of the Count method and the counting function", metricsFuncName(pi))
+		}
+	}
+	if pi.debugLog && pi.forwardTemplate == nil && pi.hooksInterface == nil {
+		_, ok := ta.allMethods(rt)["Log"]
+		for _, mi := range pi.extraMethods {
+			ok = ok || mi.name == "Log"
+		}
+		if ok {
+			return nil, fmt.Errorf("can't generate the debug log calls, %s would be both the prefix function of the Log method and the logging function", debugLogFuncName(pi))
+		}
+	}
+	if pi.stateTransitions != nil {
+		methods := ta.allMethods(rt)
+		for _, mi := range pi.extraMethods {
+			methods[mi.name] = mi
+		}
+		stateMethods := make([]string, 0, len(pi.stateTransitions))
+		for method := range pi.stateTransitions {
+			stateMethods = append(stateMethods, method)
+		}
+		sort.Strings(stateMethods)
+		for _, method := range stateMethods {
+			if _, ok := methods[method]; !ok {
+				return nil, fmt.Errorf("method %s from -state-machine is not a method of the wrappers", method)
+			}
+		}
+		if _, ok := methods["InvalidTransition"]; ok && pi.forwardTemplate == nil && pi.hooksInterface == nil {
+			return nil, fmt.Errorf("can't generate the state machine, %s would be both the prefix function of the InvalidTransition method and the invalid transition function", invalidTransitionFuncName(pi))
+		}
+	}
+	if pi.genDriverConformance {
+		if bt := rt.resolvedBaseType; bt.pkgPath != "database/sql/driver" || bt.at.name != "Conn" {
+			return nil, fmt.Errorf("-gen-driver-conformance needs database/sql/driver.Conn as the base type, got %s", bt.at)
+		}
+	}
+	if pi.genSwitcher {
+		if _, ok := ta.allMethods(rt)["Select"]; ok {
+			return nil, fmt.Errorf("can't generate the switcher, %sSelect would be both the prefix function of the Select method and the selecting function", pi.prefix)
+		}
+	}
+	if pi.genClone {
+		if _, ok := ta.allMethods(rt)["Clone"]; ok {
+			return nil, errors.New("can't generate the Clone method, the wrapped interfaces already have a method with this name")
+		}
+	}
+	if pi.genFieldAccessors {
+		methods := ta.allMethods(rt)
+		for _, ef := range pi.extraFields {
+			accessor := fieldAccessorName(ef)
+			if _, ok := methods[accessor]; ok {
+				return nil, fmt.Errorf("can't generate the accessor of extra field %s, the wrapped interfaces already have a method named %s", ef.name, accessor)
+			}
+			if pi.genRebind && accessor == "Rebind" {
+				return nil, fmt.Errorf("can't generate the accessor of extra field %s, it would collide with the Rebind method", ef.name)
+			}
+		}
+	}
+	errorsPkgName := ""
+	if pi.useErrorsJoin {
+		// used by the methods, so it must be known before the
+		// imports are printed
+		errorsPkgName = ta.useImport("errors", "errors")
+	}
+	if pi.genRebind {
+		if _, ok := ta.allMethods(rt)["Rebind"]; ok {
+			return nil, errors.New("can't generate the Rebind method, the wrapped interfaces already have a method with this name")
+		}
+		if pi.rebindOnMismatch == rebindOnMismatchError {
+			errorsPkgName = ta.useImport("errors", "errors")
+		}
+	}
+
+	secs := &generatedSections{}
+	printHeader(&secs.header, rt, pi, args, "")
+	printImports(&secs.imports, ta)
+	fmt.Fprintf(&secs.types, "\n")
+	fmt.Fprintf(&secs.impls, "\n")
+	if rt.constraintBaseType != nil {
+		printConstraintMethodsType(&secs.types, rt, ta, pi)
+	}
+	if pi.strategy == strategySparse {
+		printSparseTypes(&secs.types, rt, pi)
+		if !pi.noAsserts {
+			printSparseVars(&secs.impls, rt, pi)
+			fmt.Fprintf(&secs.impls, "\n")
+		}
+		printSparseImpls(&secs.impls, rt, ta, pi)
+	} else {
+		if pi.genCapabilityConsts {
+			printCapabilityConsts(&secs.types, rt)
+			fmt.Fprintf(&secs.types, "\n")
+		}
+		printTypes(&secs.types, rt, pi, ifaceNames, buildTags, "")
+		if !pi.noAsserts {
+			printVars(&secs.impls, rt, pi, buildTags, "")
+			fmt.Fprintf(&secs.impls, "\n")
+		}
+		printImpls(&secs.impls, rt, ta, pi, buildTags, "")
+		taggedSet := stringset.StringSet{}
+		for _, tag := range buildTags {
+			if tag != "" {
+				taggedSet.Add(tag)
+			}
+		}
+		for _, tag := range taggedSet.ToSlice() {
+			tagged := &bytes.Buffer{}
+			printHeader(tagged, rt, pi, args, tag)
+			printImports(tagged, ta)
+			fmt.Fprintf(tagged, "\n")
+			printTypes(tagged, rt, pi, ifaceNames, buildTags, tag)
+			fmt.Fprintf(tagged, "\n")
+			if !pi.noAsserts {
+				printVars(tagged, rt, pi, buildTags, tag)
+				fmt.Fprintf(tagged, "\n")
+			}
+			printImpls(tagged, rt, ta, pi, buildTags, tag)
+			fmt.Fprintf(tagged, "\n")
+			printTaggedInit(tagged, rt, pi, ifaceNames, buildTags, tag)
+			if secs.tagged == nil {
+				secs.tagged = make(map[string]*bytes.Buffer)
+			}
+			secs.tagged[tag] = tagged
+		}
+	}
+	if pi.genRebind {
+		fmt.Fprintf(&secs.impls, "\n")
+		printRebindMethods(&secs.impls, rt, pi, ifaceNames, errorsPkgName)
+	}
+	if pi.genFieldAccessors {
+		fmt.Fprintf(&secs.impls, "\n")
+		printFieldAccessors(&secs.impls, rt, pi)
+	}
+	if pi.genClone {
+		fmt.Fprintf(&secs.impls, "\n")
+		printCloneMethods(&secs.impls, rt, pi)
+	}
+	fmt.Fprintf(&secs.newFunc, "\n")
+	if pi.strategy == strategySparse {
+		printSparseNewFunc(&secs.newFunc, rt, pi)
+	} else {
+		printNewFunc(&secs.newFunc, rt, pi, ifaceNames, buildTags)
+	}
+	if pi.genCapabilities {
+		fmt.Fprintf(&secs.newFunc, "\n")
+		printCapabilitiesFunc(&secs.newFunc, rt, pi)
+	}
+	if pi.deprecatedAlias != "" {
+		fmt.Fprintf(&secs.newFunc, "\n")
+		printDeprecatedAliases(&secs.newFunc, rt, pi, ifaceNames)
+	}
+	if pi.genFuncAdapter {
+		fmt.Fprintf(&secs.adapter, "\n")
+		printFuncAdapter(&secs.adapter, rt, pi, funcAdapterMethod)
+	}
+	if pi.genSwitcher {
+		fmt.Fprintf(&secs.adapter, "\n")
+		printSwitcher(&secs.adapter, rt, ta, pi)
+	}
+	if pi.wrapAny != "" {
+		fmt.Fprintf(&secs.adapter, "\n")
+		printWrapAny(&secs.adapter, rt, pi)
+	}
+	if pi.initFunc != "" {
+		fmt.Fprintf(&secs.init, "\nfunc init() {\n\t%s()\n}\n", pi.initFunc)
+	}
+	if pi.genDriverConformance {
+		printDriverConformanceTest(&secs.driverConformance, rt, ta, pi, args, ifaceNames)
+	}
+	if pi.genCombinationTest {
+		printCombinationTest(&secs.combinationTest, rt, ta, pi, args, ifaceNames)
+	}
+	return secs, nil
+}
+
+// printHeader prints the header of the generated file. The build tag,
+// if not empty, is put into a build constraint.
+func printHeader(w io.Writer, rt *resolvedTypes, pi *parsedInput, args []string, buildTag string) {
+	if len(pi.header) > 0 {
+		// The blank line keeps the header detached from the
+		// comment below and from the package clause.
+		w.Write(pi.header)
+		if !bytes.HasSuffix(pi.header, []byte("\n")) {
+			fmt.Fprintf(w, "\n")
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	if pi.region == "" {
+		// the file with regions is not generated as a whole,
+		// the regions are marked instead
+		fmt.Fprintf(w, "// Code generated by \"wrappergen %s\"; DO NOT EDIT.\n", strings.Join(args, " "))
+		fmt.Fprintf(w, "\n")
+	}
+	if buildTag != "" {
+		fmt.Fprintf(w, "//go:build %s\n", buildTag)
+		fmt.Fprintf(w, "\n")
+	}
+	fmt.Fprintf(w, "package %s\n", pi.outPkgName(rt))
+	fmt.Fprintf(w, "\n")
+}
+
+// printTestHeader prints the header of a generated test. Unlike
+// printHeader, it does not care about -region, the tests are always
+// generated as whole files.
+func printTestHeader(w io.Writer, rt *resolvedTypes, pi *parsedInput, args []string) {
+	if len(pi.header) > 0 {
+		w.Write(pi.header)
+		if !bytes.HasSuffix(pi.header, []byte("\n")) {
+			fmt.Fprintf(w, "\n")
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	fmt.Fprintf(w, "// Code generated by \"wrappergen %s\"; DO NOT EDIT.\n", strings.Join(args, " "))
+	fmt.Fprintf(w, "\n")
+	fmt.Fprintf(w, "package %s\n", pi.outPkgName(rt))
+	fmt.Fprintf(w, "\n")
+}
+
+// driverConformanceTestFile returns the path of the test from
+// -gen-driver-conformance, which is generated next to the outfile.
+func driverConformanceTestFile(outFile string) string {
+	return fmt.Sprintf("%s_conformance_test.go", strings.TrimSuffix(outFile, ".go"))
+}
+
+// printDriverConformanceTest prints a test running every combination
+// of the extension types through database/sql, once as is and once
+// wrapped. database/sql looks for the optional interfaces of the
+// connection with type assertions, so both runs must make the same
+// calls to the connection.
+func printDriverConformanceTest(w io.Writer, rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput, args []string, ifaceNames []string) {
+	testTA := &typeAnalysis{
+		imports:      make(map[string]string, len(ta.imports)),
+		inputImports: ta.inputImports,
+	}
+	for pkgPath, name := range ta.imports {
+		testTA.imports[pkgPath] = name
+	}
+	contextPkg := testTA.useImport("context", "context")
+	sqlPkg := testTA.useImport("database/sql", "sql")
+	driverPkg := testTA.useImport("database/sql/driver", "driver")
+	reflectPkg := testTA.useImport("reflect", "reflect")
+	testingPkg := testTA.useImport("testing", "testing")
+	testName := fmt.Sprintf("%s%s", strings.ToUpper(pi.newFuncName[:1]), pi.newFuncName[1:])
+	fakeName := fmt.Sprintf("conformance%sConn", testName)
+	connectorName := fmt.Sprintf("conformance%sConnector", testName)
+	runName := fmt.Sprintf("conformance%sRun", testName)
+
+	printTestHeader(w, rt, pi, args)
+	printImports(w, testTA)
+	fmt.Fprintf(w, "\n// %s records the calls database/sql makes, its methods\n// return zero values.\n", fakeName)
+	fmt.Fprintf(w, "type %s struct {\n\tcalls []string\n}\n", fakeName)
+	methods := ta.allMethods(rt)
+	for _, name := range sortedMethodNames(methods) {
+		mi := methods[name]
+		paramTypes := make([]string, 0, len(mi.parameters))
+		for _, param := range mi.parameters {
+			paramTypes = append(paramTypes, param.typeStr)
+		}
+		results := make([]string, 0, len(mi.returnTypes))
+		for idx, typeStr := range mi.returnTypes {
+			results = append(results, fmt.Sprintf("r%d %s", idx, typeStr))
+		}
+		fmt.Fprintf(w, "\nfunc (c *%s) %s(%s) (%s) {\n", fakeName, mi.name, strings.Join(paramTypes, ", "), strings.Join(results, ", "))
+		fmt.Fprintf(w, "\tc.calls = append(c.calls, %q)\n\treturn\n}\n", mi.name)
+	}
+	fmt.Fprintf(w, "\ntype %s struct {\n\tconn %s.Conn\n}\n", connectorName, driverPkg)
+	fmt.Fprintf(w, "\nfunc (c %s) Connect(%s.Context) (%s.Conn, error) {\n\treturn c.conn, nil\n}\n", connectorName, contextPkg, driverPkg)
+	fmt.Fprintf(w, "\nfunc (c %s) Driver() %s.Driver {\n\treturn c\n}\n", connectorName, driverPkg)
+	fmt.Fprintf(w, "\nfunc (c %s) Open(string) (%s.Conn, error) {\n\treturn c.conn, nil\n}\n", connectorName, driverPkg)
+	fmt.Fprintf(w, "\n// %s pings the connection and takes it from the pool\n// twice, so it is reset and validated.\n", runName)
+	fmt.Fprintf(w, "func %s(conn %s.Conn) error {\n", runName, driverPkg)
+	fmt.Fprintf(w, "\tdb := %s.OpenDB(%s{conn: conn})\n", sqlPkg, connectorName)
+	fmt.Fprintf(w, "\tdefer db.Close()\n")
+	fmt.Fprintf(w, "\tctx := %s.Background()\n", contextPkg)
+	fmt.Fprintf(w, "\tfor i := 0; i < 2; i++ {\n")
+	fmt.Fprintf(w, "\t\tc, err := db.Conn(ctx)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n")
+	fmt.Fprintf(w, "\t\tif err := c.PingContext(ctx); err != nil {\n\t\t\tc.Close()\n\t\t\treturn err\n\t\t}\n")
+	fmt.Fprintf(w, "\t\tif err := c.Close(); err != nil {\n\t\t\treturn err\n\t\t}\n")
+	fmt.Fprintf(w, "\t}\n\treturn nil\n}\n")
+	fmt.Fprintf(w, "\nfunc Test%sDriverConformance(t *%s.T) {\n", testName, testingPkg)
+	fmt.Fprintf(w, "\tfor idx, fake := range []func(*%s) %s.Conn{\n", fakeName, driverPkg)
+	for _, ifaceName := range ifaceNames {
+		fmt.Fprintf(w, "\t\tfunc(c *%s) %s.Conn { return struct{ %s }{c} },\n", fakeName, driverPkg, ifaceName)
+	}
+	fmt.Fprintf(w, "\t} {\n")
+	fmt.Fprintf(w, "\t\traw, wrapped := &%s{}, &%s{}\n", fakeName, fakeName)
+	fmt.Fprintf(w, "\t\tif err := %s(fake(raw)); err != nil {\n", runName)
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"running combination %%d failed: %%v\", idx, err)\n\t\t}\n")
+	fmt.Fprintf(w, "\t\tif err := %s(%s(fake(wrapped)", runName, pi.newFuncName)
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(w, ", *new(%s)", ef.typeStr)
+	}
+	if es := pi.embedStruct; es != nil {
+		fmt.Fprintf(w, ", *new(%s)", es)
+	}
+	fmt.Fprintf(w, ")); err != nil {\n")
+	fmt.Fprintf(w, "\t\t\tt.Fatalf(\"running wrapped combination %%d failed: %%v\", idx, err)\n\t\t}\n")
+	fmt.Fprintf(w, "\t\tif !%s.DeepEqual(raw.calls, wrapped.calls) {\n", reflectPkg)
+	fmt.Fprintf(w, "\t\t\tt.Errorf(\"combination %%d: database/sql made calls %%v, through the wrapper it made %%v\", idx, raw.calls, wrapped.calls)\n\t\t}\n")
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+// combinationTestFile returns the path of the test from
+// -gen-combination-test, which is generated next to the outfile.
+func combinationTestFile(outFile string) string {
+	return fmt.Sprintf("%s_combination_test.go", strings.TrimSuffix(outFile, ".go"))
+}
+
+// printCombinationTest prints a test passing a value of every
+// combination of the extension types to the new func and checking
+// that the wrapper implements exactly the extension types the value
+// implements.
+func printCombinationTest(w io.Writer, rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput, args []string, ifaceNames []string) {
+	testTA := &typeAnalysis{
+		imports:      make(map[string]string, len(ta.imports)),
+		inputImports: ta.inputImports,
+	}
+	for pkgPath, name := range ta.imports {
+		testTA.imports[pkgPath] = name
+	}
+	testingPkg := testTA.useImport("testing", "testing")
+	emptyIface := "interface{}"
+	if pi.useAny {
+		emptyIface = "any"
+	}
+
+	printTestHeader(w, rt, pi, args)
+	printImports(w, testTA)
+	fmt.Fprintf(w, "\nfunc Test%s%sCombinations(t *%s.T) {\n", strings.ToUpper(pi.newFuncName[:1]), pi.newFuncName[1:], testingPkg)
+	fmt.Fprintf(w, "\textTypes := []struct {\n\t\tname       string\n\t\timplements func(%s) bool\n\t}{\n", emptyIface)
+	for _, resType := range rt.resolvedExtTypes {
+		fmt.Fprintf(w, "\t\t{\n\t\t\tname: %q,\n", resType.at)
+		fmt.Fprintf(w, "\t\t\timplements: func(v %s) bool {\n\t\t\t\t_, ok := v.(%s)\n\t\t\t\treturn ok\n\t\t\t},\n\t\t},\n", emptyIface, resType.at)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tfor idx, r := range []%s{\n", rt.resolvedBaseType.at)
+	for _, ifaceName := range ifaceNames {
+		fmt.Fprintf(w, "\t\tstruct{ %s }{},\n", ifaceName)
+	}
+	fmt.Fprintf(w, "\t} {\n")
+	fmt.Fprintf(w, "\t\tw := %s(r", pi.newFuncName)
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(w, ", *new(%s)", ef.typeStr)
+	}
+	if es := pi.embedStruct; es != nil {
+		fmt.Fprintf(w, ", *new(%s)", es)
+	}
+	fmt.Fprintf(w, ")\n")
+	fmt.Fprintf(w, "\t\tfor _, et := range extTypes {\n")
+	fmt.Fprintf(w, "\t\t\tif rOK, wOK := et.implements(r), et.implements(w); rOK != wOK {\n")
+	fmt.Fprintf(w, "\t\t\t\tt.Errorf(\"combination %%d implements %%s: %%t, its wrapper implements it: %%t\", idx, et.name, rOK, wOK)\n")
+	fmt.Fprintf(w, "\t\t\t}\n\t\t}\n")
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+// reprint prints the formatted code again with the printer
+// configuration. Unlike replacing the tabs in the text, it keeps the
+// comments and the raw strings intact.
+func reprint(src []byte, cfg *printer.Config) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the formatted code: %w", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := cfg.Fprint(buf, fset, file); err != nil {
+		return nil, fmt.Errorf("failed to print the formatted code: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func transformAST(buf *bytes.Buffer, transform func(*token.FileSet, *ast.File) error) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("failed to parse the generated code for transformation: %w", err)
+	}
+	if err := transform(fset, file); err != nil {
+		return fmt.Errorf("failed to transform the generated code: %w", err)
+	}
+	buf.Reset()
+	if err := format.Node(buf, fset, file); err != nil {
+		return fmt.Errorf("failed to print the transformed code: %w", err)
+	}
+	return nil
+}
+
+// normalizeWhitespace trims the trailing whitespace and collapses
+// multiple blank lines into one, except in comments and raw string
+// literals, where the whitespace is a part of the text.
+func normalizeWhitespace(src []byte) []byte {
+	verbatim := verbatimRanges(src)
+	inVerbatim := func(start, end int) bool {
+		for _, r := range verbatim {
+			if start < r[1] && r[0] < end {
+				return true
+			}
+		}
+		return false
+	}
+	lines := bytes.Split(src, []byte("\n"))
+	normalized := make([][]byte, 0, len(lines))
+	prevBlank := false
+	offset := 0
+	for _, line := range lines {
+		lineStart, lineEnd := offset, offset+len(line)
+		offset = lineEnd + 1
+		trimmed := bytes.TrimRight(line, " \t\r")
+		// the newline ending the line is checked too, it
+		// is a part of a multiline comment or literal
+		if inVerbatim(lineStart+len(trimmed), lineEnd+1) {
+			normalized = append(normalized, line)
+			prevBlank = false
+			continue
+		}
+		line = trimmed
+		blank := len(line) == 0
+		if blank && prevBlank {
+			continue
+		}
+		normalized = append(normalized, line)
+		prevBlank = blank
+	}
+	joined := bytes.Join(normalized, []byte("\n"))
+	joined = bytes.TrimRight(joined, "\n")
+	return append(joined, '\n')
+}
+
+// verbatimRanges returns the offsets of the beginning and the end of
+// the comments and the raw string literals in the source.
+func verbatimRanges(src []byte) [][2]int {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	// the code may not compile, the ranges found so far are
+	// still fine
+	s.Init(file, src, nil, scanner.ScanComments)
+	var ranges [][2]int
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.COMMENT || (tok == token.STRING && strings.HasPrefix(lit, "`")) {
+			start := file.Offset(pos)
+			ranges = append(ranges, [2]int{start, start + len(lit)})
+		}
+	}
+	return ranges
+}
+
+type flagsInput struct {
+	inFile       string
+	outFile      string
+	baseType     string
+	extTypes     string
+	extraFields  string
+	imports      string
+	extraImports string
+	prefix       string
+	newFuncName  string
+	lockField    string
+
+	interfacePattern string
+	outFileTemplate  string
+	outDir           string
+	embedStruct      string
+	rebindOnMismatch string
+	headerFile       string
+	forwardTemplate  string
+	extrasOptIn      string
+	strategy         string
+	resultHooks      string
+	passthrough      string
+	fieldOrder       string
+	region           string
+	wrapAny          string
+	wrapAnyBases     string
+	packageName      string
+	receiver         string
+	initFunc         string
+	combinationTags  string
+	methodPragmas    string
+	indent           string
+	hooksInterface   string
+	wrappedConcrete  string
+	deprecatedAlias  string
+	extraMethods     string
+	receiverName     string
+	stateMachine     string
+	extrasPosition   string
+
+	tabWidth int
+
+	normalizeWhitespace bool
+	validateExtraFields bool
+	useAny              bool
+	genCapabilities     bool
+	extTypesInBasePkg   bool
+	genRebind           bool
+	strict              bool
+	allowMissing        bool
+
+	exportCombinationInterfaces bool
+	genFuncAdapter              bool
+	genDriverConformance        bool
+	genCombinationTest          bool
+	noAsserts                   bool
+	splitFiles                  bool
+	copyDoc                     bool
+	genSwitcher                 bool
+	groupFields                 bool
+	exportMethods               bool
+	compact                     bool
+	genFieldAccessors           bool
+	fetch                       bool
+	nilCheck                    bool
+	missingImpls                bool
+	accumulateErrors            bool
+	runtimeToggles              bool
+	genCapabilityConsts         bool
+	genClone                    bool
+	selfIdempotent              bool
+	metrics                     bool
+	debugLog                    bool
+	useErrorsJoin               bool
+	appendMode                  bool
+}
+
+const usageExamples = `
+Examples:
+
+  Wrap driver.Conn together with some of its optional extensions,
+  passing an extra value to the prefix functions:
+
+    //go:generate wrappergen -basetype=driver.Conn -exttypes=driver.ConnBeginTx;driver.Pinger -extrafields=extra,interface{} -prefix=realDC -newfuncname=newConn
+
+  Wrap driver.Tx without any extensions:
+
+    //go:generate wrappergen -basetype=driver.Tx -prefix=realDT -newfuncname=newTx -extrafields extra,interface{}
+
+  The above generate the driverconn_wrappers.go and drivertx_wrappers.go
+  files next to the file containing the directive. The prefix functions
+  (like realDCClose or realDTCommit) need to be written by hand.
+`
+
+// hiddenFlags are the flags meant for testing wrappergen itself, they
+// are not listed in the usage.
+var hiddenFlags = stringset.StringSet{"self-idempotent": {}}
+
+func (fi *flagsInput) configureFlagSet(flagset *flag.FlagSet) {
+	flagset.Usage = func() {
+		fmt.Fprintf(flagset.Output(), "Usage of %s:\n", flagset.Name())
+		visible := flag.NewFlagSet(flagset.Name(), flag.ContinueOnError)
+		visible.SetOutput(flagset.Output())
+		flagset.VisitAll(func(f *flag.Flag) {
+			if hiddenFlags.Has(f.Name) {
+				return
+			}
+			visible.Var(f.Value, f.Name, f.Usage)
+			// the value may be already set when -h is
+			// parsed
+			visible.Lookup(f.Name).DefValue = f.DefValue
+		})
+		visible.PrintDefaults()
+		fmt.Fprintf(flagset.Output(), "\nEnvironment variables:\n")
+		for _, env := range environmentVariables {
+			fmt.Fprintf(flagset.Output(), "  %s\n    \t%s\n", env.name, env.description)
+		}
+		fmt.Fprint(flagset.Output(), usageExamples)
+	}
+	flagset.StringVar(&fi.inFile, "infile", "", fmt.Sprintf("input file, if empty, %s env var will be consulted", envInFile))
+	flagset.StringVar(&fi.outFile, "outfile", "", "output file, if empty, will be deduced from the base type")
+	flagset.StringVar(&fi.outFileTemplate, "outfile-template", "", fmt.Sprintf("template for deducing the output file when -outfile is empty, relative paths are relative to the directory of the infile; available fields are BaseType, BaseTypeName, BaseTypePkg, BaseTypeLower and Prefix (default %s)", defaultOutFileTemplate))
+	flagset.StringVar(&fi.outDir, "out-dir", "", "directory to put the output files into, keeping the base names of the files given with -outfile or deduced from -outfile-template, a relative path is relative to the directory of the infile; the directory is created if it does not exist, and if it is not the directory of the infile, -package-name is required, as the generated code belongs to another package then")
+	flagset.StringVar(&fi.headerFile, "header-file", "", "file with a header (like a license) to put verbatim at the top of the output file, relative paths are relative to the directory of the infile")
+	flagset.StringVar(&fi.baseType, "basetype", "", "base type, like driver.Conn; a generic base type given without type arguments (like Store for Store[T any]) makes the wrappers and the new func generic, with the generic extension types instantiated with the same type parameters")
+	flagset.StringVar(&fi.interfacePattern, "interface-pattern", "", "regexp selecting the interfaces of the package of the infile to generate a wrapper family for each, instead of -basetype; -prefix and -newfuncname are templates then, with the same fields as -outfile-template (except Prefix in -prefix), like -interface-pattern=^Conn -prefix=real{{.BaseTypeName}} -newfuncname=new{{.BaseTypeName}}")
+	flagset.StringVar(&fi.extTypes, "exttypes", "", "semicolon-separated list of extension types, like driver.ConnBeginTx,driver.ConnPrepareContext")
+	flagset.StringVar(&fi.extraFields, "extrafields", "", "semicolon-separated list of comma-separated pairs of names and types of extra fields, like count,int;rate,double")
+	flagset.StringVar(&fi.imports, "imports", "", "semicolon-separated list of imports; imports can be in form of either path (like database/sql/driver) or name,path (like driver,database/sql/driver)")
+	flagset.StringVar(&fi.extraMethods, "extra-methods", "", "semicolon-separated list of signatures of methods the wrappers should have even if the wrapped interfaces do not, like Flush() error;Stats(verbose bool) map[string]int; they call the prefix functions (with the wrapped value as the base type) like the other methods")
+	flagset.StringVar(&fi.extraImports, "extra-imports", "", "semicolon-separated list of imports that will be added to the generated code even if no type refers to them, in the same form as -imports, the ones the generated code does not use become blank imports")
+	flagset.StringVar(&fi.prefix, "prefix", "", "prefix of the function called by interface implementations, like real (will cause Close method to call realClose function")
+	flagset.StringVar(&fi.hooksInterface, "hooks-interface", "", fmt.Sprintf("interface type whose methods the generated methods should call instead of the prefix functions, like myHooks; the wrappers get a %s extra field of this type, the methods of the interface take the same parameters as the prefix functions", hooksFieldName))
+	flagset.StringVar(&fi.wrappedConcrete, "wrapped-concrete", "", fmt.Sprintf("with -strategy=%s, concrete type of the wrapped value, like *mypkg.RealConn; the wrapper stores it and the new func takes it instead of the base type, so the calls to it can be devirtualized; the type must implement the base type and all the extension types", strategySparse))
+	flagset.StringVar(&fi.forwardTemplate, "forward-template", "", "template for the name of the method of the wrapped value the generated methods should call instead of the prefix functions, like {{.Method}}Bytes (will cause Read method to call o.r.ReadBytes); the only available field is Method")
+	flagset.StringVar(&fi.newFuncName, "newfuncname", "", "name of the function creating a wrapper, like newConn")
+	flagset.StringVar(&fi.embedStruct, "embed-struct", "", "struct type (or a pointer to it) to embed in wrappers, like mypkg.Base or *mypkg.Base; the new func will take it as a last parameter")
+	flagset.StringVar(&fi.extrasOptIn, "extras-opt-in", "", "semicolon-separated list of methods whose prefix functions should get the extra fields, other prefix functions get none; a method may be followed by an equal sign and a comma-separated list of the extra fields to pass, like Begin;Prepare=count")
+	flagset.StringVar(&fi.extrasPosition, "extras-position", extrasPositionBefore, fmt.Sprintf("where the extra fields go in the calls of the prefix functions, either %s (after the wrapped value and before the parameters of the method, like realPrepare(o.r, o.extra, query)) or %s (after the parameters of the method, like realPrepare(o.r, query, o.extra)); the same applies to the methods of -hooks-interface", extrasPositionBefore, extrasPositionAfter))
+	flagset.StringVar(&fi.resultHooks, "result-hook", "", "semicolon-separated list of methods whose results should be passed through a function named after the prefix and the method with the Result suffix before returning them, like Begin;Prepare (will cause Begin method to return realBeginResult(realBegin(...)))")
+	flagset.StringVar(&fi.passthrough, "passthrough-methods", "", "semicolon-separated list of methods that should call the same method of the wrapped value directly instead of a prefix function, like Error;String")
+	flagset.StringVar(&fi.stateMachine, "state-machine", "", "semicolon-separated list of equal sign-separated pairs of comma-separated methods and transitions, a transition being a comma-separated list of states the method may be called in, followed by > and the state the wrapper gets into after the call, like Begin=idle>inTx;Commit,Rollback=inTx>idle; the wrappers start in the first listed state and the methods called in other states first call a function named after the prefix with the InvalidTransition suffix, taking the name of the method and the current state, like realInvalidTransition(\"Commit\", \"idle\"), which may panic")
+	flagset.StringVar(&fi.lockField, "lock-field", "", "name of an extra field holding a lock (like a *sync.Mutex) that will be held for the duration of each method, like mu")
+	flagset.BoolVar(&fi.validateExtraFields, "validate-extrafields", false, "fully type-check the types of the extra fields, not only the names they refer to")
+	flagset.BoolVar(&fi.useAny, "use-any", false, "use any instead of interface{} for empty interfaces in the generated code (requires Go 1.18 or newer)")
+	flagset.BoolVar(&fi.extTypesInBasePkg, "exttypes-in-base-pkg", false, "look for the extension types without a package name also in the package of the base type, if they can't be found in this package, so -basetype=driver.Conn -exttypes=Pinger will find driver.Pinger")
+	flagset.BoolVar(&fi.genRebind, "gen-rebind", false, "generate a Rebind method in wrappers replacing the wrapped value")
+	flagset.StringVar(&fi.rebindOnMismatch, "rebind-on-mismatch", rebindOnMismatchPanic, fmt.Sprintf("what the Rebind method should do if the new value does not implement the interfaces of the wrapper, either %s or %s (returning an error)", rebindOnMismatchPanic, rebindOnMismatchError))
+	flagset.StringVar(&fi.strategy, "strategy", strategyCombinations, fmt.Sprintf("how to generate the wrappers, either %s (a wrapper for each combination of the extension types, so type assertions on wrappers work like on the wrapped values) or %s (a single wrapper implementing all the extension types, panicking if a method of an extension type not implemented by the wrapped value is called; the generated code grows linearly with the number of the extension types)", strategyCombinations, strategySparse))
+	flagset.StringVar(&fi.combinationTags, "combination-tags", "", "semicolon-separated list of equal sign-separated pairs of comma-separated sets of extension types and build tags, like driver.Pinger,driver.SessionResetter=withreset; the wrappers of the combinations of exactly these extension types are put into files built only with the build tag (with the tag replacing the .go suffix of the outfile, like conn_wrappers_withreset.go), so they can be compiled out; the new func falls back to the wrappers of smaller combinations then")
+	flagset.BoolVar(&fi.runtimeToggles, "runtime-toggles", false, fmt.Sprintf("with -strategy=%s, add an enabled bitmask to the wrapper, initially equal to the capability bits of the wrapped value, so the extension types can be switched off after the wrapper is created (like o.enabled &^= capConnPinger); the methods of the disabled extension types panic", strategySparse))
+	flagset.StringVar(&fi.receiver, "receiver", receiverPointer, fmt.Sprintf("kind of the receivers of the wrapper methods, either %s or %s (the new func returns the wrappers by value then)", receiverPointer, receiverValue))
+	flagset.StringVar(&fi.receiverName, "receiver-name", "", "name of the receivers of the wrapper methods, like w; by default it is o followed by the name of the wrapper type without the t prefix, like oConn0 for tConn0; the parameters of the methods named like it are renamed")
+	flagset.StringVar(&fi.fieldOrder, "field-order", fieldOrderDefault, fmt.Sprintf("order of the fields in the wrappers, either %s (the wrapped value, the embedded struct and the extra fields in the order of -extrafields), %s (like %s, but with the extra fields sorted by name) or %s (the extra fields first)", fieldOrderDefault, fieldOrderAlphabetical, fieldOrderDefault, fieldOrderExtrasFirst))
+	flagset.BoolVar(&fi.groupFields, "group-fields", false, "separate the extra fields from the wrapped value and the embedded struct with an empty line in the wrappers")
+	flagset.BoolVar(&fi.genCapabilities, "gen-capabilities", false, "generate a function returning names of the extension types implemented by a wrapper, like connCapabilities for the driver.Conn base type")
+	flagset.BoolVar(&fi.genCapabilityConsts, "gen-capability-consts", false, fmt.Sprintf("generate a %s type with a bit constant for each extension type, like %sConnBeginTx for driver.ConnBeginTx; the constants are used for the capability bits of -strategy=%s and are returned by the function of -gen-capabilities", capabilityTypeName, capabilityConstPrefix, strategySparse))
+	flagset.BoolVar(&fi.genFuncAdapter, "gen-func-adapter", false, "generate a func adapter type for a single-method base type, like ConnFunc for the driver.Conn base type, similar to http.HandlerFunc")
+	flagset.BoolVar(&fi.genDriverConformance, "gen-driver-conformance", false, "also generate a test next to the outfile (with the _conformance_test.go suffix) running fake connections implementing every combination of the extension types through database/sql, checking that database/sql makes the same calls to the wrapped ones, the base type must be database/sql/driver.Conn")
+	flagset.BoolVar(&fi.genCombinationTest, "gen-combination-test", false, "also generate a test next to the outfile (with the _combination_test.go suffix) passing a value implementing every combination of the extension types to the new func and checking that the wrapper implements exactly the extension types of the value")
+	flagset.BoolVar(&fi.genSwitcher, "gen-switcher", false, "also generate a switcher implementing the base type, which calls the prefix function with the Select suffix on every method call to pick the value to forward the call to, the switcher is created with the function named like the new func with the Switcher suffix")
+	flagset.BoolVar(&fi.missingImpls, "missing-impls", false, "do not write anything, only print the signatures of the prefix functions (and the result hook functions) the package of the infile does not have yet; with the list command, print them instead of the methods")
+	flagset.BoolVar(&fi.accumulateErrors, "accumulate-errors", false, "make the wrappers remember the first non-nil error returned by their methods (as the last result) and generate an Err method returning it, so the error of a chain of calls can be checked once at the end")
+	flagset.BoolVar(&fi.useErrorsJoin, "use-errors-join", false, "with -accumulate-errors, remember all the non-nil errors returned by the methods combined with errors.Join instead of only the first one (requires Go 1.20 or newer)")
+	flagset.BoolVar(&fi.nilCheck, "nil-check", false, "make the new func return nil when the value to wrap is nil, instead of a wrapper that panics when used")
+	flagset.BoolVar(&fi.fetch, "fetch", false, "run go get on a copy of go.mod for packages of the types that can't be loaded, so types from modules that are not dependencies of this module yet can be wrapped; go.mod and go.sum are not modified, so the modules still need to be added for the generated code to compile")
+	flagset.BoolVar(&fi.allowMissing, "allow-missing", false, "only warn and do not write the outfile if a type is missing from its package, useful when the type is defined in a file generated later")
+	flagset.StringVar(&fi.deprecatedAlias, "deprecated-alias", "", "with -export-combination-interfaces, old name of the base type, like OldConn; the combination interfaces get deprecated aliases named after it (like OldConnWithPinger for ConnWithPinger) and the new func gets a deprecated forwarder (like NewOldConn for NewConn)")
+	flagset.BoolVar(&fi.exportCombinationInterfaces, "export-combination-interfaces", false, "export the interfaces combining the base type with extension types, with names like ConnWithPinger")
+	flagset.BoolVar(&fi.noAsserts, "no-asserts", false, "do not generate the var block asserting at compile time that the wrappers implement the base and extension types")
+	flagset.StringVar(&fi.wrapAny, "wrap-any", "", "name of a function to generate, taking a value of any type and wrapping it with the new func of the first base type it implements, like wrapAny; the base type goes first, followed by the base types from -wrap-any-bases")
+	flagset.StringVar(&fi.wrapAnyBases, "wrap-any-bases", "", "semicolon-separated list of equal sign-separated pairs of other base types and their new funcs (generated separately, taking the same extra fields and embedded struct) to try in the function from -wrap-any, like driver.Tx=newTx;driver.Stmt=newStmt")
+	flagset.StringVar(&fi.initFunc, "init-func", "", "name of a function to call once from the generated init function, like registerConnWrappers; with -region, the init function is not generated if another one in the outfile already calls it")
+	flagset.StringVar(&fi.packageName, "package-name", "", "package name to put in the package clause of the generated code instead of the package name of the infile; the types are still looked up in the package of the infile, but the generated code must not refer to any of its types")
+	flagset.StringVar(&fi.region, "region", "", "name of the region of the outfile to put the generated code into, the region is delimited by the // wrappergen:begin <name> and // wrappergen:end <name> comments and the rest of the outfile is kept as is, so several generations and hand-written code can share one file")
+	flagset.BoolVar(&fi.appendMode, "append", false, "keep the names of the wrappers of the combinations the outfile already has and number the wrappers of the new combinations after them, so adding an extension type only adds code to the outfile (and updates the new func) instead of renumbering the wrappers")
+	flagset.BoolVar(&fi.splitFiles, "split-files", false, "split the generated code into three files, with the _types.go, _impls.go and _new.go suffixes replacing the .go suffix of the outfile")
+	flagset.BoolVar(&fi.copyDoc, "copy-doc", false, "copy the doc comments of the interface methods to the generated methods")
+	flagset.BoolVar(&fi.selfIdempotent, "self-idempotent", false, "generate everything twice and fail if the results differ, for testing the determinism of the generation")
+	flagset.BoolVar(&fi.metrics, "metrics", false, "make each generated method start with a call to a function named after the prefix with the Count suffix, taking the name of the method, like realCount(\"Close\")")
+	flagset.BoolVar(&fi.debugLog, "debug-log", false, "make each generated method call a function named after the prefix with the Log suffix with the name of the method and its arguments on entry and with the name of the method followed by \" returned\" and its results on exit, like realLog(\"Read\", p) and realLog(\"Read returned\", res0, res1); the function takes the method name and variadic interface{} arguments")
+	flagset.BoolVar(&fi.genClone, "gen-clone", false, "generate a Clone method in wrappers, returning a copy of the wrapper with the same wrapped value and extra fields; the extra fields must not contain locks or channels")
+	flagset.BoolVar(&fi.genFieldAccessors, "gen-field-accessors", false, "generate methods returning the values of the extra fields in wrappers, named after the capitalized names of the fields, like Extra for the extra field")
+	flagset.StringVar(&fi.methodPragmas, "method-pragma", "", fmt.Sprintf("semicolon-separated list of compiler directives to put before each generated method, like //go:noinline, useful when measuring the cost of the wrappers; allowed directives are %s", strings.Join(knownMethodPragmas, ", ")))
+	flagset.BoolVar(&fi.compact, "compact", false, "make the generated code smaller by omitting the doc comments and the empty lines between the wrappers")
+	flagset.BoolVar(&fi.exportMethods, "export-methods", false, "fail if any method of the wrapped interfaces is unexported, so the method set of the exported wrappers exactly matches the interfaces also outside of the package")
+	flagset.BoolVar(&fi.strict, "strict", false, "treat warnings as errors, the outfile is not written if there were any")
+	flagset.StringVar(&fi.indent, "indent", indentTabs, fmt.Sprintf("indentation of the generated code, either %s (like gofmt) or %s", indentTabs, indentSpaces))
+	flagset.IntVar(&fi.tabWidth, "tab-width", defaultTabWidth, fmt.Sprintf("number of spaces per indentation level with -indent=%s", indentSpaces))
+	flagset.BoolVar(&fi.normalizeWhitespace, "normalize-whitespace", false, "collapse multiple blank lines into one and ensure a single trailing newline in the generated code")
+}
+
+func (fi *flagsInput) parseFlagsAndEnvironment(flagset *flag.FlagSet, args, environ []string) error {
+	if err := flagset.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return silentFailure
+		}
+		return err
+	}
+	if fi.inFile == "" {
+		prefix := envInFile + "="
+		for _, envkv := range environ {
+			if strings.HasPrefix(envkv, prefix) {
+				fi.inFile = envkv[len(prefix):]
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (fi *flagsInput) ensureValid() error {
+	if fi.baseType == "" && fi.interfacePattern == "" {
+		return errors.New("no base type (or it is empty), use -basetype to specify it")
+	}
+	if fi.baseType != "" && fi.interfacePattern != "" {
+		return errors.New("both -basetype and -interface-pattern specified, use only one of them")
+	}
+	if fi.interfacePattern != "" {
+		if _, err := regexp.Compile(fi.interfacePattern); err != nil {
+			return fmt.Errorf("failed to compile the interface pattern %s: %w", fi.interfacePattern, err)
+		}
+		if fi.outFile != "" {
+			return errors.New("-outfile can't be used with -interface-pattern, the wrapper families need different files, use -outfile-template instead")
+		}
+	}
+	if fi.prefix == "" {
+		return errors.New("no prefix (or it is empty), use -prefix to specify it")
+	}
+	if fi.newFuncName == "" {
+		return errors.New("no new func name (or it is empty), use -newfuncname to specify it")
+	}
+	if fi.outFile != "" && fi.outFileTemplate != "" {
+		return errors.New("both -outfile and -outfile-template specified, use only one of them")
+	}
+	if fi.inFile == "" {
+		return fmt.Errorf("no in file, use -infile to specify it or export the %s environment variable", envInFile)
+	}
+	inFileInfo, err := os.Stat(fi.inFile)
+	if err != nil {
+		return fmt.Errorf("failed to stat infile %s: %w", fi.inFile, err)
+	}
+	if !inFileInfo.Mode().IsRegular() {
+		return fmt.Errorf("infile %s is not a file", fi.inFile)
+	}
+	return nil
+}
+
+type parsedInput struct {
+	baseType     aType
+	extTypes     []aType
+	extraFields  []extraField
+	imports      []anImport
+	extraImports []anImport
+	inFile       string
+	outFile      string
+	prefix       string
+	newFuncName  string
+	lockField    string
+	embedStruct  *embeddedStruct
+	header       []byte
+	// outDir, if not empty, is the directory of the outfile from
+	// -out-dir, created when writing the outfile.
+	outDir string
+	// region, if not empty, is the name of the region of the
+	// outfile the generated code replaces.
+	region string
+	// wrapAny, if not empty, is the name of the function
+	// wrapping values of any of the base types.
+	wrapAny      string
+	wrapAnyBases []wrapAnyBase
+	// packageName, if not empty, overrides the package name of
+	// the infile in the package clause of the generated code.
+	packageName string
+	receiver    string
+	// extrasPosition tells whether the extra fields are passed to
+	// the prefix functions before or after the parameters of the
+	// method.
+	extrasPosition string
+	// receiverName, if not empty, is the name of the receivers of
+	// the wrapper methods, instead of o followed by the name of
+	// the wrapper type without the t prefix.
+	receiverName string
+	// initFunc, if not empty, is the name of the function called
+	// from the generated init function.
+	initFunc string
+	// combinationTags maps the comma-separated sorted names of
+	// the extension types of a combination to the build tag
+	// required to build its wrapper.
+	combinationTags map[string]string
+	// methodPragmas are the compiler directives put before each
+	// generated method.
+	methodPragmas []string
+	indent        string
+	tabWidth      int
+
+	forwardTemplate *template.Template
+	// extrasOptIn maps method names to names of the extra fields
+	// passed to their prefix functions. If nil, all the extra
+	// fields are passed to all the prefix functions.
+	extrasOptIn map[string][]string
+	// resultHooks contains names of the methods whose results are
+	// passed through <prefix><Method>Result functions.
+	resultHooks stringset.StringSet
+	// passthrough contains names of the methods that call the
+	// wrapped value directly instead of the prefix functions.
+	passthrough stringset.StringSet
+	// hooksInterface, if not nil, is the type of the hooks extra
+	// field, whose methods are called instead of the prefix
+	// functions.
+	hooksInterface *aType
+	// wrappedConcrete, if not nil, is the type of the wrapped
+	// value stored in the single wrapper of -strategy=sparse.
+	wrappedConcrete *concreteType
+	// deprecatedAlias is the old name of the base type, the
+	// exported names of the wrapper family get deprecated aliases
+	// with the old name.
+	deprecatedAlias string
+	// extraMethods are the methods from -extra-methods, which the
+	// wrappers implement even if the wrapped interfaces do not
+	// have them.
+	extraMethods []methodInfo
+	// extraMethodTypes are the types of the extra methods, for
+	// resolving the types they refer to.
+	extraMethodTypes []*ast.FuncType
+	// stateTransitions maps the method names from -state-machine
+	// to their transitions. The wrappers start in initialState.
+	stateTransitions map[string]stateTransition
+	initialState     string
+
+	normalizeWhitespace bool
+	validateExtraFields bool
+	useAny              bool
+	genCapabilities     bool
+	extTypesInBasePkg   bool
+	genRebind           bool
+	rebindOnMismatch    string
+	allowMissing        bool
+	strategy            string
+	fieldOrder          string
+	groupFields         bool
+
+	exportCombinationInterfaces bool
+	genFuncAdapter              bool
+	genDriverConformance        bool
+	genCombinationTest          bool
+	noAsserts                   bool
+	splitFiles                  bool
+	copyDoc                     bool
+	genSwitcher                 bool
+	exportMethods               bool
+	compact                     bool
+	genFieldAccessors           bool
+	fetch                       bool
+	nilCheck                    bool
+	missingImpls                bool
+	accumulateErrors            bool
+	runtimeToggles              bool
+	genCapabilityConsts         bool
+	genClone                    bool
+	selfIdempotent              bool
+	metrics                     bool
+	debugLog                    bool
+	useErrorsJoin               bool
+	appendMode                  bool
+
+	warnings *warningCollector
+
+	// typeParams is not nil if the base type is generic and was
+	// given without the type arguments. It is not an input, it is
+	// filled after analyzing the types.
+	typeParams *typeParamsInfo
+
+	// astTransform, if not nil, is called with the parsed
+	// generated code before it gets formatted. There is no flag
+	// for it, it is set by Generate.
+	astTransform func(*ast.File) error
+}
+
+func (pi *parsedInput) parseInput(fi *flagsInput) error {
+	{
+		baseType, err := strToAType(fi.baseType)
+		if err != nil {
+			return fmt.Errorf("failed to get base type from input parameter %s: %w", fi.baseType, err)
+		}
+		pi.baseType = baseType
+	}
+	pi.warnings = &warningCollector{
+		strict: fi.strict,
+	}
+	if fi.extTypes != "" {
+		ets := strings.Split(fi.extTypes, ";")
+		seen := stringset.StringSet{}
+		for _, et := range ets {
+			at, err := strToAType(et)
+			if err != nil {
+				return fmt.Errorf("failed to get an extension type from input parameter %s: %w", et, err)
+			}
+			if seen.Has(at.String()) {
+				pi.warnings.warn("duplicate extension type %s, ignoring it", at)
+				continue
+			}
+			seen.Add(at.String())
+			pi.extTypes = append(pi.extTypes, at)
+		}
+	}
+	if fi.extraFields != "" {
+		efs := strings.Split(fi.extraFields, ";")
+		for _, ef := range efs {
+			aef, err := strToExtraField(ef)
+			if err != nil {
+				return fmt.Errorf("failed to get an extra field from input parameter %s: %w", ef, err)
+			}
+			if fi.useAny {
+				aef, err = aef.withAny()
+				if err != nil {
+					return fmt.Errorf("failed to replace empty interfaces with any in extra field %s: %w", ef, err)
+				}
+			}
+			pi.extraFields = append(pi.extraFields, aef)
+		}
+	}
+	if fi.extraMethods != "" {
+		if fi.forwardTemplate != "" {
+			return errors.New("-extra-methods can't be used with -forward-template, the wrapped value has no methods to forward the extra methods to")
+		}
+		seen := stringset.StringSet{}
+		for _, em := range strings.Split(fi.extraMethods, ";") {
+			mi, funcType, err := strToExtraMethod(em)
+			if err != nil {
+				return fmt.Errorf("failed to get an extra method from input parameter %s: %w", em, err)
+			}
+			if seen.Has(mi.name) {
+				return fmt.Errorf("extra method %s is specified more than once", mi.name)
+			}
+			seen.Add(mi.name)
+			pi.extraMethods = append(pi.extraMethods, mi)
+			pi.extraMethodTypes = append(pi.extraMethodTypes, funcType)
+		}
+	}
+	if fi.imports != "" {
+		is := strings.Split(fi.imports, ";")
+		for _, i := range is {
+			ai, err := strToAnImport(i)
+			if err != nil {
+				return fmt.Errorf("failed to get an import from input parameter %s: %w", i, err)
+			}
+			pi.imports = append(pi.imports, ai)
+		}
+	}
+	if fi.extraImports != "" {
+		is := strings.Split(fi.extraImports, ";")
+		for _, i := range is {
+			ai, err := strToAnImport(i)
+			if err != nil {
+				return fmt.Errorf("failed to get an extra import from input parameter %s: %w", i, err)
+			}
+			pi.extraImports = append(pi.extraImports, ai)
+		}
+	}
+	if filepath.IsAbs(fi.inFile) {
+		pi.inFile = fi.inFile
+	} else if absPath, err := filepath.Abs(fi.inFile); err != nil {
+		return fmt.Errorf("failed to get an absolute path of the infile %s: %w", fi.inFile, err)
+	} else {
+		pi.inFile = absPath
+	}
+	if generated, err := isGeneratedFile(pi.inFile); err != nil {
+		return err
+	} else if generated {
+		pi.warnings.warn("infile %s is a generated file, generating code from it is likely a mistake", pi.inFile)
+	}
+	if fi.outFile != "" {
+		pi.outFile = fi.outFile
+	} else {
+		outFileTemplate := fi.outFileTemplate
+		if outFileTemplate == "" {
+			outFileTemplate = defaultOutFileTemplate
+		}
+		outFile, err := deduceOutFile(outFileTemplate, pi.baseType, fi.prefix)
+		if err != nil {
+			return err
+		}
+		if !filepath.IsAbs(outFile) {
+			outFile = filepath.Join(filepath.Dir(pi.inFile), outFile)
+		}
+		pi.outFile = outFile
+	}
+	if fi.outDir != "" {
+		outDir := fi.outDir
+		if !filepath.IsAbs(outDir) {
+			outDir = filepath.Join(filepath.Dir(pi.inFile), outDir)
+		}
+		pi.outDir = filepath.Clean(outDir)
+		pi.outFile = filepath.Join(pi.outDir, filepath.Base(pi.outFile))
+		if pi.outDir != filepath.Dir(pi.inFile) && fi.packageName == "" {
+			return fmt.Errorf("-out-dir %s is not the directory of the infile, so the generated code would belong to another package, use -package-name to name it", fi.outDir)
+		}
+	}
+	if fi.headerFile != "" {
+		headerFile := fi.headerFile
+		if !filepath.IsAbs(headerFile) {
+			headerFile = filepath.Join(filepath.Dir(pi.inFile), headerFile)
+		}
+		header, err := ioutil.ReadFile(headerFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the header file %s: %w", fi.headerFile, err)
+		}
+		pi.header = header
+	}
+	if !isValidFunctionName(fi.prefix) {
+		return fmt.Errorf("prefix %s is invalid, it should start with either uppercase or lowercase ASCII character or an underline, and then followed by uppercase or lowercase ASCII characters or ASCII digits or underlines", fi.prefix)
+	}
+	pi.prefix = fi.prefix
+	if !isValidFunctionName(fi.newFuncName) {
+		return fmt.Errorf("function name %s is invalid, it should start with either uppercase or lowercase ASCII character or an underline, and then followed by uppercase or lowercase ASCII characters or ASCII digits or underlines", fi.newFuncName)
+	}
+	pi.newFuncName = fi.newFuncName
+	if fi.forwardTemplate != "" {
+		tmpl, err := template.New("forward").Option("missingkey=error").Parse(fi.forwardTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse the forward template %s: %w", fi.forwardTemplate, err)
+		}
+		pi.forwardTemplate = tmpl
+	}
+	if fi.hooksInterface != "" {
+		if fi.forwardTemplate != "" {
+			return errors.New("-hooks-interface can't be used with -forward-template, there would be no hooks to call")
+		}
+		hooksType, err := strToAType(fi.hooksInterface)
+		if err != nil {
+			return fmt.Errorf("failed to get a hooks interface from input parameter %s: %w", fi.hooksInterface, err)
+		}
+		if hooksType.pkgPath != "" {
+			return fmt.Errorf("hooks interface %s needs to use the package name instead of the import path", fi.hooksInterface)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == hooksFieldName {
+				return fmt.Errorf("extra field %s collides with the hooks field of -hooks-interface", hooksFieldName)
+			}
+		}
+		pi.hooksInterface = &hooksType
+		// the hooks are the first extra field, so they are the
+		// first extra parameter of the new func
+		hooksField := extraField{
+			name:    hooksFieldName,
+			typeStr: hooksType.String(),
+			expr:    hooksType.expr(),
+		}
+		pi.extraFields = append([]extraField{hooksField}, pi.extraFields...)
+	}
+	if fi.embedStruct != "" {
+		es, err := strToEmbeddedStruct(fi.embedStruct)
+		if err != nil {
+			return fmt.Errorf("failed to get an embedded struct from input parameter %s: %w", fi.embedStruct, err)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == es.at.name || ef.name == es.paramName() {
+				return fmt.Errorf("embedded struct %s collides with extra field %s", es, ef.name)
+			}
+		}
+		if es.at.name == "r" {
+			return fmt.Errorf("embedded struct %s collides with the wrapped field r", es)
+		}
+		pi.embedStruct = es
+	}
+	if fi.extrasOptIn != "" {
+		extrasOptIn, err := parseExtrasOptIn(fi.extrasOptIn, pi.extraFields)
+		if err != nil {
+			return err
+		}
+		pi.extrasOptIn = extrasOptIn
+	}
+	if fi.resultHooks != "" {
+		pi.resultHooks = stringset.StringSet{}
+		for _, method := range strings.Split(fi.resultHooks, ";") {
+			if !isValidFunctionName(method) {
+				return fmt.Errorf("invalid method name %q in -result-hook", method)
+			}
+			pi.resultHooks.Add(method)
+		}
+	}
+	if fi.passthrough != "" {
+		pi.passthrough = stringset.StringSet{}
+		for _, method := range strings.Split(fi.passthrough, ";") {
+			if !isValidFunctionName(method) {
+				return fmt.Errorf("invalid method name %q in -passthrough-methods", method)
+			}
+			pi.passthrough.Add(method)
+		}
+	}
+	if fi.lockField != "" {
+		found := false
+		for _, ef := range pi.extraFields {
+			if ef.name == fi.lockField {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("lock field %s is not one of the extra fields, use -extrafields to add it", fi.lockField)
+		}
+		pi.lockField = fi.lockField
+	}
+	pi.normalizeWhitespace = fi.normalizeWhitespace
+	pi.validateExtraFields = fi.validateExtraFields
+	pi.useAny = fi.useAny
+	pi.genCapabilities = fi.genCapabilities
+	pi.extTypesInBasePkg = fi.extTypesInBasePkg
+	switch fi.rebindOnMismatch {
+	case rebindOnMismatchPanic, rebindOnMismatchError:
+	default:
+		return fmt.Errorf("invalid value %s for -rebind-on-mismatch, expected either %s or %s", fi.rebindOnMismatch, rebindOnMismatchPanic, rebindOnMismatchError)
+	}
+	pi.genRebind = fi.genRebind
+	pi.allowMissing = fi.allowMissing
+	pi.exportCombinationInterfaces = fi.exportCombinationInterfaces
+	if fi.deprecatedAlias != "" {
+		if !fi.exportCombinationInterfaces {
+			return errors.New("-deprecated-alias requires -export-combination-interfaces, there are no exported names to alias otherwise")
+		}
+		if fi.combinationTags != "" {
+			return errors.New("-deprecated-alias can't be used with -combination-tags, the aliased interfaces may be compiled out")
+		}
+		if !token.IsIdentifier(fi.deprecatedAlias) || !token.IsExported(fi.deprecatedAlias) {
+			return fmt.Errorf("deprecated alias %s is not an exported identifier", fi.deprecatedAlias)
+		}
+		if fi.deprecatedAlias == pi.baseType.name {
+			return fmt.Errorf("deprecated alias %s is the name of the base type", fi.deprecatedAlias)
+		}
+		if !strings.Contains(pi.newFuncName, pi.baseType.name) {
+			return fmt.Errorf("can't derive the name of the deprecated new func, the new func name %s does not contain the base type name %s", pi.newFuncName, pi.baseType.name)
+		}
+		pi.deprecatedAlias = fi.deprecatedAlias
+	}
+	pi.genFuncAdapter = fi.genFuncAdapter
+	pi.genDriverConformance = fi.genDriverConformance
+	pi.genCombinationTest = fi.genCombinationTest
+	pi.noAsserts = fi.noAsserts
+	pi.splitFiles = fi.splitFiles
+	if fi.wrapAny != "" {
+		if !isValidFunctionName(fi.wrapAny) {
+			return fmt.Errorf("function name %s from -wrap-any is invalid", fi.wrapAny)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == "v" {
+				return fmt.Errorf("extra field v collides with the parameter of %s", fi.wrapAny)
+			}
+		}
+		if pi.embedStruct != nil && pi.embedStruct.paramName() == "v" {
+			return fmt.Errorf("embedded struct %s collides with the parameter of %s", pi.embedStruct, fi.wrapAny)
+		}
+		pi.wrapAny = fi.wrapAny
+	}
+	if fi.wrapAnyBases != "" {
+		if fi.wrapAny == "" {
+			return errors.New("-wrap-any-bases requires -wrap-any")
+		}
+		wrapAnyBases, err := parseWrapAnyBases(fi.wrapAnyBases)
+		if err != nil {
+			return err
+		}
+		pi.wrapAnyBases = wrapAnyBases
+	}
+	if fi.initFunc != "" {
+		if !isValidFunctionName(fi.initFunc) || fi.initFunc == "init" {
+			return fmt.Errorf("function name %s from -init-func is invalid", fi.initFunc)
+		}
+		pi.initFunc = fi.initFunc
+	}
+	if fi.packageName != "" {
+		if !token.IsIdentifier(fi.packageName) {
+			return fmt.Errorf("package name %s from -package-name is not a valid identifier", fi.packageName)
+		}
+		pi.packageName = fi.packageName
+	}
+	if fi.region != "" {
+		if strings.IndexFunc(fi.region, unicode.IsSpace) >= 0 {
+			return fmt.Errorf("region name %q must not contain whitespace", fi.region)
+		}
+		if fi.splitFiles {
+			return errors.New("-region can't be used with -split-files")
+		}
+		pi.region = fi.region
+	}
+	pi.copyDoc = fi.copyDoc
+	pi.exportMethods = fi.exportMethods
+	if fi.compact && fi.copyDoc {
+		return errors.New("-compact can't be used with -copy-doc")
+	}
+	pi.compact = fi.compact
+	if fi.methodPragmas != "" {
+		known := stringset.StringSet{}
+		for _, pragma := range knownMethodPragmas {
+			known.Add(pragma)
+		}
+		seen := stringset.StringSet{}
+		for _, pragma := range strings.Split(fi.methodPragmas, ";") {
+			if !known.Has(pragma) {
+				return fmt.Errorf("unsupported directive %q in -method-pragma, expected one of %s", pragma, strings.Join(knownMethodPragmas, ", "))
+			}
+			if seen.Has(pragma) {
+				continue
+			}
+			seen.Add(pragma)
+			pi.methodPragmas = append(pi.methodPragmas, pragma)
+		}
+	}
+	if fi.genFieldAccessors {
+		if len(pi.extraFields) == 0 {
+			return errors.New("-gen-field-accessors requires extra fields, use -extrafields to add them")
+		}
+		accessors := stringset.StringSet{}
+		for _, ef := range pi.extraFields {
+			if !unicode.IsLetter(rune(ef.name[0])) {
+				return fmt.Errorf("can't generate an exported accessor of extra field %s, its name does not start with a letter", ef.name)
+			}
+			accessor := fieldAccessorName(ef)
+			if accessors.Has(accessor) {
+				return fmt.Errorf("extra fields would have more than one accessor named %s", accessor)
+			}
+			accessors.Add(accessor)
+		}
+	}
+	pi.genFieldAccessors = fi.genFieldAccessors
+	pi.fetch = fi.fetch
+	pi.nilCheck = fi.nilCheck
+	if fi.missingImpls && fi.forwardTemplate != "" {
+		return errors.New("-missing-impls can't be used with -forward-template, there are no prefix functions to implement")
+	}
+	pi.missingImpls = fi.missingImpls
+	if fi.accumulateErrors {
+		if fi.receiver == receiverValue {
+			return fmt.Errorf("-accumulate-errors can't be used with -receiver=%s, the methods need to modify the wrapper", receiverValue)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == "err" {
+				return errors.New("extra field err collides with the accumulated error field of -accumulate-errors")
+			}
+			if fi.genFieldAccessors && fieldAccessorName(ef) == "Err" {
+				return fmt.Errorf("the accessor of extra field %s collides with the Err method of -accumulate-errors", ef.name)
+			}
+		}
+		if pi.embedStruct != nil && pi.embedStruct.at.name == "err" {
+			return fmt.Errorf("embedded struct %s collides with the accumulated error field of -accumulate-errors", pi.embedStruct)
+		}
+	}
+	pi.accumulateErrors = fi.accumulateErrors
+	if fi.useErrorsJoin {
+		if !fi.accumulateErrors {
+			return errors.New("-use-errors-join can be used only with -accumulate-errors")
+		}
+		pi.useErrorsJoin = true
+	}
+	if fi.genClone {
+		for _, ef := range pi.extraFields {
+			if fi.genFieldAccessors && fieldAccessorName(ef) == "Clone" {
+				return fmt.Errorf("the accessor of extra field %s collides with the Clone method of -gen-clone", ef.name)
+			}
+		}
+	}
+	pi.genClone = fi.genClone
+	if fi.stateMachine != "" {
+		if fi.receiver == receiverValue {
+			return fmt.Errorf("-state-machine can't be used with -receiver=%s, the methods need to modify the wrapper", receiverValue)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == "state" {
+				return errors.New("extra field state collides with the state field of -state-machine")
+			}
+		}
+		if pi.embedStruct != nil && pi.embedStruct.at.name == "state" {
+			return fmt.Errorf("embedded struct %s collides with the state field of -state-machine", pi.embedStruct)
+		}
+		transitions, initial, err := parseStateMachine(fi.stateMachine)
+		if err != nil {
+			return err
+		}
+		pi.stateTransitions = transitions
+		pi.initialState = initial
+	}
+	pi.selfIdempotent = fi.selfIdempotent
+	pi.metrics = fi.metrics
+	pi.debugLog = fi.debugLog
+	pi.genSwitcher = fi.genSwitcher
+	switch fi.fieldOrder {
+	case fieldOrderDefault, fieldOrderAlphabetical, fieldOrderExtrasFirst:
+	default:
+		return fmt.Errorf("invalid value %s for -field-order, expected %s, %s or %s", fi.fieldOrder, fieldOrderDefault, fieldOrderAlphabetical, fieldOrderExtrasFirst)
+	}
+	pi.fieldOrder = fi.fieldOrder
+	switch fi.extrasPosition {
+	case extrasPositionBefore, extrasPositionAfter:
+	default:
+		return fmt.Errorf("invalid value %s for -extras-position, expected either %s or %s", fi.extrasPosition, extrasPositionBefore, extrasPositionAfter)
+	}
+	pi.extrasPosition = fi.extrasPosition
+	switch fi.receiver {
+	case receiverPointer:
+	case receiverValue:
+		if fi.genRebind {
+			return fmt.Errorf("-gen-rebind can't be used with -receiver=%s, the Rebind method needs to modify the wrapper", receiverValue)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name != pi.lockField {
+				continue
+			}
+			if _, isPointer := ef.expr.(*ast.StarExpr); !isPointer {
+				return fmt.Errorf("lock field %s of type %s can't be used with -receiver=%s, each method would lock its own copy of it, use a pointer type", ef.name, ef.typeStr, receiverValue)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid value %s for -receiver, expected either %s or %s", fi.receiver, receiverPointer, receiverValue)
+	}
+	pi.receiver = fi.receiver
+	if fi.receiverName != "" {
+		if !token.IsIdentifier(fi.receiverName) || fi.receiverName == "_" {
+			return fmt.Errorf("receiver name %s from -receiver-name is not a valid identifier", fi.receiverName)
+		}
+		if fi.genRebind && (fi.receiverName == "r" || fi.receiverName == "ri" || fi.receiverName == "ok") {
+			return fmt.Errorf("receiver name %s from -receiver-name collides with a variable of the Rebind method of -gen-rebind", fi.receiverName)
+		}
+		if fi.genClone && fi.receiverName == "clone" {
+			return errors.New("receiver name clone from -receiver-name collides with a variable of the Clone method of -gen-clone")
+		}
+		pi.receiverName = fi.receiverName
+	}
+	switch fi.indent {
+	case indentTabs, indentSpaces:
+	default:
+		return fmt.Errorf("invalid value %s for -indent, expected either %s or %s", fi.indent, indentTabs, indentSpaces)
+	}
+	if fi.indent == indentSpaces && fi.region != "" {
+		return fmt.Errorf("-indent=%s can't be used with -region, the rest of the outfile would be reindented too", indentSpaces)
+	}
+	pi.indent = fi.indent
+	if fi.tabWidth < 1 {
+		return fmt.Errorf("invalid value %d for -tab-width, expected a positive number", fi.tabWidth)
+	}
+	pi.tabWidth = fi.tabWidth
+	pi.groupFields = fi.groupFields
+	pi.rebindOnMismatch = fi.rebindOnMismatch
+	switch fi.strategy {
+	case strategyCombinations:
+	case strategySparse:
+		incompatibleFlags := []struct {
+			name string
+			used bool
+		}{
+			{"-gen-rebind", fi.genRebind},
+			{"-gen-capabilities", fi.genCapabilities},
+			{"-export-combination-interfaces", fi.exportCombinationInterfaces},
+			{"-gen-driver-conformance", fi.genDriverConformance},
+			{"-gen-combination-test", fi.genCombinationTest},
+			{"-forward-template", fi.forwardTemplate != ""},
+		}
+		for _, incompatible := range incompatibleFlags {
+			if incompatible.used {
+				return fmt.Errorf("%s can't be used with -strategy=%s", incompatible.name, strategySparse)
+			}
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == "caps" {
+				return fmt.Errorf("extra field caps collides with the capabilities field of -strategy=%s", strategySparse)
+			}
+		}
+		if len(pi.extTypes) > 64 {
+			return fmt.Errorf("-strategy=%s supports at most 64 extension types, got %d", strategySparse, len(pi.extTypes))
+		}
+	default:
+		return fmt.Errorf("invalid value %s for -strategy, expected either %s or %s", fi.strategy, strategyCombinations, strategySparse)
+	}
+	pi.strategy = fi.strategy
+	if fi.runtimeToggles {
+		if fi.strategy != strategySparse {
+			return fmt.Errorf("-runtime-toggles requires -strategy=%s", strategySparse)
+		}
+		if fi.receiver == receiverValue {
+			return fmt.Errorf("-runtime-toggles can't be used with -receiver=%s, the enabled bitmask could not be modified", receiverValue)
+		}
+		for _, ef := range pi.extraFields {
+			if ef.name == "enabled" {
+				return errors.New("extra field enabled collides with the bitmask field of -runtime-toggles")
+			}
+		}
+	}
+	pi.runtimeToggles = fi.runtimeToggles
+	if fi.wrappedConcrete != "" {
+		if fi.strategy != strategySparse {
+			return fmt.Errorf("-wrapped-concrete requires -strategy=%s, the concrete type is stored in the single wrapper", strategySparse)
+		}
+		incompatibleFlags := []struct {
+			name string
+			used bool
+		}{
+			{"-runtime-toggles", fi.runtimeToggles},
+			{"-wrap-any", fi.wrapAny != ""},
+		}
+		for _, incompatible := range incompatibleFlags {
+			if incompatible.used {
+				return fmt.Errorf("%s can't be used with -wrapped-concrete", incompatible.name)
+			}
+		}
+		ct, err := strToConcreteType(fi.wrappedConcrete)
+		if err != nil {
+			return fmt.Errorf("failed to get a concrete type from input parameter %s: %w", fi.wrappedConcrete, err)
+		}
+		if fi.nilCheck && !ct.isPointer {
+			return fmt.Errorf("-nil-check can't be used with -wrapped-concrete=%s, a value of a non-pointer type can't be nil", ct)
+		}
+		pi.wrappedConcrete = ct
+	}
+	pi.genCapabilityConsts = fi.genCapabilityConsts
+	if fi.appendMode {
+		incompatibleFlags := []struct {
+			name string
+			used bool
+		}{
+			{"-strategy=" + strategySparse, fi.strategy == strategySparse},
+			{"-split-files", fi.splitFiles},
+			{"-region", fi.region != ""},
+			{"-combination-tags", fi.combinationTags != ""},
+		}
+		for _, incompatible := range incompatibleFlags {
+			if incompatible.used {
+				return fmt.Errorf("%s can't be used with -append", incompatible.name)
+			}
+		}
+		pi.appendMode = true
+	}
+	if fi.combinationTags != "" {
+		incompatibleFlags := []struct {
+			name string
+			used bool
+		}{
+			{"-strategy=" + strategySparse, fi.strategy == strategySparse},
+			{"-split-files", fi.splitFiles},
+			{"-region", fi.region != ""},
+			{"-gen-rebind", fi.genRebind},
+			{"-gen-capabilities", fi.genCapabilities},
+			{"-gen-driver-conformance", fi.genDriverConformance},
+			{"-gen-combination-test", fi.genCombinationTest},
+			{"-gen-field-accessors", fi.genFieldAccessors},
+			{"-gen-clone", fi.genClone},
+		}
+		for _, incompatible := range incompatibleFlags {
+			if incompatible.used {
+				return fmt.Errorf("%s can't be used with -combination-tags", incompatible.name)
+			}
+		}
+		combinationTags, err := parseCombinationTags(fi.combinationTags)
+		if err != nil {
+			return err
+		}
+		pi.combinationTags = combinationTags
+	}
+	return nil
+}
+
+var buildTagRegexp = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+func parseCombinationTags(s string) (map[string]string, error) {
+	combinationTags := make(map[string]string)
+	for _, pair := range strings.Split(s, ";") {
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("malformed entry %q in -combination-tags, expected comma-separated extension types and a build tag separated with an equal sign, like driver.Pinger,driver.SessionResetter=withreset", pair)
+		}
+		tag := parts[1]
+		if !buildTagRegexp.MatchString(tag) {
+			return nil, fmt.Errorf("build tag %q from -combination-tags entry %s is invalid, it should consist of ASCII letters, digits, underlines and dots", tag, pair)
+		}
+		if tag == "test" {
+			// the file would be taken for a test file
+			return nil, fmt.Errorf("build tag %s from -combination-tags entry %s can't be used", tag, pair)
+		}
+		names := stringset.StringSet{}
+		for _, et := range strings.Split(parts[0], ",") {
+			at, err := strToAType(et)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get an extension type from -combination-tags entry %s: %w", pair, err)
+			}
+			names.Add(at.String())
+		}
+		key := strings.Join(names.ToSlice(), ",")
+		if _, ok := combinationTags[key]; ok {
+			return nil, fmt.Errorf("combination %s is given more than once in -combination-tags", key)
+		}
+		combinationTags[key] = tag
+	}
+	return combinationTags, nil
+}
+
+// wrapAnyBase is a base type with the name of its new func, tried by
+// the function generated with -wrap-any.
+type wrapAnyBase struct {
+	at          aType
+	newFuncName string
+}
+
+func parseWrapAnyBases(s string) ([]wrapAnyBase, error) {
+	var wrapAnyBases []wrapAnyBase
+	for _, pair := range strings.Split(s, ";") {
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("malformed entry %q in -wrap-any-bases, expected a base type and a new func name separated with an equal sign, like driver.Tx=newTx", pair)
+		}
+		at, err := strToAType(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get a base type from -wrap-any-bases entry %s: %w", pair, err)
+		}
+		if !isValidFunctionName(parts[1]) {
+			return nil, fmt.Errorf("function name %s from -wrap-any-bases entry %s is invalid", parts[1], pair)
+		}
+		wrapAnyBases = append(wrapAnyBases, wrapAnyBase{
+			at:          at,
+			newFuncName: parts[1],
+		})
+	}
+	return wrapAnyBases, nil
+}
+
+// handwrittenNames returns the names in the package scope that are
+// not declared in the generated files.
+func handwrittenNames(scope *types.Scope, fset *token.FileSet) (stringset.StringSet, error) {
+	generatedFiles := make(map[string]bool)
+	names := stringset.StringSet{}
+	for _, name := range scope.Names() {
+		path := fset.Position(scope.Lookup(name).Pos()).Filename
+		generated, ok := generatedFiles[path]
+		if !ok && path != "" {
+			var err error
+			if generated, err = isGeneratedFile(path); err != nil {
+				return nil, err
+			}
+			generatedFiles[path] = generated
+		}
+		if !generated {
+			names.Add(name)
+		}
+	}
+	return names, nil
+}
+
+var generatedCodeRegexp = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// isGeneratedFile checks if the file has the comment marking
+// generated code before the package clause.
+func isGeneratedFile(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if generatedCodeRegexp.MatchString(line) {
+			return true, nil
+		}
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return false, nil
+}
+
+// knownMethodPragmas are the compiler directives that can be put
+// before the generated methods.
+var knownMethodPragmas = []string{
+	"//go:noinline",
+	"//go:nosplit",
+	"//go:norace",
+	"//go:nocheckptr",
+}
+
+const (
+	indentTabs   = "tabs"
+	indentSpaces = "spaces"
+
+	defaultTabWidth = 8
+)
+
+const (
+	receiverPointer = "pointer"
+	receiverValue   = "value"
+)
+
+const (
+	rebindOnMismatchPanic = "panic"
+	rebindOnMismatchError = "error"
+)
+
+const (
+	fieldOrderDefault      = "default"
+	fieldOrderAlphabetical = "alphabetical"
+	fieldOrderExtrasFirst  = "extras-first"
+)
+
+const (
+	extrasPositionBefore = "before"
+	extrasPositionAfter  = "after"
+)
+
+const (
+	strategyCombinations = "combinations"
+	strategySparse       = "sparse"
+)
+
+func parseExtrasOptIn(s string, extraFields []extraField) (map[string][]string, error) {
+	if len(extraFields) == 0 {
+		return nil, errors.New("-extras-opt-in makes no sense without extra fields, use -extrafields to add them")
+	}
+	allNames := make([]string, 0, len(extraFields))
+	known := stringset.StringSet{}
+	for _, ef := range extraFields {
+		allNames = append(allNames, ef.name)
+		known.Add(ef.name)
+	}
+	extrasOptIn := make(map[string][]string)
+	for _, entry := range strings.Split(s, ";") {
+		method, fieldsStr, hasFields := strings.Cut(entry, "=")
+		if !isValidFunctionName(method) {
+			return nil, fmt.Errorf("invalid method name %q in -extras-opt-in entry %s", method, entry)
+		}
+		if _, ok := extrasOptIn[method]; ok {
+			return nil, fmt.Errorf("duplicate method %s in -extras-opt-in", method)
+		}
+		if !hasFields {
+			extrasOptIn[method] = allNames
+			continue
+		}
+		fields := strings.Split(fieldsStr, ",")
+		for _, field := range fields {
+			if !known.Has(field) {
+				return nil, fmt.Errorf("%s in -extras-opt-in entry %s is not one of the extra fields", field, entry)
+			}
+		}
+		extrasOptIn[method] = fields
+	}
+	return extrasOptIn, nil
+}
+
+// stateTransition describes what -state-machine allows for a method:
+// the states it may be called in and the state after the call.
+type stateTransition struct {
+	from []string
+	to   string
+}
+
+// parseStateMachine parses the transitions from -state-machine and
+// returns them together with the initial state, which is the first
+// state listed.
+func parseStateMachine(s string) (map[string]stateTransition, string, error) {
+	transitions := make(map[string]stateTransition)
+	initial := ""
+	for _, entry := range strings.Split(s, ";") {
+		methodsStr, transitionStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, "", fmt.Errorf("malformed entry %q in -state-machine, expected methods and a transition separated with an equal sign, like Begin=idle>inTx", entry)
+		}
+		fromStr, to, ok := strings.Cut(transitionStr, ">")
+		if !ok {
+			return nil, "", fmt.Errorf("malformed transition %q in -state-machine entry %s, expected states separated from the next state with >, like idle>inTx", transitionStr, entry)
+		}
+		from := strings.Split(fromStr, ",")
+		for _, state := range append([]string{to}, from...) {
+			if !isValidFunctionName(state) {
+				return nil, "", fmt.Errorf("invalid state name %q in -state-machine entry %s", state, entry)
+			}
+		}
+		if initial == "" {
+			initial = from[0]
+		}
+		for _, method := range strings.Split(methodsStr, ",") {
+			if !isValidFunctionName(method) {
+				return nil, "", fmt.Errorf("invalid method name %q in -state-machine entry %s", method, entry)
+			}
+			if _, ok := transitions[method]; ok {
+				return nil, "", fmt.Errorf("duplicate method %s in -state-machine", method)
+			}
+			transitions[method] = stateTransition{from: from, to: to}
+		}
+	}
+	return transitions, initial, nil
+}
+
+// wrapperRefs returns what should precede the name of the wrapper
+// type in receivers and type switches and what should precede its
+// composite literals, depending on -receiver.
+func (pi *parsedInput) wrapperRefs() (string, string) {
+	if pi.receiver == receiverValue {
+		return "", ""
+	}
+	return "*", "&"
+}
+
+// typeParamsInfo describes the type parameters of the generic
+// wrappers.
+type typeParamsInfo struct {
+	// decl is the type parameter list, like [K comparable, V any].
+	decl string
+	// ref is the type parameter list without the constraints,
+	// like [K, V].
+	ref string
+	// methods are the names of the methods whose prefix functions
+	// are generic, because they take a generic wrapped value.
+	methods stringset.StringSet
+}
+
+// typeParamsDecl returns the type parameter list to put after the
+// names of the generated types and the new func, or an empty string
+// if the wrappers are not generic.
+func (pi *parsedInput) typeParamsDecl() string {
+	if pi.typeParams == nil {
+		return ""
+	}
+	return pi.typeParams.decl
+}
+
+// typeParamsRef returns the type parameters to put after the names
+// of the generated types when referring to them, or an empty string
+// if the wrappers are not generic.
+func (pi *parsedInput) typeParamsRef() string {
+	if pi.typeParams == nil {
+		return ""
+	}
+	return pi.typeParams.ref
+}
+
+// receiverVar returns the name of the receiver of the methods of the
+// wrapper type with the given base name, depending on -receiver-name.
+func (pi *parsedInput) receiverVar(tbn string) string {
+	if pi.receiverName != "" {
+		return pi.receiverName
+	}
+	return "o" + tbn
+}
+
+// printerConfig returns the configuration for printing the
+// generated code. The code is indented with tabs like gofmt does or,
+// with -indent=spaces, with spaces.
+func (pi *parsedInput) printerConfig() *printer.Config {
+	if pi.indent == indentSpaces {
+		return &printer.Config{
+			Mode:     printer.UseSpaces,
+			Tabwidth: pi.tabWidth,
+		}
+	}
+	return &printer.Config{
+		Mode:     printer.UseSpaces | printer.TabIndent,
+		Tabwidth: 8,
+	}
+}
+
+// outPkgName returns the name of the package of the generated code.
+func (pi *parsedInput) outPkgName(rt *resolvedTypes) string {
+	if pi.packageName != "" {
+		return pi.packageName
+	}
+	return rt.thisPkgName
+}
+
+// hooksFieldName is the name of the extra field added by
+// -hooks-interface.
+const hooksFieldName = "hooks"
+
+// hookExtraFieldNamesFor returns the names of the extra fields passed
+// to the prefix function or the hook of the method. The hooks field
+// itself is not passed.
+func (pi *parsedInput) hookExtraFieldNamesFor(method string) []string {
+	names := pi.extraFieldNamesFor(method)
+	if pi.hooksInterface == nil {
+		return names
+	}
+	filtered := make([]string, 0, len(names))
+	for _, name := range names {
+		if name != hooksFieldName {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
+}
+
+// extraFieldNamesFor returns the names of the extra fields that
+// should be passed to the prefix function of the method.
+func (pi *parsedInput) extraFieldNamesFor(method string) []string {
+	if pi.extrasOptIn != nil {
+		return pi.extrasOptIn[method]
+	}
+	names := make([]string, 0, len(pi.extraFields))
+	for _, ef := range pi.extraFields {
+		names = append(names, ef.name)
+	}
+	return names
+}
+
+// forwardTemplateData is passed to the template given with
+// -forward-template.
+type forwardTemplateData struct {
+	// Method is the name of the method being generated, like
+	// Read.
+	Method string
+}
+
+// forwardTarget returns the name of the method of the wrapped value
+// that the generated method should call.
+func (pi *parsedInput) forwardTarget(method string) (string, error) {
+	sb := strings.Builder{}
+	if err := pi.forwardTemplate.Execute(&sb, forwardTemplateData{Method: method}); err != nil {
+		return "", fmt.Errorf("failed to execute the forward template for method %s: %w", method, err)
+	}
+	target := sb.String()
+	if !isValidFunctionName(target) {
+		return "", fmt.Errorf("forward template maps method %s to %q, which is not a valid method name", method, target)
+	}
+	return target, nil
+}
+
+const defaultOutFileTemplate = "{{.BaseTypeLower}}_wrappers.go"
+
+// outFileTemplateData is passed to the template given with
+// -outfile-template.
+type outFileTemplateData struct {
+	// BaseType is the base type as passed in -basetype, like
+	// driver.Conn.
+	BaseType string
+	// BaseTypeName is the name of the base type without a
+	// package, like Conn.
+	BaseTypeName string
+	// BaseTypePkg is the package name of the base type, like
+	// driver. Empty for types from this package.
+	BaseTypePkg string
+	// BaseTypeLower is the base type without the dot and in
+	// lowercase, like driverconn.
+	BaseTypeLower string
+	// Prefix is the value of -prefix.
+	Prefix string
+}
+
+func newOutFileTemplateData(baseType aType, prefix string) outFileTemplateData {
+	return outFileTemplateData{
+		BaseType:      baseType.String(),
+		BaseTypeName:  baseType.name,
+		BaseTypePkg:   baseType.pkgName,
+		BaseTypeLower: strings.ToLower(baseType.StringNoDot()),
+		Prefix:        prefix,
+	}
+}
+
+func deduceOutFile(outFileTemplate string, baseType aType, prefix string) (string, error) {
+	tmpl, err := template.New("outfile").Option("missingkey=error").Parse(outFileTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse outfile template %s: %w", outFileTemplate, err)
+	}
+	data := newOutFileTemplateData(baseType, prefix)
+	sb := strings.Builder{}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to execute outfile template %s: %w", outFileTemplate, err)
+	}
+	if sb.Len() == 0 {
+		return "", fmt.Errorf("outfile template %s yielded an empty file name", outFileTemplate)
+	}
+	return sb.String(), nil
+}
+
+// expandInterfacePattern returns the inputs of the wrapper families
+// of the interfaces matching -interface-pattern, with -prefix and
+// -newfuncname templates executed for each of them.
+func (fi *flagsInput) expandInterfacePattern() ([]*flagsInput, error) {
+	pattern := regexp.MustCompile(fi.interfacePattern)
+	names, err := findInterfaces(fi.inFile, pattern)
+	if err != nil {
+		return nil, withExitCode(exitCodeResolve, err)
+	}
+	if len(names) == 0 {
+		return nil, withExitCode(exitCodeResolve, fmt.Errorf("no interfaces in the package of infile %s match the interface pattern %s", fi.inFile, fi.interfacePattern))
+	}
+	prefixes := make(map[string]string, len(names))
+	newFuncNames := make(map[string]string, len(names))
+	expanded := make([]*flagsInput, 0, len(names))
+	for _, name := range names {
+		baseType := aType{name: name}
+		prefix, err := executeNameTemplate("prefix", fi.prefix, newOutFileTemplateData(baseType, ""))
+		if err != nil {
+			return nil, withExitCode(exitCodeInput, err)
+		}
+		newFuncName, err := executeNameTemplate("newfuncname", fi.newFuncName, newOutFileTemplateData(baseType, prefix))
+		if err != nil {
+			return nil, withExitCode(exitCodeInput, err)
+		}
+		if other, ok := prefixes[prefix]; ok {
+			return nil, withExitCode(exitCodeInput, fmt.Errorf("both %s and %s would get prefix %s, use a template in -prefix, like real{{.BaseTypeName}}", other, name, prefix))
+		}
+		prefixes[prefix] = name
+		if other, ok := newFuncNames[newFuncName]; ok {
+			return nil, withExitCode(exitCodeInput, fmt.Errorf("both %s and %s would get new func %s, use a template in -newfuncname, like new{{.BaseTypeName}}", other, name, newFuncName))
+		}
+		newFuncNames[newFuncName] = name
+		family := *fi
+		family.interfacePattern = ""
+		family.baseType = name
+		family.prefix = prefix
+		family.newFuncName = newFuncName
+		expanded = append(expanded, &family)
+	}
+	if fi.genCapabilityConsts && len(expanded) > 1 {
+		return nil, withExitCode(exitCodeInput, fmt.Errorf("-gen-capability-consts can't be used when several interfaces match the interface pattern, each of %s would declare the %s type", strings.Join(names, ", "), capabilityTypeName))
+	}
+	return expanded, nil
+}
+
+func executeNameTemplate(flagName, text string, data outFileTemplateData) (string, error) {
+	tmpl, err := template.New(flagName).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse -%s template %s: %w", flagName, text, err)
+	}
+	sb := strings.Builder{}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to execute -%s template %s: %w", flagName, text, err)
+	}
+	return sb.String(), nil
+}
+
+// findInterfaces returns the sorted names of the interfaces in the
+// package of the infile matching the pattern.
+func findInterfaces(inFile string, pattern *regexp.Regexp) ([]string, error) {
+	absInFile, err := filepath.Abs(inFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get an absolute path of the infile %s: %w", inFile, err)
+	}
+	loadPattern := fmt.Sprintf("file=%s", absInFile)
+	cfg := packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes,
+		Logf: debug,
+		Dir:  filepath.Dir(absInFile),
+	}
+	pkgs, err := packages.Load(&cfg, loadPattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages with pattern %s: %w", loadPattern, err)
+	}
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		return nil, fmt.Errorf("failed to load the package of infile %s", inFile)
+	}
+	scope := pkgs[0].Types.Scope()
+	var names []string
+	// Names are sorted
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || typeName.IsAlias() || !pattern.MatchString(name) {
+			continue
+		}
+		if _, ok := typeName.Type().Underlying().(*types.Interface); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func isValidFunctionName(s string) bool {
+	if s == "" {
+		return false
+	}
+	if (s[0] < 'A' || s[0] > 'Z') &&
+		(s[0] < 'a' || s[0] > 'z') &&
+		(s[0] != '_') {
+		return false
+	}
+	for idx := 1; idx < len(s); idx++ { // first character was already checked
+		if (s[idx] < 'A' || s[idx] > 'Z') &&
+			(s[idx] < 'a' || s[idx] > 'z') &&
+			(s[idx] < '0' || s[idx] > '9') &&
+			(s[idx] != '_') {
+			return false
+		}
+	}
+	return true
+}
+
+type resolvedTypes struct {
+	thisPkgName      string
+	thisPkgPath      string
+	thisPkgScope     *types.Scope
+	fset             *token.FileSet
+	resolvedBaseType resolvedType
+	resolvedExtTypes []resolvedType
+	resolvedEfTypes  []resolvedType
+	// handwrittenNames are the names declared in the package of
+	// the infile outside the generated files, so the code from
+	// the previous run does not collide with the code being
+	// generated.
+	handwrittenNames stringset.StringSet
+	// resolvedWrapAnyBases are in the same order as
+	// parsedInput.wrapAnyBases.
+	resolvedWrapAnyBases []resolvedType
+	// resolvedTypeArgs are the named type arguments of the
+	// instantiated types, their packages need to be imported.
+	resolvedTypeArgs []resolvedType
+
+	resolvedEmbedStruct *resolvedType
+	// resolvedHooksInterface is not nil with -hooks-interface.
+	resolvedHooksInterface *resolvedType
+	// resolvedWrappedConcrete is not nil with -wrapped-concrete.
+	resolvedWrappedConcrete *resolvedType
+	// constraintBaseType is not nil if the base type has type
+	// constraints, it is the original base type then and
+	// resolvedBaseType is the generated interface with its
+	// methods.
+	constraintBaseType *resolvedType
+	// fetchDir is the directory with the copy of go.mod and
+	// go.sum made by -fetch, empty if nothing was fetched.
+	fetchDir string
+	// typeParams is not nil if the base type is a generic type
+	// given without the type arguments, the wrappers are generic
+	// then.
+	typeParams *types.TypeParamList
+}
+
+func (rt *resolvedTypes) resolveTypes(pi *parsedInput) error {
+	pattern := fmt.Sprintf("file=%s", pi.inFile)
+	cfg := packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedModule,
+		Logf: debug,
+		Fset: token.NewFileSet(),
+		// the infile may belong to a different module than
+		// the current directory
+		Dir: filepath.Dir(pi.inFile),
+		// TODO: specify parser function that skips function
+		// bodies
+	}
+	pkgs, err := packages.Load(&cfg, pattern)
+	if err != nil {
+		return fmt.Errorf("failed to load packages with pattern %s: %w", pattern, err)
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("loaded %d packages for pattern %s, expected one", len(pkgs), pattern)
+	}
+	if err := goVersionError(pkgs[0]); err != nil {
+		return err
+	}
+	// the package may be in the middle of a refactoring, so its
+	// errors are fatal only if they break the wrapped types
+	pkgErrs := describePackageErrors(pkgs[0].Errors)
+	withPkgErrs := func(err error) error {
+		if pkgErrs == "" {
+			return err
+		}
+		return fmt.Errorf("%w (the package of the infile has errors, which may be the cause: %s)", err, pkgErrs)
+	}
+	rt.thisPkgName = pkgs[0].Name
+	rt.thisPkgPath = pkgs[0].PkgPath
+	rt.thisPkgScope = pkgs[0].Types.Scope()
+	rt.fset = cfg.Fset
+	// the copy of go.mod made by -fetch is needed only for
+	// loading the packages
+	defer func() {
+		if rt.fetchDir != "" {
+			os.RemoveAll(rt.fetchDir)
+		}
+	}()
+	if rt.handwrittenNames, err = handwrittenNames(rt.thisPkgScope, rt.fset); err != nil {
+		return err
+	}
+	{
+		resType, err := rt.resolveType(&cfg, pkgs[0], pi, pi.baseType)
+		if err != nil {
+			return withPkgErrs(&TypeResolutionError{
+				Role:   "base type",
+				Type:   pi.baseType.String(),
+				Reason: err,
+			})
+		}
+		if tparams := resType.rt.TypeParams(); tparams.Len() > 0 && resType.rt.TypeArgs().Len() == 0 {
+			// the type is referred to with its type parameters
+			// in the generated code, like Store[T]
+			names := make([]string, 0, tparams.Len())
+			for idx := 0; idx < tparams.Len(); idx++ {
+				names = append(names, tparams.At(idx).Obj().Name())
+			}
+			resType.at.typeArgs = strings.Join(names, ", ")
+			resType.generic = true
+			rt.typeParams = tparams
+		}
+		rt.resolvedBaseType = resType
+	}
+	for _, extType := range pi.extTypes {
+		resType, err := rt.resolveType(&cfg, pkgs[0], pi, extType)
+		if err != nil && pi.extTypesInBasePkg && extType.pkgName == "" && pi.baseType.pkgName != "" {
+			baseExtType := aType{
+				pkgName: pi.baseType.pkgName,
+				name:    extType.name,
+				pkgPath: pi.baseType.pkgPath,
+			}
+			if baseResType, baseErr := rt.resolveType(&cfg, pkgs[0], pi, baseExtType); baseErr == nil {
+				resType, err = baseResType, nil
+			}
+		}
+		if err != nil {
+			return withPkgErrs(&TypeResolutionError{
+				Role:   "ext type",
+				Type:   extType.String(),
+				Reason: err,
+			})
+		}
+		if rt.typeParams != nil {
+			if resType, err = rt.instantiateWithTypeParams(resType); err != nil {
+				return err
+			}
+		}
+		rt.resolvedExtTypes = append(rt.resolvedExtTypes, resType)
+	}
+	if pkgErrs != "" {
+		// not collected, so -strict does not fail, the package
+		// usually has errors before the first generation,
+		// because it refers to the code not generated yet
+		warn("the package of the infile has errors, generating the wrappers anyway: %s", pkgErrs)
+	}
+	if pi.hooksInterface != nil {
+		resType, err := rt.resolveType(&cfg, pkgs[0], pi, *pi.hooksInterface)
+		if err != nil {
+			return &TypeResolutionError{
+				Role:   "hooks interface",
+				Type:   pi.hooksInterface.String(),
+				Reason: err,
+			}
+		}
+		if _, ok := resType.rt.Underlying().(*types.Interface); !ok {
+			return &NotAnInterfaceError{
+				Role: "hooks interface",
+				Type: pi.hooksInterface.String(),
+			}
+		}
+		rt.resolvedHooksInterface = &resType
+	}
+	if ct := pi.wrappedConcrete; ct != nil {
+		resType, err := rt.resolveType(&cfg, pkgs[0], pi, ct.at)
+		if err != nil {
+			return &TypeResolutionError{
+				Role:   "concrete type",
+				Type:   ct.at.String(),
+				From:   "-wrapped-concrete",
+				Reason: err,
+			}
+		}
+		if err := rt.checkConcreteImplements(resType, ct); err != nil {
+			return err
+		}
+		rt.resolvedWrappedConcrete = &resType
+		// the concrete type is printed from the parsed input,
+		// make it use the real package name
+		ct.at = resType.at
+	}
+	efPkgs := make(map[string]*types.Package)
+	for _, ef := range pi.extraFields {
+		efTypes, err := collectNamesFromAST(ef.expr)
+		if err != nil {
+			return fmt.Errorf("failed to collect type names from field type %s, likely an unsupported go type expression: %w", ef.typeStr, err)
+		}
+		for _, efType := range efTypes {
+			pkg, realType, err := rt.resolveAnyType(&cfg, pkgs[0], pi, efType)
+			if err != nil {
+				return fmt.Errorf("failed to resolve a type %s from extra field type %s: %w", efType, ef.typeStr, err)
+			}
+			if pkg != nil {
+				efPkgs[efType.pkgName] = pkg.Types
+			}
+			named, ok := types.Unalias(realType).(*types.Named)
+			if !ok {
+				// all the efType are names in form of
+				// either pkg.typename or typename, so
+				// the realType can be either a named
+				// type or a basic type. If it's a
+				// basic type, then let's ignore it -
+				// there is nothing to import for it
+				// anyway.
+				continue
+			}
+			resType := wrapIntoResolvedType(efType, pkg, named)
+			rt.resolvedEfTypes = append(rt.resolvedEfTypes, resType)
+		}
+	}
+	for idx, funcType := range pi.extraMethodTypes {
+		mi := pi.extraMethods[idx]
+		emTypes, err := collectNamesFromAST(funcType)
+		if err != nil {
+			return fmt.Errorf("failed to collect type names from the signature of extra method %s, likely an unsupported go type expression: %w", mi.name, err)
+		}
+		for _, emType := range emTypes {
+			pkg, realType, err := rt.resolveAnyType(&cfg, pkgs[0], pi, emType)
+			if err != nil {
+				return fmt.Errorf("failed to resolve a type %s from the signature of extra method %s: %w", emType, mi.name, err)
+			}
+			if named, ok := types.Unalias(realType).(*types.Named); ok {
+				rt.resolvedEfTypes = append(rt.resolvedEfTypes, wrapIntoResolvedType(emType, pkg, named))
+			}
+		}
+	}
+	if pi.embedStruct != nil {
+		resType, err := rt.resolveType(&cfg, pkgs[0], pi, pi.embedStruct.at)
+		if err != nil {
+			return fmt.Errorf("failed to resolve embedded struct %s: %w", pi.embedStruct, err)
+		}
+		if _, ok := resType.rt.Underlying().(*types.Struct); !ok {
+			return fmt.Errorf("embedded struct %s is not a struct", pi.embedStruct)
+		}
+		rt.resolvedEmbedStruct = &resType
+		// the embedded struct is printed from the parsed
+		// input, make it use the real package name
+		pi.embedStruct.at = resType.at
+	}
+	for _, wab := range pi.wrapAnyBases {
+		resType, err := rt.resolveType(&cfg, pkgs[0], pi, wab.at)
+		if err != nil {
+			return &TypeResolutionError{
+				Role:   "base type",
+				Type:   wab.at.String(),
+				From:   "-wrap-any-bases",
+				Reason: err,
+			}
+		}
+		if _, ok := resType.rt.Underlying().(*types.Interface); !ok {
+			return &NotAnInterfaceError{
+				Role: "base type",
+				Type: wab.at.String(),
+				From: "-wrap-any-bases",
+			}
+		}
+		rt.resolvedWrapAnyBases = append(rt.resolvedWrapAnyBases, resType)
+	}
+	if pi.validateExtraFields {
+		if err := validateExtraFieldTypes(pkgs[0], pi.extraFields, efPkgs); err != nil {
+			return err
+		}
+	}
+	if pi.receiver == receiverValue {
+		rt.warnAboutIncomparableFields(pkgs[0], pi, efPkgs)
+	}
+	if pi.genClone {
+		if err := rt.checkCloneableFields(pkgs[0], pi, efPkgs); err != nil {
+			return err
+		}
+	}
+	if pi.lockField != "" {
+		if err := rt.checkLockField(&cfg, pkgs[0], pi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extraFieldsPkg returns a package with the types of this package and
+// the packages the types of the extra fields refer to in scope, for
+// evaluating the types of the extra fields.
+func extraFieldsPkg(thisPkg *packages.Package, efPkgs map[string]*types.Package) *types.Package {
+	pkg := types.NewPackage(thisPkg.PkgPath, thisPkg.Name)
+	scope := pkg.Scope()
+	thisScope := thisPkg.Types.Scope()
+	for _, name := range thisScope.Names() {
+		scope.Insert(thisScope.Lookup(name))
+	}
+	for name, efPkg := range efPkgs {
+		scope.Insert(types.NewPkgName(token.NoPos, pkg, name, efPkg))
+	}
+	return pkg
+}
+
+// validateExtraFieldTypes type-checks the extra field types as if
+// they were written in this package with the packages they refer to
+// imported under the names used in the type expressions.
+func validateExtraFieldTypes(thisPkg *packages.Package, extraFields []extraField, efPkgs map[string]*types.Package) error {
+	pkg := extraFieldsPkg(thisPkg, efPkgs)
+	fset := token.NewFileSet()
+	for _, ef := range extraFields {
+		tv, err := types.Eval(fset, pkg, token.NoPos, ef.typeStr)
+		if err != nil {
+			return fmt.Errorf("extra field %s has an invalid type %s: %w", ef.name, ef.typeStr, err)
+		}
+		if !tv.IsType() {
+			return fmt.Errorf("extra field %s has type %s, which is not a type", ef.name, ef.typeStr)
+		}
+	}
+	return nil
+}
+
+// warnAboutIncomparableFields warns about the fields that make the
+// value wrappers incomparable, comparing interfaces holding such
+// wrappers panics.
+func (rt *resolvedTypes) warnAboutIncomparableFields(thisPkg *packages.Package, pi *parsedInput, efPkgs map[string]*types.Package) {
+	pkg := extraFieldsPkg(thisPkg, efPkgs)
+	fset := token.NewFileSet()
+	for _, ef := range pi.extraFields {
+		tv, err := types.Eval(fset, pkg, token.NoPos, ef.typeStr)
+		if err != nil || !tv.IsType() {
+			// invalid types are reported by
+			// -validate-extrafields or by the compiler
+			continue
+		}
+		if !types.Comparable(tv.Type) {
+			pi.warnings.warn("extra field %s of type %s is not comparable, so comparing the value wrappers will panic", ef.name, ef.typeStr)
+		}
+	}
+	if es := rt.resolvedEmbedStruct; es != nil && !pi.embedStruct.isPointer && !types.Comparable(es.rt) {
+		pi.warnings.warn("embedded struct %s is not comparable, so comparing the value wrappers will panic", pi.embedStruct)
+	}
+}
+
+// checkCloneableFields makes sure that the copies made by the Clone
+// methods do not share or copy locks and channels of the extra fields
+// and of the embedded struct.
+func (rt *resolvedTypes) checkCloneableFields(thisPkg *packages.Package, pi *parsedInput, efPkgs map[string]*types.Package) error {
+	pkg := extraFieldsPkg(thisPkg, efPkgs)
+	fset := token.NewFileSet()
+	for _, ef := range pi.extraFields {
+		tv, err := types.Eval(fset, pkg, token.NoPos, ef.typeStr)
+		if err != nil || !tv.IsType() {
+			// invalid types are reported by
+			// -validate-extrafields or by the compiler
+			continue
+		}
+		if what := uncopyableContents(tv.Type); what != "" {
+			return fmt.Errorf("can't generate the Clone method, extra field %s of type %s contains %s", ef.name, ef.typeStr, what)
+		}
+	}
+	if es := rt.resolvedEmbedStruct; es != nil && !pi.embedStruct.isPointer {
+		if what := uncopyableContents(es.rt); what != "" {
+			return fmt.Errorf("can't generate the Clone method, embedded struct %s contains %s", pi.embedStruct, what)
+		}
+	}
+	return nil
+}
+
+// uncopyableContents returns a description of what makes the copies
+// of a value of the type problematic, or an empty string if there is
+// nothing like that. Locks are detected the same way go vet does it,
+// by the Lock and Unlock methods on the pointer receiver.
+func uncopyableContents(t types.Type) string {
+	if _, ok := t.Underlying().(*types.Interface); !ok {
+		ptrMethods := types.NewMethodSet(types.NewPointer(t))
+		if ptrMethods.Lookup(nil, "Lock") != nil && ptrMethods.Lookup(nil, "Unlock") != nil && types.NewMethodSet(t).Lookup(nil, "Lock") == nil {
+			return fmt.Sprintf("a lock (%s)", t)
+		}
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Chan:
+		return fmt.Sprintf("a channel (%s)", t)
+	case *types.Array:
+		return uncopyableContents(u.Elem())
+	case *types.Struct:
+		for idx := 0; idx < u.NumFields(); idx++ {
+			if what := uncopyableContents(u.Field(idx).Type()); what != "" {
+				return what
+			}
+		}
+	}
+	return ""
+}
+
+func (rt *resolvedTypes) checkLockField(cfg *packages.Config, thisPkg *packages.Package, pi *parsedInput) error {
+	var lockField extraField
+	for _, ef := range pi.extraFields {
+		if ef.name == pi.lockField {
+			lockField = ef
+			break
+		}
+	}
+	expr := lockField.expr
+	star, isPointer := expr.(*ast.StarExpr)
+	if isPointer {
+		expr = star.X
+	}
+	switch expr.(type) {
+	case *ast.Ident, *ast.SelectorExpr:
+	default:
+		return fmt.Errorf("lock field %s has type %s, expected a named type or a pointer to a named type", lockField.name, lockField.typeStr)
+	}
+	names, err := collectNamesFromAST(expr)
+	if err != nil {
+		return fmt.Errorf("failed to collect type names from lock field type %s: %w", lockField.typeStr, err)
+	}
+	_, realType, err := rt.resolveAnyType(cfg, thisPkg, pi, names[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve a type %s from lock field type %s: %w", names[0], lockField.typeStr, err)
+	}
+	if isPointer {
+		realType = types.NewPointer(realType)
+	}
+	for _, methodName := range []string{"Lock", "Unlock"} {
+		obj, _, _ := types.LookupFieldOrMethod(realType, true, nil, methodName)
+		if _, ok := obj.(*types.Func); !ok {
+			return fmt.Errorf("lock field %s of type %s has no %s method", lockField.name, lockField.typeStr, methodName)
+		}
+	}
+	return nil
+}
+
+func collectNamesFromAST(a ast.Expr) ([]aType, error) {
+	if a == nil {
+		return nil, fmt.Errorf("nil ast node")
+	}
+	switch t := a.(type) {
+	case *ast.Ident:
+		return []aType{
+			{
+				pkgName: "",
+				name:    t.Name,
+			},
+		}, nil
+	case *ast.SelectorExpr:
+		xident, ok := t.X.(*ast.Ident)
+		if !ok || xident == nil || t.Sel == nil {
+			return nil, fmt.Errorf("can't parse ast selector expression")
+		}
+		return []aType{
+			{
+				pkgName: xident.Name,
+				name:    t.Sel.Name,
+			},
+		}, nil
+	case *ast.ArrayType:
+		return collectNamesFromAST(t.Elt)
+	case *ast.StarExpr:
+		return collectNamesFromAST(t.X)
+	case *ast.FuncType:
+		var types []aType
+		for _, field := range t.Params.List {
+			ptypes, err := collectNamesFromAST(field.Type)
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, ptypes...)
+		}
+		if t.Results == nil {
+			return types, nil
+		}
+		for _, field := range t.Results.List {
+			rtypes, err := collectNamesFromAST(field.Type)
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, rtypes...)
+		}
+		return types, nil
+	case *ast.MapType:
+		keyTypes, err := collectNamesFromAST(t.Key)
+		if err != nil {
+			return nil, err
+		}
+		valueTypes, err := collectNamesFromAST(t.Value)
+		if err != nil {
+			return nil, err
+		}
+		return append(keyTypes, valueTypes...), nil
+	case *ast.ChanType:
+		return collectNamesFromAST(t.Value)
+	case *ast.IndexExpr:
+		return collectNamesFromASTs(append([]ast.Expr{t.X}, t.Index))
+	case *ast.IndexListExpr:
+		return collectNamesFromASTs(append([]ast.Expr{t.X}, t.Indices...))
+	}
+	return nil, nil
+}
+
+// collectNamesFromASTs collects the type names from all the passed
+// expressions, like the generic type and its type arguments.
+func collectNamesFromASTs(exprs []ast.Expr) ([]aType, error) {
+	var names []aType
+	for _, expr := range exprs {
+		exprNames, err := collectNamesFromAST(expr)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, exprNames...)
+	}
+	return names, nil
+}
+
+func (rt *resolvedTypes) resolveType(cfg *packages.Config, thisPkg *packages.Package, pi *parsedInput, typeToResolve aType) (resolvedType, error) {
+	nilrt := resolvedType{}
+	pkg, realType, err := rt.resolveAnyType(cfg, thisPkg, pi, typeToResolve)
+	if err != nil {
+		return nilrt, err
+	}
+	named, ok := types.Unalias(realType).(*types.Named)
+	if !ok {
+		return nilrt, fmt.Errorf("type %s is not a named type", typeToResolve)
+	}
+	return wrapIntoResolvedType(typeToResolve, pkg, named), nil
+}
+
+func wrapIntoResolvedType(typeToResolve aType, pkg *packages.Package, named *types.Named) resolvedType {
+	if pkg != nil && typeToResolve.pkgPath != "" {
+		// the name was only guessed
+		typeToResolve.pkgName = pkg.Name
+	}
+	if pkg == nil {
+		return resolvedType{
+			at: typeToResolve,
+			rt: named,
+		}
+	}
+	return resolvedType{
+		at:          typeToResolve,
+		rt:          named,
+		origPkgName: pkg.Name,
+		pkgPath:     pkg.PkgPath,
+	}
+}
+
+func (rt *resolvedTypes) resolveAnyType(cfg *packages.Config, thisPkg *packages.Package, pi *parsedInput, typeToResolve aType) (*packages.Package, types.Type, error) {
+	if typeToResolve.typeArgs != "" {
+		return rt.resolveInstance(cfg, thisPkg, pi, typeToResolve)
+	}
+	pkgPath, err := getPkgPath(thisPkg, typeToResolve, pi.inFile, pi.imports)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get package path for type %s: %w (means, the package of the type is not imported in this package nor mentioned in -imports)", typeToResolve, err)
+	}
+	if pkgPath == "" {
+		// no package name means one of the following:
+		// - type comes from this package
+		// - type is a builtin (error)
+		// - type comes from a package imported with a dot
+		//
+		// last case is currently not supported
+		realType, err := getType(thisPkg.Types.Scope(), typeToResolve.name)
+		if err != nil {
+			realType, err = getType(types.Universe, typeToResolve.name)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve the type %s in this package and in Universe: %w", typeToResolve, &missingTypeError{
+				typeName: typeToResolve.name,
+				pkgPath:  thisPkg.PkgPath,
+			})
+		}
+		return nil, realType, nil
+	}
+	pkg, err := findPackage(cfg, thisPkg, pkgPath)
+	if err != nil && pi.fetch {
+		if fetchErr := rt.fetchPackage(cfg, thisPkg, pkgPath); fetchErr != nil {
+			return nil, nil, fetchErr
+		}
+		pkg, err = findPackage(cfg, thisPkg, pkgPath)
+		if err == nil {
+			pi.warnings.warn("package %s was fetched without modifying go.mod, run go get %s in the module of the infile, so the generated code compiles", pkgPath, pkgPath)
+		}
+	}
+	if err != nil {
+		hint := ""
+		if !pi.fetch {
+			hint = ", if the module providing it is not a dependency of this module yet, use -fetch to add it"
+		}
+		return nil, nil, fmt.Errorf("failed to find package %s for type %s: %w (means, it isn't imported in this package, nor the go tools loader could load it%s)", pkgPath, typeToResolve, err, hint)
+	}
+	realType, err := getType(pkg.Types.Scope(), typeToResolve.name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve the type %s in pkg %s: %w", typeToResolve, pkg.Name, &missingTypeError{
+			typeName: typeToResolve.name,
+			pkgPath:  pkg.PkgPath,
+		})
+	}
+	return pkg, realType, nil
+}
+
+// instantiateWithTypeParams instantiates the generic extension type
+// given without the type arguments with the type parameters of the
+// generic base type, so the wrappers can implement it. Other types
+// are returned as is.
+func (rt *resolvedTypes) instantiateWithTypeParams(resType resolvedType) (resolvedType, error) {
+	tparams := resType.rt.TypeParams()
+	if tparams.Len() == 0 || resType.rt.TypeArgs().Len() > 0 {
+		return resType, nil
+	}
+	if tparams.Len() != rt.typeParams.Len() {
+		return resolvedType{}, fmt.Errorf("generic ext type %s has %d type parameter(s) and the generic base type %s has %d, can't instantiate it with the type parameters of the base type", resType.at, tparams.Len(), rt.resolvedBaseType.at.name, rt.typeParams.Len())
+	}
+	typeArgs := make([]types.Type, 0, rt.typeParams.Len())
+	for idx := 0; idx < rt.typeParams.Len(); idx++ {
+		typeArgs = append(typeArgs, rt.typeParams.At(idx))
+	}
+	instance, err := types.Instantiate(nil, resType.rt, typeArgs, true)
+	if err != nil {
+		return resolvedType{}, fmt.Errorf("failed to instantiate ext type %s with the type parameters of the base type %s: %w", resType.at, rt.resolvedBaseType.at, err)
+	}
+	resType.rt = instance.(*types.Named)
+	resType.at.typeArgs = rt.resolvedBaseType.at.typeArgs
+	resType.generic = true
+	return resType, nil
+}
+
+// resolveInstance resolves the generic type and instantiates it with
+// the type arguments. The generic type can be either a named type or
+// a generic alias (since Go 1.24), like Set[string] for type
+// Set[T comparable] = internal.Set[T].
+func (rt *resolvedTypes) resolveInstance(cfg *packages.Config, thisPkg *packages.Package, pi *parsedInput, typeToResolve aType) (*packages.Package, types.Type, error) {
+	genericType := typeToResolve
+	genericType.typeArgs = ""
+	pkg, realType, err := rt.resolveAnyType(cfg, thisPkg, pi, genericType)
+	if err != nil {
+		return nil, nil, err
+	}
+	var typeArgs []types.Type
+	for _, argType := range typeToResolve.typeArgList() {
+		argPkg, argRealType, err := rt.resolveAnyType(cfg, thisPkg, pi, argType)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve type argument %s of %s: %w", argType, typeToResolve, err)
+		}
+		if named, ok := types.Unalias(argRealType).(*types.Named); ok && argPkg != nil {
+			rt.resolvedTypeArgs = append(rt.resolvedTypeArgs, wrapIntoResolvedType(argType, argPkg, named))
+		}
+		typeArgs = append(typeArgs, argRealType)
+	}
+	instance, err := types.Instantiate(nil, realType, typeArgs, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to instantiate %s: %w", typeToResolve, err)
+	}
+	return pkg, instance, nil
+}
+
+// checkConcreteImplements makes sure that the concrete type of
+// -wrapped-concrete implements the base type and all the extension
+// types, the single wrapper implements all of them.
+func (rt *resolvedTypes) checkConcreteImplements(concrete resolvedType, ct *concreteType) error {
+	var concreteType types.Type = concrete.rt
+	if ct.isPointer {
+		concreteType = types.NewPointer(concreteType)
+	}
+	for _, resType := range append([]resolvedType{rt.resolvedBaseType}, rt.resolvedExtTypes...) {
+		iface, ok := resType.rt.Underlying().(*types.Interface)
+		if !ok {
+			// reported when analyzing the types
+			continue
+		}
+		if method, _ := types.MissingMethod(concreteType, iface, true); method != nil {
+			hint := ""
+			if !ct.isPointer && types.Implements(types.NewPointer(concreteType), iface) {
+				hint = fmt.Sprintf(", but *%s does", ct)
+			}
+			return fmt.Errorf("concrete type %s does not implement %s (missing method %s)%s", ct, resType.at, method.Name(), hint)
+		}
+	}
+	return nil
+}
+
+// hookMethod returns the method of the hooks interface with the
+// given name or nil if there is no such method.
+func (rt *resolvedTypes) hookMethod(name string) *types.Func {
+	iface := rt.resolvedHooksInterface.rt.Underlying().(*types.Interface)
+	for idx := 0; idx < iface.NumMethods(); idx++ {
+		if method := iface.Method(idx); method.Name() == name {
+			return method
+		}
+	}
+	return nil
+}
+
+// checkHookMethod checks if the hooks interface has a method the
+// generated method can call. Only the number of the parameters and
+// the results is checked, the compiler reports the mismatched types.
+func checkHookMethod(rt *resolvedTypes, pi *parsedInput, mi methodInfo) error {
+	hook := rt.hookMethod(mi.name)
+	if hook == nil {
+		return fmt.Errorf("hooks interface %s has no method %s, use -missing-impls to print the missing methods", pi.hooksInterface, mi.name)
+	}
+	sig := hook.Type().(*types.Signature)
+	// the wrapped value, the extra fields and the parameters of
+	// the method
+	params := 1 + len(pi.hookExtraFieldNamesFor(mi.name)) + len(mi.parameters)
+	if sig.Params().Len() != params || sig.Results().Len() != len(mi.returnTypes) {
+		return fmt.Errorf("method %s of hooks interface %s takes %d parameter(s) and returns %d result(s), expected %d and %d", mi.name, pi.hooksInterface, sig.Params().Len(), sig.Results().Len(), params, len(mi.returnTypes))
+	}
+	return nil
+}
+
+// missingTypeError is returned when the package of the type was
+// loaded, but the type is not there. This may happen when the type is
+// defined in a file that is not generated yet.
+type missingTypeError struct {
+	typeName string
+	pkgPath  string
+}
+
+func (e *missingTypeError) Error() string {
+	return fmt.Sprintf("package %s has no type %s (if the type is defined in a generated file, make sure that the file is generated first)", e.pkgPath, e.typeName)
+}
+
+// TypeResolutionError is returned when a type from the flags can't be
+// resolved.
+type TypeResolutionError struct {
+	// Role is the role of the type, like base type.
+	Role string
+	// Type is the type as given in the flags, like driver.Conn.
+	Type string
+	// From, if not empty, is the flag the type comes from, when
+	// it's not obvious from the role.
+	From string
+	// Reason is the reason why the type can't be resolved.
+	Reason error
+}
+
+func (e *TypeResolutionError) Error() string {
+	if e.From != "" {
+		return fmt.Sprintf("failed to resolve %s %s from %s: %v", e.Role, e.Type, e.From, e.Reason)
+	}
+	return fmt.Sprintf("failed to resolve %s %s: %v", e.Role, e.Type, e.Reason)
+}
+
+func (e *TypeResolutionError) Unwrap() error {
+	return e.Reason
+}
+
+// NotAnInterfaceError is returned when a type that is supposed to be
+// wrapped is not an interface.
+type NotAnInterfaceError struct {
+	// Role, Type and From are like in TypeResolutionError, Role
+	// may be empty.
+	Role string
+	Type string
+	From string
+}
+
+func (e *NotAnInterfaceError) Error() string {
+	sb := strings.Builder{}
+	if e.Role != "" {
+		fmt.Fprintf(&sb, "%s ", e.Role)
+	}
+	fmt.Fprintf(&sb, "%s ", e.Type)
+	if e.From != "" {
+		fmt.Fprintf(&sb, "from %s ", e.From)
+	}
+	sb.WriteString("is not an interface")
+	return sb.String()
+}
+
+// ImportConsistencyError is returned when a package would need to be
+// imported under two different names.
+type ImportConsistencyError struct {
+	// Path is the import path of the package.
+	Path string
+	// NameA and NameB are the two names of the package.
+	NameA string
+	NameB string
+}
+
+func (e *ImportConsistencyError) Error() string {
+	return fmt.Sprintf("inconsistent imported package name, package %s is referred as %s and as %s, either fix the name in -imports or -basetype or -exttypes", e.Path, e.NameA, e.NameB)
+}
+
+type pkgPathAndName struct {
+	pkgPath  string
+	typeName string
+}
+
+func (i pkgPathAndName) String() string {
+	if i.pkgPath != "" {
+		return fmt.Sprintf(`"%s".%s`, i.pkgPath, i.typeName)
+	}
+	return i.typeName
+}
+
+type parameterInfo struct {
+	name    string
+	typeStr string
+}
+
+type methodInfo struct {
+	name        string
+	parameters  []parameterInfo
+	returnTypes []string
+	// doc contains the lines of the doc comment of the method,
+	// filled only with -copy-doc
+	doc []string
+}
+
+// signature returns the types of the parameters and the return
+// values in a form that can be used for comparing methods.
+func (mi methodInfo) signature() string {
+	paramTypes := make([]string, 0, len(mi.parameters))
+	for _, param := range mi.parameters {
+		paramTypes = append(paramTypes, param.typeStr)
+	}
+	return fmt.Sprintf("(%s) (%s)", strings.Join(paramTypes, ", "), strings.Join(mi.returnTypes, ", "))
+}
+
+type interfaceInfo struct {
+	embeddedTypes   []pkgPathAndName
+	explicitMethods []methodInfo
+}
+
+type processedType struct {
+	info  pkgPathAndName
+	iface *types.Interface
+}
+
+type typeAnalysis struct {
+	useAny        bool
+	copyDoc       bool
+	exportMethods bool
+	fset          *token.FileSet       // positions of the loaded types
+	docFset       *token.FileSet       // positions of the files parsed for doc comments
+	docFiles      map[string]*ast.File // file name -> parsed file, for doc comments
+	thisPkgPath   string
+	imports       map[string]string                   // pkg path -> pkg name
+	inputImports  map[string]string                   // pkg path -> pkg name, from -imports
+	typeInfo      map[string]map[string]interfaceInfo // pkg path -> type name -> interface info
+	typeQueue     []processedType
+	localTypes    stringset.StringSet // names of the types from this package used in method signatures
+	// typeParams are the type parameters of the generic base type,
+	// the only type parameters the method signatures may use
+	typeParams *types.TypeParamList
+}
+
+func (ta *typeAnalysis) analyze(rt *resolvedTypes, imports []anImport) error {
+	ta.thisPkgPath = rt.thisPkgPath
+	ta.fset = rt.fset
+	ta.typeParams = rt.typeParams
+	ta.docFset = token.NewFileSet()
+	ta.docFiles = make(map[string]*ast.File)
+	ta.imports = make(map[string]string)
+	ta.typeInfo = make(map[string]map[string]interfaceInfo)
+	ta.localTypes = stringset.StringSet{}
+	importsMap := make(map[string]string, len(imports))
+	for _, imprt := range imports {
+		if _, ok := importsMap[imprt.path]; ok {
+			return fmt.Errorf("duplicate entry in input imports for path %s", imprt.path)
+		}
+		importsMap[imprt.path] = imprt.name
+	}
+	ta.inputImports = importsMap
+	if err := ta.analyzeForImports(rt, importsMap); err != nil {
+		return err
+	}
+	if err := ta.analyzeForExtraImportsTypesAndMethods(rt); err != nil {
+		return err
+	}
+	if err := ta.checkMethodConflicts(rt); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkMethodConflicts makes sure that the base type and the
+// extension types can be combined - a method with the same name
+// declared in two of them must have the same signature.
+func (ta *typeAnalysis) checkMethodConflicts(rt *resolvedTypes) error {
+	allTypes := append([]resolvedType{rt.resolvedBaseType}, rt.resolvedExtTypes...)
+	methodSets := make([]map[string]methodInfo, 0, len(allTypes))
+	for _, resType := range allTypes {
+		methods := make(map[string]methodInfo)
+		ta.collectMethods(resTypeInfo(resType), methods)
+		methodSets = append(methodSets, methods)
+	}
+	for idx1 := 0; idx1 < len(allTypes); idx1++ {
+		for idx2 := idx1 + 1; idx2 < len(allTypes); idx2++ {
+			for _, name := range sortedMethodNames(methodSets[idx1]) {
+				mi1 := methodSets[idx1][name]
+				mi2, ok := methodSets[idx2][name]
+				if !ok {
+					continue
+				}
+				if sig1, sig2 := mi1.signature(), mi2.signature(); sig1 != sig2 {
+					return fmt.Errorf("method %s has incompatible signatures in %s (%s) and in %s (%s), the types can't be combined", name, allTypes[idx1].at, sig1, allTypes[idx2].at, sig2)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// allMethods returns all the methods of the base type and the
+// extension types.
+func (ta *typeAnalysis) allMethods(rt *resolvedTypes) map[string]methodInfo {
+	methods := make(map[string]methodInfo)
+	ta.collectMethods(resTypeInfo(rt.resolvedBaseType), methods)
+	for _, resType := range rt.resolvedExtTypes {
+		ta.collectMethods(resTypeInfo(resType), methods)
+	}
+	return methods
+}
+
+func (ta *typeAnalysis) collectMethods(info pkgPathAndName, methods map[string]methodInfo) {
+	ifaceInfo := ta.mustGet(info)
+	for _, eti := range ifaceInfo.embeddedTypes {
+		ta.collectMethods(eti, methods)
+	}
+	for _, mi := range ifaceInfo.explicitMethods {
+		methods[mi.name] = mi
+	}
+}
+
+// checkEmbeddedStructCollisions makes sure that the embedded struct
+// does not provide methods or fields that would be shadowed by the
+// wrapper's methods, and that the wrapped interfaces do not have a
+// method named like the embedded field.
+func checkEmbeddedStructCollisions(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) error {
+	methods := ta.allMethods(rt)
+	es := pi.embedStruct
+	if _, ok := methods[es.at.name]; ok {
+		return fmt.Errorf("embedded struct %s collides with the interface method %s", es, es.at.name)
+	}
+	named := rt.resolvedEmbedStruct.rt
+	for _, name := range sortedMethodNames(methods) {
+		obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, named.Obj().Pkg(), name)
+		if obj != nil {
+			return fmt.Errorf("embedded struct %s provides %s, which collides with the interface method %s", es, obj.Name(), name)
+		}
+	}
+	return nil
+}
+
+// checkGenericWrappersFlags makes sure that only the flags supported
+// by the generic wrappers are used, if the base type is generic.
+func checkGenericWrappersFlags(rt *resolvedTypes, pi *parsedInput) error {
+	incompatibleFlags := []struct {
+		name string
+		used bool
+	}{
+		{"-strategy=" + strategySparse, pi.strategy == strategySparse},
+		{"-combination-tags", pi.combinationTags != nil},
+		{"-append", pi.appendMode},
+		{"-hooks-interface", pi.hooksInterface != nil},
+		{"-result-hook", len(pi.resultHooks) > 0},
+		{"-export-combination-interfaces", pi.exportCombinationInterfaces},
+		{"-wrap-any", pi.wrapAny != ""},
+		{"-gen-rebind", pi.genRebind},
+		{"-gen-clone", pi.genClone},
+		{"-gen-field-accessors", pi.genFieldAccessors},
+		{"-gen-capabilities", pi.genCapabilities},
+		{"-gen-func-adapter", pi.genFuncAdapter},
+		{"-gen-switcher", pi.genSwitcher},
+		{"-gen-driver-conformance", pi.genDriverConformance},
+		{"-gen-combination-test", pi.genCombinationTest},
+	}
+	for _, incompatible := range incompatibleFlags {
+		if incompatible.used {
+			return fmt.Errorf("%s can't be used with the generic wrappers of base type %s given without type arguments", incompatible.name, rt.resolvedBaseType.at.name)
+		}
+	}
+	return nil
+}
+
+// checkFieldCollisions makes sure that no method of the wrappers has
+// the name of a field of the wrappers, a struct can't have both.
+func checkFieldCollisions(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) error {
+	methods := ta.allMethods(rt)
+	for _, mi := range pi.extraMethods {
+		methods[mi.name] = mi
+	}
+	if _, ok := methods["r"]; ok {
+		return errors.New("method r collides with the field r of the wrappers holding the wrapped value")
+	}
+	for _, ef := range pi.extraFields {
+		if _, ok := methods[ef.name]; !ok {
+			continue
+		}
+		if pi.hooksInterface != nil && ef.name == hooksFieldName {
+			return fmt.Errorf("method %s collides with the hooks field of -hooks-interface", ef.name)
+		}
+		return fmt.Errorf("method %s collides with extra field %s, rename the extra field in -extrafields", ef.name, ef.name)
+	}
+	if pi.strategy == strategySparse && pi.wrappedConcrete == nil {
+		if _, ok := methods["caps"]; ok {
+			return fmt.Errorf("method caps collides with the capabilities field of -strategy=%s", strategySparse)
+		}
+	}
+	if pi.runtimeToggles {
+		if _, ok := methods["enabled"]; ok {
+			return errors.New("method enabled collides with the bitmask field of -runtime-toggles")
+		}
+	}
+	if pi.accumulateErrors {
+		if _, ok := methods["err"]; ok {
+			return errors.New("method err collides with the accumulated error field of -accumulate-errors")
+		}
+	}
+	if pi.stateTransitions != nil {
+		if _, ok := methods["state"]; ok {
+			return errors.New("method state collides with the state field of -state-machine")
+		}
+	}
+	return nil
+}
+
+// checkPackageName makes sure that the code generated into a
+// package overridden with -package-name does not refer to the types
+// from the package of the infile. They are printed unqualified, so
+// they would not compile in the other package.
+func checkPackageName(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) error {
+	if pi.outPkgName(rt) == rt.thisPkgName {
+		return nil
+	}
+	localTypes := stringset.StringSet{}
+	localTypes.AddSet(ta.localTypes)
+	allTypes := append([]resolvedType{rt.resolvedBaseType}, rt.resolvedExtTypes...)
+	allTypes = append(allTypes, rt.resolvedEfTypes...)
+	if rt.resolvedEmbedStruct != nil {
+		allTypes = append(allTypes, *rt.resolvedEmbedStruct)
+	}
+	for _, resType := range allTypes {
+		if pkg := resType.rt.Obj().Pkg(); pkg != nil && pkg.Path() == rt.thisPkgPath {
+			localTypes.Add(resType.rt.Obj().Name())
+		}
+	}
+	if len(localTypes) > 0 {
+		return fmt.Errorf("can't generate code for package %s from -package-name, it would refer to types %s from package %s of the infile without qualifying them", pi.packageName, strings.Join(localTypes.ToSlice(), ", "), rt.thisPkgName)
+	}
+	return nil
+}
+
+// combinationIfaceNames returns names of the interfaces for each
+// combination of the extension types, in the order of the
+// combination generator.
+func combinationIfaceNames(rt *resolvedTypes, pi *parsedInput) []string {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	nComb := combgen.NCombs(len(rt.resolvedExtTypes))
+	names := make([]string, 0, nComb)
+	if !pi.exportCombinationInterfaces {
+		for counter := (uint64)(0); counter < nComb; counter++ {
+			names = append(names, fmt.Sprintf("i%s%d", en, counter))
+		}
+		return names
+	}
+	// the interfaces from the previous generation keep their
+	// names
+	taken := stringset.StringSet{}
+	for name := range rt.handwrittenNames {
+		taken.Add(name)
+	}
+	comb := combgen.NewCombGen(len(rt.resolvedExtTypes))
+	for comb.Next() {
+		idxs := comb.Get()
+		if len(idxs) == 0 {
+			names = append(names, fmt.Sprintf("i%s0", en))
+			continue
+		}
+		sb := strings.Builder{}
+		sb.WriteString(rt.resolvedBaseType.at.name)
+		sb.WriteString("With")
+		for _, idx := range idxs {
+			sb.WriteString(rt.resolvedExtTypes[idx].at.name)
+		}
+		name := sb.String()
+		for suffix := 2; taken.Has(name); suffix++ {
+			name = fmt.Sprintf("%s%d", sb.String(), suffix)
+		}
+		taken.Add(name)
+		names = append(names, name)
+	}
+	return names
+}
+
+// keepCombinationNames returns a function renaming the wrappers of
+// the combinations in the generated code, so the combinations the
+// outfile already has keep their numbers and the new ones are
+// numbered after the highest one in the outfile. It returns nil if
+// there is no outfile yet.
+func keepCombinationNames(rt *resolvedTypes, pi *parsedInput) (func(*token.FileSet, *ast.File) error, error) {
+	current, err := ioutil.ReadFile(pi.outFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outfile %s: %w", pi.outFile, err)
+	}
+	currentFile, err := parser.ParseFile(token.NewFileSet(), pi.outFile, current, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse outfile %s: %w", pi.outFile, err)
+	}
+	en := rt.resolvedBaseType.at.StringNoDot()
+	baseType := rt.resolvedBaseType.at.String()
+	currentIdxs := combinationIndexes(currentFile, en, baseType)
+	next := 0
+	for _, idx := range currentIdxs {
+		if idx >= next {
+			next = idx + 1
+		}
+	}
+	return func(_ *token.FileSet, file *ast.File) error {
+		renames := make(map[string]string)
+		generatedIdxs := combinationIndexes(file, en, baseType)
+		labels := make([]string, 0, len(generatedIdxs))
+		for label := range generatedIdxs {
+			labels = append(labels, label)
+		}
+		// the new combinations get the new numbers in the
+		// order of the generated ones
+		sort.Slice(labels, func(i, j int) bool {
+			return generatedIdxs[labels[i]] < generatedIdxs[labels[j]]
+		})
+		for _, label := range labels {
+			idx, ok := currentIdxs[label]
+			if !ok {
+				idx = next
+				next++
+			}
+			for _, prefix := range []string{"i", "t", "o"} {
+				renames[fmt.Sprintf("%s%s%d", prefix, en, generatedIdxs[label])] = fmt.Sprintf("%s%s%d", prefix, en, idx)
+			}
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok {
+				if name, ok := renames[ident.Name]; ok {
+					ident.Name = name
+				}
+			}
+			return true
+		})
+		return nil
+	}, nil
+}
+
+// combinationIndexes returns the numbers of the wrappers of the
+// combinations (like 3 for tConn3) in the file, keyed by the sorted
+// comma-separated extension types of the combinations. The extension
+// types are found in the interface of the r field of the wrappers.
+func combinationIndexes(file *ast.File, en, baseType string) map[string]int {
+	specs := make(map[string]*ast.TypeSpec)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			specs[typeSpec.Name.Name] = typeSpec
+		}
+	}
+	idxs := make(map[string]int)
+	wrapperPrefix := "t" + en
+	for name, spec := range specs {
+		if !strings.HasPrefix(name, wrapperPrefix) {
+			continue
+		}
+		idx, err := strconv.Atoi(name[len(wrapperPrefix):])
+		if err != nil || idx < 0 {
+			continue
+		}
+		st, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+		var ifaceName string
+		for _, field := range st.Fields.List {
+			if len(field.Names) == 1 && field.Names[0].Name == "r" {
+				if ident, ok := field.Type.(*ast.Ident); ok {
+					ifaceName = ident.Name
+				}
+			}
+		}
+		ifaceSpec, ok := specs[ifaceName]
+		if !ok {
+			continue
+		}
+		iface, ok := ifaceSpec.Type.(*ast.InterfaceType)
+		if !ok {
+			continue
+		}
+		var extTypes []string
+		for _, field := range iface.Methods.List {
+			if len(field.Names) > 0 {
+				continue
+			}
+			if embedded := types.ExprString(field.Type); embedded != baseType {
+				extTypes = append(extTypes, embedded)
+			}
+		}
+		sort.Strings(extTypes)
+		idxs[strings.Join(extTypes, ",")] = idx
+	}
+	return idxs
+}
+
+// combinationBuildTags returns the build tags of the combinations,
+// indexed like the combination interface names. The tag is empty for
+// the combinations that are always built.
+func combinationBuildTags(rt *resolvedTypes, pi *parsedInput) ([]string, error) {
+	tags := make([]string, combgen.NCombs(len(rt.resolvedExtTypes)))
+	if len(pi.combinationTags) == 0 {
+		return tags, nil
+	}
+	used := stringset.StringSet{}
+	counter := 0
+	comb := combgen.NewCombGen(len(rt.resolvedExtTypes))
+	for comb.Next() {
+		names := stringset.StringSet{}
+		for _, idx := range comb.Get() {
+			names.Add(pi.extTypes[idx].String())
+		}
+		key := strings.Join(names.ToSlice(), ",")
+		if tag, ok := pi.combinationTags[key]; ok {
+			tags[counter] = tag
+			used.Add(key)
+		}
+		counter++
+	}
+	keys := make([]string, 0, len(pi.combinationTags))
+	for key := range pi.combinationTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !used.Has(key) {
+			return nil, fmt.Errorf("combination %s from -combination-tags is not a combination of the extension types", key)
+		}
+	}
+	return tags, nil
+}
+
+// checkForwardTargets makes sure that the methods the generated
+// methods forward to have the same signatures and are in the same
+// wrapped interfaces as the forwarding methods, so the wrappers of
+// all the combinations have them.
+func checkForwardTargets(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) error {
+	for _, resType := range append([]resolvedType{rt.resolvedBaseType}, rt.resolvedExtTypes...) {
+		methods := make(map[string]methodInfo)
+		ta.collectMethods(resTypeInfo(resType), methods)
+		for _, name := range sortedMethodNames(methods) {
+			target, err := pi.forwardTarget(name)
+			if err != nil {
+				return err
+			}
+			tmi, ok := methods[target]
+			if !ok {
+				return fmt.Errorf("forward template maps method %s of %s to %s, which is not a method of %s", name, resType.at, target, resType.at)
+			}
+			if sig, tsig := methods[name].signature(), tmi.signature(); sig != tsig {
+				return fmt.Errorf("forward template maps method %s %s to %s, which has an incompatible signature %s", name, sig, target, tsig)
+			}
+		}
+	}
+	return nil
+}
+
+// funcAdapterMethodInfo returns the only method of the base type,
+// for which the func adapter will be generated.
+func funcAdapterMethodInfo(rt *resolvedTypes, ta *typeAnalysis) (methodInfo, error) {
+	methods := make(map[string]methodInfo)
+	ta.collectMethods(resTypeInfo(rt.resolvedBaseType), methods)
+	if len(methods) != 1 {
+		return methodInfo{}, fmt.Errorf("can't generate a func adapter, base type %s has %d methods, expected exactly one", rt.resolvedBaseType.at, len(methods))
+	}
+	// the adapter from the previous generation is fine
+	adapterName := funcAdapterName(rt)
+	if rt.handwrittenNames.Has(adapterName) {
+		return methodInfo{}, fmt.Errorf("can't generate a func adapter, %s is already declared in this package", adapterName)
+	}
+	return methods[sortedMethodNames(methods)[0]], nil
+}
+
+func funcAdapterName(rt *resolvedTypes) string {
+	return fmt.Sprintf("%sFunc", rt.resolvedBaseType.at.name)
+}
+
+// warnAboutNoOpExtTypes warns about extension types that do not add
+// any methods to the base type - wrappers implementing them are the
+// same as the ones that don't.
+func warnAboutNoOpExtTypes(rt *resolvedTypes, ta *typeAnalysis, warnings *warningCollector) {
+	baseMethods := make(map[string]methodInfo)
+	ta.collectMethods(resTypeInfo(rt.resolvedBaseType), baseMethods)
+	for _, resType := range rt.resolvedExtTypes {
+		extMethods := make(map[string]methodInfo)
+		ta.collectMethods(resTypeInfo(resType), extMethods)
+		noOp := true
+		for _, name := range sortedMethodNames(extMethods) {
+			if _, ok := baseMethods[name]; !ok {
+				noOp = false
+				break
+			}
+		}
+		if noOp {
+			warnings.warn("extension type %s adds no methods to the base type %s", resType.at, rt.resolvedBaseType.at)
+		}
+	}
+}
+
+// sortedMethodNames returns the names of the methods in a sorted
+// order. Code producing output or errors should iterate methods this
+// way, so the results do not depend on the map iteration order.
+func sortedMethodNames(methods map[string]methodInfo) []string {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func resTypeInfo(resType resolvedType) pkgPathAndName {
+	return pkgPathAndName{
+		pkgPath:  resType.pkgPath,
+		typeName: resType.at.name + resType.at.typeArgsSuffix(),
+	}
+}
+
+// addExtraImports adds the imports that were explicitly requested to
+// be in the generated code, even if the generated types and methods
+// do not refer to them.
+func (ta *typeAnalysis) addExtraImports(extraImports []anImport) error {
+	for _, imprt := range extraImports {
+		if name, ok := ta.imports[imprt.path]; ok {
+			if name != imprt.name {
+				return fmt.Errorf("extra import %s is already imported under a different name", imprt.path)
+			}
+			continue
+		}
+		ta.imports[imprt.path] = imprt.name
+	}
+	return nil
+}
+
+func (ta *typeAnalysis) analyzeForImports(rt *resolvedTypes, importsMap map[string]string) error {
+	if err := ta.analyzeResolvedTypeForImports(rt.resolvedBaseType, importsMap); err != nil {
+		return err
+	}
+	for _, resType := range rt.resolvedExtTypes {
+		if err := ta.analyzeResolvedTypeForImports(resType, importsMap); err != nil {
+			return err
+		}
+	}
+	for _, resType := range rt.resolvedEfTypes {
+		if err := ta.analyzeResolvedTypeForImports(resType, importsMap); err != nil {
+			return err
+		}
+	}
+	if rt.resolvedEmbedStruct != nil {
+		if err := ta.analyzeResolvedTypeForImports(*rt.resolvedEmbedStruct, importsMap); err != nil {
+			return err
+		}
+	}
+	for _, resType := range rt.resolvedWrapAnyBases {
+		if err := ta.analyzeResolvedTypeForImports(resType, importsMap); err != nil {
+			return err
+		}
+	}
+	for _, resType := range rt.resolvedTypeArgs {
+		if err := ta.analyzeResolvedTypeForImports(resType, importsMap); err != nil {
+			return err
+		}
+	}
+	if rt.resolvedWrappedConcrete != nil {
+		if err := ta.analyzeResolvedTypeForImports(*rt.resolvedWrappedConcrete, importsMap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ta *typeAnalysis) analyzeResolvedTypeForImports(resType resolvedType, importsMap map[string]string) error {
+	if resType.pkgPath == "" {
+		return nil // builtin type, nothing to import
+	}
+	if resType.pkgPath == ta.thisPkgPath {
+		// type from this package, nothing to import
+		return nil
+	}
+	overriddenName, ok := ta.imports[resType.pkgPath]
+	if ok {
+		if overriddenName == "" {
+			if resType.origPkgName != resType.at.pkgName {
+				return &ImportConsistencyError{
+					Path:  resType.pkgPath,
+					NameA: resType.origPkgName,
+					NameB: resType.at.pkgName,
+				}
+			}
+		} else if overriddenName != resType.at.pkgName {
+			return &ImportConsistencyError{
+				Path:  resType.pkgPath,
+				NameA: overriddenName,
+				NameB: resType.at.pkgName,
+			}
+		}
+	} else {
+		if resType.origPkgName != resType.at.pkgName {
+			overriddenName = resType.at.pkgName
+			importName, ok := importsMap[resType.pkgPath]
+			if ok {
+				if importName != overriddenName {
+					return &ImportConsistencyError{
+						Path:  resType.pkgPath,
+						NameA: overriddenName,
+						NameB: importName,
+					}
+				}
+			}
+		} else {
+			overriddenName = ""
+			importName, ok := importsMap[resType.pkgPath]
+			if ok {
+				if importName != resType.origPkgName {
+					return &ImportConsistencyError{
+						Path:  resType.pkgPath,
+						NameA: resType.origPkgName,
+						NameB: importName,
+					}
+				}
+			}
+		}
+		ta.imports[resType.pkgPath] = overriddenName
+	}
+	return nil
+}
+
+func (ta *typeAnalysis) analyzeForExtraImportsTypesAndMethods(rt *resolvedTypes) error {
+	if err := ta.analyzeResolvedTypeForExtraImportsTypesAndMethods(rt.resolvedBaseType); err != nil {
+		return err
+	}
+	for _, resType := range rt.resolvedExtTypes {
+		if err := ta.analyzeResolvedTypeForExtraImportsTypesAndMethods(resType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ta *typeAnalysis) analyzeResolvedTypeForExtraImportsTypesAndMethods(resType resolvedType) error {
+	info := resTypeInfo(resType)
+	if ta.contains(info) {
+		return nil
+	}
+	underType := resType.rt.Underlying()
+	underIface, ok := underType.(*types.Interface)
+	if !ok {
+		return &NotAnInterfaceError{
+			Type: resType.at.String(),
+		}
+	}
+	err := ta.analyzeInterface(info, underIface)
+	if err != nil {
+		return fmt.Errorf("failed to analyze resolved type for imports, types and methods %s: %w", resType.at, err)
+	}
+	return nil
+}
+
+func (ta *typeAnalysis) analyzeInterface(info pkgPathAndName, iface *types.Interface) error {
+	ta.addForAnalysis(info, iface)
+	for len(ta.typeQueue) > 0 {
+		pt := ta.typeQueue[0]
+		ta.typeQueue[0] = processedType{}
+		ta.typeQueue = ta.typeQueue[1:]
+		if ta.contains(pt.info) {
+			continue
+		}
+		embeddedTypes, err := ta.analyzeEmbeddedTypes(pt.iface)
+		if err != nil {
+			return err
+		}
+		explicitMethods, err := ta.analyzeExplicitMethods(pt.iface)
+		if err != nil {
+			return err
+		}
+		ta.insert(pt.info, embeddedTypes, explicitMethods)
+	}
+	ta.typeQueue = nil
+	return ta.checkEmbeddingCycles(info, nil, stringset.StringSet{})
+}
+
+// checkEmbeddingCycles makes sure that the interface does not embed
+// itself, directly or transitively. Go does not allow it, but the
+// code walking the embedded types would loop forever if it happened.
+func (ta *typeAnalysis) checkEmbeddingCycles(info pkgPathAndName, path []pkgPathAndName, checked stringset.StringSet) error {
+	for idx, pathInfo := range path {
+		if pathInfo == info {
+			cycle := make([]string, 0, len(path)-idx+1)
+			for _, cycleInfo := range path[idx:] {
+				cycle = append(cycle, cycleInfo.String())
+			}
+			cycle = append(cycle, info.String())
+			return fmt.Errorf("interface %s embeds itself (%s)", info, strings.Join(cycle, " -> "))
+		}
+	}
+	if checked.Has(info.String()) {
+		return nil
+	}
+	path = append(path, info)
+	for _, eti := range ta.mustGet(info).embeddedTypes {
+		if err := ta.checkEmbeddingCycles(eti, path, checked); err != nil {
+			return err
+		}
+	}
+	checked.Add(info.String())
+	return nil
+}
+
+func (ta *typeAnalysis) insert(info pkgPathAndName, embeddedTypes []pkgPathAndName, explicitMethods []methodInfo) {
+	typeNameToInfos, ok := ta.typeInfo[info.pkgPath]
+	if !ok {
+		typeNameToInfos = make(map[string]interfaceInfo)
+		ta.typeInfo[info.pkgPath] = typeNameToInfos
+	}
+	typeNameToInfos[info.typeName] = interfaceInfo{
+		embeddedTypes:   embeddedTypes,
+		explicitMethods: explicitMethods,
+	}
+}
+
+func (ta *typeAnalysis) analyzeEmbeddedTypes(iface *types.Interface) ([]pkgPathAndName, error) {
+	infos := make([]pkgPathAndName, 0, iface.NumEmbeddeds())
+	for idx := 0; idx < iface.NumEmbeddeds(); idx++ {
+		// embedding through an alias (like type Reader =
+		// io.Reader) is the same as embedding the aliased
+		// type, so identify it by its defining package
+		et := types.Unalias(iface.EmbeddedType(idx))
+		if _, ok := et.Underlying().(*types.Interface); !ok {
+			// the type set elements of constraints (like
+			// ~int | ~float64) bring no methods
+			continue
+		}
+		named, ok := et.(*types.Named)
+		if !ok {
+			return nil, fmt.Errorf("embedded type %s is not an named type (%#v)", et, et)
+		}
+		obj := named.Obj()
+		pkgPath := ""
+		if pkg := obj.Pkg(); pkg != nil {
+			pkgPath = pkg.Path()
+		}
+		// embedded types are never spelled out in the
+		// generated code, only their methods are, so there
+		// is nothing to import here - packages used in the
+		// method signatures are registered by typeToStr
+		info := pkgPathAndName{
+			pkgPath:  pkgPath,
+			typeName: obj.Name(),
+		}
+		infos = append(infos, info)
+		if ta.contains(info) {
+			continue
+		}
+		underType := named.Underlying()
+		underIface, ok := underType.(*types.Interface)
+		if !ok {
+			return nil, fmt.Errorf("embedded type %s is not a named interface type (%#v)", info, underType)
+		}
+		ta.addForAnalysis(info, underIface)
+	}
+	return infos, nil
+}
+
+func (ta *typeAnalysis) addForAnalysis(info pkgPathAndName, iface *types.Interface) {
+	ta.typeQueue = append(ta.typeQueue, processedType{
+		info:  info,
+		iface: iface,
+	})
+}
+
+func (ta *typeAnalysis) contains(info pkgPathAndName) bool {
+	if typeNameToInfos, ok := ta.typeInfo[info.pkgPath]; ok {
+		if _, ok := typeNameToInfos[info.typeName]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (ta *typeAnalysis) get(info pkgPathAndName) (interfaceInfo, bool) {
+	typeNameToInfos, ok := ta.typeInfo[info.pkgPath]
+	if !ok {
+		return interfaceInfo{}, false
+	}
+	ifaceInfo, ok := typeNameToInfos[info.typeName]
+	return ifaceInfo, ok
+}
+
+func (ta *typeAnalysis) mustGet(info pkgPathAndName) interfaceInfo {
+	ifaceInfo, ok := ta.get(info)
+	if !ok {
+		bug("no interface info for %s", info)
+	}
+	return ifaceInfo
+}
+
+func (ta *typeAnalysis) analyzeExplicitMethods(iface *types.Interface) ([]methodInfo, error) {
+	infos := make([]methodInfo, 0, iface.NumExplicitMethods())
+	for idx := 0; idx < iface.NumExplicitMethods(); idx++ {
+		m := iface.ExplicitMethod(idx)
+		if ta.exportMethods && !m.Exported() {
+			return nil, fmt.Errorf("method %s is unexported, but -export-methods requires all the methods to be exported", m.Name())
+		}
+		sig, ok := m.Type().(*types.Signature)
+		if !ok {
+			return nil, fmt.Errorf("function %s has no signature", m.Name())
+		}
+		params, err := ta.tupleToParameters(sig.Params())
+		if err != nil {
+			return nil, fmt.Errorf("failed to handle parameters of method %s: %w", m.Name(), err)
+		}
+		results, err := ta.tupleToTypes(sig.Results())
+		if err != nil {
+			return nil, fmt.Errorf("failed to handle results of method %s: %w", m.Name(), err)
+		}
+		var doc []string
+		if ta.copyDoc {
+			doc, err = ta.methodDoc(m)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the doc comment of method %s: %w", m.Name(), err)
+			}
+		}
+		infos = append(infos, methodInfo{
+			name:        m.Name(),
+			parameters:  params,
+			returnTypes: results,
+			doc:         doc,
+		})
+	}
+	return infos, nil
+}
+
+// methodDoc returns the lines of the doc comment of the interface
+// method, found by parsing the file the method is declared in.
+func (ta *typeAnalysis) methodDoc(m *types.Func) ([]string, error) {
+	pos := ta.fset.Position(m.Pos())
+	if !pos.IsValid() || pos.Filename == "" {
+		return nil, nil
+	}
+	file, ok := ta.docFiles[pos.Filename]
+	if !ok {
+		var err error
+		file, err = parser.ParseFile(ta.docFset, pos.Filename, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", pos.Filename, err)
+		}
+		ta.docFiles[pos.Filename] = file
+	}
+	var doc *ast.CommentGroup
+	ast.Inspect(file, func(n ast.Node) bool {
+		if doc != nil {
+			return false
+		}
+		field, ok := n.(*ast.Field)
+		if !ok {
+			return true
+		}
+		for _, name := range field.Names {
+			namePos := ta.docFset.Position(name.Pos())
+			if name.Name == m.Name() && namePos.Line == pos.Line && namePos.Column == pos.Column {
+				doc = field.Doc
+				break
+			}
+		}
+		return true
+	})
+	if doc == nil {
+		return nil, nil
+	}
+	lines := make([]string, 0, len(doc.List))
+	for _, c := range doc.List {
+		lines = append(lines, c.Text)
+	}
+	return lines, nil
+}
+
+func (ta *typeAnalysis) tupleToTypes(tuple *types.Tuple) ([]string, error) {
+	types := make([]string, 0, tuple.Len())
+	for idx := 0; idx < tuple.Len(); idx++ {
+		str, err := ta.typeToStr(tuple.At(idx).Type())
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, str)
+	}
+	return types, nil
+}
+
+func (ta *typeAnalysis) typeToStr(vType types.Type) (string, error) {
+	switch vRealType := vType.(type) {
+	case *types.Basic:
+		return vRealType.Name(), nil
+	case *types.Pointer:
+		elemStr, err := ta.typeToStr(vRealType.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("*%s", elemStr), nil
+	case *types.Array:
+		elemStr, err := ta.typeToStr(vRealType.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[%d]%s", vRealType.Len(), elemStr), nil
+	case *types.Slice:
+		elemStr, err := ta.typeToStr(vRealType.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[]%s", elemStr), nil
+	case *types.Map:
+		keyStr, err := ta.typeToStr(vRealType.Key())
+		if err != nil {
+			return "", err
+		}
+		elemStr, err := ta.typeToStr(vRealType.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("map[%s]%s", keyStr, elemStr), nil
+	case *types.Chan:
+		elemStr, err := ta.typeToStr(vRealType.Elem())
+		if err != nil {
+			return "", err
+		}
+		switch vRealType.Dir() {
+		case types.SendRecv:
+			if c, ok := vRealType.Elem().(*types.Chan); ok && c.Dir() == types.RecvOnly {
+				return fmt.Sprintf("chan (%s)", elemStr), nil
+			}
+			return fmt.Sprintf("chan %s", elemStr), nil
+		case types.RecvOnly:
+			return fmt.Sprintf("<-chan %s", elemStr), nil
+		case types.SendOnly:
+			return fmt.Sprintf("chan<- %s", elemStr), nil
+		}
+		return "", fmt.Errorf("invalid channel direction %v", vRealType.Dir())
+	case *types.Struct:
+		return ta.structLiteralToStr(vRealType)
+	case *types.Tuple:
+		return "", errors.New("tuple types are not supported")
+	case *types.Signature:
+		params, err := ta.paramTupleToTypesString(vRealType.Params(), vRealType.Variadic())
+		if err != nil {
+			return "", err
+		}
+		retvals, err := ta.retvalTupleToTypesString(vRealType.Results())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("func %s %s", params, retvals), nil
+	case *types.Named:
+		typeArgsStr, err := ta.typeArgsToStr(vRealType.TypeArgs())
+		if err != nil {
+			return "", err
+		}
+		return ta.typeNameToStr(vRealType.Obj()) + typeArgsStr, nil
+	case *types.Alias:
+		if ta.useAny && vRealType.Obj().Pkg() == nil && vRealType.Obj().Name() == "any" {
+			return "any", nil
+		}
+		typeArgsStr, err := ta.typeArgsToStr(vRealType.TypeArgs())
+		if err != nil {
+			return "", err
+		}
+		// keep the alias instead of resolving it, it may be
+		// the only importable way to refer to the aliased
+		// type
+		return ta.typeNameToStr(vRealType.Obj()) + typeArgsStr, nil
+	case *types.Interface:
+		if vRealType.Empty() {
+			if ta.useAny {
+				return "any", nil
+			}
+			return "interface{}", nil
+		}
+		return ta.interfaceLiteralToStr(vRealType)
+	case *types.Union:
+		terms := make([]string, 0, vRealType.Len())
+		for idx := 0; idx < vRealType.Len(); idx++ {
+			term := vRealType.Term(idx)
+			termStr, err := ta.typeToStr(term.Type())
+			if err != nil {
+				return "", err
+			}
+			if term.Tilde() {
+				termStr = "~" + termStr
+			}
+			terms = append(terms, termStr)
+		}
+		return strings.Join(terms, " | "), nil
+	case *types.TypeParam:
+		for idx := 0; idx < ta.typeParams.Len(); idx++ {
+			if ta.typeParams.At(idx) == vRealType {
+				return vRealType.Obj().Name(), nil
+			}
+		}
+		return "", fmt.Errorf("type parameter %s is not supported, only the base type and the extension types of a generic base type can be given without type arguments, give them or instantiate the interface in an interface in this package instead", vRealType.Obj().Name())
+	}
+	return "", fmt.Errorf("unknown type %#v", vType)
+}
+
+// structLiteralToStr returns a struct literal with the fields and
+// the tags of the anonymous struct, like struct{ Timeout int }.
+func (ta *typeAnalysis) structLiteralToStr(st *types.Struct) (string, error) {
+	if st.NumFields() == 0 {
+		return "struct{}", nil
+	}
+	fields := make([]string, 0, st.NumFields())
+	for idx := 0; idx < st.NumFields(); idx++ {
+		field := st.Field(idx)
+		if !field.Exported() && field.Pkg() != nil && field.Pkg().Path() != ta.thisPkgPath {
+			return "", fmt.Errorf("anonymous struct with unexported field %s from package %s can't be referred to", field.Name(), field.Pkg().Path())
+		}
+		fieldStr, err := ta.typeToStr(field.Type())
+		if err != nil {
+			return "", err
+		}
+		if !field.Embedded() {
+			fieldStr = fmt.Sprintf("%s %s", field.Name(), fieldStr)
+		}
+		if tag := st.Tag(idx); tag != "" {
+			quoted := "`" + tag + "`"
+			if strings.Contains(tag, "`") {
+				quoted = strconv.Quote(tag)
+			}
+			fieldStr = fmt.Sprintf("%s %s", fieldStr, quoted)
+		}
+		fields = append(fields, fieldStr)
+	}
+	return fmt.Sprintf("struct{ %s }", strings.Join(fields, "; ")), nil
+}
+
+// interfaceLiteralToStr returns an interface literal with the
+// embedded types and the explicit methods of the anonymous
+// interface, like interface{ io.Reader; Name() string }.
+func (ta *typeAnalysis) interfaceLiteralToStr(iface *types.Interface) (string, error) {
+	elems := make([]string, 0, iface.NumEmbeddeds()+iface.NumExplicitMethods())
+	for idx := 0; idx < iface.NumEmbeddeds(); idx++ {
+		embeddedStr, err := ta.typeToStr(iface.EmbeddedType(idx))
+		if err != nil {
+			return "", err
+		}
+		elems = append(elems, embeddedStr)
+	}
+	for idx := 0; idx < iface.NumExplicitMethods(); idx++ {
+		method := iface.ExplicitMethod(idx)
+		if !method.Exported() && method.Pkg() != nil && method.Pkg().Path() != ta.thisPkgPath {
+			return "", fmt.Errorf("anonymous interface with unexported method %s from package %s can't be referred to", method.Name(), method.Pkg().Path())
+		}
+		sig := method.Type().(*types.Signature)
+		params, err := ta.paramTupleToTypesString(sig.Params(), sig.Variadic())
+		if err != nil {
+			return "", err
+		}
+		if sig.Results().Len() == 0 {
+			elems = append(elems, method.Name()+params)
+			continue
+		}
+		retvals, err := ta.retvalTupleToTypesString(sig.Results())
+		if err != nil {
+			return "", err
+		}
+		elems = append(elems, fmt.Sprintf("%s%s %s", method.Name(), params, retvals))
+	}
+	return fmt.Sprintf("interface{ %s }", strings.Join(elems, "; ")), nil
+}
+
+// typeArgsToStr returns the type arguments of an instantiated
+// generic type in square brackets, like [string, int], or an empty
+// string if there are none.
+func (ta *typeAnalysis) typeArgsToStr(typeArgs *types.TypeList) (string, error) {
+	if typeArgs.Len() == 0 {
+		return "", nil
+	}
+	argStrs := make([]string, 0, typeArgs.Len())
+	for idx := 0; idx < typeArgs.Len(); idx++ {
+		argStr, err := ta.typeToStr(typeArgs.At(idx))
+		if err != nil {
+			return "", err
+		}
+		argStrs = append(argStrs, argStr)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(argStrs, ", ")), nil
+}
+
+func (ta *typeAnalysis) typeNameToStr(obj *types.TypeName) string {
+	vName := obj.Name()
+	vPkg := obj.Pkg()
+	if vPkg == nil {
+		return vName
+	}
+	vPkgPath := vPkg.Path()
+	if vPkgPath == ta.thisPkgPath {
+		ta.localTypes.Add(vName)
+		return vName
+	}
+	return fmt.Sprintf("%s.%s", ta.useImport(vPkgPath, vPkg.Name()), vName)
+}
+
+// useImport makes sure that the package is imported and returns the
+// name the package should be referred to in the generated code.
+func (ta *typeAnalysis) useImport(pkgPath, pkgName string) string {
+	name, ok := ta.imports[pkgPath]
+	if !ok {
+		// honor the name from -imports, if any
+		name = ta.inputImports[pkgPath]
+		if name == pkgName {
+			name = ""
+		}
+		ta.imports[pkgPath] = name
+	}
+	if name != "" {
+		return name
+	}
+	return pkgName
+}
+
+func (ta *typeAnalysis) paramTupleToTypesString(tuple *types.Tuple, variadic bool) (string, error) {
+	types := make([]string, 0, tuple.Len())
+	for idx := 0; idx < tuple.Len(); idx++ {
+		str, err := ta.typeToStr(tuple.At(idx).Type())
+		if err != nil {
+			return "", err
+		}
+		types = append(types, str)
+	}
+	if variadic {
+		types[tuple.Len()-1] = fmt.Sprintf("...%s", types[tuple.Len()-1])
+	}
+	joined := strings.Join(types, ", ")
+	return fmt.Sprintf("(%s)", joined), nil
+}
+
+func (ta *typeAnalysis) retvalTupleToTypesString(tuple *types.Tuple) (string, error) {
+	types, err := ta.tupleToTypes(tuple)
+	if err != nil {
+		return "", err
+	}
+	if len(types) == 1 {
+		return types[0], nil
+	}
+	joined := strings.Join(types, ", ")
+	return fmt.Sprintf("(%s)", joined), nil
+}
+
+func (ta *typeAnalysis) tupleToParameters(t *types.Tuple) ([]parameterInfo, error) {
+	if t == nil || t.Len() == 0 {
+		return nil, nil
+	}
+	var params []parameterInfo
+	for idx := 0; idx < t.Len(); idx++ {
+		v := t.At(idx)
+		vName := v.Name()
+		vType := v.Type()
+		vTypeStr, err := ta.typeToStr(vType)
+		if err != nil {
+			return nil, fmt.Errorf("could not handle parameter %s: %w", vName, err)
+		}
+		params = append(params, parameterInfo{
+			name:    vName,
+			typeStr: vTypeStr,
+		})
+	}
+	return params, nil
+}
+
+func printNewFunc(w io.Writer, rt *resolvedTypes, pi *parsedInput, ifaceNames, buildTags []string) {
+	varName := fmt.Sprintf("%s%s", pi.prefix, rt.resolvedBaseType.at.name)
+	en := rt.resolvedBaseType.at.StringNoDot()
+	tagged := false
+	for _, tag := range buildTags {
+		if tag != "" {
+			tagged = true
+			break
+		}
+	}
+	if tagged {
+		printTaggedNewFunc(w, rt, pi, ifaceNames, buildTags)
+		return
+	}
+	// exclude the zero - it will be handled after the switch
+	fmt.Fprintf(w, "func %s%s(%s %s", pi.newFuncName, pi.typeParamsDecl(), varName, rt.resolvedBaseType.at)
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(w, ", %s %s", ef.name, ef.typeStr)
+	}
+	if es := pi.embedStruct; es != nil {
+		fmt.Fprintf(w, ", %s %s", es.paramName(), es)
+	}
+	fmt.Fprintf(w, ") %s {\n", rt.resolvedBaseType.at)
+	printNilCheck(w, pi, varName)
+	_, amp := pi.wrapperRefs()
+	nComb := combgen.NCombs(len(rt.resolvedExtTypes))
+	if nComb > 1 {
+		fmt.Fprintf(w, "\tswitch r := %s.(type) {\n", varName)
+		for counter := nComb - 1; counter > 0; counter-- {
+			tbn := fmt.Sprintf("%s%d", en, counter)
+			fmt.Fprintf(w, "\tcase %s%s:\n\t\treturn %st%s%s{\n", ifaceNames[counter], pi.typeParamsRef(), amp, tbn, pi.typeParamsRef())
+			printWrapperFieldValues(w, "\t\t\t", wrapperFieldGroups(pi, wrapperField{name: "r", value: "r"}))
+			fmt.Fprintf(w, "\t\t}\n")
+		}
+		fmt.Fprintf(w, "\t}\n")
+	}
+	fmt.Fprintf(w, "\treturn %st%s0%s{\n", amp, en, pi.typeParamsRef())
+	printWrapperFieldValues(w, "\t\t", wrapperFieldGroups(pi, wrapperField{name: "r", value: varName}))
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+// printTaggedNewFunc prints the new func trying the combinations one
+// by one, so a combination compiled out due to a missing build tag
+// falls back to the next one. The wrappers of the tagged combinations
+// are created by the functions set in the tagged files.
+func printTaggedNewFunc(w io.Writer, rt *resolvedTypes, pi *parsedInput, ifaceNames, buildTags []string) {
+	varName := fmt.Sprintf("%s%s", pi.prefix, rt.resolvedBaseType.at.name)
+	en := rt.resolvedBaseType.at.StringNoDot()
+	params, args := newFuncExtraParams(pi)
+	if !pi.compact {
+		fmt.Fprintf(w, "// These are set by the files built with the build tags of the\n")
+		fmt.Fprintf(w, "// combinations.\n")
+	}
+	fmt.Fprintf(w, "var (\n")
+	for counter, tag := range buildTags {
+		if tag != "" {
+			fmt.Fprintf(w, "\twrap%s%d func(r %s%s) %s\n", en, counter, ifaceNames[counter], params, rt.resolvedBaseType.at)
+		}
+	}
+	fmt.Fprintf(w, ")\n\n")
+	fmt.Fprintf(w, "func %s(%s %s%s) %s {\n", pi.newFuncName, varName, rt.resolvedBaseType.at, params, rt.resolvedBaseType.at)
+	printNilCheck(w, pi, varName)
+	_, amp := pi.wrapperRefs()
+	for counter := len(buildTags) - 1; counter > 0; counter-- {
+		tbn := fmt.Sprintf("%s%d", en, counter)
+		if buildTags[counter] != "" {
+			fmt.Fprintf(w, "\tif r, ok := %s.(%s); ok && wrap%s != nil {\n", varName, ifaceNames[counter], tbn)
+			fmt.Fprintf(w, "\t\treturn wrap%s(r%s)\n\t}\n", tbn, args)
+			continue
+		}
+		fmt.Fprintf(w, "\tif r, ok := %s.(%s); ok {\n\t\treturn %st%s{\n", varName, ifaceNames[counter], amp, tbn)
+		printWrapperFieldValues(w, "\t\t\t", wrapperFieldGroups(pi, wrapperField{name: "r", value: "r"}))
+		fmt.Fprintf(w, "\t\t}\n\t}\n")
+	}
+	fmt.Fprintf(w, "\treturn %st%s0{\n", amp, en)
+	printWrapperFieldValues(w, "\t\t", wrapperFieldGroups(pi, wrapperField{name: "r", value: varName}))
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+// printTaggedInit prints the init function of the file with the
+// given build tag, setting the functions creating the wrappers of its
+// combinations.
+func printTaggedInit(w io.Writer, rt *resolvedTypes, pi *parsedInput, ifaceNames, buildTags []string, buildTag string) {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	params, _ := newFuncExtraParams(pi)
+	_, amp := pi.wrapperRefs()
+	fmt.Fprintf(w, "func init() {\n")
+	for counter, tag := range buildTags {
+		if tag != buildTag {
+			continue
+		}
+		tbn := fmt.Sprintf("%s%d", en, counter)
+		fmt.Fprintf(w, "\twrap%s = func(r %s%s) %s {\n", tbn, ifaceNames[counter], params, rt.resolvedBaseType.at)
+		fmt.Fprintf(w, "\t\treturn %st%s{\n", amp, tbn)
+		printWrapperFieldValues(w, "\t\t\t", wrapperFieldGroups(pi, wrapperField{name: "r", value: "r"}))
+		fmt.Fprintf(w, "\t\t}\n\t}\n")
+	}
+	fmt.Fprintf(w, "}\n")
+}
+
+// newFuncExtraParams returns the parameters the new func takes after
+// the wrapped value, both with their types and as the arguments to
+// pass them on.
+func newFuncExtraParams(pi *parsedInput) (string, string) {
+	var params, args strings.Builder
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(&params, ", %s %s", ef.name, ef.typeStr)
+		fmt.Fprintf(&args, ", %s", ef.name)
+	}
+	if es := pi.embedStruct; es != nil {
+		fmt.Fprintf(&params, ", %s %s", es.paramName(), es)
+		fmt.Fprintf(&args, ", %s", es.paramName())
+	}
+	return params.String(), args.String()
+}
+
+// deprecatedName pairs an exported name of the wrapper family with
+// its deprecated counterpart.
+type deprecatedName struct {
+	current string
+	old     string
+}
+
+// deprecatedNames returns the exported combination interfaces and the
+// new func with their names for -deprecated-alias. The new func goes
+// last.
+func deprecatedNames(rt *resolvedTypes, pi *parsedInput, ifaceNames []string) []deprecatedName {
+	baseName := rt.resolvedBaseType.at.name
+	var names []deprecatedName
+	// the first interface is the unexported one with no
+	// extension types
+	for _, name := range ifaceNames[1:] {
+		names = append(names, deprecatedName{
+			current: name,
+			old:     pi.deprecatedAlias + strings.TrimPrefix(name, baseName),
+		})
+	}
+	names = append(names, deprecatedName{
+		current: pi.newFuncName,
+		old:     strings.Replace(pi.newFuncName, baseName, pi.deprecatedAlias, 1),
+	})
+	return names
+}
+
+// printDeprecatedAliases prints the deprecated aliases of the exported
+// combination interfaces and the deprecated new func forwarding to
+// the current one.
+func printDeprecatedAliases(w io.Writer, rt *resolvedTypes, pi *parsedInput, ifaceNames []string) {
+	names := deprecatedNames(rt, pi, ifaceNames)
+	aliases, newFunc := names[:len(names)-1], names[len(names)-1]
+	if len(aliases) > 0 {
+		fmt.Fprintf(w, "type (\n")
+		for idx, name := range aliases {
+			if idx > 0 && !pi.compact {
+				fmt.Fprintf(w, "\n")
+			}
+			fmt.Fprintf(w, "\t// Deprecated: Use %s instead.\n\t%s = %s\n", name.current, name.old, name.current)
+		}
+		fmt.Fprintf(w, ")\n\n")
+	}
+	varName := fmt.Sprintf("%s%s", pi.prefix, rt.resolvedBaseType.at.name)
+	params, args := newFuncExtraParams(pi)
+	fmt.Fprintf(w, "// Deprecated: Use %s instead.\n", newFunc.current)
+	fmt.Fprintf(w, "func %s(%s %s%s) %s {\n", newFunc.old, varName, rt.resolvedBaseType.at, params, rt.resolvedBaseType.at)
+	fmt.Fprintf(w, "\treturn %s(%s%s)\n}\n", newFunc.current, varName, args)
+}
+
+// printNilCheck prints the guard making the new func return nil
+// instead of a wrapper of nil, if requested.
+func printNilCheck(w io.Writer, pi *parsedInput, varName string) {
+	if pi.nilCheck {
+		fmt.Fprintf(w, "\tif %s == nil {\n\t\treturn nil\n\t}\n", varName)
+	}
+}
+
+// sparseCapName returns the name of the constant with the
+// capability bit of the extension type.
+func sparseCapName(rt *resolvedTypes, extType resolvedType, pi *parsedInput) string {
+	if pi.genCapabilityConsts {
+		return capabilityConstName(extType)
+	}
+	return fmt.Sprintf("cap%s%s", rt.resolvedBaseType.at.StringNoDot(), extType.at.StringNoDot())
+}
+
+// sparseCapsType returns the type of the capability bits of the
+// single wrapper.
+func sparseCapsType(pi *parsedInput) string {
+	if pi.genCapabilityConsts {
+		return capabilityTypeName
+	}
+	return "uint64"
+}
+
+const (
+	capabilityTypeName    = "Capability"
+	capabilityConstPrefix = "Cap"
+)
+
+// capabilityConstName returns the name of the constant generated by
+// -gen-capability-consts for the extension type, like CapConnBeginTx
+// for driver.ConnBeginTx.
+func capabilityConstName(extType resolvedType) string {
+	name := extType.at.name
+	return capabilityConstPrefix + strings.ToUpper(name[:1]) + name[1:]
+}
+
+// checkCapabilityConstNames makes sure that no two extension types
+// get the same capability constant, which happens for the types of
+// the same name from different packages.
+func checkCapabilityConstNames(rt *resolvedTypes) error {
+	owners := make(map[string]aType, len(rt.resolvedExtTypes))
+	for _, extType := range rt.resolvedExtTypes {
+		name := capabilityConstName(extType)
+		if other, ok := owners[name]; ok {
+			return fmt.Errorf("both %s and %s would get capability constant %s", other, extType.at, name)
+		}
+		owners[name] = extType.at
+	}
+	return nil
+}
+
+// printCapabilityConsts prints the type of -gen-capability-consts
+// and a bit constant for each extension type.
+func printCapabilityConsts(w io.Writer, rt *resolvedTypes) {
+	fmt.Fprintf(w, "type %s uint64\n", capabilityTypeName)
+	if len(rt.resolvedExtTypes) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nconst (\n")
+	for idx, extType := range rt.resolvedExtTypes {
+		if idx == 0 {
+			fmt.Fprintf(w, "\t%s %s = 1 << iota\n", capabilityConstName(extType), capabilityTypeName)
+		} else {
+			fmt.Fprintf(w, "\t%s\n", capabilityConstName(extType))
+		}
+	}
+	fmt.Fprintf(w, ")\n")
+}
+
+func printSparseTypes(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	if pi.genCapabilityConsts {
+		printCapabilityConsts(w, rt)
+		fmt.Fprintf(w, "\n")
+	} else if len(rt.resolvedExtTypes) > 0 && pi.wrappedConcrete == nil {
+		fmt.Fprintf(w, "const (\n")
+		for idx, extType := range rt.resolvedExtTypes {
+			if idx == 0 {
+				fmt.Fprintf(w, "\t%s uint64 = 1 << iota\n", sparseCapName(rt, extType, pi))
+			} else {
+				fmt.Fprintf(w, "\t%s\n", sparseCapName(rt, extType, pi))
+			}
+		}
+		fmt.Fprintf(w, ")\n\n")
+	}
+	fmt.Fprintf(w, "type t%s struct {\n", en)
+	coreFields := []wrapperField{
+		{name: "r", typeStr: rt.resolvedBaseType.at.String()},
+		{name: "caps", typeStr: sparseCapsType(pi)},
+	}
+	if ct := pi.wrappedConcrete; ct != nil {
+		// the concrete type implements everything, no need
+		// for the capabilities
+		coreFields = []wrapperField{{name: "r", typeStr: ct.String()}}
+	}
+	if pi.runtimeToggles {
+		coreFields = append(coreFields, wrapperField{name: "enabled", typeStr: sparseCapsType(pi)})
+	}
+	printWrapperStructFields(w, "\t", structFieldGroups(pi, coreFields...), pi)
+	fmt.Fprintf(w, "}\n")
+}
+
+func printSparseVars(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	fmt.Fprintf(w, "var (\n")
+	_, amp := pi.wrapperRefs()
+	fmt.Fprintf(w, "\t_ %s = %st%s{}\n", rt.resolvedBaseType.at, amp, en)
+	for _, extType := range rt.resolvedExtTypes {
+		fmt.Fprintf(w, "\t_ %s = %st%s{}\n", extType.at, amp, en)
+	}
+	fmt.Fprintf(w, ")\n")
+}
+
+// printSparseImpls prints the methods of the single wrapper. The
+// methods of the extension types check whether the wrapped value
+// implements the extension type and panic if it does not.
+func printSparseImpls(w io.Writer, rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	recv := pi.receiverVar(en)
+	emitted := stringset.StringSet{}
+	printImplsFromResolvedType(w, rt.resolvedBaseType, ta, en, pi, nil, emitted)
+	for _, extType := range rt.resolvedExtTypes {
+		methods := make(map[string]methodInfo)
+		ta.collectMethods(resTypeInfo(extType), methods)
+		for _, name := range sortedMethodNames(methods) {
+			if emitted.Has(name) {
+				continue
+			}
+			emitted.Add(name)
+			if pi.wrappedConcrete != nil {
+				printMethodImpl(w, methods[name], ta, en, pi, fmt.Sprintf("%s.r", recv), "")
+				continue
+			}
+			check := fmt.Sprintf("\tif %s.caps&%s == 0 {\n\t\tpanic(\"wrapped value does not implement %s\")\n\t}\n", recv, sparseCapName(rt, extType, pi), extType.at)
+			if pi.runtimeToggles {
+				check += fmt.Sprintf("\tif %s.enabled&%s == 0 {\n\t\tpanic(\"%s is disabled in the wrapper\")\n\t}\n", recv, sparseCapName(rt, extType, pi), extType.at)
+			}
+			printMethodImpl(w, methods[name], ta, en, pi, fmt.Sprintf("%s.r.(%s)", recv, extType.at), check)
+		}
+	}
+	printExtraMethods(w, ta, en, pi)
+	printErrMethod(w, en, pi)
+}
+
+func printSparseNewFunc(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	varName := fmt.Sprintf("%s%s", pi.prefix, rt.resolvedBaseType.at.name)
+	en := rt.resolvedBaseType.at.StringNoDot()
+	paramType := rt.resolvedBaseType.at.String()
+	if ct := pi.wrappedConcrete; ct != nil {
+		paramType = ct.String()
+	}
+	fmt.Fprintf(w, "func %s(%s %s", pi.newFuncName, varName, paramType)
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(w, ", %s %s", ef.name, ef.typeStr)
+	}
+	if es := pi.embedStruct; es != nil {
+		fmt.Fprintf(w, ", %s %s", es.paramName(), es)
+	}
+	fmt.Fprintf(w, ") %s {\n", rt.resolvedBaseType.at)
+	printNilCheck(w, pi, varName)
+	_, amp := pi.wrapperRefs()
+	if pi.wrappedConcrete != nil {
+		fmt.Fprintf(w, "\treturn %st%s{\n", amp, en)
+		printWrapperFieldValues(w, "\t\t", wrapperFieldGroups(pi, wrapperField{name: "r", value: varName}))
+		fmt.Fprintf(w, "\t}\n}\n")
+		return
+	}
+	fmt.Fprintf(w, "\tcaps := (%s)(0)\n", sparseCapsType(pi))
+	for _, extType := range rt.resolvedExtTypes {
+		fmt.Fprintf(w, "\tif _, ok := %s.(%s); ok {\n\t\tcaps |= %s\n\t}\n", varName, extType.at, sparseCapName(rt, extType, pi))
+	}
+	fmt.Fprintf(w, "\treturn %st%s{\n", amp, en)
+	coreFields := []wrapperField{
+		{name: "r", value: varName},
+		{name: "caps", value: "caps"},
+	}
+	if pi.runtimeToggles {
+		// everything the wrapped value implements is
+		// initially enabled
+		coreFields = append(coreFields, wrapperField{name: "enabled", value: "caps"})
+	}
+	printWrapperFieldValues(w, "\t\t", wrapperFieldGroups(pi, coreFields...))
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+// wrapperField describes a field of a wrapper struct. The type is
+// used when printing the struct, the value when printing the struct
+// literal in the new func.
+type wrapperField struct {
+	name     string
+	typeStr  string
+	value    string
+	embedded bool
+}
+
+// wrapperFieldGroups returns the fields of a wrapper in the order
+// chosen with -field-order. One group has the core fields (the
+// wrapped value and the embedded struct), the other has the extra
+// fields.
+func wrapperFieldGroups(pi *parsedInput, coreFields ...wrapperField) [][]wrapperField {
+	core := append([]wrapperField{}, coreFields...)
+	if pi.stateTransitions != nil {
+		core = append(core, wrapperField{
+			name:    "state",
+			typeStr: "string",
+			value:   strconv.Quote(pi.initialState),
+		})
+	}
+	if es := pi.embedStruct; es != nil {
+		core = append(core, wrapperField{
+			name:     es.at.name,
+			typeStr:  es.String(),
+			value:    es.paramName(),
+			embedded: true,
+		})
+	}
+	extras := make([]wrapperField, 0, len(pi.extraFields))
+	for _, ef := range pi.extraFields {
+		extras = append(extras, wrapperField{
+			name:    ef.name,
+			typeStr: ef.typeStr,
+			value:   ef.name,
+		})
+	}
+	switch pi.fieldOrder {
+	case fieldOrderAlphabetical:
+		sort.SliceStable(extras, func(i, j int) bool {
+			return extras[i].name < extras[j].name
+		})
+	case fieldOrderExtrasFirst:
+		return [][]wrapperField{extras, core}
+	}
+	return [][]wrapperField{core, extras}
+}
+
+func printWrapperStructFields(w io.Writer, indent string, groups [][]wrapperField, pi *parsedInput) {
+	printed := false
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if printed && pi.groupFields {
+			fmt.Fprintf(w, "\n")
+		}
+		for _, field := range group {
+			if field.embedded {
+				fmt.Fprintf(w, "%s%s\n", indent, field.typeStr)
+			} else {
+				fmt.Fprintf(w, "%s%s %s\n", indent, field.name, field.typeStr)
+			}
+		}
+		printed = true
+	}
+}
+
+// wrapperStructFields is printWrapperStructFields for the wrappers
+// built as an AST, the fields get the positions of the next lines.
+func wrapperStructFields(lines *linePositions, groups [][]wrapperField, pi *parsedInput) *ast.FieldList {
+	fields := &ast.FieldList{}
+	printed := false
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if printed && pi.groupFields {
+			lines.skip()
+		}
+		for _, field := range group {
+			pos := lines.next()
+			astField := &ast.Field{
+				Type: parseTypeSpec("", field.typeStr, pos).Type,
+			}
+			if !field.embedded {
+				astField.Names = []*ast.Ident{
+					{
+						NamePos: pos,
+						Name:    field.name,
+					},
+				}
+			}
+			fields.List = append(fields.List, astField)
+		}
+		printed = true
+	}
+	return fields
+}
+
+func printWrapperFieldValues(w io.Writer, indent string, groups [][]wrapperField) {
+	for _, group := range groups {
+		for _, field := range group {
+			fmt.Fprintf(w, "%s%s: %s,\n", indent, field.name, field.value)
+		}
+	}
+}
+
+func printFuncAdapter(w io.Writer, rt *resolvedTypes, pi *parsedInput, mi methodInfo) {
+	adapterName := funcAdapterName(rt)
+	results := ""
+	switch len(mi.returnTypes) {
+	case 0:
+		// nothing to print
+	case 1:
+		results = fmt.Sprintf(" %s", mi.returnTypes[0])
+	default:
+		results = fmt.Sprintf(" (%s)", strings.Join(mi.returnTypes, ", "))
+	}
+	if !pi.compact {
+		fmt.Fprintf(w, "// %s is an adapter to allow the use of ordinary functions as\n// %s.\n", adapterName, rt.resolvedBaseType.at)
+	}
+	fmt.Fprintf(w, "type %s func(%s)%s\n\n", adapterName, (parametersFull)(mi.parameters), results)
+	fmt.Fprintf(w, "var _ %s = %s(nil)\n\n", rt.resolvedBaseType.at, adapterName)
+	fmt.Fprintf(w, "func (o%s %s) %s(%s)%s {\n\t", adapterName, adapterName, mi.name, (parametersFull)(mi.parameters), results)
+	if len(mi.returnTypes) > 0 {
+		fmt.Fprintf(w, "return ")
+	}
+	fmt.Fprintf(w, "o%s(%s)\n}\n", adapterName, (parametersNames)(mi.parameters))
+}
+
+// printWrapAny prints a function wrapping a value with the new func
+// of the first base type the value implements. The base types are
+// tried in order, this generation's base type goes first, followed
+// by the ones from -wrap-any-bases, whose new funcs are generated
+// separately and must take the same extra fields.
+func printWrapAny(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	emptyIface := "interface{}"
+	if pi.useAny {
+		emptyIface = "any"
+	}
+	params, args := newFuncExtraParams(pi)
+	if !pi.compact {
+		fmt.Fprintf(w, "// %s wraps v with the new func of the first base type it implements,\n", pi.wrapAny)
+		fmt.Fprintf(w, "// v is returned as is if it implements none of them.\n")
+	}
+	fmt.Fprintf(w, "func %s(v %s%s) %s {\n", pi.wrapAny, emptyIface, params, emptyIface)
+	fmt.Fprintf(w, "\tswitch r := v.(type) {\n")
+	fmt.Fprintf(w, "\tcase %s:\n\t\treturn %s(r%s)\n", rt.resolvedBaseType.at, pi.newFuncName, args)
+	for idx, wab := range pi.wrapAnyBases {
+		fmt.Fprintf(w, "\tcase %s:\n\t\treturn %s(r%s)\n", rt.resolvedWrapAnyBases[idx].at, wab.newFuncName, args)
+	}
+	fmt.Fprintf(w, "\t}\n\treturn v\n}\n")
+}
+
+// printSwitcher prints a type implementing the base type by calling
+// the methods of a value returned by the <prefix>Select function on
+// every call.
+func printSwitcher(w io.Writer, rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	sn := fmt.Sprintf("s%s", en)
+	fmt.Fprintf(w, "type %s struct {\n", sn)
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(w, "\t%s %s\n", ef.name, ef.typeStr)
+	}
+	fmt.Fprintf(w, "}\n\n")
+	fmt.Fprintf(w, "var _ %s = &%s{}\n\n", rt.resolvedBaseType.at, sn)
+	methods := make(map[string]methodInfo)
+	ta.collectMethods(resTypeInfo(rt.resolvedBaseType), methods)
+	for _, name := range sortedMethodNames(methods) {
+		mi := methods[name]
+		fmt.Fprintf(w, "func (o%s *%s) %s(%s)", sn, sn, mi.name, (parametersFull)(mi.parameters))
+		switch len(mi.returnTypes) {
+		case 0:
+			// nothing to print
+		case 1:
+			fmt.Fprintf(w, " %s", mi.returnTypes[0])
+		default:
+			fmt.Fprintf(w, " (%s)", strings.Join(mi.returnTypes, ", "))
+		}
+		fmt.Fprintf(w, " {\n\t")
+		if len(mi.returnTypes) > 0 {
+			fmt.Fprintf(w, "return ")
+		}
+		fmt.Fprintf(w, "%sSelect(", pi.prefix)
+		for idx, ef := range pi.extraFields {
+			if idx > 0 {
+				fmt.Fprintf(w, ", ")
+			}
+			fmt.Fprintf(w, "o%s.%s", sn, ef.name)
+		}
+		fmt.Fprintf(w, ").%s(%s)\n}\n\n", mi.name, (parametersNames)(mi.parameters))
+	}
+	fmt.Fprintf(w, "func %sSwitcher(", pi.newFuncName)
+	for idx, ef := range pi.extraFields {
+		if idx > 0 {
+			fmt.Fprintf(w, ", ")
+		}
+		fmt.Fprintf(w, "%s %s", ef.name, ef.typeStr)
+	}
+	fmt.Fprintf(w, ") %s {\n\treturn &%s{\n", rt.resolvedBaseType.at, sn)
+	for _, ef := range pi.extraFields {
+		fmt.Fprintf(w, "\t\t%s: %s,\n", ef.name, ef.name)
+	}
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+func printCapabilitiesFunc(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	baseName := rt.resolvedBaseType.at.name
+	funcName := fmt.Sprintf("%s%sCapabilities", strings.ToLower(baseName[:1]), baseName[1:])
+	en := rt.resolvedBaseType.at.StringNoDot()
+	resultType := "[]string"
+	if pi.genCapabilityConsts {
+		resultType = capabilityTypeName
+	}
+	fmt.Fprintf(w, "func %s(w %s) %s {\n", funcName, rt.resolvedBaseType.at, resultType)
+	star, _ := pi.wrapperRefs()
+	nComb := combgen.NCombs(len(rt.resolvedExtTypes))
+	if nComb > 1 {
+		fmt.Fprintf(w, "\tswitch w.(type) {\n")
+		counter := 0
+		comb := combgen.NewCombGen(len(rt.resolvedExtTypes))
+		for comb.Next() {
+			idxs := comb.Get()
+			if len(idxs) > 0 {
+				names := make([]string, 0, len(idxs))
+				for _, idx := range idxs {
+					if pi.genCapabilityConsts {
+						names = append(names, capabilityConstName(rt.resolvedExtTypes[idx]))
+					} else {
+						names = append(names, fmt.Sprintf("%q", rt.resolvedExtTypes[idx].at))
+					}
+				}
+				if pi.genCapabilityConsts {
+					fmt.Fprintf(w, "\tcase %st%s%d:\n\t\treturn %s\n", star, en, counter, strings.Join(names, " | "))
+				} else {
+					fmt.Fprintf(w, "\tcase %st%s%d:\n\t\treturn []string{%s}\n", star, en, counter, strings.Join(names, ", "))
+				}
+			}
+			counter++
+		}
+		fmt.Fprintf(w, "\t}\n")
+	}
+	if pi.genCapabilityConsts {
+		fmt.Fprintf(w, "\treturn 0\n}\n")
+	} else {
+		fmt.Fprintf(w, "\treturn nil\n}\n")
+	}
+}
+
+// fieldAccessorName returns the name of the method returning the
+// value of the extra field, like Extra for the extra field.
+func fieldAccessorName(ef extraField) string {
+	return strings.ToUpper(ef.name[:1]) + ef.name[1:]
+}
+
+// printFieldAccessors prints the methods returning the values of the
+// extra fields of each wrapper.
+func printFieldAccessors(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	star, _ := pi.wrapperRefs()
+	for idx, tbn := range wrapperTypeBaseNames(rt, pi) {
+		if idx > 0 && !pi.compact {
+			fmt.Fprintf(w, "\n")
+		}
+		for _, ef := range pi.extraFields {
+			recv := pi.receiverVar(tbn)
+			fmt.Fprintf(w, "func (%s %st%s) %s() %s {\n\treturn %s.%s\n}\n", recv, star, tbn, fieldAccessorName(ef), ef.typeStr, recv, ef.name)
+		}
+	}
+}
+
+// wrapperTypeBaseNames returns the names of the wrapper types without
+// the t prefix, like Conn0 for tConn0.
+func wrapperTypeBaseNames(rt *resolvedTypes, pi *parsedInput) []string {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	if pi.strategy == strategySparse {
+		return []string{en}
+	}
+	var tbns []string
+	nComb := combgen.NCombs(len(rt.resolvedExtTypes))
+	for counter := (uint64)(0); counter < nComb; counter++ {
+		tbns = append(tbns, fmt.Sprintf("%s%d", en, counter))
+	}
+	return tbns
+}
+
+// printCloneMethods prints the Clone method of each wrapper. The
+// value wrappers are copied when returned, the pointer ones are
+// copied explicitly.
+func printCloneMethods(w io.Writer, rt *resolvedTypes, pi *parsedInput) {
+	star, _ := pi.wrapperRefs()
+	for idx, tbn := range wrapperTypeBaseNames(rt, pi) {
+		if idx > 0 && !pi.compact {
+			fmt.Fprintf(w, "\n")
+		}
+		recv := pi.receiverVar(tbn)
+		fmt.Fprintf(w, "func (%s %st%s) Clone() %s {\n", recv, star, tbn, rt.resolvedBaseType.at)
+		if pi.receiver == receiverValue {
+			fmt.Fprintf(w, "\treturn %s\n}\n", recv)
+		} else {
+			fmt.Fprintf(w, "\tclone := *%s\n\treturn &clone\n}\n", recv)
+		}
+	}
+}
+
+func printRebindMethods(w io.Writer, rt *resolvedTypes, pi *parsedInput, ifaceNames []string, errorsPkgName string) {
+	en := rt.resolvedBaseType.at.StringNoDot()
+	nComb := combgen.NCombs(len(rt.resolvedExtTypes))
+	for counter := (uint64)(0); counter < nComb; counter++ {
+		tbn := fmt.Sprintf("%s%d", en, counter)
+		recv := pi.receiverVar(tbn)
+		if counter > 0 && !pi.compact {
+			fmt.Fprintf(w, "\n")
+		}
+		switch pi.rebindOnMismatch {
+		case rebindOnMismatchPanic:
+			fmt.Fprintf(w, "func (%s *t%s) Rebind(r %s) {\n", recv, tbn, rt.resolvedBaseType.at)
+			fmt.Fprintf(w, "\t%s.r = r.(%s)\n", recv, ifaceNames[counter])
+			fmt.Fprintf(w, "}\n")
+		case rebindOnMismatchError:
+			fmt.Fprintf(w, "func (%s *t%s) Rebind(r %s) error {\n", recv, tbn, rt.resolvedBaseType.at)
+			fmt.Fprintf(w, "\tri, ok := r.(%s)\n\tif !ok {\n", ifaceNames[counter])
+			fmt.Fprintf(w, "\t\treturn %s.New(\"the rebound value does not implement the interfaces of the wrapper\")\n", errorsPkgName)
+			fmt.Fprintf(w, "\t}\n\t%s.r = ri\n\treturn nil\n}\n", recv)
+		default:
+			bug("unknown rebind on mismatch mode %s", pi.rebindOnMismatch)
+		}
+	}
+}
+
+type parametersFull []parameterInfo
+
+func (p parametersFull) String() string {
+	strs := make([]string, 0, len(p))
+	names := stringset.StringSet{}
+	for idx, e := range p {
+		name := generateName(names, e.name, idx)
+		strs = append(strs, fmt.Sprintf("%s %s", name, e.typeStr))
+	}
+	return strings.Join(strs, ", ")
+}
+
+type parametersNames []parameterInfo
+
+func (p parametersNames) String() string {
+	strs := make([]string, 0, len(p))
+	names := stringset.StringSet{}
+	for idx, e := range p {
+		name := generateName(names, e.name, idx)
+		strs = append(strs, name)
+	}
+	return strings.Join(strs, ", ")
+}
+
+// generateName returns the name of the parameter with the given
+// index, unnamed and blank parameters are named param<idx>, so the
+// generated methods can pass them on. If the name is already taken,
+// the first free param<N> with N greater than the index is used
+// instead.
+func generateName(names stringset.StringSet, name string, idx int) string {
+	if name == "" || name == "_" {
+		name = fmt.Sprintf("param%d", idx)
+	}
+	for names.Has(name) {
+		idx++
+		name = fmt.Sprintf("param%d", idx)
+	}
+	names.Add(name)
+	return name
+}
+
+// safeParameters returns the parameters with the names
+// parametersFull and parametersNames would print, the names are
+// generated so none of them is one of the reserved names, like the
+// name of the receiver.
+func safeParameters(params []parameterInfo, reserved ...string) []parameterInfo {
+	taken := stringset.StringSet{}
+	for _, name := range reserved {
+		if name != "" {
+			taken.Add(name)
+		}
+	}
+	names := stringset.StringSet{}
+	names.AddSet(taken)
+	safe := make([]parameterInfo, 0, len(params))
+	for idx, param := range params {
+		if taken.Has(param.name) {
+			param.name = ""
+		}
+		param.name = generateName(names, param.name, idx)
+		safe = append(safe, param)
+	}
+	return safe
+}
+
+// printImpls prints the methods of the wrappers of the combinations
+// with the given build tag.
+func printImpls(w io.Writer, rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput, buildTags []string, buildTag string) {
+	comb := combgen.NewCombGen(len(rt.resolvedExtTypes))
+	counter := 0
+	en := rt.resolvedBaseType.at.StringNoDot()
+	first := true
+	for comb.Next() {
+		idxs := comb.Get()
+		tbn := fmt.Sprintf("%s%d", en, counter)
+		if buildTags[counter] != buildTag {
+			counter++
+			continue
+		}
+		if first {
+			first = false
+		} else if !pi.compact {
+			fmt.Fprintf(w, "\n")
+		}
+		// the same method may come from several interfaces,
+		// print it only once
+		emitted := stringset.StringSet{}
+		handled := printImplsFromResolvedType(w, rt.resolvedBaseType, ta, tbn, pi, nil, emitted)
+		for _, idx := range idxs {
+			handled = printImplsFromResolvedType(w, rt.resolvedExtTypes[idx], ta, tbn, pi, handled, emitted)
+		}
+		printExtraMethods(w, ta, tbn, pi)
+		printErrMethod(w, tbn, pi)
+		counter++
+	}
+}
+
+func printExplicitImplsOfInterface(w io.Writer, info pkgPathAndName, ta *typeAnalysis, tbn string, pi *parsedInput, emitted stringset.StringSet) {
+	ifaceInfo := ta.mustGet(info)
+	for _, mi := range ifaceInfo.explicitMethods {
+		if emitted.Has(mi.name) {
+			continue
+		}
+		emitted.Add(mi.name)
+		printMethodImpl(w, mi, ta, tbn, pi, fmt.Sprintf("%s.r", pi.receiverVar(tbn)), "")
+	}
+}
+
+// printMethodImpl prints a wrapper method calling either the prefix
+// function or the method of the wrapped value. The wrapped parameter
+// is an expression evaluating to the wrapped value, check is printed
+// at the beginning of the method body.
+func printMethodImpl(w io.Writer, mi methodInfo, ta *typeAnalysis, tbn string, pi *parsedInput, wrapped, check string) {
+	for _, line := range mi.doc {
+		fmt.Fprintf(w, "%s\n", line)
+	}
+	for _, pragma := range pi.methodPragmas {
+		fmt.Fprintf(w, "%s\n", pragma)
+	}
+	star, _ := pi.wrapperRefs()
+	recv := pi.receiverVar(tbn)
+	errorsPkgName := ""
+	if pi.useErrorsJoin {
+		errorsPkgName = ta.useImport("errors", "errors")
+	}
+	mi.parameters = safeParameters(mi.parameters, recv, errorsPkgName)
+	names := resultNames(mi, recv)
+	fmt.Fprintf(w, "func (%s %st%s%s) %s(%s)", recv, star, tbn, pi.typeParamsRef(), mi.name, (parametersFull)(mi.parameters))
+	switch {
+	case len(mi.returnTypes) == 0:
+		// nothing to print
+	case pi.debugLog:
+		// the deferred logging call needs named results
+		named := make([]string, 0, len(names))
+		for idx, name := range names {
+			named = append(named, fmt.Sprintf("%s %s", name, mi.returnTypes[idx]))
+		}
+		fmt.Fprintf(w, " (%s)", strings.Join(named, ", "))
+	case len(mi.returnTypes) == 1:
+		fmt.Fprintf(w, " %s", mi.returnTypes[0])
+	default:
+		fmt.Fprintf(w, " (%s)", strings.Join(mi.returnTypes, ", "))
+	}
+	fmt.Fprintf(w, " {\n")
+	if pi.metrics {
+		fmt.Fprintf(w, "\t%s(%q)\n", metricsFuncName(pi), mi.name)
+	}
+	if pi.debugLog {
+		printDebugLogCalls(w, mi, pi, names)
+	}
+	fmt.Fprintf(w, "%s", check)
+	if pi.lockField != "" {
+		fmt.Fprintf(w, "\t%s.%s.Lock()\n\tdefer %s.%s.Unlock()\n", recv, pi.lockField, recv, pi.lockField)
+	}
+	if transition, ok := pi.stateTransitions[mi.name]; ok {
+		printStateGuard(w, mi, pi, recv, transition)
+	}
+	call := &strings.Builder{}
+	if pi.passthrough.Has(mi.name) {
+		fmt.Fprintf(call, "%s.%s(%s)", wrapped, mi.name, (parametersNames)(mi.parameters))
+	} else if pi.forwardTemplate != nil {
+		target, err := pi.forwardTarget(mi.name)
+		if err != nil {
+			bug("forward target of %s was not checked: %v", mi.name, err)
+		}
+		fmt.Fprintf(call, "%s.%s(%s)", wrapped, target, (parametersNames)(mi.parameters))
+	} else {
+		callee := pi.prefix + mi.name
+		if pi.typeParams != nil && pi.typeParams.methods.Has(mi.name) {
+			// the type parameters can't be inferred from
+			// the wrapped value
+			callee += pi.typeParamsRef()
+		}
+		if pi.hooksInterface != nil {
+			callee = fmt.Sprintf("%s.%s.%s", recv, hooksFieldName, mi.name)
+		}
+		fmt.Fprintf(call, "%s(%s", callee, wrapped)
+		if len(mi.parameters) > 0 && pi.extrasPosition == extrasPositionAfter {
+			fmt.Fprintf(call, ", %s", (parametersNames)(mi.parameters))
+		}
+		for _, name := range pi.hookExtraFieldNamesFor(mi.name) {
+			fmt.Fprintf(call, ", %s.%s", recv, name)
+		}
+		if len(mi.parameters) > 0 && pi.extrasPosition != extrasPositionAfter {
+			fmt.Fprintf(call, ", %s", (parametersNames)(mi.parameters))
+		}
+		fmt.Fprintf(call, ")")
+	}
+	result := call.String()
+	if pi.resultHooks.Has(mi.name) {
+		result = fmt.Sprintf("%s%sResult(%s)", pi.prefix, mi.name, result)
+	}
+	switch {
+	case len(mi.returnTypes) == 0:
+		fmt.Fprintf(w, "\t%s\n}\n", result)
+	case pi.accumulateErrors && mi.returnTypes[len(mi.returnTypes)-1] == "error":
+		errName := names[len(names)-1]
+		assign := ":="
+		if pi.debugLog {
+			// the results are named already
+			assign = "="
+		}
+		fmt.Fprintf(w, "\t%s %s %s\n", strings.Join(names, ", "), assign, result)
+		if pi.useErrorsJoin {
+			fmt.Fprintf(w, "\tif %s != nil {\n\t\t%s.err = %s.Join(%s.err, %s)\n\t}\n", errName, recv, errorsPkgName, recv, errName)
+		} else {
+			fmt.Fprintf(w, "\tif %s != nil && %s.err == nil {\n\t\t%s.err = %s\n\t}\n", errName, recv, recv, errName)
+		}
+		fmt.Fprintf(w, "\treturn %s\n}\n", strings.Join(names, ", "))
+	default:
+		fmt.Fprintf(w, "\treturn %s\n}\n", result)
+	}
+}
+
+// printDebugLogCalls prints the call logging the arguments of the
+// method and the deferred call logging its results, with -debug-log.
+func printDebugLogCalls(w io.Writer, mi methodInfo, pi *parsedInput, names []string) {
+	fmt.Fprintf(w, "\t%s(%q", debugLogFuncName(pi), mi.name)
+	if len(mi.parameters) > 0 {
+		fmt.Fprintf(w, ", %s", (parametersNames)(mi.parameters))
+	}
+	fmt.Fprintf(w, ")\n")
+	if len(names) == 0 {
+		fmt.Fprintf(w, "\tdefer %s(%q)\n", debugLogFuncName(pi), mi.name+" returned")
+		return
+	}
+	fmt.Fprintf(w, "\tdefer func() {\n\t\t%s(%q, %s)\n\t}()\n", debugLogFuncName(pi), mi.name+" returned", strings.Join(names, ", "))
+}
+
+// printStateGuard prints the check of the state of the wrapper and
+// the deferred transition to the next state, with -state-machine.
+func printStateGuard(w io.Writer, mi methodInfo, pi *parsedInput, recv string, transition stateTransition) {
+	conds := make([]string, 0, len(transition.from))
+	for _, state := range transition.from {
+		conds = append(conds, fmt.Sprintf("%s.state != %q", recv, state))
+	}
+	fmt.Fprintf(w, "\tif %s {\n\t\t%s(%q, %s.state)\n\t}\n", strings.Join(conds, " && "), invalidTransitionFuncName(pi), mi.name, recv)
+	fmt.Fprintf(w, "\tdefer func() {\n\t\t%s.state = %q\n\t}()\n", recv, transition.to)
+}
+
+// checkReceiverName makes sure that the receiver from -receiver-name
+// does not shadow the functions the wrapper methods call.
+func checkReceiverName(rt *resolvedTypes, ta *typeAnalysis, pi *parsedInput) error {
+	if pi.metrics && pi.receiverName == metricsFuncName(pi) {
+		return fmt.Errorf("receiver name %s from -receiver-name collides with the function of -metrics", pi.receiverName)
+	}
+	if pi.debugLog && pi.receiverName == debugLogFuncName(pi) {
+		return fmt.Errorf("receiver name %s from -receiver-name collides with the function of -debug-log", pi.receiverName)
+	}
+	if pi.stateTransitions != nil && pi.receiverName == invalidTransitionFuncName(pi) {
+		return fmt.Errorf("receiver name %s from -receiver-name collides with the function of -state-machine", pi.receiverName)
+	}
+	if pi.useErrorsJoin && pi.receiverName == ta.useImport("errors", "errors") {
+		return fmt.Errorf("receiver name %s from -receiver-name collides with the errors package used by -use-errors-join", pi.receiverName)
+	}
+	if pi.forwardTemplate != nil || pi.hooksInterface != nil {
+		return nil
+	}
+	methods := ta.allMethods(rt)
+	for _, mi := range pi.extraMethods {
+		methods[mi.name] = mi
+	}
+	for _, name := range sortedMethodNames(methods) {
+		if pi.passthrough.Has(name) {
+			continue
+		}
+		if pi.receiverName == pi.prefix+name {
+			return fmt.Errorf("receiver name %s from -receiver-name collides with the prefix function of %s", pi.receiverName, name)
+		}
+		if pi.resultHooks.Has(name) && pi.receiverName == pi.prefix+name+"Result" {
+			return fmt.Errorf("receiver name %s from -receiver-name collides with the result hook function of %s", pi.receiverName, name)
+		}
+	}
+	return nil
+}
+
+// metricsFuncName returns the name of the function counting the calls
+// of the methods with -metrics.
+func metricsFuncName(pi *parsedInput) string {
+	return pi.prefix + "Count"
+}
+
+// debugLogFuncName returns the name of the function logging the
+// arguments and the results of the methods with -debug-log.
+func debugLogFuncName(pi *parsedInput) string {
+	return pi.prefix + "Log"
+}
+
+// invalidTransitionFuncName returns the name of the function called
+// by the methods of -state-machine in a state they are not allowed in.
+func invalidTransitionFuncName(pi *parsedInput) string {
+	return pi.prefix + "InvalidTransition"
+}
+
+// resultNames returns the names of the variables for the results of
+// the method, distinct from the names of its parameters and its
+// receiver.
+func resultNames(mi methodInfo, receiver string) []string {
+	taken := stringset.StringSet{}
+	taken.Add(receiver)
+	for _, param := range mi.parameters {
+		taken.Add(param.name)
+	}
+	names := make([]string, 0, len(mi.returnTypes))
+	for idx := range mi.returnTypes {
+		name := fmt.Sprintf("res%d", idx)
+		for taken.Has(name) {
+			name = "_" + name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// printExtraMethods prints the methods from -extra-methods, they get
+// the wrapped value as is.
+func printExtraMethods(w io.Writer, ta *typeAnalysis, tbn string, pi *parsedInput) {
+	for _, mi := range pi.extraMethods {
+		printMethodImpl(w, mi, ta, tbn, pi, fmt.Sprintf("%s.r", pi.receiverVar(tbn)), "")
+	}
+}
+
+// printErrMethod prints the method returning the error accumulated
+// by the wrapper, if requested.
+func printErrMethod(w io.Writer, tbn string, pi *parsedInput) {
+	if pi.accumulateErrors {
+		recv := pi.receiverVar(tbn)
+		fmt.Fprintf(w, "func (%s *t%s%s) Err() error {\n\treturn %s.err\n}\n", recv, tbn, pi.typeParamsRef(), recv)
+	}
+}
+
+// structFieldGroups returns the field groups of the wrapper struct,
+// which, unlike the new func, also has the accumulated error.
+func structFieldGroups(pi *parsedInput, coreFields ...wrapperField) [][]wrapperField {
+	groups := wrapperFieldGroups(pi, coreFields...)
+	if pi.accumulateErrors {
+		groups = append(groups, []wrapperField{{name: "err", typeStr: "error"}})
+	}
+	return groups
+}
+
+func printImplsOfEmbeddedTypes(w io.Writer, info pkgPathAndName, ta *typeAnalysis, excludes stringset.StringSet, tbn string, pi *parsedInput, emitted stringset.StringSet) stringset.StringSet {
+	newExcludes := stringset.StringSet{}
+	ifaceInfo := ta.mustGet(info)
+	for _, eti := range ifaceInfo.embeddedTypes {
+		etiStr := eti.String()
+		if excludes.Has(etiStr) {
+			continue
+		}
+		newExcludes.Add(etiStr)
+		subExcludes := printImplsFromInterfaceRecursive(w, eti, ta, newExcludes, tbn, pi, emitted)
+		newExcludes.AddSet(subExcludes)
+	}
+	return newExcludes
+}
+
+func printImplsFromInterfaceRecursive(w io.Writer, info pkgPathAndName, ta *typeAnalysis, excludes stringset.StringSet, tbn string, pi *parsedInput, emitted stringset.StringSet) stringset.StringSet {
+	subExcludes := printImplsOfEmbeddedTypes(w, info, ta, excludes, tbn, pi, emitted)
+	printExplicitImplsOfInterface(w, info, ta, tbn, pi, emitted)
+	return excludes.Union(subExcludes)
+}
+
+func printImplsFromResolvedType(w io.Writer, resType resolvedType, ta *typeAnalysis, tbn string, pi *parsedInput, excludes, emitted stringset.StringSet) stringset.StringSet {
+	info := resTypeInfo(resType)
+	newExcludes := stringset.StringSet{}
+	newExcludes.AddSet(excludes)
+	newExcludes.Add(info.String())
+	subExcludes := printImplsFromInterfaceRecursive(w, info, ta, newExcludes, tbn, pi, emitted)
+	return subExcludes
+}
+
+// printVars prints the compile-time assertions that the wrappers of
+// the combinations with the given build tag implement their
+// interfaces. The declaration is built as an AST and printed with
+// go/printer.
+func printVars(w io.Writer, rt *resolvedTypes, pi *parsedInput, buildTags []string, buildTag string) {
+	decl := &ast.GenDecl{
+		Tok: token.VAR,
+		// any valid position makes it a parenthesized block
+		Lparen: token.Pos(1),
+	}
+	counter := 0
+	en := rt.resolvedBaseType.at.StringNoDot()
+	_, amp := pi.wrapperRefs()
+	comb := combgen.NewCombGen(len(rt.resolvedExtTypes))
+	for comb.Next() {
+		idxs := comb.Get()
+		tbn := fmt.Sprintf("%s%d", en, counter)
+		if buildTags[counter] != buildTag {
+			counter++
+			continue
+		}
+		ifaces := []aType{rt.resolvedBaseType.at}
+		for _, idx := range idxs {
+			ifaces = append(ifaces, rt.resolvedExtTypes[idx].at)
+		}
+		wrapperType := aType{name: "t" + tbn}
+		if rt.resolvedBaseType.generic {
+			wrapperType.typeArgs = rt.resolvedBaseType.at.typeArgs
+		}
+		for _, iface := range ifaces {
+			var value ast.Expr = &ast.CompositeLit{
+				Type: wrapperType.expr(),
+			}
+			if amp != "" {
+				value = &ast.UnaryExpr{
+					Op: token.AND,
+					X:  value,
+				}
+			}
+			decl.Specs = append(decl.Specs, &ast.ValueSpec{
+				Names:  []*ast.Ident{ast.NewIdent("_")},
+				Type:   iface.expr(),
+				Values: []ast.Expr{value},
+			})
+		}
+		counter++
+	}
+	if pi.typeParams != nil {
+		// the generic wrappers can be checked only
+		// inside a generic function
+		fmt.Fprintf(w, "func _%s() {\n", pi.typeParamsDecl())
+		defer fmt.Fprintf(w, "}\n")
+	}
+	if err := pi.printerConfig().Fprint(w, token.NewFileSet(), decl); err != nil {
+		bug("failed to print the var block: %v", err)
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+// printTypes prints the wrappers of the combinations with the given
+// build tag. The interfaces of all the combinations are printed along
+// with the untagged wrappers, the new func refers to them.
+func printTypes(w io.Writer, rt *resolvedTypes, pi *parsedInput, ifaceNames, buildTags []string, buildTag string) {
+	lines := &linePositions{}
+	decl := &ast.GenDecl{
+		Tok:    token.TYPE,
+		Lparen: lines.next(),
+	}
+	var comments []*ast.CommentGroup
+	counter := 0
+	en := rt.resolvedBaseType.at.StringNoDot()
+	comb := combgen.NewCombGen(len(rt.resolvedExtTypes))
+	for comb.Next() {
+		idxs := comb.Get()
+		tbn := fmt.Sprintf("%s%d", en, counter)
+		ifaceName := ifaceNames[counter]
+		if buildTag == "" {
+			if !pi.compact {
+				lines.skip()
+			}
+			spec := &ast.TypeSpec{}
+			if pi.exportCombinationInterfaces && counter > 0 && !pi.compact {
+				names := make([]string, 0, len(idxs))
+				for _, idx := range idxs {
+					names = append(names, rt.resolvedExtTypes[idx].at.String())
+				}
+				spec.Doc = &ast.CommentGroup{
+					List: []*ast.Comment{
+						{
+							Slash: lines.next(),
+							Text:  fmt.Sprintf("// %s is %s that also implements %s.", ifaceName, rt.resolvedBaseType.at, strings.Join(names, ", ")),
+						},
+					},
+				}
+				comments = append(comments, spec.Doc)
+			}
+			pos := lines.next()
+			spec.Name = &ast.Ident{
+				NamePos: pos,
+				Name:    ifaceName,
+			}
+			spec.TypeParams = typeParamsAt(pi, pos)
+			elems := []*ast.Field{
+				{Type: exprAt(rt.resolvedBaseType.at.expr(), lines.next())},
+			}
+			for _, idx := range idxs {
+				elems = append(elems, &ast.Field{
+					Type: exprAt(rt.resolvedExtTypes[idx].at.expr(), lines.next()),
+				})
+			}
+			spec.Type = &ast.InterfaceType{
+				Interface: pos,
+				Methods: &ast.FieldList{
+					Opening: pos,
+					List:    elems,
+					Closing: lines.next(),
+				},
+			}
+			decl.Specs = append(decl.Specs, spec)
+		}
+		if buildTags[counter] == buildTag {
+			if !pi.compact {
+				lines.skip()
+			}
+			pos := lines.next()
+			spec := &ast.TypeSpec{
+				Name: &ast.Ident{
+					NamePos: pos,
+					Name:    "t" + tbn,
+				},
+				TypeParams: typeParamsAt(pi, pos),
+			}
+			fields := wrapperStructFields(lines, structFieldGroups(pi, wrapperField{name: "r", typeStr: ifaceName + pi.typeParamsRef()}), pi)
+			fields.Opening = pos
+			fields.Closing = lines.next()
+			spec.Type = &ast.StructType{
+				Struct: pos,
+				Fields: fields,
+			}
+			decl.Specs = append(decl.Specs, spec)
+		}
+		counter++
+	}
+	decl.Rparen = lines.next()
+	node := &printer.CommentedNode{
+		Node:     decl,
+		Comments: comments,
+	}
+	if err := pi.printerConfig().Fprint(w, lines.fileSet(), node); err != nil {
+		bug("failed to print the type block: %v", err)
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+// typeParamsAt returns the type parameters of the generic wrappers
+// at the position, or nil if the wrappers are not generic.
+func typeParamsAt(pi *parsedInput, pos token.Pos) *ast.FieldList {
+	if pi.typeParams == nil {
+		return nil
+	}
+	return parseTypeSpec(pi.typeParamsDecl(), "int", pos).TypeParams
+}
+
+// linePositions hands out the positions of the consecutive lines of
+// a made up file. go/printer keeps the blank lines between the nodes
+// of an AST only if they have positions, so the nodes on the lines
+// get them.
+type linePositions struct {
+	lines int
+}
+
+// lineWidth is the size of the made up lines. It needs to be more
+// than go/printer writes between two nodes with positions, otherwise
+// it would print the comments of the next lines too early.
+const lineWidth = 1 << 10
+
+// next returns the position of the next line.
+func (lp *linePositions) next() token.Pos {
+	lp.lines++
+	// the file starts at the base of a new file set, which is 1
+	return token.Pos(1 + (lp.lines-1)*lineWidth)
+}
+
+// skip leaves the next line blank.
+func (lp *linePositions) skip() {
+	lp.lines++
+}
+
+// fileSet returns the file set with the made up file, for printing
+// the AST.
+func (lp *linePositions) fileSet() *token.FileSet {
+	fset := token.NewFileSet()
+	lineOffsets := make([]int, 0, lp.lines)
+	for line := 0; line < lp.lines; line++ {
+		lineOffsets = append(lineOffsets, line*lineWidth)
+	}
+	fset.AddFile("", fset.Base(), lp.lines*lineWidth).SetLines(lineOffsets)
+	return fset
+}
+
+// exprAt puts the type expression at the position by setting the
+// position of its leftmost node.
+func exprAt(expr ast.Expr, pos token.Pos) ast.Expr {
+	for x := expr; ; {
+		switch e := x.(type) {
+		case *ast.Ident:
+			e.NamePos = pos
+			return expr
+		case *ast.StarExpr:
+			e.Star = pos
+			return expr
+		case *ast.SelectorExpr:
+			x = e.X
+		case *ast.IndexExpr:
+			x = e.X
+		case *ast.IndexListExpr:
+			x = e.X
+		default:
+			bug("unexpected embedded type expression %#v", expr)
+		}
+	}
+}
+
+// parseTypeSpec parses the declaration of type _ with the type
+// parameters and the type. The positions of the parsed nodes refer
+// to the parsed string, not to the AST the nodes are put into, so
+// they are all moved to pos.
+func parseTypeSpec(typeParams, typeStr string, pos token.Pos) *ast.TypeSpec {
+	src := fmt.Sprintf("package p\n\ntype _%s %s\n", typeParams, typeStr)
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		bug("failed to parse type %s with type parameters %q: %v", typeStr, typeParams, err)
+	}
+	spec := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+	posType := reflect.TypeOf(token.NoPos)
+	ast.Inspect(spec, func(node ast.Node) bool {
+		if node == nil {
+			return false
+		}
+		v := reflect.ValueOf(node).Elem()
+		for idx := 0; idx < v.NumField(); idx++ {
+			// the invalid positions mark the missing
+			// tokens, like the parentheses of a single
+			// result
+			if field := v.Field(idx); field.Type() == posType && token.Pos(field.Int()).IsValid() {
+				field.SetInt(int64(pos))
+			}
+		}
+		return true
+	})
+	return spec
+}
+
+func printImports(w io.Writer, ta *typeAnalysis) {
+	sortedImports := make([]string, 0, len(ta.imports))
+	for pkgPath := range ta.imports {
+		sortedImports = append(sortedImports, pkgPath)
+	}
+	sort.Strings(sortedImports)
+	fmt.Fprintf(w, "import (\n")
+
+	for _, pkgPath := range sortedImports {
+		name, ok := ta.imports[pkgPath]
+		if !ok {
+			bug("corrupted imports, %#v and %#v", sortedImports, ta.imports)
+		}
+		if name != "" {
+			fmt.Fprintf(w, "\t%s %q\n", name, pkgPath)
+		} else {
+			fmt.Fprintf(w, "\t%q\n", pkgPath)
+		}
+	}
+	fmt.Fprintf(w, ")\n")
+}
+
+func getPkgPath(thisPkg *packages.Package, at aType, inFile string, imports []anImport) (string, error) {
+	if at.pkgPath != "" {
+		return at.pkgPath, nil
+	}
+	if at.pkgName == "" {
+		return "", nil
+	}
+	for _, imprt := range imports {
+		if imprt.name == at.pkgName {
+			return imprt.path, nil
+		}
+	}
+	// the infile may import the package under a different name,
+	// like d for database/sql/driver
+	path, err := getPkgPathFromInFileImports(inFile, at.pkgName)
+	if err != nil {
+		return "", err
+	}
+	if path != "" {
+		return path, nil
+	}
+	// files of this package may import different packages with
+	// the same name, do not pick one of them arbitrarily
+	var candidates []string
+	for path, ipkg := range thisPkg.Imports {
+		if ipkg.Name == at.pkgName {
+			candidates = append(candidates, path)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("package path for %s not found", at.pkgName)
+	case 1:
+		return candidates[0], nil
+	}
+	sort.Strings(candidates)
+	return "", fmt.Errorf("package name %s is ambiguous, it may refer to any of %s, use -imports to pick one", at.pkgName, strings.Join(candidates, ", "))
+}
+
+func getPkgPathFromInFileImports(inFile, pkgName string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, inFile, nil, parser.ImportsOnly)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse imports of the infile %s: %w", inFile, err)
+	}
+	for _, spec := range file.Imports {
+		if spec.Name == nil || spec.Name.Name != pkgName {
+			continue
+		}
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", fmt.Errorf("failed to unquote import path %s in the infile %s: %w", spec.Path.Value, inFile, err)
+		}
+		return path, nil
+	}
+	return "", nil
+}
+
+func findPackage(cfg *packages.Config, thisPkg *packages.Package, pkgPath string) (*packages.Package, error) {
+	if pkg := findPackageNoLoad(thisPkg, pkgPath); pkg != nil {
+		if err := goVersionError(pkg); err != nil {
+			return nil, err
+		}
+		return pkg, nil
+	}
+	// still not found, load it
+	loadedPkgs, err := packages.Load(cfg, pkgPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s package: %w", pkgPath, err)
+	}
+	for _, lpkg := range loadedPkgs {
+		if pkg := findPackageNoLoad(lpkg, pkgPath); pkg != nil {
+			if err := goVersionError(pkg); err != nil {
+				return nil, err
+			}
+			if pkg.Types == nil || pkg.Name == "" {
+				if len(pkg.Errors) > 0 {
+					return nil, fmt.Errorf("failed to load %s package: %v", pkgPath, pkg.Errors[0])
+				}
+				return nil, fmt.Errorf("failed to load %s package", pkgPath)
+			}
+			return pkg, nil
+		}
+	}
+	return nil, fmt.Errorf("package %s not found", pkgPath)
+}
+
+// describePackageErrors returns the first of the errors and the
+// number of the remaining ones, or an empty string if there are no
+// errors.
+func describePackageErrors(errs []packages.Error) string {
+	switch len(errs) {
+	case 0:
+		return ""
+	case 1:
+		return errs[0].Error()
+	default:
+		return fmt.Sprintf("%v and %d more", errs[0], len(errs)-1)
+	}
+}
+
+// goVersionError returns an error suggesting a newer toolchain if the
+// package has errors and its module requires a newer Go than the one
+// wrappergen was built with, so its type checker likely does not
+// understand the code. Otherwise it returns nil.
+func goVersionError(pkg *packages.Package) error {
+	if len(pkg.Errors) == 0 || pkg.Module == nil || pkg.Module.GoVersion == "" {
+		return nil
+	}
+	builtWith := runtime.Version()
+	required := "go" + pkg.Module.GoVersion
+	if !version.IsValid(builtWith) || version.Compare(required, builtWith) <= 0 {
+		return nil
+	}
+	return fmt.Errorf("failed to load %s package: %v (the module %s requires %s, but wrappergen was built with %s, reinstall wrappergen with %s or newer)", pkg.PkgPath, pkg.Errors[0], pkg.Module.Path, required, builtWith, required)
+}
+
+// fetchPackage adds the module providing the package to a copy of
+// go.mod of the module of this package with go get and makes the
+// config use the copy, so the package can be loaded, but go.mod and
+// go.sum stay untouched.
+func (rt *resolvedTypes) fetchPackage(cfg *packages.Config, thisPkg *packages.Package, pkgPath string) error {
+	debug("fetching package %s", pkgPath)
+	if rt.fetchDir == "" {
+		if thisPkg.Module == nil || thisPkg.Module.GoMod == "" {
+			return fmt.Errorf("failed to fetch package %s, the package of the infile does not belong to a module", pkgPath)
+		}
+		dir, err := ioutil.TempDir("", "wrappergen-fetch")
+		if err != nil {
+			return fmt.Errorf("failed to create a directory for the copy of go.mod: %w", err)
+		}
+		rt.fetchDir = dir
+		modDir := filepath.Dir(thisPkg.Module.GoMod)
+		for _, name := range []string{"go.mod", "go.sum"} {
+			src, err := ioutil.ReadFile(filepath.Join(modDir, name))
+			if name == "go.sum" && os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read %s of module %s: %w", name, thisPkg.Module.Path, err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(dir, name), src, 0644); err != nil {
+				return fmt.Errorf("failed to copy %s of module %s: %w", name, thisPkg.Module.Path, err)
+			}
+		}
+		cfg.BuildFlags = append(cfg.BuildFlags, "-modfile="+filepath.Join(dir, "go.mod"))
+	}
+	cmd := exec.Command("go", "get", "-modfile="+filepath.Join(rt.fetchDir, "go.mod"), pkgPath)
+	cmd.Dir = cfg.Dir
+	cmd.Env = cfg.Env
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to fetch package %s with go get on a copy of go.mod: %w, output:\n%s", pkgPath, err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+func findPackageNoLoad(fpkg *packages.Package, pkgPath string) *packages.Package {
+	pkgsToGo := []*packages.Package{fpkg}
+	for i := 0; i < len(pkgsToGo); i++ {
+		pkg := pkgsToGo[i]
+		if pkg.PkgPath == pkgPath {
+			return pkg
+		}
+		for _, ipkg := range pkg.Imports {
+			pkgsToGo = append(pkgsToGo, ipkg)
+		}
+	}
+	return nil
+}
+
+func getType(scope *types.Scope, name string) (types.Type, error) {
+	obj := scope.Lookup(name)
+	if obj != nil {
+		return obj.Type(), nil
+	}
+	return nil, fmt.Errorf("no type %s", name)
+}
+
+// bugError is a bug in wrappergen itself. bug panics with it, so it
+// does not need to be passed up through all the callers, and
+// recoverBug turns it back into an error.
+type bugError struct {
+	msg string
+}
+
+func (e *bugError) Error() string {
+	return e.msg
+}
+
+func bug(formatStr string, args ...interface{}) {
+	panic(&bugError{
+		msg: fmt.Sprintf(formatStr, args...),
+	})
+}
+
+// recoverBug stores the error of the bug panic in err, other panics
+// are not recovered.
+func recoverBug(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	bErr, ok := r.(*bugError)
+	if !ok {
+		panic(r)
+	}
+	*err = withExitCode(exitCodeBug, bErr)
+}
+
+// warningCollector prints warnings and, in strict mode, remembers
+// them, so the generation can fail at the end.
+type warningCollector struct {
+	strict bool
+	// quiet drops the warnings, it is set while generating for
+	// the second time with -self-idempotent, which reports the
+	// same warnings again
+	quiet    bool
+	warnings []string
+}
+
+func (wc *warningCollector) warn(formatStr string, args ...interface{}) {
+	if wc.quiet {
+		return
+	}
+	msg := fmt.Sprintf(formatStr, args...)
+	wc.warnings = append(wc.warnings, msg)
+	warn("%s", msg)
+}
+
+func (wc *warningCollector) strictError() error {
+	if !wc.strict || len(wc.warnings) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d warning(s) reported in strict mode, first one: %s", len(wc.warnings), wc.warnings[0])
+}
+
+func warn(formatStr string, args ...interface{}) {
+	printWithPrefix("WARN", formatStr, args...)
+}
+
+func debug(formatStr string, args ...interface{}) {
+	if !isDbg {
+		return
+	}
+	printWithPrefix("DEBUG", formatStr, args...)
+}
+
+func printWithPrefix(prefix, formatStr string, args ...interface{}) {
+	newFormatStr := fmt.Sprintf("%s: %s\n", prefix, formatStr)
+	fmt.Fprintf(os.Stderr, newFormatStr, args...)
+}
@@ -0,0 +1,2871 @@
+// Copyright Krzesimir Nowak
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"go/version"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "regenerate the golden files in the test directory instead of comparing them")
+
+func runGenerate(args ...string) ([]byte, error) {
+	pi, err := parseArgs(commandGenerate, args, nil)
+	if err != nil {
+		return nil, err
+	}
+	return generate(pi, args)
+}
+
+func mustGenerate(t *testing.T, args ...string) string {
+	t.Helper()
+	src, err := runGenerate(args...)
+	require.NoError(t, err)
+	return string(src)
+}
+
+// tempModule copies the Go files from the fixture directory into a
+// module in a temporary directory and returns the directory, so the
+// generated code can be written next to the infile and seen by the
+// next run.
+func tempModule(t *testing.T, fixtureDir string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/fixture\n\ngo 1.22\n"), 0644))
+	paths, err := filepath.Glob(filepath.Join(fixtureDir, "*.go"))
+	require.NoError(t, err)
+	for _, path := range paths {
+		src, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, filepath.Base(path)), src, 0644))
+	}
+	return dir
+}
+
+// generateTwice writes the generated code and then generates it
+// again, when the package of the infile already has the code from the
+// first run.
+func generateTwice(t *testing.T, args ...string) {
+	t.Helper()
+	for run := 1; run <= 2; run++ {
+		pi, err := parseArgs(commandGenerate, args, nil)
+		require.NoError(t, err)
+		require.NoError(t, generateAndWrite(pi, args), "run %d", run)
+	}
+}
+
+func TestEmbeddedCrossPackageAlias(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/alias/alias.go",
+		"-basetype=Base",
+		"-exttypes=Ext",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	// method promoted through the alias is implemented
+	assert.Contains(t, src, "func (oBase0 *tBase0) Read(p []byte) (int, error) {")
+	assert.Contains(t, src, "return realRead(oBase0.r, p)")
+	// embedded types are not spelled out, so neither the
+	// package with the alias nor the aliased package are
+	// needed
+	assert.NotContains(t, src, `"io"`)
+	// but the alias used in a signature is kept as is
+	assert.Contains(t, src, `"github.com/krnowak/wrappergen/generator/testdata/alias/a"`)
+	assert.Contains(t, src, "func (oBase1 *tBase1) Peek(r a.Reader) error {")
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	type testcase struct {
+		name     string
+		input    string
+		expected string
+	}
+	testcases := []testcase{
+		{
+			name:     "already normalized",
+			input:    "package foo\n\nvar x int\n",
+			expected: "package foo\n\nvar x int\n",
+		},
+		{
+			name:     "multiple blank lines",
+			input:    "package foo\n\n\n\nvar x int\n",
+			expected: "package foo\n\nvar x int\n",
+		},
+		{
+			name:     "trailing whitespace and newlines",
+			input:    "package foo \n\t\n\nvar x int\n\n\n",
+			expected: "package foo\n\nvar x int\n",
+		},
+		{
+			name:     "no trailing newline",
+			input:    "package foo",
+			expected: "package foo\n",
+		},
+		{
+			name:     "raw string literal",
+			input:    "package foo\n\nvar x = `a  \n\n\n\tb`\n\n\nvar y int \n",
+			expected: "package foo\n\nvar x = `a  \n\n\n\tb`\n\nvar y int\n",
+		},
+		{
+			name:     "comments",
+			input:    "// Header  \n\n\n/*\n  a\t\n\n\n  b\n*/\npackage foo // c  \n",
+			expected: "// Header  \n\n/*\n  a\t\n\n\n  b\n*/\npackage foo // c  \n",
+		},
+	}
+	for _, tc := range testcases {
+		got := normalizeWhitespace([]byte(tc.input))
+		assert.Equal(t, tc.expected, string(got), "%s", tc.name)
+	}
+}
+
+func TestLockField(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/lock/lock.go",
+		"-basetype=Base",
+		"-extrafields=mu,*sync.Mutex",
+		"-lock-field=mu",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "\toBase0.mu.Lock()\n\tdefer oBase0.mu.Unlock()\n\treturn realGet(oBase0.r, oBase0.mu, key)\n")
+	assert.Contains(t, src, "\toBase0.mu.Lock()\n\tdefer oBase0.mu.Unlock()\n\trealClose(oBase0.r, oBase0.mu)\n")
+}
+
+func TestLockFieldErrors(t *testing.T) {
+	_, err := runGenerate(
+		"-infile=testdata/lock/lock.go",
+		"-basetype=Base",
+		"-extrafields=mu,*sync.Mutex",
+		"-lock-field=lock",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.EqualError(t, err, "lock field lock is not one of the extra fields, use -extrafields to add it")
+	_, err = runGenerate(
+		"-infile=testdata/lock/lock.go",
+		"-basetype=Base",
+		"-extrafields=mu,int",
+		"-lock-field=mu",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.EqualError(t, err, "lock field mu of type int has no Lock method")
+}
+
+func TestValidateExtraFields(t *testing.T) {
+	generateWithField := func(field string) error {
+		_, err := runGenerate(
+			"-infile=testdata/extrafields/extrafields.go",
+			"-basetype=Base",
+			"-extrafields="+field,
+			"-validate-extrafields",
+			"-prefix=real",
+			"-newfuncname=newBase",
+		)
+		return err
+	}
+	assert.NoError(t, generateWithField("f,chan<- func() map[Local]driver.Value"))
+	assert.NoError(t, generateWithField("f,[]*driver.NamedValue"))
+	assert.NoError(t, generateWithField("f,interface{}"))
+	err := generateWithField("f,map[[]Local]int")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "extra field f has an invalid type map[[]Local]int")
+	}
+	err = generateWithField("f,driver.ErrBadConn")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "extra field f has")
+	}
+}
+
+func TestASTTransform(t *testing.T) {
+	args := []string{
+		"-infile=testdata/lock/lock.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	dropNewFunc := func(file *ast.File) error {
+		decls := make([]ast.Decl, 0, len(file.Decls))
+		for _, decl := range file.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Name.Name == "newBase" {
+				continue
+			}
+			decls = append(decls, decl)
+		}
+		file.Decls = decls
+		return nil
+	}
+	src, err := GenerateSource(args, dropNewFunc)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func (oBase0 *tBase0) Close() {")
+	assert.NotContains(t, string(src), "func newBase(")
+
+	failure := errors.New("nope")
+	_, err = GenerateSource(args, func(*ast.File) error {
+		return failure
+	})
+	assert.True(t, errors.Is(err, failure))
+
+	src, err = GenerateSource(args, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func newBase(")
+}
+
+func TestGenerateUnsupportedFlags(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	for _, flag := range []string{
+		"-combination-tags=Pinger=withping",
+		"-region=wrappers",
+		"-gen-combination-test",
+	} {
+		_, err := Generate(append(args, flag), nil)
+		assert.EqualError(t, err, fmt.Sprintf("%s can't be used with Generate, it returns only the code of the outfile", strings.SplitN(flag, "=", 2)[0]))
+	}
+}
+
+func TestRecoverBug(t *testing.T) {
+	err := func() (err error) {
+		defer recoverBug(&err)
+		bug("broken %d", 42)
+		return nil
+	}()
+	assert.EqualError(t, err, "broken 42")
+	assert.Equal(t, exitCodeBug, exitCode(err))
+	assert.Panics(t, func() {
+		var err error
+		defer recoverBug(&err)
+		panic("not a bug")
+	})
+}
+
+func TestUseAny(t *testing.T) {
+	args := []string{
+		"-infile=testdata/empty/empty.go",
+		"-basetype=Base",
+		"-extrafields=extra,interface{};cb,func(map[string]interface{}) []interface{}",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Set(key string, value interface{}) {")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Get(key string) any {")
+	assert.Contains(t, src, "extra interface{}\n")
+
+	src = mustGenerate(t, append(args, "-use-any")...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Set(key string, value any) {")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Get(key string) any {")
+	assert.Contains(t, src, "extra any\n")
+	assert.Contains(t, src, "cb    func(map[string]any) []any\n")
+	// skip the header, it contains the arguments
+	assert.NotContains(t, strings.SplitN(src, "\n", 2)[1], "interface{}")
+}
+
+func TestCommaOkResults(t *testing.T) {
+	args := []string{
+		"-infile=testdata/empty/empty.go",
+		"-basetype=Cache",
+		"-prefix=real",
+		"-newfuncname=newCache",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oCache0 *tCache0) Lookup(k string) (interface{}, bool) {\n\treturn realLookup(oCache0.r, k)\n}\n")
+	src = mustGenerate(t, append(args, "-use-any")...)
+	assert.Contains(t, src, "func (oCache0 *tCache0) Lookup(k string) (any, bool) {\n\treturn realLookup(oCache0.r, k)\n}\n")
+}
+
+func TestGenCapabilities(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-gen-capabilities",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	expected := `func baseCapabilities(w Base) []string {
+	switch w.(type) {
+	case *tBase1:
+		return []string{"Pinger"}
+	case *tBase2:
+		return []string{"Resetter"}
+	case *tBase3:
+		return []string{"Pinger", "Resetter"}
+	}
+	return nil
+}
+`
+	assert.Contains(t, src, expected)
+}
+
+func TestDeduceOutFile(t *testing.T) {
+	type testcase struct {
+		name     string
+		tmpl     string
+		baseType aType
+		expected string
+	}
+	driverConn := aType{
+		pkgName: "driver",
+		name:    "Conn",
+	}
+	testcases := []testcase{
+		{
+			name:     "default",
+			tmpl:     defaultOutFileTemplate,
+			baseType: driverConn,
+			expected: "driverconn_wrappers.go",
+		},
+		{
+			name:     "default, local type",
+			tmpl:     defaultOutFileTemplate,
+			baseType: aType{name: "Base"},
+			expected: "base_wrappers.go",
+		},
+		{
+			name:     "all fields",
+			tmpl:     "wrappers/{{.BaseTypePkg}}/{{.Prefix}}{{.BaseTypeName}}_{{.BaseType}}.go",
+			baseType: driverConn,
+			expected: "wrappers/driver/realConn_driver.Conn.go",
+		},
+	}
+	for _, tc := range testcases {
+		got, err := deduceOutFile(tc.tmpl, tc.baseType, "real")
+		if assert.NoError(t, err, "%s", tc.name) {
+			assert.Equal(t, tc.expected, got, "%s", tc.name)
+		}
+	}
+	_, err := deduceOutFile("{{.Bogus}}", driverConn, "real")
+	assert.Error(t, err)
+	_, err = deduceOutFile("{{if false}}x{{end}}", driverConn, "real")
+	assert.Error(t, err)
+}
+
+func TestIdenticalMethodsFromDifferentPackages(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/dedup/dedup.go",
+		"-basetype=Base",
+		"-exttypes=a.Resetter;b.Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Equal(t, 1, strings.Count(src, "func (oBase3 *tBase3) Reset() {"))
+	assert.Equal(t, 1, strings.Count(src, "func (oBase3 *tBase3) Flush() error {"))
+	assert.Equal(t, 1, strings.Count(src, "func (oBase1 *tBase1) Reset() {"))
+	assert.Equal(t, 1, strings.Count(src, "func (oBase2 *tBase2) Reset() {"))
+}
+
+func TestMethodPromotedFromSeveralEmbeddedInterfaces(t *testing.T) {
+	args := []string{
+		"-infile=testdata/closers/closers.go",
+		"-basetype=Base",
+		"-exttypes=Flusher",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, append(args, "-gen-switcher")...)
+	assert.Equal(t, 1, strings.Count(src, "func (oBase0 *tBase0) Close() error {"))
+	assert.Equal(t, 1, strings.Count(src, "func (oBase1 *tBase1) Close() error {"))
+	assert.Equal(t, 1, strings.Count(src, "func (osBase *sBase) Close() error {"))
+	src = mustGenerate(t, append(args, "-strategy=sparse")...)
+	assert.Equal(t, 1, strings.Count(src, "func (oBase *tBase) Close() error {"))
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Equal(t, 1, strings.Count(missing.String(), "func realClose("))
+}
+
+func TestConflictingMethodsFromDifferentPackages(t *testing.T) {
+	_, err := runGenerate(
+		"-infile=testdata/dedup/dedup.go",
+		"-basetype=Base",
+		"-exttypes=a.Resetter;c.Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.EqualError(t, err, "method Reset has incompatible signatures in a.Resetter (() ()) and in c.Resetter (() (error)), the types can't be combined")
+}
+
+func TestEmbedStruct(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/embed/embed.go",
+		"-basetype=Base",
+		"-extrafields=extra,int",
+		"-embed-struct=*shared.Common",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, `"github.com/krnowak/wrappergen/generator/testdata/embed/shared"`)
+	assert.Contains(t, src, "tBase0 struct {\n\t\tr iBase0\n\t\t*shared.Common\n\t\textra int\n\t}")
+	assert.Contains(t, src, "func newBase(realBase Base, extra int, common *shared.Common) Base {")
+	assert.Contains(t, src, "\t\tCommon: common,\n")
+}
+
+func TestEmbedStructErrors(t *testing.T) {
+	type testcase struct {
+		name        string
+		embed       string
+		extTypes    string
+		expectedErr string
+	}
+	testcases := []testcase{
+		{
+			name:        "method collision",
+			embed:       "shared.Common",
+			extTypes:    "Describer",
+			expectedErr: "embedded struct shared.Common provides Describe, which collides with the interface method Describe",
+		},
+		{
+			name:        "field collision",
+			embed:       "shared.Common",
+			extTypes:    "Namer",
+			expectedErr: "embedded struct shared.Common provides Name, which collides with the interface method Name",
+		},
+		{
+			name:        "not a struct",
+			embed:       "shared.NotAStruct",
+			expectedErr: "embedded struct shared.NotAStruct is not a struct",
+		},
+	}
+	for _, tc := range testcases {
+		args := []string{
+			"-infile=testdata/embed/embed.go",
+			"-basetype=Base",
+			"-embed-struct=" + tc.embed,
+			"-prefix=real",
+			"-newfuncname=newBase",
+		}
+		if tc.extTypes != "" {
+			args = append(args, "-exttypes="+tc.extTypes)
+		}
+		_, err := runGenerate(args...)
+		assert.EqualError(t, err, tc.expectedErr, "%s", tc.name)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	flagset := flag.NewFlagSet("wrappergen", flag.ContinueOnError)
+	fi := &flagsInput{}
+	fi.configureFlagSet(flagset)
+	out := &strings.Builder{}
+	flagset.SetOutput(out)
+	err := fi.parseFlagsAndEnvironment(flagset, []string{"-h"}, nil)
+	assert.Equal(t, silentFailure, err)
+	usage := out.String()
+	assert.Contains(t, usage, "Usage of wrappergen:\n")
+	assert.Contains(t, usage, "-basetype string")
+	assert.Contains(t, usage, "\nExamples:\n")
+	assert.Contains(t, usage, "//go:generate wrappergen -basetype=driver.Conn ")
+	assert.Contains(t, usage, "\nEnvironment variables:\n  GOFILE\n")
+	assert.Contains(t, usage, "\n  DBG\n")
+	assert.NotContains(t, usage, "self-idempotent")
+}
+
+func TestExtTypesInBasePkg(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basepkg/basepkg.go",
+		"-basetype=drv.Conn",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}
+	_, err := runGenerate(args...)
+	assert.Error(t, err)
+	src := mustGenerate(t, append(args, "-exttypes-in-base-pkg")...)
+	assert.Regexp(t, `_ drv\.Pinger += &tdrvConn1\{\}`, src)
+	// type from this package takes precedence
+	assert.Regexp(t, `_ Resetter += &tdrvConn2\{\}`, src)
+	assert.Contains(t, src, "func (odrvConn2 *tdrvConn2) ResetLocal() {")
+}
+
+func TestGenRebind(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-gen-rebind",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase1 *tBase1) Rebind(r Base) {\n\toBase1.r = r.(iBase1)\n}\n")
+	assert.NotContains(t, src, `"errors"`)
+
+	src = mustGenerate(t, append(args, "-rebind-on-mismatch=error")...)
+	assert.Contains(t, src, `"errors"`)
+	assert.Contains(t, src, "func (oBase1 *tBase1) Rebind(r Base) error {\n\tri, ok := r.(iBase1)\n\tif !ok {\n\t\treturn errors.New(")
+	assert.Contains(t, src, "\toBase1.r = ri\n\treturn nil\n}\n")
+
+	_, err := runGenerate(append(args, "-rebind-on-mismatch=ignore")...)
+	assert.EqualError(t, err, "invalid value ignore for -rebind-on-mismatch, expected either panic or error")
+}
+
+func TestExtraImports(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	// nothing uses them, so they are blank imports
+	src := mustGenerate(t, append(args, "-extra-imports=fmt;sc,strconv")...)
+	assert.Contains(t, src, "\t_ \"fmt\"\n")
+	assert.Contains(t, src, "\t_ \"strconv\"\n")
+	// context is already imported by the method signatures,
+	// so using it under the same name is fine
+	src = mustGenerate(t, append(args, "-extra-imports=context")...)
+	assert.Equal(t, 1, strings.Count(src, `"context"`))
+	_, err := runGenerate(append(args, "-extra-imports=stdctx,context")...)
+	assert.EqualError(t, err, "extra import context is already imported under a different name")
+}
+
+func TestSplitCommand(t *testing.T) {
+	type testcase struct {
+		args            []string
+		expectedCommand string
+		expectedArgs    []string
+	}
+	testcases := []testcase{
+		{
+			args:            nil,
+			expectedCommand: "generate",
+			expectedArgs:    nil,
+		},
+		{
+			args:            []string{"-basetype=driver.Conn", "-prefix=real"},
+			expectedCommand: "generate",
+			expectedArgs:    []string{"-basetype=driver.Conn", "-prefix=real"},
+		},
+		{
+			args:            []string{"list", "-basetype=driver.Conn"},
+			expectedCommand: "list",
+			expectedArgs:    []string{"-basetype=driver.Conn"},
+		},
+	}
+	for _, tc := range testcases {
+		command, args := splitCommand(tc.args)
+		assert.Equal(t, tc.expectedCommand, command, "%v", tc.args)
+		assert.Equal(t, tc.expectedArgs, args, "%v", tc.args)
+	}
+}
+
+func TestListCommand(t *testing.T) {
+	out := &strings.Builder{}
+	err := listCommand(out, []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	})
+	require.NoError(t, err)
+	expected := `Close() error
+Ping(ctx context.Context) error
+Reset()
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestCompareWithOutFile(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "out.go")
+	err := compareWithOutFile(outFile, []byte("package foo\n"))
+	assert.Error(t, err)
+	require.NoError(t, ioutil.WriteFile(outFile, []byte("package foo\n"), 0644))
+	assert.NoError(t, compareWithOutFile(outFile, []byte("package foo\n")))
+	assert.EqualError(t, compareWithOutFile(outFile, []byte("package bar\n")), fmt.Sprintf("outfile %s is out of date", outFile))
+}
+
+func TestDiffCommand(t *testing.T) {
+	dir := tempModule(t, "testdata/basic")
+	outFile := filepath.Join(dir, "base_wrappers.go")
+	args := []string{
+		"-infile=" + filepath.Join(dir, "basic.go"),
+		"-outfile=" + outFile,
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	require.NoError(t, generateCommand(args))
+	src, err := ioutil.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(src), "// Code generated by \"wrappergen -infile="), "%s", src)
+	assert.NoError(t, diffCommand(args))
+
+	require.NoError(t, ioutil.WriteFile(outFile, append(src, "\n// edited\n"...), 0644))
+	assert.EqualError(t, diffCommand(args), fmt.Sprintf("outfile %s is out of date", outFile))
+}
+
+func TestImportAliasInMethodSignatures(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-imports=ctx,context",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "\tctx \"context\"\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping(ctx ctx.Context) error {")
+	assert.NotContains(t, src, "context.Context")
+}
+
+func TestStrict(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	// duplicate was ignored
+	assert.NotContains(t, src, "tBase2")
+	_, err := runGenerate(append(args, "-strict")...)
+	assert.EqualError(t, err, "1 warning(s) reported in strict mode, first one: duplicate extension type Pinger, ignoring it")
+
+	_, err = runGenerate(
+		"-infile=testdata/dedup/dedup.go",
+		"-basetype=b.Resetter",
+		"-exttypes=a.Resetter",
+		"-prefix=real",
+		"-newfuncname=newResetter",
+		"-strict",
+	)
+	assert.EqualError(t, err, "1 warning(s) reported in strict mode, first one: extension type a.Resetter adds no methods to the base type b.Resetter")
+}
+
+func TestMissingType(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=NotGeneratedYet",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	_, err := runGenerate(args...)
+	var mtErr *missingTypeError
+	if assert.True(t, errors.As(err, &mtErr)) {
+		assert.Equal(t, "NotGeneratedYet", mtErr.typeName)
+		assert.Equal(t, "github.com/krnowak/wrappergen/generator/testdata/basic", mtErr.pkgPath)
+	}
+
+	outFile := filepath.Join(t.TempDir(), "out.go")
+	args = append(args, "-outfile="+outFile)
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	assert.True(t, errors.As(generateAndWrite(pi, args), &mtErr))
+
+	pi, err = parseArgs(commandGenerate, append(args, "-allow-missing"), nil)
+	require.NoError(t, err)
+	assert.NoError(t, generateAndWrite(pi, args))
+	assert.NoFileExists(t, outFile)
+
+	pi, err = parseArgs(commandGenerate, append(args, "-allow-missing", "-strict"), nil)
+	require.NoError(t, err)
+	assert.Error(t, generateAndWrite(pi, args))
+}
+
+func TestExportCombinationInterfaces(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/export/export.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;a.Resetter;b.Resetter",
+		"-export-combination-interfaces",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "\tiBase0 interface {\n")
+	assert.Contains(t, src, "\t// BaseWithPinger2 is Base that also implements Pinger.\n\tBaseWithPinger2 interface {\n")
+	assert.Contains(t, src, "\tBaseWithResetter interface {\n")
+	assert.Contains(t, src, "\tBaseWithResetter2 interface {\n")
+	assert.Contains(t, src, "\tBaseWithPingerResetterResetter interface {\n")
+	assert.Contains(t, src, "\ttBase1 struct {\n\t\tr BaseWithPinger2\n")
+	assert.Contains(t, src, "\tcase BaseWithPingerResetterResetter:\n\t\treturn &tBase7{\n")
+
+	dir := tempModule(t, "testdata/basic")
+	outFile := filepath.Join(dir, "base_wrappers.go")
+	generateTwice(t,
+		"-infile="+filepath.Join(dir, "basic.go"),
+		"-outfile="+outFile,
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-export-combination-interfaces",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	regenerated, err := ioutil.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(regenerated), "\tBaseWithPinger interface {\n")
+	assert.NotContains(t, string(regenerated), "BaseWithPinger2")
+}
+
+func TestHeaderFile(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-header-file=header.txt",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	require.True(t, strings.HasPrefix(src, "// Copyright 2020 The Wrappergen Authors\n//\n// Licensed under the Apache License, Version 2.0.\n\n// Code generated by \"wrappergen "), "%s", src)
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	require.NoError(t, err)
+	assert.Nil(t, file.Doc, "header should not be attached to the package clause")
+
+	_, err = runGenerate(
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-header-file=nonexistent.txt",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Error(t, err)
+}
+
+func TestAliasedBasePackage(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/aliasbase/aliasbase.go",
+		"-basetype=d.Conn",
+		"-exttypes=d.Execer",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	)
+	assert.Contains(t, src, "\td \"database/sql/driver\"\n")
+	assert.Contains(t, src, "Exec(query string, args []d.Value) (d.Result, error)")
+	assert.Contains(t, src, "Begin() (d.Tx, error)")
+	assert.NotContains(t, src, "driver.")
+}
+
+func TestGenFuncAdapter(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Pinger",
+		"-exttypes=Resetter",
+		"-gen-func-adapter",
+		"-prefix=real",
+		"-newfuncname=newPinger",
+	)
+	assert.Contains(t, src, "// PingerFunc is an adapter to allow the use of ordinary functions as\n// Pinger.\ntype PingerFunc func(ctx context.Context) error\n")
+	assert.Contains(t, src, "var _ Pinger = PingerFunc(nil)\n")
+	assert.Contains(t, src, "func (oPingerFunc PingerFunc) Ping(ctx context.Context) error {\n\treturn oPingerFunc(ctx)\n}\n")
+
+	_, err := runGenerate(
+		"-infile=testdata/alias/alias.go",
+		"-basetype=Base",
+		"-gen-func-adapter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Error(t, err)
+
+	// the adapter from the previous run does not count
+	dir := tempModule(t, "testdata/basic")
+	args := []string{
+		"-infile=" + filepath.Join(dir, "basic.go"),
+		"-outfile=" + filepath.Join(dir, "pinger_wrappers.go"),
+		"-basetype=Pinger",
+		"-exttypes=Resetter",
+		"-gen-func-adapter",
+		"-prefix=real",
+		"-newfuncname=newPinger",
+	}
+	generateTwice(t, args...)
+	generateTwice(t, append(args, "-strategy=sparse")...)
+}
+
+func TestDeterministicOutput(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-gen-capabilities",
+		"-gen-rebind",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	first := mustGenerate(t, args...)
+	for i := 0; i < 5; i++ {
+		require.Equal(t, first, mustGenerate(t, args...))
+	}
+}
+
+func TestTypeParameters(t *testing.T) {
+	_, err := runGenerate(
+		"-infile=testdata/generic/generic.go",
+		"-basetype=IntStore",
+		"-exttypes=Store",
+		"-prefix=real",
+		"-newfuncname=newStore",
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "method Get")
+	assert.Contains(t, err.Error(), "type parameter T is not supported")
+
+	src := mustGenerate(t,
+		"-infile=testdata/generic/generic.go",
+		"-basetype=IntStore",
+		"-prefix=real",
+		"-newfuncname=newStore",
+	)
+	assert.Contains(t, src, "Get(id string) (int, error) {\n")
+}
+
+func TestGenericBaseType(t *testing.T) {
+	args := []string{
+		"-infile=testdata/genericbase/genericbase.go",
+		"-basetype=Repo",
+		"-exttypes=Lister;Flusher",
+		"-extrafields=count,int",
+		"-prefix=real",
+		"-newfuncname=newRepo",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\tiRepoKV3[K comparable, V Number] interface {\n\t\tRepo[K, V]\n\t\tLister[K, V]\n\t\tFlusher\n\t}\n")
+	assert.Contains(t, src, "\ttRepoKV3[K comparable, V Number] struct {\n\t\tr     iRepoKV3[K, V]\n\t\tcount int\n\t}\n")
+	assert.Contains(t, src, "func _[K comparable, V Number]() {\n\tvar (\n\t\t_ Repo[K, V]   = &tRepoKV0[K, V]{}\n")
+	assert.Contains(t, src, "func (oRepoKV1 *tRepoKV1[K, V]) Get(ctx context.Context, key K) (V, error) {\n\treturn realGet[K, V](oRepoKV1.r, oRepoKV1.count, ctx, key)\n}\n")
+	assert.Contains(t, src, "func (oRepoKV3 *tRepoKV3[K, V]) List() map[K]V {\n\treturn realList[K, V](oRepoKV3.r, oRepoKV3.count)\n}\n")
+	// the extension type is not generic
+	assert.Contains(t, src, "\treturn realFlush(oRepoKV2.r, oRepoKV2.count)\n")
+	assert.Contains(t, src, "func newRepo[K comparable, V Number](realRepo Repo[K, V], count int) Repo[K, V] {\n")
+	assert.Contains(t, src, "\tcase iRepoKV3[K, V]:\n\t\treturn &tRepoKV3[K, V]{\n")
+	assert.Contains(t, src, "\treturn &tRepoKV0[K, V]{\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realGet[K comparable, V Number](r Repo[K, V], count int, ctx context.Context, key K) (V, error)\n")
+	assert.Contains(t, missing.String(), "func realFlush(r Flusher, count int) error\n")
+
+	src = mustGenerate(t, "-infile=testdata/genericbase/genericbase.go", "-basetype=Single", "-prefix=real", "-newfuncname=newSingle", "-accumulate-errors")
+	assert.Contains(t, src, "func (oSingleT0 *tSingleT0[T]) Err() error {\n")
+
+	_, err = runGenerate(append(args[:1:1], "-basetype=Single", "-exttypes=Lister", "-prefix=real", "-newfuncname=newSingle")...)
+	assert.EqualError(t, err, "generic ext type Lister has 2 type parameter(s) and the generic base type Single has 1, can't instantiate it with the type parameters of the base type")
+	_, err = runGenerate(append(args, "-gen-clone")...)
+	assert.EqualError(t, err, "-gen-clone can't be used with the generic wrappers of base type Repo given without type arguments")
+}
+
+func TestForwardTemplate(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/forward/forward.go",
+		"-basetype=Reader",
+		`-forward-template={{if eq .Method "Read" "ReadBytes"}}ReadBytes{{else}}CloseNow{{end}}`,
+		"-prefix=real",
+		"-newfuncname=newReader",
+	)
+	assert.Contains(t, src, "func (oReader0 *tReader0) Read(p []byte) (int, error) {\n\treturn oReader0.r.ReadBytes(p)\n}\n")
+	assert.Contains(t, src, "func (oReader0 *tReader0) Close() error {\n\treturn oReader0.r.CloseNow()\n}\n")
+	assert.NotContains(t, src, "realRead(")
+
+	type testcase struct {
+		name     string
+		template string
+	}
+	testcases := []testcase{
+		{
+			name:     "missing target",
+			template: "{{.Method}}Bytes",
+		},
+		{
+			name:     "incompatible signature",
+			template: "ReadBytes",
+		},
+		{
+			name:     "invalid name",
+			template: "{{.Method}}.X",
+		},
+		{
+			name:     "unknown field",
+			template: "{{.Name}}",
+		},
+	}
+	for _, tc := range testcases {
+		_, err := runGenerate(
+			"-infile=testdata/forward/forward.go",
+			"-basetype=Reader",
+			"-forward-template="+tc.template,
+			"-prefix=real",
+			"-newfuncname=newReader",
+		)
+		assert.Error(t, err, "%s", tc.name)
+	}
+
+	// the wrapper of Conn alone has no CloseNow method
+	_, err := runGenerate(
+		"-infile=testdata/forward/forward.go",
+		"-basetype=Conn",
+		"-exttypes=NowCloser",
+		`-forward-template={{if eq .Method "Close"}}CloseNow{{else}}{{.Method}}{{end}}`,
+		"-prefix=real",
+		"-newfuncname=newConn",
+	)
+	assert.EqualError(t, err, "forward template maps method Close of Conn to CloseNow, which is not a method of Conn")
+}
+
+func TestExtrasOptIn(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-extrafields=count,int;name,string",
+		"-extras-opt-in=Close;Ping=name",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "\treturn realClose(oBase0.r, oBase0.count, oBase0.name)\n")
+	assert.Contains(t, src, "\treturn realPing(oBase1.r, oBase1.name, ctx)\n")
+	assert.Contains(t, src, "\trealReset(oBase2.r)\n")
+
+	type testcase struct {
+		name        string
+		extrasOptIn string
+		extraFields string
+	}
+	testcases := []testcase{
+		{
+			name:        "unknown method",
+			extrasOptIn: "Flush",
+			extraFields: "count,int",
+		},
+		{
+			name:        "unknown field",
+			extrasOptIn: "Close=rate",
+			extraFields: "count,int",
+		},
+		{
+			name:        "duplicate method",
+			extrasOptIn: "Close;Close=count",
+			extraFields: "count,int",
+		},
+		{
+			name:        "no extra fields",
+			extrasOptIn: "Close",
+		},
+	}
+	for _, tc := range testcases {
+		args := []string{
+			"-infile=testdata/basic/basic.go",
+			"-basetype=Base",
+			"-exttypes=Pinger;Resetter",
+			"-extras-opt-in=" + tc.extrasOptIn,
+			"-prefix=real",
+			"-newfuncname=newBase",
+		}
+		if tc.extraFields != "" {
+			args = append(args, "-extrafields="+tc.extraFields)
+		}
+		_, err := runGenerate(args...)
+		assert.Error(t, err, "%s", tc.name)
+	}
+}
+
+func TestGenDriverConformance(t *testing.T) {
+	dir := tempModule(t, "testdata/driverconn")
+	args := []string{
+		"-infile=" + filepath.Join(dir, "driverconn.go"),
+		"-outfile=" + filepath.Join(dir, "driverconn_wrappers.go"),
+		"-basetype=driver.Conn",
+		"-exttypes=driver.Pinger;driver.SessionResetter;driver.Validator",
+		"-gen-driver-conformance",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	require.NoError(t, generateAndWrite(pi, args))
+	src, err := ioutil.ReadFile(filepath.Join(dir, "driverconn_wrappers_conformance_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func TestNewConnDriverConformance(t *testing.T) {\n")
+	assert.Contains(t, string(src), "\t\tfunc(c *conformanceNewConnConn) driver.Conn { return struct{ idriverConn7 }{c} },\n")
+	// the generated test needs to pass
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "%s", out)
+}
+
+func TestGenDriverConformanceNotConn(t *testing.T) {
+	_, err := runGenerate(
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-gen-driver-conformance",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.EqualError(t, err, "-gen-driver-conformance needs database/sql/driver.Conn as the base type, got Base")
+}
+
+func TestGenCombinationTest(t *testing.T) {
+	dir := tempModule(t, "testdata/driverconn")
+	args := []string{
+		"-infile=" + filepath.Join(dir, "driverconn.go"),
+		"-outfile=" + filepath.Join(dir, "driverconn_wrappers.go"),
+		"-basetype=driver.Conn",
+		"-exttypes=driver.Pinger;driver.SessionResetter;driver.Validator",
+		"-gen-combination-test",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	require.NoError(t, generateAndWrite(pi, args))
+	src, err := ioutil.ReadFile(filepath.Join(dir, "driverconn_wrappers_combination_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func TestNewConnCombinations(t *testing.T) {\n")
+	assert.Contains(t, string(src), "\t\t\t\t_, ok := v.(driver.Pinger)\n")
+	assert.Contains(t, string(src), "\t\tstruct{ idriverConn7 }{},\n")
+	// the generated test needs to pass
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "%s", out)
+}
+
+func TestGenCombinationTestSparse(t *testing.T) {
+	_, err := runGenerate(
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-gen-combination-test",
+		"-strategy=sparse",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.EqualError(t, err, "-gen-combination-test can't be used with -strategy=sparse")
+}
+
+func TestSparseStrategy(t *testing.T) {
+	extTypes := make([]string, 0, 12)
+	for i := 1; i <= 12; i++ {
+		extTypes = append(extTypes, fmt.Sprintf("Ext%d", i))
+	}
+	start := time.Now()
+	src := mustGenerate(t,
+		"-infile=testdata/sparse/sparse.go",
+		"-basetype=Base",
+		"-exttypes="+strings.Join(extTypes, ";"),
+		"-strategy=sparse",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, 1, strings.Count(src, " struct {"))
+	assert.Contains(t, src, "\tif oBase.caps&capBaseExt12 == 0 {\n\t\tpanic(\"wrapped value does not implement Ext12\")\n\t}\n\treturn realMethod12(oBase.r.(Ext12), x)\n")
+
+	// the generated code compiles together with the package
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for name, content := range map[string]interface{}{
+		"testdata/sparse/sparse.go": nil,
+		"base_wrappers.go":          src,
+	} {
+		file, err := parser.ParseFile(fset, name, content, 0)
+		require.NoError(t, err)
+		files = append(files, file)
+	}
+	_, err := (&types.Config{}).Check("sparse", fset, files, nil)
+	assert.NoError(t, err)
+
+	_, err = runGenerate(
+		"-infile=testdata/sparse/sparse.go",
+		"-basetype=Base",
+		"-exttypes=Ext1",
+		"-strategy=sparse",
+		"-gen-rebind",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Error(t, err)
+}
+
+func TestNoAsserts(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	assert.Contains(t, mustGenerate(t, args...), "\t_ Pinger = &tBase1{}\n")
+	src := mustGenerate(t, append(args, "-no-asserts")...)
+	assert.NotContains(t, src, "var (")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping(ctx context.Context) error {\n")
+	src = mustGenerate(t, append(args, "-no-asserts", "-strategy=sparse")...)
+	assert.NotContains(t, src, "var (")
+}
+
+func TestResultHook(t *testing.T) {
+	args := []string{
+		"-infile=testdata/forward/forward.go",
+		"-basetype=Reader",
+		"-prefix=real",
+		"-newfuncname=newReader",
+	}
+	src := mustGenerate(t, append(args, "-result-hook=Read;Close")...)
+	assert.Contains(t, src, "\treturn realReadResult(realRead(oReader0.r, p))\n")
+	assert.Contains(t, src, "\treturn realCloseResult(realClose(oReader0.r))\n")
+	assert.Contains(t, src, "\treturn realReadBytes(oReader0.r, p)\n")
+
+	src = mustGenerate(t, append(args, "-result-hook=Read", `-forward-template={{if eq .Method "Read" "ReadBytes"}}ReadBytes{{else}}CloseNow{{end}}`)...)
+	assert.Contains(t, src, "\treturn realReadResult(oReader0.r.ReadBytes(p))\n")
+
+	_, err := runGenerate(append(args, "-result-hook=Write")...)
+	assert.Error(t, err)
+	_, err = runGenerate(
+		"-infile=testdata/lock/lock.go",
+		"-basetype=Base",
+		"-result-hook=Close",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Error(t, err)
+}
+
+func TestEmbeddingCycle(t *testing.T) {
+	// Go does not allow such cycles, so the types are constructed
+	// by hand
+	pkg := types.NewPackage("example.com/cycle", "cycle")
+	namedA := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "A", nil), nil, nil)
+	namedB := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "B", nil), nil, nil)
+	ifaceA := types.NewInterfaceType(nil, []types.Type{namedB})
+	ifaceB := types.NewInterfaceType(nil, []types.Type{namedA})
+	namedA.SetUnderlying(ifaceA)
+	namedB.SetUnderlying(ifaceB)
+
+	ta := &typeAnalysis{
+		imports:  make(map[string]string),
+		typeInfo: make(map[string]map[string]interfaceInfo),
+	}
+	info := pkgPathAndName{
+		pkgPath:  pkg.Path(),
+		typeName: "A",
+	}
+	err := ta.analyzeInterface(info, ifaceA)
+	assert.EqualError(t, err, `interface "example.com/cycle".A embeds itself ("example.com/cycle".A -> "example.com/cycle".B -> "example.com/cycle".A)`)
+}
+
+func TestSplitFiles(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "base_wrappers.go")
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-outfile=" + outFile,
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-extra-imports=fmt",
+		"-split-files",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	files, err := generateFiles(pi, args)
+	require.NoError(t, err)
+	base := strings.TrimSuffix(outFile, ".go")
+	require.Len(t, files, 3)
+	typesSrc := string(files[base+"_types.go"])
+	implsSrc := string(files[base+"_impls.go"])
+	newSrc := string(files[base+"_new.go"])
+	assert.Contains(t, typesSrc, "\tiBase1 interface {\n")
+	assert.Contains(t, typesSrc, "\"fmt\"")
+	assert.NotContains(t, typesSrc, "\"context\"")
+	assert.Contains(t, implsSrc, "\"context\"")
+	assert.Contains(t, implsSrc, "func (oBase1 *tBase1) Ping(ctx context.Context) error {\n")
+	assert.NotContains(t, implsSrc, "\"fmt\"")
+	assert.Contains(t, newSrc, "func newBase(realBase Base) Base {\n")
+	assert.NotContains(t, newSrc, "\"context\"")
+	for name, src := range files {
+		assert.True(t, strings.HasPrefix(string(src), "// Code generated by "), "%s", name)
+	}
+
+	require.NoError(t, generateAndWrite(pi, args))
+	for name := range files {
+		assert.FileExists(t, name)
+	}
+	assert.NoFileExists(t, outFile)
+
+	// all three files from the previous run are generated ones
+	dir := tempModule(t, "testdata/basic")
+	generateTwice(t,
+		"-infile="+filepath.Join(dir, "basic.go"),
+		"-outfile="+filepath.Join(dir, "pinger_wrappers.go"),
+		"-basetype=Pinger",
+		"-exttypes=Resetter",
+		"-split-files",
+		"-gen-func-adapter",
+		"-export-combination-interfaces",
+		"-prefix=real",
+		"-newfuncname=newPinger",
+	)
+}
+
+func TestCopyDoc(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.NotContains(t, src, "// Ping checks")
+	src = mustGenerate(t, append(args, "-copy-doc")...)
+	assert.Contains(t, src, "// Ping checks if the connection is alive.\n//\n// It returns an error if it is not.\nfunc (oBase1 *tBase1) Ping(ctx context.Context) error {\n")
+}
+
+func TestAmbiguousPackageName(t *testing.T) {
+	args := []string{
+		"-infile=testdata/ambiguous/ambiguous.go",
+		"-basetype=util.Closer",
+		"-prefix=real",
+		"-newfuncname=newCloser",
+	}
+	_, err := runGenerate(args...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "package name util is ambiguous, it may refer to any of github.com/krnowak/wrappergen/generator/testdata/ambiguous/x/util, github.com/krnowak/wrappergen/generator/testdata/ambiguous/y/util, use -imports to pick one")
+
+	src := mustGenerate(t, append(args, "-imports=util,github.com/krnowak/wrappergen/generator/testdata/ambiguous/y/util")...)
+	assert.Contains(t, src, "func (outilCloser0 *tutilCloser0) Close() {\n")
+}
+
+func TestGenSwitcher(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-extrafields=flag,string",
+		"-gen-switcher",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "type sBase struct {\n\tflag string\n}\n")
+	assert.Contains(t, src, "func (osBase *sBase) Close() error {\n\treturn realSelect(osBase.flag).Close()\n}\n")
+	assert.NotContains(t, src, "func (osBase *sBase) Ping(")
+	assert.Contains(t, src, "func newBaseSwitcher(flag string) Base {\n\treturn &sBase{\n\t\tflag: flag,\n\t}\n}\n")
+}
+
+func TestFieldOrder(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-extrafields=zeta,int;alpha,string",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\ttBase0 struct {\n\t\tr     iBase0\n\t\tzeta  int\n\t\talpha string\n\t}\n")
+
+	src = mustGenerate(t, append(args, "-field-order=alphabetical", "-group-fields")...)
+	assert.Contains(t, src, "\ttBase0 struct {\n\t\tr iBase0\n\n\t\talpha string\n\t\tzeta  int\n\t}\n")
+	assert.Contains(t, src, "\treturn &tBase0{\n\t\tr:     realBase,\n\t\talpha: alpha,\n\t\tzeta:  zeta,\n\t}\n")
+
+	src = mustGenerate(t, append(args, "-field-order=extras-first")...)
+	assert.Contains(t, src, "\ttBase0 struct {\n\t\tzeta  int\n\t\talpha string\n\t\tr     iBase0\n\t}\n")
+	assert.Contains(t, src, "\treturn &tBase0{\n\t\tzeta:  zeta,\n\t\talpha: alpha,\n\t\tr:     realBase,\n\t}\n")
+
+	_, err := runGenerate(append(args, "-field-order=random")...)
+	assert.Error(t, err)
+}
+
+func TestGeneratedInFile(t *testing.T) {
+	args := []string{
+		"-infile=testdata/generated/generated.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping() error {")
+	_, err := runGenerate(append(args, "-strict")...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 warning(s) reported in strict mode, first one: infile ")
+	assert.Contains(t, err.Error(), "testdata/generated/generated.go is a generated file, generating code from it is likely a mistake")
+
+	generated, err := isGeneratedFile("testdata/basic/basic.go")
+	require.NoError(t, err)
+	assert.False(t, generated)
+}
+
+func TestRegion(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "wrappers.go")
+	regionArgs := func(region, baseType, extTypes string) []string {
+		return []string{
+			"-infile=testdata/basic/basic.go",
+			"-outfile=" + outFile,
+			"-basetype=" + baseType,
+			"-exttypes=" + extTypes,
+			"-prefix=real" + baseType,
+			"-newfuncname=new" + baseType,
+			"-region=" + region,
+		}
+	}
+	write := func(args []string) string {
+		pi, err := parseArgs(commandGenerate, args, nil)
+		require.NoError(t, err)
+		require.NoError(t, generateAndWrite(pi, args))
+		src, err := ioutil.ReadFile(outFile)
+		require.NoError(t, err)
+		return string(src)
+	}
+
+	src := write(regionArgs("base", "Base", "Pinger"))
+	assert.True(t, strings.HasPrefix(src, "package basic\n"))
+	assert.NotContains(t, src, "// Code generated by ")
+	assert.Contains(t, src, "// wrappergen:begin base\n// Code in this region is generated by \"wrappergen ")
+	assert.Contains(t, src, "\"context\"")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping(ctx context.Context) error {\n")
+	assert.True(t, strings.HasSuffix(src, "\n// wrappergen:end base\n"))
+
+	handWritten := "\n// handWritten is not generated.\nfunc handWritten() {}\n"
+	require.NoError(t, ioutil.WriteFile(outFile, []byte(src+handWritten), 0644))
+	src = write(regionArgs("resetter", "Resetter", ""))
+	assert.Contains(t, src, handWritten+"\n// wrappergen:begin resetter\n")
+	assert.Contains(t, src, "func newResetter(realResetterResetter Resetter) Resetter {\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping(ctx context.Context) error {\n")
+
+	// the base region shrinks and no longer needs the context
+	// import, the resetter region and the hand-written code
+	// stay intact
+	src = write(regionArgs("base", "Base", ""))
+	assert.NotContains(t, src, "Ping")
+	assert.NotContains(t, src, "\"context\"")
+	assert.Contains(t, src, handWritten)
+	assert.Contains(t, src, "func newResetter(realResetterResetter Resetter) Resetter {\n")
+	assert.Less(t, strings.Index(src, "// wrappergen:end base"), strings.Index(src, "func handWritten() {}"))
+
+	// regenerating is idempotent
+	args := regionArgs("base", "Base", "Pinger")
+	src = write(args)
+	assert.Contains(t, src, "\"context\"")
+	assert.Equal(t, src, write(args))
+	pi, err := parseArgs(commandDiff, args, nil)
+	require.NoError(t, err)
+	files, err := generateFiles(pi, args)
+	require.NoError(t, err)
+	assert.Equal(t, src, string(files[outFile]))
+
+	require.NoError(t, ioutil.WriteFile(outFile, []byte("package basic\n\n// wrappergen:begin base\n"), 0644))
+	_, err = generateFiles(pi, args)
+	assert.EqualError(t, err, fmt.Sprintf("region base in outfile %s has a beginning, but no end", outFile))
+
+	_, err = parseArgs(commandGenerate, append(args, "-split-files"), nil)
+	assert.EqualError(t, err, "-region can't be used with -split-files")
+}
+
+func TestArrayTypes(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/array/array.go",
+		"-basetype=Base",
+		"-exttypes=Keyer",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Hash() [32]byte {\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Keys() [4]somepkg.Key {\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) SetKeys(keys [2][4]somepkg.Key) {\n")
+	assert.Contains(t, src, `"github.com/krnowak/wrappergen/generator/testdata/array/somepkg"`)
+}
+
+func TestChannelsOfImportedTypes(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/channel/channel.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Events() <-chan somepkg.Event {\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Forward(out chan<- somepkg.Event) {\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Pipe(in chan somepkg.Event) chan []somepkg.Event {\n")
+	assert.Contains(t, src, `"github.com/krnowak/wrappergen/generator/testdata/channel/somepkg"`)
+
+	// the errors about the element types are not swallowed
+	for _, extType := range []string{"Stream", "Sink"} {
+		_, err := runGenerate(
+			"-infile=testdata/channel/channel.go",
+			"-basetype=Base",
+			"-exttypes="+extType,
+			"-prefix=real",
+			"-newfuncname=newBase",
+		)
+		require.Error(t, err, "%s", extType)
+		assert.Contains(t, err.Error(), "type parameter T is not supported", "%s", extType)
+	}
+}
+
+func TestWrapAny(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-extrafields=count,int",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-wrap-any=wrapAny",
+	}
+	src := mustGenerate(t, append(args, "-wrap-any-bases=Resetter=newResetter;Pinger=newPinger")...)
+	assert.Contains(t, src, "func wrapAny(v interface{}, count int) interface{} {\n"+
+		"\tswitch r := v.(type) {\n"+
+		"\tcase Base:\n\t\treturn newBase(r, count)\n"+
+		"\tcase Resetter:\n\t\treturn newResetter(r, count)\n"+
+		"\tcase Pinger:\n\t\treturn newPinger(r, count)\n"+
+		"\t}\n\treturn v\n}\n")
+
+	src = mustGenerate(t, append(args, "-use-any")...)
+	assert.Contains(t, src, "func wrapAny(v any, count int) any {\n")
+
+	_, err := runGenerate(append(args, "-wrap-any-bases=Base=newOtherBase")...)
+	assert.EqualError(t, err, "base type Base is passed to wrapAny more than once")
+	_, err = runGenerate(append(args, "-wrap-any-bases=Resetter")...)
+	assert.EqualError(t, err, `malformed entry "Resetter" in -wrap-any-bases, expected a base type and a new func name separated with an equal sign, like driver.Tx=newTx`)
+	_, err = runGenerate(append(args, "-wrap-any-bases=context.CancelFunc=newCancel")...)
+	assert.EqualError(t, err, "base type context.CancelFunc from -wrap-any-bases is not an interface")
+	_, err = runGenerate(append(args[:len(args)-1], "-wrap-any-bases=Resetter=newResetter")...)
+	assert.EqualError(t, err, "-wrap-any-bases requires -wrap-any")
+}
+
+func TestExtraFieldOfBaseType(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basepkg/basepkg.go",
+		"-basetype=drv.Conn",
+		"-exttypes=drv.Pinger",
+		"-extrafields=parent,drv.Conn;resetter,drv.Resetter",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}
+	src := mustGenerate(t, args...)
+	assert.Equal(t, 1, strings.Count(src, `"github.com/krnowak/wrappergen/generator/testdata/basepkg/drv"`))
+	assert.Contains(t, src, "\t\tparent   drv.Conn\n")
+	assert.Contains(t, src, "func newConn(realConn drv.Conn, parent drv.Conn, resetter drv.Resetter) drv.Conn {\n")
+	assert.Contains(t, src, "return realClose(odrvConn1.r, odrvConn1.parent, odrvConn1.resetter)")
+
+	// the types of the extra fields are only resolved for
+	// imports, their methods are not wrapped
+	out := &strings.Builder{}
+	require.NoError(t, listCommand(out, args))
+	assert.Equal(t, "Close() error\nPing() error\n", out.String())
+}
+
+func TestExportMethods(t *testing.T) {
+	args := []string{
+		"-infile=testdata/unexported/unexported.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=NewBase",
+		"-export-methods",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n")
+
+	src = mustGenerate(t, append(args[:len(args)-1:len(args)-1], "-exttypes=Flusher")...)
+	assert.Contains(t, src, "func (oBase1 *tBase1) flushLocked() error {\n")
+	_, err := runGenerate(append(args, "-exttypes=Flusher")...)
+	assert.EqualError(t, err, "failed to analyze resolved type for imports, types and methods Flusher: method flushLocked is unexported, but -export-methods requires all the methods to be exported")
+}
+
+func TestEmbeddingChainAcrossPackages(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/chain/chain.go",
+		"-basetype=Service",
+		"-prefix=real",
+		"-newfuncname=newService",
+	)
+	for _, pkg := range []string{"a", "b", "c"} {
+		assert.Contains(t, src, fmt.Sprintf("\t\"github.com/krnowak/wrappergen/generator/testdata/chain/%s\"\n", pkg))
+	}
+	assert.Contains(t, src, "func (oService0 *tService0) Find(id a.ID) (b.Key, error) {\n")
+	assert.Contains(t, src, "func (oService0 *tService0) Put(key b.Key) error {\n")
+	assert.Contains(t, src, "func (oService0 *tService0) Close(opts c.Options) error {\n")
+}
+
+func TestPackageName(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basepkg/basepkg.go",
+		"-basetype=drv.Conn",
+		"-exttypes=drv.Pinger",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\npackage basepkg\n")
+	src = mustGenerate(t, append(args, "-package-name=wrapped")...)
+	assert.Contains(t, src, "\npackage wrapped\n")
+	assert.Contains(t, src, "_ drv.Pinger = &tdrvConn1{}")
+	// overriding with the same name changes nothing
+	src = mustGenerate(t, "-infile=testdata/basic/basic.go", "-basetype=Base", "-prefix=real", "-newfuncname=newBase", "-package-name=basic")
+	assert.Contains(t, src, "\npackage basic\n")
+
+	_, err := runGenerate(append(args, "-exttypes=Resetter", "-package-name=wrapped")...)
+	assert.EqualError(t, err, "can't generate code for package wrapped from -package-name, it would refer to types Resetter from package basepkg of the infile without qualifying them")
+	_, err = runGenerate(append(args, "-package-name=wrapped-pkg")...)
+	assert.EqualError(t, err, "package name wrapped-pkg from -package-name is not a valid identifier")
+}
+
+func TestOutDir(t *testing.T) {
+	outDir := filepath.Join(t.TempDir(), "wrapped")
+	args := []string{
+		"-infile=testdata/basepkg/basepkg.go",
+		"-basetype=drv.Conn",
+		"-exttypes=drv.Pinger",
+		"-prefix=real",
+		"-newfuncname=newConn",
+		"-out-dir=" + outDir,
+	}
+	_, err := parseArgs(commandGenerate, args, nil)
+	assert.EqualError(t, err, fmt.Sprintf("-out-dir %s is not the directory of the infile, so the generated code would belong to another package, use -package-name to name it", outDir))
+
+	args = append(args, "-package-name=wrapped", "-split-files")
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(outDir, "drvconn_wrappers.go"), pi.outFile)
+	require.NoError(t, generateAndWrite(pi, args))
+	for _, name := range []string{"drvconn_wrappers_types.go", "drvconn_wrappers_impls.go", "drvconn_wrappers_new.go"} {
+		src, err := ioutil.ReadFile(filepath.Join(outDir, name))
+		require.NoError(t, err)
+		assert.Contains(t, string(src), "\npackage wrapped\n")
+	}
+
+	// the directory of the infile needs no -package-name
+	pi, err = parseArgs(commandGenerate, []string{"-infile=testdata/basic/basic.go", "-basetype=Base", "-prefix=real", "-newfuncname=newBase", "-outfile=/elsewhere/base.go", "-out-dir=."}, nil)
+	require.NoError(t, err)
+	inFile, err := filepath.Abs("testdata/basic/basic.go")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(filepath.Dir(inFile), "base.go"), pi.outFile)
+}
+
+func TestValueReceiver(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-extrafields=count,int",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-gen-capabilities",
+		"-receiver=value",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\t_ Pinger = tBase1{}\n")
+	assert.Contains(t, src, "func (oBase1 tBase1) Ping(ctx context.Context) error {\n")
+	assert.Contains(t, src, "\tcase iBase1:\n\t\treturn tBase1{\n")
+	assert.Contains(t, src, "\treturn tBase0{\n")
+	assert.Contains(t, src, "\tcase tBase1:\n")
+	assert.NotContains(t, src, "&t")
+	assert.NotContains(t, src, "*t")
+
+	src = mustGenerate(t, append(args, "-strategy=sparse", "-gen-capabilities=false")...)
+	assert.Contains(t, src, "\t_ Pinger = tBase{}\n")
+	assert.Contains(t, src, "func (oBase tBase) Ping(ctx context.Context) error {\n")
+	assert.Contains(t, src, "\treturn tBase{\n")
+
+	_, err := runGenerate(append(args, "-extrafields=data,[]byte", "-strict")...)
+	assert.EqualError(t, err, "1 warning(s) reported in strict mode, first one: extra field data of type []byte is not comparable, so comparing the value wrappers will panic")
+	_, err = runGenerate(append(args, "-gen-rebind")...)
+	assert.EqualError(t, err, "-gen-rebind can't be used with -receiver=value, the Rebind method needs to modify the wrapper")
+	_, err = runGenerate(append(args, "-extrafields=mu,sync.Mutex", "-lock-field=mu")...)
+	assert.EqualError(t, err, "lock field mu of type sync.Mutex can't be used with -receiver=value, each method would lock its own copy of it, use a pointer type")
+	_, err = runGenerate(append(args, "-receiver=ref")...)
+	assert.EqualError(t, err, "invalid value ref for -receiver, expected either pointer or value")
+}
+
+func TestCompact(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-export-combination-interfaces",
+		"-gen-func-adapter",
+		"-compact",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "type (\n\tiBase0 interface {\n\t\tBase\n\t}\n\ttBase0 struct {\n")
+	assert.Contains(t, src, "\t}\n\tBaseWithPinger interface {\n")
+	assert.Contains(t, src, "}\nfunc (oBase1 *tBase1) Close() error {\n")
+	assert.NotContains(t, src, "// BaseWithPinger is")
+	assert.NotContains(t, src, "// BaseFunc is")
+	assert.Less(t, len(src), len(mustGenerate(t, args[:len(args)-1]...)))
+
+	_, err := runGenerate(append(args, "-copy-doc")...)
+	assert.EqualError(t, err, "-compact can't be used with -copy-doc")
+}
+
+func TestPathQualifiedTypes(t *testing.T) {
+	type testcase struct {
+		input    string
+		expected aType
+	}
+	testcases := []testcase{
+		{"database/sql/driver.Conn", aType{pkgName: "driver", name: "Conn", pkgPath: "database/sql/driver"}},
+		{"example.com/foo/v2.Bar", aType{pkgName: "foo", name: "Bar", pkgPath: "example.com/foo/v2"}},
+		{"gopkg.in/yaml.v2.Node", aType{pkgName: "yaml", name: "Node", pkgPath: "gopkg.in/yaml.v2"}},
+		{"example.com/go-foo.Bar", aType{pkgName: "go_foo", name: "Bar", pkgPath: "example.com/go-foo"}},
+	}
+	for _, tc := range testcases {
+		at, err := strToAType(tc.input)
+		require.NoError(t, err, tc.input)
+		assert.Equal(t, tc.expected, at, tc.input)
+	}
+	_, err := strToAType("database/sql/driver.")
+	assert.EqualError(t, err, "empty type name in database/sql/driver.")
+	_, err = strToAType("foo.Bar.Baz")
+	assert.EqualError(t, err, "malformed type foo.Bar.Baz, expected a string like int, driver.Driver or database/sql/driver.Driver")
+
+	// the drv package is not imported by the infile, so it is
+	// loaded directly
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=github.com/krnowak/wrappergen/generator/testdata/basepkg/drv.Conn",
+		"-exttypes=github.com/krnowak/wrappergen/generator/testdata/basepkg/drv.Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	)
+	assert.Contains(t, src, "\t\"github.com/krnowak/wrappergen/generator/testdata/basepkg/drv\"\n")
+	assert.Contains(t, src, "func newConn(realConn drv.Conn) drv.Conn {\n")
+	assert.Contains(t, src, "\t_ drv.Pinger = &tdrvConn1{}\n")
+	assert.Contains(t, src, "\t_ Resetter   = &tdrvConn2{}\n")
+}
+
+func TestGenFieldAccessors(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-extrafields=extra,interface{};count,int",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-gen-field-accessors",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Extra() interface{} {\n\treturn oBase0.extra\n}\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Count() int {\n\treturn oBase1.count\n}\n")
+
+	src = mustGenerate(t, append(args, "-strategy=sparse")...)
+	assert.Contains(t, src, "func (oBase *tBase) Count() int {\n\treturn oBase.count\n}\n")
+
+	_, err := runGenerate(append(args, "-extrafields=ping,int")...)
+	assert.EqualError(t, err, "can't generate the accessor of extra field ping, the wrapped interfaces already have a method named Ping")
+	_, err = runGenerate(append(args, "-extrafields=count,int;Count,int")...)
+	assert.EqualError(t, err, "extra fields would have more than one accessor named Count")
+	_, err = runGenerate(append(args, "-extrafields=")...)
+	assert.EqualError(t, err, "-gen-field-accessors requires extra fields, use -extrafields to add them")
+}
+
+func TestInitFunc(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-init-func=registerWrappers",
+	}
+	src := mustGenerate(t, args...)
+	assert.True(t, strings.HasSuffix(src, "\nfunc init() {\n\tregisterWrappers()\n}\n"))
+
+	outFile := filepath.Join(t.TempDir(), "wrappers.go")
+	write := func(region, baseType string) string {
+		args := []string{
+			"-infile=testdata/basic/basic.go",
+			"-outfile=" + outFile,
+			"-basetype=" + baseType,
+			"-prefix=real" + baseType,
+			"-newfuncname=new" + baseType,
+			"-init-func=registerWrappers",
+			"-region=" + region,
+		}
+		pi, err := parseArgs(commandGenerate, args, nil)
+		require.NoError(t, err)
+		require.NoError(t, generateAndWrite(pi, args))
+		src, err := ioutil.ReadFile(outFile)
+		require.NoError(t, err)
+		return string(src)
+	}
+	write("base", "Base")
+	src = write("resetter", "Resetter")
+	assert.Equal(t, 1, strings.Count(src, "func init() {"))
+	// regenerating the region with the init function keeps it
+	src = write("base", "Base")
+	assert.Equal(t, 1, strings.Count(src, "func init() {"))
+
+	_, err := runGenerate(append(args, "-init-func=init")...)
+	assert.EqualError(t, err, "function name init from -init-func is invalid")
+}
+
+func TestFetch(t *testing.T) {
+	// make sure nothing gets downloaded
+	t.Setenv("GOPROXY", "off")
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=example.com/nonexistent/foo.Bar",
+		"-prefix=real",
+		"-newfuncname=newBar",
+	}
+	_, err := runGenerate(args...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to find package example.com/nonexistent/foo for type foo.Bar: failed to load example.com/nonexistent/foo package: ")
+	assert.Contains(t, err.Error(), "use -fetch to add it)")
+
+	// go get works on a copy of go.mod
+	dir := tempModule(t, "testdata/basic")
+	goMod, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	args[0] = "-infile=" + filepath.Join(dir, "basic.go")
+	_, err = runGenerate(append(args, "-fetch")...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to fetch package example.com/nonexistent/foo with go get on a copy of go.mod: ")
+	current, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, string(goMod), string(current))
+	assert.NoFileExists(t, filepath.Join(dir, "go.sum"))
+}
+
+// TestGoldenFiles regenerates the wrappers of the example in the test
+// directory and compares them with the committed ones.
+func TestGoldenFiles(t *testing.T) {
+	inFile := filepath.Join("..", "test", "test.go")
+	src, err := ioutil.ReadFile(inFile)
+	require.NoError(t, err)
+	directives := 0
+	for _, line := range strings.Split(string(src), "\n") {
+		const prefix = "//go:generate wrappergen "
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		directives++
+		args := strings.Fields(strings.TrimPrefix(line, prefix))
+		pi, err := parseArgs(commandGenerate, args, []string{"GOFILE=" + inFile})
+		require.NoError(t, err, line)
+		files, err := generateFiles(pi, args)
+		require.NoError(t, err, line)
+		for _, outFile := range sortedFileNames(files) {
+			if *updateGolden {
+				require.NoError(t, ioutil.WriteFile(outFile, files[outFile], 0644))
+				continue
+			}
+			golden, err := ioutil.ReadFile(outFile)
+			require.NoError(t, err, "missing golden file, run the tests with -update-golden")
+			assert.Equal(t, string(golden), string(files[outFile]), "%s is out of date, run the tests with -update-golden", outFile)
+		}
+	}
+	assert.Equal(t, 6, directives)
+}
+
+func TestPointersToInterfaces(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/pointer/pointer.go",
+		"-basetype=Base",
+		"-exttypes=Swapper",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	)
+	assert.Contains(t, src, "\t\"io\"\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Fill(r *io.Reader) error {\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Swap(old *Swapper) *Swapper {\n")
+}
+
+func TestExitCodes(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	_, err := runGenerate(append(args, "-strategy=bogus")...)
+	assert.Equal(t, exitCodeInput, exitCode(err))
+	_, err = runGenerate(append(args, "-exttypes=Missing")...)
+	assert.Equal(t, exitCodeResolve, exitCode(err))
+	_, err = runGenerate(append(args, "-exttypes=Pinger", "-result-hook=Ping", "-forward-template={{.Method}}X")...)
+	assert.Equal(t, exitCodeAnalysis, exitCode(err))
+	_, err = runGenerate(append(args, "-exttypes=a.Resetter")...)
+	assert.Equal(t, exitCodeResolve, exitCode(err))
+	_, err = runGenerate(
+		"-infile=testdata/dedup/dedup.go",
+		"-basetype=b.Resetter",
+		"-exttypes=a.Resetter",
+		"-prefix=real",
+		"-newfuncname=newResetter",
+		"-strict",
+	)
+	assert.Equal(t, exitCodeFailure, exitCode(err))
+
+	outArgs := append(args, "-outfile="+filepath.Join(t.TempDir(), "missing", "out.go"))
+	pi, err := parseArgs(commandGenerate, outArgs, nil)
+	require.NoError(t, err)
+	err = generateAndWrite(pi, outArgs)
+	assert.Equal(t, exitCodeWrite, exitCode(err))
+
+	// the exit codes do not change the messages
+	wrapped := withExitCode(exitCodeInput, errors.New("foo"))
+	assert.EqualError(t, wrapped, "foo")
+	assert.Equal(t, exitCodeInput, exitCode(fmt.Errorf("bar: %w", wrapped)))
+	assert.Equal(t, exitCodeInput, exitCode(withExitCode(exitCodeWrite, wrapped)))
+}
+
+func TestNilCheck(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.NotContains(t, src, "== nil")
+	src = mustGenerate(t, append(args, "-nil-check")...)
+	assert.Contains(t, src, "func newBase(realBase Base) Base {\n\tif realBase == nil {\n\t\treturn nil\n\t}\n\tswitch r := realBase.(type) {\n")
+	src = mustGenerate(t, append(args, "-nil-check", "-strategy=sparse")...)
+	assert.Contains(t, src, "func newBase(realBase Base) Base {\n\tif realBase == nil {\n\t\treturn nil\n\t}\n\tcaps := (uint64)(0)\n")
+}
+
+func TestCombinationTags(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "base_wrappers.go")
+	args := []string{
+		"-infile=testdata/sparse/sparse.go",
+		"-outfile=" + outFile,
+		"-basetype=Base",
+		"-exttypes=Ext1;Ext2",
+		"-combination-tags=Ext2,Ext1=withboth",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	files, err := generateFiles(pi, args)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	src := string(files[outFile])
+	taggedSrc := string(files[strings.TrimSuffix(outFile, ".go")+"_withboth.go"])
+	assert.Contains(t, src, "\tiBase3 interface {\n")
+	assert.NotContains(t, src, "tBase3 struct")
+	assert.Contains(t, src, "\twrapBase3 func(r iBase3) Base\n")
+	assert.Contains(t, src, "\tif r, ok := realBase.(iBase3); ok && wrapBase3 != nil {\n\t\treturn wrapBase3(r)\n\t}\n\tif r, ok := realBase.(iBase2); ok {\n")
+	assert.Contains(t, taggedSrc, "//go:build withboth\n\npackage sparse\n")
+	assert.Contains(t, taggedSrc, "\ttBase3 struct {\n")
+	assert.Contains(t, taggedSrc, "\t_ Ext2 = &tBase3{}\n")
+	assert.Contains(t, taggedSrc, "func (oBase3 *tBase3) Method2(x int) (int, error) {\n")
+	assert.Contains(t, taggedSrc, "\twrapBase3 = func(r iBase3) Base {\n")
+	assert.NotContains(t, taggedSrc, "tBase2")
+
+	// the generated code compiles both with and without the tag
+	for _, names := range [][]string{{outFile}, {outFile, strings.TrimSuffix(outFile, ".go") + "_withboth.go"}} {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, "testdata/sparse/sparse.go", nil, 0)
+		require.NoError(t, err)
+		astFiles := []*ast.File{file}
+		for _, name := range names {
+			file, err := parser.ParseFile(fset, name, files[name], 0)
+			require.NoError(t, err)
+			astFiles = append(astFiles, file)
+		}
+		_, err = (&types.Config{}).Check("sparse", fset, astFiles, nil)
+		assert.NoError(t, err)
+	}
+
+	_, err = runGenerate(append(args, "-combination-tags=Ext3=withext3")...)
+	assert.EqualError(t, err, "combination Ext3 from -combination-tags is not a combination of the extension types")
+	_, err = runGenerate(append(args, "-combination-tags=Ext1=a;Ext1=b")...)
+	assert.EqualError(t, err, "combination Ext1 is given more than once in -combination-tags")
+	_, err = runGenerate(append(args, "-combination-tags=Ext1=!a")...)
+	assert.EqualError(t, err, "build tag \"!a\" from -combination-tags entry Ext1=!a is invalid, it should consist of ASCII letters, digits, underlines and dots")
+	_, err = runGenerate(append(args, "-gen-rebind")...)
+	assert.EqualError(t, err, "-gen-rebind can't be used with -combination-tags")
+}
+
+func TestMethodPragma(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-copy-doc",
+	}
+	src := mustGenerate(t, args...)
+	assert.NotContains(t, src, "//go:")
+	src = mustGenerate(t, append(args, "-method-pragma=//go:noinline;//go:norace")...)
+	assert.Contains(t, src, "//go:noinline\n//go:norace\nfunc (oBase0 *tBase0) Close() error {\n")
+	assert.Contains(t, src, "// It returns an error if it is not.\n//\n//go:noinline\n//go:norace\nfunc (oBase1 *tBase1) Ping(ctx context.Context) error {\n")
+	src = mustGenerate(t, append(args, "-method-pragma=//go:noinline", "-strategy=sparse")...)
+	assert.Contains(t, src, "//go:noinline\nfunc (oBase *tBase) Ping(ctx context.Context) error {\n")
+
+	_, err := runGenerate(append(args, "-method-pragma=//go:linkname")...)
+	assert.EqualError(t, err, "unsupported directive \"//go:linkname\" in -method-pragma, expected one of //go:noinline, //go:nosplit, //go:norace, //go:nocheckptr")
+}
+
+func TestInterfacePattern(t *testing.T) {
+	dir := t.TempDir()
+	args := []string{
+		"-infile=testdata/pattern/pattern.go",
+		"-outfile-template=" + filepath.Join(dir, "{{.BaseTypeLower}}_wrappers.go"),
+		"-interface-pattern=^Conn",
+		"-prefix=real{{.BaseTypeName}}",
+		"-newfuncname=new{{.BaseTypeName}}",
+	}
+	pis, err := parseFamilies(commandGenerate, args, nil)
+	require.NoError(t, err)
+	require.Len(t, pis, 2)
+	assert.Equal(t, "ConnReader", pis[0].baseType.String())
+	assert.Equal(t, "realConnReader", pis[0].prefix)
+	assert.Equal(t, "newConnReader", pis[0].newFuncName)
+	assert.Equal(t, filepath.Join(dir, "connreader_wrappers.go"), pis[0].outFile)
+	assert.Equal(t, "ConnWriter", pis[1].baseType.String())
+	files, err := generateFiles(pis[1], args)
+	require.NoError(t, err)
+	src := string(files[filepath.Join(dir, "connwriter_wrappers.go")])
+	assert.Contains(t, src, "\treturn realConnWriterWrite(oConnWriter0.r, p)\n")
+	assert.Contains(t, src, "func newConnWriter(realConnWriterConnWriter ConnWriter) ConnWriter {\n")
+
+	var list strings.Builder
+	require.NoError(t, listCommand(&list, args))
+	assert.Equal(t, "ConnReader:\n\tRead(p []byte) (int, error)\nConnWriter:\n\tWrite(p []byte) (int, error)\n", list.String())
+
+	_, err = parseFamilies(commandGenerate, append(args, "-prefix=real"), nil)
+	assert.EqualError(t, err, "both ConnReader and ConnWriter would get prefix real, use a template in -prefix, like real{{.BaseTypeName}}")
+	_, err = parseFamilies(commandGenerate, append(args, "-interface-pattern=^Foo"), nil)
+	assert.EqualError(t, err, "no interfaces in the package of infile testdata/pattern/pattern.go match the interface pattern ^Foo")
+	_, err = parseFamilies(commandGenerate, append(args, "-basetype=Closer"), nil)
+	assert.EqualError(t, err, "both -basetype and -interface-pattern specified, use only one of them")
+	_, err = parseArgs(commandGenerate, args, nil)
+	assert.EqualError(t, err, "expected one base type, got 2")
+}
+
+func TestMissingImpls(t *testing.T) {
+	args := []string{
+		"-infile=testdata/missing/missing.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-extrafields=count,int",
+		"-result-hook=Close;Ping",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-missing-impls",
+	}
+	var list strings.Builder
+	require.NoError(t, listCommand(&list, args))
+	assert.Equal(t, "func realCloseResult(error) error\n"+
+		"func realPing(r Pinger, count int, ctx context.Context) error\n"+
+		"func realPingResult(error) error\n"+
+		"func realReset(r Resetter, count int)\n", list.String())
+
+	list.Reset()
+	require.NoError(t, listCommand(&list, append(args, "-extras-opt-in=Ping", "-result-hook=Close")))
+	assert.Equal(t, "func realCloseResult(error) error\n"+
+		"func realPing(r Pinger, count int, ctx context.Context) error\n"+
+		"func realReset(r Resetter)\n", list.String())
+
+	list.Reset()
+	require.NoError(t, listCommand(&list, append(args, "-gen-switcher", "-init-func=registerBase")))
+	assert.Equal(t, "func realSelect(count int) Base\n"+
+		"func registerBase()\n"+
+		"func realCloseResult(error) error\n"+
+		"func realPing(r Pinger, count int, ctx context.Context) error\n"+
+		"func realPingResult(error) error\n"+
+		"func realReset(r Resetter, count int)\n", list.String())
+
+	_, err := runGenerate(append(args, "-forward-template={{.Method}}")...)
+	assert.EqualError(t, err, "-missing-impls can't be used with -forward-template, there are no prefix functions to implement")
+}
+
+func TestPassthroughMethods(t *testing.T) {
+	args := []string{
+		"-infile=testdata/passthrough/passthrough.go",
+		"-basetype=Base",
+		"-extrafields=count,int",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-passthrough-methods=Error;String",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Error() string {\n\treturn oBase0.r.Error()\n}\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) String() string {\n\treturn oBase0.r.String()\n}\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n\treturn realClose(oBase0.r, oBase0.count)\n}\n")
+
+	var list strings.Builder
+	require.NoError(t, listCommand(&list, append(args, "-missing-impls")))
+	assert.Equal(t, "func realClose(r Base, count int) error\n", list.String())
+
+	_, err := runGenerate(append(args, "-passthrough-methods=Error;Reset")...)
+	assert.EqualError(t, err, "method Reset from -passthrough-methods is not a method of the wrapped interfaces")
+}
+
+func TestTypedErrors(t *testing.T) {
+	// the errors are what the users of Generate get
+	_, err := Generate([]string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Nope",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}, nil)
+	var trErr *TypeResolutionError
+	require.True(t, errors.As(err, &trErr))
+	assert.Equal(t, "ext type", trErr.Role)
+	assert.Equal(t, "Nope", trErr.Type)
+	var mtErr *missingTypeError
+	assert.True(t, errors.As(err, &mtErr))
+
+	_, err = Generate([]string{
+		"-infile=testdata/pattern/pattern.go",
+		"-basetype=ConnConfig",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}, nil)
+	var naiErr *NotAnInterfaceError
+	require.True(t, errors.As(err, &naiErr))
+	assert.Equal(t, "ConnConfig", naiErr.Type)
+	assert.EqualError(t, err, "ConnConfig is not an interface")
+
+	_, err = Generate([]string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=database/sql/driver.Conn",
+		"-imports=drv,database/sql/driver",
+		"-prefix=real",
+		"-newfuncname=newConn",
+	}, nil)
+	var icErr *ImportConsistencyError
+	require.True(t, errors.As(err, &icErr))
+	assert.Equal(t, ImportConsistencyError{Path: "database/sql/driver", NameA: "driver", NameB: "drv"}, *icErr)
+}
+
+func TestIndent(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "var (\n\t_ Base   = &tBase0{}\n\t_ Base   = &tBase1{}\n\t_ Pinger = &tBase1{}\n)\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n\treturn realClose(oBase0.r)\n}\n")
+
+	src = mustGenerate(t, append(args, "-indent=spaces", "-tab-width=2")...)
+	assert.Contains(t, src, "var (\n  _ Base   = &tBase0{}\n  _ Base   = &tBase1{}\n  _ Pinger = &tBase1{}\n)\n")
+	assert.Contains(t, src, "  iBase1 interface {\n    Base\n    Pinger\n  }\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n  return realClose(oBase0.r)\n}\n")
+	assert.NotContains(t, src, "\t")
+
+	src = mustGenerate(t, append(args, "-receiver=value")...)
+	assert.Contains(t, src, "\t_ Pinger = tBase1{}\n")
+
+	// the tabs in the comments are not indentation
+	src = mustGenerate(t, "-infile=testdata/indent/indent.go", "-basetype=Base", "-exttypes=Querier", "-prefix=real", "-newfuncname=newBase", "-copy-doc", "-indent=spaces", "-tab-width=2")
+	assert.Contains(t, src, "/*\nQuery runs the query, like:\n\n\tSELECT id FROM users\n*/\nfunc (oBase1 *tBase1) Query(query string) error {\n  return realQuery(oBase1.r, query)\n}\n")
+
+	_, err := runGenerate(append(args, "-indent=mixed")...)
+	assert.EqualError(t, err, "invalid value mixed for -indent, expected either tabs or spaces")
+	_, err = runGenerate(append(args, "-tab-width=0")...)
+	assert.EqualError(t, err, "invalid value 0 for -tab-width, expected a positive number")
+}
+
+func TestGoVersionError(t *testing.T) {
+	pkg := &packages.Package{
+		PkgPath: "example.com/newer",
+		Errors: []packages.Error{
+			{Msg: "undefined: iter"},
+		},
+		Module: &packages.Module{
+			Path:      "example.com/newer",
+			GoVersion: "1.999",
+		},
+	}
+	err := goVersionError(pkg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the module example.com/newer requires go1.999, but wrappergen was built with "+runtime.Version())
+
+	pkg.Module.GoVersion = "1.18"
+	assert.NoError(t, goVersionError(pkg))
+	pkg.Module.GoVersion = "1.999"
+	pkg.Errors = nil
+	assert.NoError(t, goVersionError(pkg))
+}
+
+func TestAccumulateErrors(t *testing.T) {
+	args := []string{
+		"-infile=testdata/missing/missing.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-accumulate-errors",
+	}
+	src := mustGenerate(t, append(args, "-result-hook=Ping")...)
+	assert.Contains(t, src, "\ttBase3 struct {\n\t\tr   iBase3\n\t\terr error\n\t}\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Close() error {\n\tres0 := realClose(oBase1.r)\n\tif res0 != nil && oBase1.err == nil {\n\t\toBase1.err = res0\n\t}\n\treturn res0\n}\n")
+	assert.Contains(t, src, "\tres0 := realPingResult(realPing(oBase1.r, ctx))\n")
+	assert.Contains(t, src, "func (oBase2 *tBase2) Reset() {\n\trealReset(oBase2.r)\n}\nfunc (oBase2 *tBase2) Err() error {\n\treturn oBase2.err\n}\n")
+	assert.NotContains(t, src, "err: ")
+
+	src = mustGenerate(t, append(args, "-strategy=sparse")...)
+	assert.Contains(t, src, "func (oBase *tBase) Err() error {\n\treturn oBase.err\n}\n")
+
+	_, err := runGenerate(append(args, "-receiver=value")...)
+	assert.EqualError(t, err, "-accumulate-errors can't be used with -receiver=value, the methods need to modify the wrapper")
+	_, err = runGenerate(append(args, "-extrafields=err,error")...)
+	assert.EqualError(t, err, "extra field err collides with the accumulated error field of -accumulate-errors")
+}
+
+func TestPackageWithErrors(t *testing.T) {
+	args := []string{
+		"-infile=testdata/broken/broken.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-strict",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n")
+
+	_, err := runGenerate(append(args, "-basetype=Closer")...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve base type Closer: ")
+	assert.Contains(t, err.Error(), "(the package of the infile has errors, which may be the cause: ")
+	assert.Contains(t, err.Error(), "other.go:3:21: cannot use \"not an int\"")
+	var mtErr *missingTypeError
+	assert.True(t, errors.As(err, &mtErr))
+}
+
+func TestRuntimeToggles(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-runtime-toggles",
+	}
+	_, err := runGenerate(args...)
+	assert.EqualError(t, err, "-runtime-toggles requires -strategy=sparse")
+	args = append(args, "-strategy=sparse")
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\tcaps    uint64\n\tenabled uint64\n}\n")
+	assert.Contains(t, src, "\tif oBase.enabled&capBasePinger == 0 {\n\t\tpanic(\"Pinger is disabled in the wrapper\")\n\t}\n\treturn realPing(oBase.r.(Pinger), ctx)\n")
+	assert.Contains(t, src, "\t\tcaps:    caps,\n\t\tenabled: caps,\n")
+	assert.NotContains(t, mustGenerate(t, args[:len(args)-2]...), "enabled")
+
+	_, err = runGenerate(append(args, "-receiver=value")...)
+	assert.EqualError(t, err, "-runtime-toggles can't be used with -receiver=value, the enabled bitmask could not be modified")
+	_, err = runGenerate(append(args, "-extrafields=enabled,bool")...)
+	assert.EqualError(t, err, "extra field enabled collides with the bitmask field of -runtime-toggles")
+}
+
+func TestCapabilityConsts(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-gen-capability-consts",
+	}
+	consts := "type Capability uint64\n\nconst (\n\tCapPinger Capability = 1 << iota\n\tCapResetter\n)\n"
+	src := mustGenerate(t, append(args, "-gen-capabilities")...)
+	assert.Contains(t, src, consts)
+	assert.Contains(t, src, "func baseCapabilities(w Base) Capability {\n")
+	assert.Contains(t, src, "\tcase *tBase3:\n\t\treturn CapPinger | CapResetter\n\t}\n\treturn 0\n}\n")
+	src = mustGenerate(t, append(args, "-strategy=sparse")...)
+	assert.Contains(t, src, consts)
+	assert.NotContains(t, src, "capBasePinger")
+	assert.Contains(t, src, "\tcaps Capability\n")
+	assert.Contains(t, src, "\tcaps := (Capability)(0)\n\tif _, ok := realBase.(Pinger); ok {\n\t\tcaps |= CapPinger\n\t}\n")
+
+	_, err := runGenerate(
+		"-infile=testdata/dedup/dedup.go",
+		"-basetype=Base",
+		"-exttypes=a.Resetter;b.Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-gen-capability-consts",
+	)
+	assert.EqualError(t, err, "both a.Resetter and b.Resetter would get capability constant CapResetter")
+	_, err = parseFamilies(commandGenerate, []string{
+		"-infile=testdata/pattern/pattern.go",
+		"-outfile-template={{.BaseTypeLower}}_wrappers.go",
+		"-interface-pattern=^Conn",
+		"-prefix=real{{.BaseTypeName}}",
+		"-newfuncname=new{{.BaseTypeName}}",
+		"-gen-capability-consts",
+	}, nil)
+	assert.EqualError(t, err, "-gen-capability-consts can't be used when several interfaces match the interface pattern, each of ConnReader, ConnWriter would declare the Capability type")
+}
+
+func TestGenericAlias(t *testing.T) {
+	// go.mod requires go1.23, so the type checker always
+	// creates the alias nodes (gotypesalias=1), but only go1.24
+	// allows the type parameters on the aliases
+	if v := runtime.Version(); version.IsValid(v) && version.Compare(v, "go1.24") < 0 {
+		t.Skipf("generic type aliases need go1.24, got %s", v)
+	}
+	args := []string{
+		"-infile=testdata/genericalias/genericalias.go",
+		"-basetype=Set[string]",
+		"-exttypes=Clearer",
+		"-prefix=real",
+		"-newfuncname=newSet",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\t_ Set[string] = &tSetString0{}\n")
+	assert.Contains(t, src, "func (oSetString1 *tSetString1) Has(item string) bool {\n\treturn realHas(oSetString1.r, item)\n}\n")
+	assert.Contains(t, src, "func newSet(realSet Set[string]) Set[string] {\n")
+
+	// the type arguments of the aliases in the method signatures
+	// are kept
+	src = mustGenerate(t, append(args, "-exttypes=Clearer;Lister")...)
+	assert.Contains(t, src, "func (oSetString2 *tSetString2) Strings() Set[string] {\n")
+	assert.Contains(t, src, "func (oSetString2 *tSetString2) Pairs() []Pair[string, int] {\n")
+
+	_, err := runGenerate(append(args, "-basetype=Set[string, int]")...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to instantiate Set[string, int]")
+	_, err = runGenerate(append(args, "-basetype=Set[[]byte]")...)
+	assert.EqualError(t, err, "failed to get base type from input parameter Set[[]byte]: unsupported type argument []byte, type arguments can't be instantiations themselves in Set[[]byte]")
+}
+
+func TestTypeArguments(t *testing.T) {
+	at, err := strToAType("pkg.Map[ string,other.Value ]")
+	require.NoError(t, err)
+	assert.Equal(t, "pkg.Map[string, other.Value]", at.String())
+	assert.Equal(t, "pkgMapStringOtherValue", at.StringNoDot())
+	_, err = strToAType("Map[string")
+	assert.EqualError(t, err, "malformed type arguments in Map[string, expected a string like Set[string]")
+	_, err = strToAType("Map[string,]")
+	assert.EqualError(t, err, "empty type argument in Map[string,]")
+	_, err = strToAType("Map[example.com/x.Value]")
+	assert.EqualError(t, err, "unsupported type argument example.com/x.Value, type arguments need to use the package name instead of the import path in Map[example.com/x.Value]")
+
+	src := mustGenerate(t,
+		"-infile=testdata/generic/generic.go",
+		"-basetype=Store[int]",
+		"-prefix=real",
+		"-newfuncname=newStore",
+	)
+	assert.Contains(t, src, "func (oStoreInt0 *tStoreInt0) Get(id string) (int, error) {\n")
+}
+
+func TestClone(t *testing.T) {
+	args := []string{
+		"-infile=testdata/lock/lock.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-gen-clone",
+	}
+	src := mustGenerate(t, append(args, "-extrafields=mu,*sync.Mutex")...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Clone() Base {\n\tclone := *oBase0\n\treturn &clone\n}\n")
+	src = mustGenerate(t, append(args, "-extrafields=n,int", "-receiver=value", "-strategy=sparse")...)
+	assert.Contains(t, src, "func (oBase tBase) Clone() Base {\n\treturn oBase\n}\n")
+
+	_, err := runGenerate(append(args, "-extrafields=mu,[1]sync.RWMutex")...)
+	assert.EqualError(t, err, "can't generate the Clone method, extra field mu of type [1]sync.RWMutex contains a lock (sync.RWMutex)")
+	_, err = runGenerate(append(args, "-extrafields=events,struct{ch chan int}")...)
+	assert.EqualError(t, err, "can't generate the Clone method, extra field events of type struct{ch chan int} contains a channel (chan int)")
+	_, err = runGenerate(append(args, "-extrafields=clone,int", "-gen-field-accessors")...)
+	assert.EqualError(t, err, "the accessor of extra field clone collides with the Clone method of -gen-clone")
+}
+
+func TestHooksInterface(t *testing.T) {
+	args := []string{
+		"-infile=testdata/hooks/hooks.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-extrafields=name,string",
+	}
+	src := mustGenerate(t, append(args, "-hooks-interface=myHooks")...)
+	assert.Contains(t, src, "\t\tr     iBase1\n\t\thooks myHooks\n\t\tname  string\n")
+	assert.Contains(t, src, "\treturn oBase1.hooks.Ping(oBase1.r, oBase1.name, ctx)\n")
+	assert.Contains(t, src, "func newBase(realBase Base, hooks myHooks, name string) Base {\n")
+	assert.NotContains(t, src, "realClose")
+
+	_, err := runGenerate(append(args, "-hooks-interface=partialHooks")...)
+	assert.EqualError(t, err, "hooks interface partialHooks has no method Ping, use -missing-impls to print the missing methods")
+	_, err = runGenerate(append(args, "-hooks-interface=badHooks")...)
+	assert.EqualError(t, err, "method Close of hooks interface badHooks takes 1 parameter(s) and returns 1 result(s), expected 2 and 1")
+	_, err = runGenerate(append(args, "-hooks-interface=Base", "-extrafields=hooks,int")...)
+	assert.EqualError(t, err, "extra field hooks collides with the hooks field of -hooks-interface")
+	_, err = runGenerate(append(args, "-hooks-interface=Missing")...)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve hooks interface Missing: ")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-hooks-interface=partialHooks", "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Equal(t, "Ping(r Pinger, name string, ctx context.Context) error\n", missing.String())
+}
+
+func TestWrappedConcrete(t *testing.T) {
+	args := []string{
+		"-infile=testdata/concrete/concrete.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-wrapped-concrete=*RealConn",
+	}
+	_, err := runGenerate(args...)
+	assert.EqualError(t, err, "-wrapped-concrete requires -strategy=sparse, the concrete type is stored in the single wrapper")
+	args = append(args, "-strategy=sparse")
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "type tBase struct {\n\tr *RealConn\n}\n")
+	assert.Contains(t, src, "func (oBase *tBase) Ping(ctx context.Context) error {\n\treturn realPing(oBase.r, ctx)\n}\n")
+	assert.Contains(t, src, "func newBase(realBase *RealConn) Base {\n\treturn &tBase{\n\t\tr: realBase,\n\t}\n}\n")
+	assert.NotContains(t, src, "caps")
+
+	_, err = runGenerate(append(args, "-wrapped-concrete=RealConn")...)
+	assert.EqualError(t, err, "concrete type RealConn does not implement Base (missing method Close), but *RealConn does")
+	_, err = runGenerate(append(args, "-exttypes=Pinger;Resetter")...)
+	assert.EqualError(t, err, "concrete type *RealConn does not implement Resetter (missing method Reset)")
+	_, err = runGenerate(append(args, "-wrapped-concrete=RealConn", "-nil-check")...)
+	assert.EqualError(t, err, "-nil-check can't be used with -wrapped-concrete=RealConn, a value of a non-pointer type can't be nil")
+	_, err = runGenerate(append(args, "-runtime-toggles")...)
+	assert.EqualError(t, err, "-runtime-toggles can't be used with -wrapped-concrete")
+}
+
+func TestSelfIdempotent(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-outfile=" + filepath.Join(t.TempDir(), "out.go"),
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-self-idempotent",
+	}
+	pi, err := parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	files, err := generateFiles(pi, args)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// renames the new func in every other generation
+	generation := 0
+	pi.astTransform = func(file *ast.File) error {
+		generation++
+		for _, decl := range file.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Name.Name == "newBase" && generation%2 == 0 {
+				fd.Name.Name = "newBaseAgain"
+			}
+		}
+		return nil
+	}
+	_, err = generateFiles(pi, args)
+	assert.EqualError(t, err, fmt.Sprintf("generation is not deterministic, two generations produced different contents of %s", pi.outFile))
+
+	// the warnings are reported once
+	args = []string{
+		"-infile=testdata/dedup/dedup.go",
+		"-outfile=" + filepath.Join(t.TempDir(), "out.go"),
+		"-basetype=b.Resetter",
+		"-exttypes=a.Resetter",
+		"-prefix=real",
+		"-newfuncname=newResetter",
+		"-self-idempotent",
+	}
+	pi, err = parseArgs(commandGenerate, args, nil)
+	require.NoError(t, err)
+	_, err = generateFiles(pi, args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"extension type a.Resetter adds no methods to the base type b.Resetter"}, pi.warnings.warnings)
+
+	assert.NoError(t, compareGenerations(map[string][]byte{"a.go": []byte("a")}, map[string][]byte{"a.go": []byte("a")}))
+	assert.EqualError(t, compareGenerations(map[string][]byte{"a.go": nil}, map[string][]byte{"b.go": nil}), "generation is not deterministic, only one of two generations produced a.go")
+}
+
+func TestGenericInstantiationsInMethods(t *testing.T) {
+	src := mustGenerate(t,
+		"-infile=testdata/boxed/boxed.go",
+		"-basetype=Getter",
+		"-prefix=real",
+		"-newfuncname=newGetter",
+	)
+	assert.Contains(t, src, "func (oGetter0 *tGetter0) Get() *box.Box[int] {\n")
+	assert.Contains(t, src, "func (oGetter0 *tGetter0) Pairs() []box.Pair[string, *box.Box[Item]] {\n")
+	// the package of the type argument is imported too
+	assert.Contains(t, src, "func (oGetter0 *tGetter0) Put(b box.Box[time.Duration]) error {\n")
+	assert.Contains(t, src, "\t\"time\"\n")
+	// a generic type from the standard library
+	assert.Contains(t, src, "func (oGetter0 *tGetter0) Updated() sql.Null[time.Time] {\n")
+	assert.Contains(t, src, "\t\"database/sql\"\n")
+}
+
+func TestDeprecatedAlias(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=NewBase",
+		"-extrafields=n,int",
+		"-deprecated-alias=OldBase",
+	}
+	_, err := runGenerate(args...)
+	assert.EqualError(t, err, "-deprecated-alias requires -export-combination-interfaces, there are no exported names to alias otherwise")
+	args = append(args, "-export-combination-interfaces")
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "type (\n\t// Deprecated: Use BaseWithPinger instead.\n\tOldBaseWithPinger = BaseWithPinger\n\n")
+	assert.Contains(t, src, "\tOldBaseWithPingerResetter = BaseWithPingerResetter\n)\n")
+	assert.Contains(t, src, "// Deprecated: Use NewBase instead.\nfunc NewOldBase(realBase Base, n int) Base {\n\treturn NewBase(realBase, n)\n}\n")
+
+	_, err = runGenerate(append(args, "-deprecated-alias=oldBase")...)
+	assert.EqualError(t, err, "deprecated alias oldBase is not an exported identifier")
+	_, err = runGenerate(append(args, "-newfuncname=NewWrapper")...)
+	assert.EqualError(t, err, "can't derive the name of the deprecated new func, the new func name NewWrapper does not contain the base type name Base")
+	_, err = runGenerate(append(args, "-newfuncname=Base", "-deprecated-alias=Pinger")...)
+	assert.EqualError(t, err, "can't generate deprecated Pinger for Base, the package of the infile already has it")
+
+	dir := tempModule(t, "testdata/basic")
+	generateTwice(t,
+		"-infile="+filepath.Join(dir, "basic.go"),
+		"-outfile="+filepath.Join(dir, "base_wrappers.go"),
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-deprecated-alias=Old",
+		"-export-combination-interfaces",
+	)
+}
+
+func TestGroupingExtensionTypes(t *testing.T) {
+	args := []string{
+		"-infile=testdata/grouping/grouping.go",
+		"-basetype=Base",
+		"-exttypes=PingResetter;ReadPinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-strict",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\t_ PingResetter = &tBase1{}\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping() error {\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Reset() {\n")
+	assert.NotContains(t, src, "func (oBase1 *tBase1) Read(")
+	// the methods of the grouped interfaces, including the ones
+	// from another package, are implemented only once
+	assert.Equal(t, 1, strings.Count(src, "func (oBase2 *tBase2) Ping() error {\n"))
+	assert.Contains(t, src, "func (oBase2 *tBase2) Read(p []byte) (int, error) {\n")
+	assert.Equal(t, 1, strings.Count(src, "func (oBase3 *tBase3) Reset() {\n"))
+	src = mustGenerate(t, append(args, "-strategy=sparse")...)
+	assert.Contains(t, src, "\tif oBase.caps&capBaseReadPinger == 0 {\n\t\tpanic(\"wrapped value does not implement ReadPinger\")\n\t}\n\treturn realRead(oBase.r.(ReadPinger), p)\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Equal(t, "func realClose(r Base) error\nfunc realPing(r PingResetter) error\nfunc realReset(r PingResetter)\nfunc realRead(r ReadPinger, p []byte) (int, error)\n", missing.String())
+}
+
+func TestMetrics(t *testing.T) {
+	args := []string{
+		"-infile=testdata/metrics/metrics.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-metrics",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n\trealCount(\"Close\")\n\treturn realClose(oBase0.r)\n}\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Equal(t, "func realCount(method string)\n", missing.String())
+
+	_, err = runGenerate(append(args, "-exttypes=Counter")...)
+	assert.EqualError(t, err, "can't generate the metrics calls, realCount would be both the prefix function of the Count method and the counting function")
+}
+
+func TestExtraMethods(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-extrafields=n,int",
+		"-imports=time,time",
+		"-extra-methods=Flush() error; Stats(verbose bool) (n, m int, err error);Wait(time.Duration)",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\t\"time\"\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Flush() error {\n\treturn realFlush(oBase0.r, oBase0.n)\n}\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Stats(verbose bool) (int, int, error) {\n\treturn realStats(oBase1.r, oBase1.n, verbose)\n}\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Wait(param0 time.Duration) {\n\trealWait(oBase1.r, oBase1.n, param0)\n}\n")
+	src = mustGenerate(t, append(args, "-strategy=sparse")...)
+	assert.Contains(t, src, "func (oBase *tBase) Flush() error {\n\treturn realFlush(oBase.r, oBase.n)\n}\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realFlush(r Base, n int) error\n")
+
+	_, err = runGenerate(append(args, "-extra-methods=Close() error")...)
+	assert.EqualError(t, err, "extra method Close is already a method of the wrapped interfaces")
+	_, err = runGenerate(append(args, "-extra-methods=Flush();Flush()")...)
+	assert.EqualError(t, err, "extra method Flush is specified more than once")
+	_, err = runGenerate(append(args, "-extra-methods=flush()")...)
+	assert.EqualError(t, err, "failed to get an extra method from input parameter flush(): method name flush is not an exported identifier")
+	_, err = runGenerate(append(args, "-extra-methods=Log(args ...string)")...)
+	assert.EqualError(t, err, "failed to get an extra method from input parameter Log(args ...string): variadic parameters of method Log are not supported")
+	_, err = runGenerate(append(args, "-extra-methods=Flush")...)
+	assert.EqualError(t, err, "failed to get an extra method from input parameter Flush: expected a method signature like Flush() error, got Flush")
+}
+
+func TestReceiverName(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-extrafields=n,int",
+		"-receiver-name=w",
+		"-extra-methods=Write(w string, p int) error",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (w *tBase0) Close() error {\n\treturn realClose(w.r, w.n)\n}\n")
+	assert.Contains(t, src, "func (w *tBase1) Write(param0 string, p int) error {\n\treturn realWrite(w.r, w.n, param0, p)\n}\n")
+	assert.NotContains(t, src, "oBase")
+	src = mustGenerate(t, append(args, "-strategy=sparse", "-gen-field-accessors")...)
+	assert.Contains(t, src, "func (w *tBase) N() int {\n\treturn w.n\n}\n")
+	assert.Contains(t, src, "\tif w.caps&capBasePinger == 0 {\n")
+
+	_, err := runGenerate(append(args, "-receiver-name=realClose")...)
+	assert.EqualError(t, err, "receiver name realClose from -receiver-name collides with the prefix function of Close")
+	_, err = runGenerate(append(args, "-receiver-name=_")...)
+	assert.EqualError(t, err, "receiver name _ from -receiver-name is not a valid identifier")
+	_, err = runGenerate(append(args, "-receiver-name=r", "-gen-rebind")...)
+	assert.EqualError(t, err, "receiver name r from -receiver-name collides with a variable of the Rebind method of -gen-rebind")
+}
+
+func TestDebugLog(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-debug-log",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping(ctx context.Context) (res0 error) {\n\trealLog(\"Ping\", ctx)\n\tdefer func() {\n\t\trealLog(\"Ping returned\", res0)\n\t}()\n\treturn realPing(oBase1.r, ctx)\n}\n")
+	assert.Contains(t, src, "func (oBase2 *tBase2) Reset() {\n\trealLog(\"Reset\")\n\tdefer realLog(\"Reset returned\")\n\trealReset(oBase2.r)\n}\n")
+	src = mustGenerate(t, append(args, "-accumulate-errors")...)
+	assert.Contains(t, src, "\tres0 = realClose(oBase0.r)\n\tif res0 != nil && oBase0.err == nil {\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls", "-use-any"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realLog(method string, args ...any)\n")
+
+	_, err = runGenerate(append(args, "-extra-methods=Log(msg string)")...)
+	assert.EqualError(t, err, "can't generate the debug log calls, realLog would be both the prefix function of the Log method and the logging function")
+}
+
+func TestStateMachine(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Resetter",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-state-machine=Ping=idle>busy;Reset,Close=busy,idle>idle",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\ttBase1 struct {\n\t\tr     iBase1\n\t\tstate string\n\t}\n")
+	assert.Contains(t, src, "func (oBase1 *tBase1) Ping(ctx context.Context) error {\n\tif oBase1.state != \"idle\" {\n\t\trealInvalidTransition(\"Ping\", oBase1.state)\n\t}\n\tdefer func() {\n\t\toBase1.state = \"busy\"\n\t}()\n\treturn realPing(oBase1.r, ctx)\n}\n")
+	assert.Contains(t, src, "\tif oBase2.state != \"busy\" && oBase2.state != \"idle\" {\n\t\trealInvalidTransition(\"Reset\", oBase2.state)\n")
+	assert.Contains(t, src, "\treturn &tBase0{\n\t\tr:     realBase,\n\t\tstate: \"idle\",\n\t}\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realInvalidTransition(method, state string)\n")
+
+	_, err = runGenerate(append(args[:5:5], "-state-machine=Begin=idle>inTx")...)
+	assert.EqualError(t, err, "method Begin from -state-machine is not a method of the wrappers")
+	_, err = runGenerate(append(args[:5:5], "-state-machine=Ping=idle")...)
+	assert.EqualError(t, err, `malformed transition "idle" in -state-machine entry Ping=idle, expected states separated from the next state with >, like idle>inTx`)
+	_, err = runGenerate(append(args, "-receiver=value")...)
+	assert.EqualError(t, err, "-state-machine can't be used with -receiver=value, the methods need to modify the wrapper")
+}
+
+func TestExtrasPosition(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger",
+		"-extrafields=extra,int;n,string",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\treturn realPing(oBase1.r, oBase1.extra, oBase1.n, ctx)\n")
+	src = mustGenerate(t, append(args, "-extras-position=after")...)
+	assert.Contains(t, src, "\treturn realPing(oBase1.r, ctx, oBase1.extra, oBase1.n)\n")
+	assert.Contains(t, src, "\treturn realClose(oBase1.r, oBase1.extra, oBase1.n)\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-extras-position=after", "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realPing(r Pinger, ctx context.Context, extra int, n string) error\n")
+
+	_, err = runGenerate(append(args, "-extras-position=middle")...)
+	assert.EqualError(t, err, "invalid value middle for -extras-position, expected either before or after")
+}
+
+func TestAnonymousInterfaces(t *testing.T) {
+	args := []string{
+		"-infile=testdata/anyiface/anyiface.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Store(key string, value any) error {\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Lookup(key string) interface{ Foo() } {\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Visit(v interface {\n\tio.Reader\n\tName() string\n\tSize() (int64, error)\n}) interface{} {\n")
+	assert.Contains(t, src, "\t\"io\"\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls", "-use-any"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realLookup(r Base, key string) interface{ Foo() }\n")
+	assert.Contains(t, missing.String(), "func realVisit(r Base, v interface{ io.Reader; Name() string; Size() (int64, error) }) any\n")
+}
+
+func TestAnonymousStructs(t *testing.T) {
+	args := []string{
+		"-infile=testdata/anonstruct/anonstruct.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Do(opts struct{ Timeout int }) {\n\trealDo(oBase0.r, opts)\n}\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Configure(cfg struct {\n\tName    string `json:\"name\"`\n\tTimeout time.Duration\n\ttime.Location\n}) struct{} {\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Stats() []struct {\n\tCount int `json:\"count\" yaml:\"count\"`\n} {\n")
+	assert.Contains(t, src, "\t\"time\"\n")
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realConfigure(r Base, cfg struct{ Name string `json:\"name\"`; Timeout time.Duration; time.Location }) struct{}\n")
+}
+
+func TestManyUnnamedParameters(t *testing.T) {
+	types := make([]string, 15)
+	for idx := range types {
+		types[idx] = "int"
+	}
+	src := mustGenerate(t,
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-receiver-name=param3",
+		"-extra-methods=Many("+strings.Join(types, ", ")+")",
+	)
+	var params, names []string
+	for idx := 0; idx <= 15; idx++ {
+		if idx == 3 {
+			continue
+		}
+		names = append(names, fmt.Sprintf("param%d", idx))
+		params = append(params, fmt.Sprintf("param%d int", idx))
+	}
+	assert.Contains(t, src, fmt.Sprintf("func (param3 *tBase0) Many(%s) {\n\trealMany(param3.r, %s)\n}\n", strings.Join(params, ", "), strings.Join(names, ", ")))
+}
+
+func TestUnnamedAndBlankParameters(t *testing.T) {
+	args := []string{
+		"-infile=testdata/blank/blank.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) Copy(param0 string, param1 string, param2 string) error {\n\treturn realCopy(oBase0.r, param0, param1, param2)\n}\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Write(param0 []byte, param1 int) error {\n\treturn realWrite(oBase0.r, param0, param1)\n}\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Seek(param0 int64, param2 int) (int64, error) {\n\treturn realSeek(oBase0.r, param0, param2)\n}\n")
+	assert.Equal(t, src, mustGenerate(t, args...))
+
+	pi, err := parseArgs(commandGenerate, append(args, "-missing-impls"), nil)
+	require.NoError(t, err)
+	var missing strings.Builder
+	require.NoError(t, listMissingImpls(&missing, pi, ""))
+	assert.Contains(t, missing.String(), "func realWrite(r Base, param0 []byte, param1 int) error\n")
+}
+
+func TestGenerateResult(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-exttypes=Pinger;Pinger",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-result-hook=Close",
+	}
+	result, err := Generate(args, nil)
+	require.NoError(t, err)
+	assert.Equal(t, mustGenerate(t, args...), string(result.Source))
+	assert.Equal(t, []RequiredFunc{
+		{Signature: "func realClose(r Base) error"},
+		{Signature: "func realCloseResult(error) error"},
+		{Signature: "func realPing(r Pinger, ctx context.Context) error"},
+	}, result.Funcs)
+	assert.Equal(t, []Import{{Path: "context"}}, result.Imports)
+	assert.Equal(t, []string{"duplicate extension type Pinger, ignoring it"}, result.Warnings)
+}
+
+func TestFieldCollisions(t *testing.T) {
+	args := []string{
+		"-infile=testdata/fieldmethod/fieldmethod.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "func (oBase0 *tBase0) extra() int {\n")
+	_, err := runGenerate(append(args, "-extrafields=extra,interface{}")...)
+	assert.EqualError(t, err, "method extra collides with extra field extra, rename the extra field in -extrafields")
+	_, err = runGenerate(append(args, "-exttypes=Reader")...)
+	assert.EqualError(t, err, "method r collides with the field r of the wrappers holding the wrapped value")
+	_, err = runGenerate(append(args, "-exttypes=Capper", "-strategy=sparse")...)
+	assert.EqualError(t, err, "method caps collides with the capabilities field of -strategy=sparse")
+}
+
+func TestUseErrorsJoin(t *testing.T) {
+	args := []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-accumulate-errors",
+		"-use-errors-join",
+		"-extra-methods=Report(errors []error) error",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "\t\"errors\"\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Close() error {\n\tres0 := realClose(oBase0.r)\n\tif res0 != nil {\n\t\toBase0.err = errors.Join(oBase0.err, res0)\n\t}\n\treturn res0\n}\n")
+	assert.Contains(t, src, "func (oBase0 *tBase0) Report(param0 []error) error {\n\tres0 := realReport(oBase0.r, param0)\n")
+
+	_, err := runGenerate(append(args, "-receiver-name=errors")...)
+	assert.EqualError(t, err, "receiver name errors from -receiver-name collides with the errors package used by -use-errors-join")
+	_, err = runGenerate(append(args[:4:4], "-use-errors-join")...)
+	assert.EqualError(t, err, "-use-errors-join can be used only with -accumulate-errors")
+}
+
+func TestConstraintBaseType(t *testing.T) {
+	args := []string{
+		"-infile=testdata/constraint/constraint.go",
+		"-basetype=Sizer",
+		"-exttypes=Pinger",
+		"-prefix=real",
+		"-newfuncname=newSizer",
+	}
+	src := mustGenerate(t, args...)
+	assert.Contains(t, src, "// SizerMethods has the methods of Sizer without its type constraints.\ntype SizerMethods interface {\n\tClose() error\n\tSize() int\n}\n")
+	assert.Contains(t, src, "\tiSizerMethods1 interface {\n\t\tSizerMethods\n\t\tPinger\n\t}\n")
+	assert.Contains(t, src, "func newSizer(realSizerMethods SizerMethods) SizerMethods {\n")
+
+	_, err := runGenerate(append(args, "-strict")...)
+	assert.EqualError(t, err, "1 warning(s) reported in strict mode, first one: base type Sizer has type constraints, they are ignored and the wrappers wrap the values of the generated SizerMethods interface with its methods")
+	_, err = runGenerate(append(args, "-exttypes=Flusher")...)
+	assert.EqualError(t, err, "extension type Flusher has type constraints, only the base type can have them")
+}
+
+func TestAppend(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "wrappers.go")
+	write := func(extTypes string) string {
+		args := []string{
+			"-infile=testdata/basic/basic.go",
+			"-outfile=" + outFile,
+			"-basetype=Base",
+			"-exttypes=" + extTypes,
+			"-prefix=real",
+			"-newfuncname=newBase",
+			"-append",
+		}
+		pi, err := parseArgs(commandGenerate, args, nil)
+		require.NoError(t, err)
+		require.NoError(t, generateAndWrite(pi, args))
+		src, err := ioutil.ReadFile(outFile)
+		require.NoError(t, err)
+		return string(src)
+	}
+
+	src := write("Pinger")
+	pingImpl := "func (oBase1 *tBase1) Ping(ctx context.Context) error {\n\treturn realPing(oBase1.r, ctx)\n}\n"
+	assert.Contains(t, src, pingImpl)
+	// without -append, Resetter would get tBase1 and Pinger
+	// tBase2
+	src = write("Resetter;Pinger")
+	assert.Contains(t, src, pingImpl)
+	assert.Contains(t, src, "\tiBase1 interface {\n\t\tBase\n\t\tPinger\n\t}\n")
+	assert.Contains(t, src, "\tiBase2 interface {\n\t\tBase\n\t\tResetter\n\t}\n")
+	assert.Contains(t, src, "\tiBase3 interface {\n\t\tBase\n\t\tResetter\n\t\tPinger\n\t}\n")
+	assert.Contains(t, src, "func (oBase2 *tBase2) Reset() {\n\trealReset(oBase2.r)\n}\n")
+	assert.Equal(t, src, write("Resetter;Pinger"))
+
+	_, err := parseArgs(commandGenerate, []string{
+		"-infile=testdata/basic/basic.go",
+		"-basetype=Base",
+		"-prefix=real",
+		"-newfuncname=newBase",
+		"-append",
+		"-strategy=sparse",
+	}, nil)
+	assert.EqualError(t, err, "-strategy=sparse can't be used with -append")
+}
@@ -0,0 +1,7 @@
+package a
+
+import (
+	"io"
+)
+
+type Reader = io.Reader
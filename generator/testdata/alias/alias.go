@@ -0,0 +1,14 @@
+package alias
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/alias/a"
+)
+
+type Base interface {
+	a.Reader
+	Close() error
+}
+
+type Ext interface {
+	Peek(r a.Reader) error
+}
@@ -0,0 +1,7 @@
+package aliasbase
+
+import (
+	d "database/sql/driver"
+)
+
+var _ d.Conn
@@ -0,0 +1,7 @@
+package ambiguous
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/ambiguous/x/util"
+)
+
+var _ util.Closer
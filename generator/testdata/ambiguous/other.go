@@ -0,0 +1,7 @@
+package ambiguous
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/ambiguous/y/util"
+)
+
+var _ util.Closer
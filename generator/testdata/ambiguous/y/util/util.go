@@ -0,0 +1,5 @@
+package util
+
+type Closer interface {
+	Close()
+}
@@ -0,0 +1,17 @@
+package anonstruct
+
+import (
+	"time"
+)
+
+type Base interface {
+	Do(opts struct{ Timeout int })
+	Configure(cfg struct {
+		Name    string `json:"name"`
+		Timeout time.Duration
+		time.Location
+	}) struct{}
+	Stats() []struct {
+		Count int `json:"count" yaml:"count"`
+	}
+}
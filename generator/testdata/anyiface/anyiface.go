@@ -0,0 +1,15 @@
+package anyiface
+
+import (
+	"io"
+)
+
+type Base interface {
+	Store(key string, value any) error
+	Lookup(key string) interface{ Foo() }
+	Visit(v interface {
+		io.Reader
+		Name() string
+		Size() (int64, error)
+	}) interface{}
+}
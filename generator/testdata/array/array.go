@@ -0,0 +1,14 @@
+package array
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/array/somepkg"
+)
+
+type Base interface {
+	Hash() [32]byte
+}
+
+type Keyer interface {
+	Keys() [4]somepkg.Key
+	SetKeys(keys [2][4]somepkg.Key)
+}
@@ -0,0 +1,5 @@
+package somepkg
+
+type Key struct {
+	ID int
+}
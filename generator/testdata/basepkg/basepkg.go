@@ -0,0 +1,12 @@
+package basepkg
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/basepkg/drv"
+)
+
+// Resetter shadows drv.Resetter when looking for extension types.
+type Resetter interface {
+	ResetLocal()
+}
+
+var _ drv.Conn
@@ -0,0 +1,13 @@
+package drv
+
+type Conn interface {
+	Close() error
+}
+
+type Pinger interface {
+	Ping() error
+}
+
+type Resetter interface {
+	Reset()
+}
@@ -0,0 +1,20 @@
+package basic
+
+import (
+	"context"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Pinger interface {
+	// Ping checks if the connection is alive.
+	//
+	// It returns an error if it is not.
+	Ping(ctx context.Context) error
+}
+
+type Resetter interface {
+	Reset()
+}
@@ -0,0 +1,8 @@
+package blank
+
+type Base interface {
+	Close() error
+	Copy(string, string, string) error
+	Write(_ []byte, _ int) error
+	Seek(_ int64, param0 int) (int64, error)
+}
@@ -0,0 +1,10 @@
+package box
+
+type Box[T any] struct {
+	Value T
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
@@ -0,0 +1,17 @@
+package boxed
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/krnowak/wrappergen/generator/testdata/boxed/box"
+)
+
+type Item struct{}
+
+type Getter interface {
+	Get() *box.Box[int]
+	Pairs() []box.Pair[string, *box.Box[Item]]
+	Put(b box.Box[time.Duration]) error
+	Updated() sql.Null[time.Time]
+}
@@ -0,0 +1,5 @@
+package broken
+
+type Base interface {
+	Close() error
+}
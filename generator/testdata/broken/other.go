@@ -0,0 +1,3 @@
+package broken
+
+var unrelated int = "not an int"
@@ -0,0 +1,12 @@
+package a
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/chain/b"
+)
+
+type ID string
+
+type Repo interface {
+	b.Store
+	Find(id ID) (b.Key, error)
+}
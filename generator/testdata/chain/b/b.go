@@ -0,0 +1,12 @@
+package b
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/chain/c"
+)
+
+type Key int
+
+type Store interface {
+	c.Closer
+	Put(key Key) error
+}
@@ -0,0 +1,9 @@
+package c
+
+type Options struct {
+	Force bool
+}
+
+type Closer interface {
+	Close(opts Options) error
+}
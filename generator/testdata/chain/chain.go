@@ -0,0 +1,9 @@
+package chain
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/chain/a"
+)
+
+type Service interface {
+	a.Repo
+}
@@ -0,0 +1,19 @@
+package channel
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/channel/somepkg"
+)
+
+type Base interface {
+	Events() <-chan somepkg.Event
+	Forward(out chan<- somepkg.Event)
+	Pipe(in chan somepkg.Event) chan []somepkg.Event
+}
+
+type Stream[T any] interface {
+	Values() chan (<-chan T)
+}
+
+type Sink[T any] interface {
+	Send(in chan<- T)
+}
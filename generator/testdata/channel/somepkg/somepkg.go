@@ -0,0 +1,5 @@
+package somepkg
+
+type Event struct {
+	Name string
+}
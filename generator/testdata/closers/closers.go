@@ -0,0 +1,18 @@
+package closers
+
+import "io"
+
+type Closer interface {
+	Close() error
+}
+
+type Base interface {
+	io.Closer
+	Closer
+	Name() string
+}
+
+type Flusher interface {
+	io.Closer
+	Flush() error
+}
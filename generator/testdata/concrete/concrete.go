@@ -0,0 +1,27 @@
+package concrete
+
+import (
+	"context"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Resetter interface {
+	Reset()
+}
+
+type RealConn struct{}
+
+func (c *RealConn) Close() error {
+	return nil
+}
+
+func (c *RealConn) Ping(ctx context.Context) error {
+	return nil
+}
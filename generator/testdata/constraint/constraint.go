@@ -0,0 +1,26 @@
+package constraint
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+type Flusher interface {
+	~*bytes.Buffer | ~*strings.Builder
+	Flush() error
+}
+
+type Number interface {
+	~int | ~float64
+}
+
+type Sizer interface {
+	Number
+	io.Closer
+	Size() int
+}
+
+type Pinger interface {
+	Ping() error
+}
@@ -0,0 +1,5 @@
+package a
+
+type Resetter interface {
+	Reset()
+}
@@ -0,0 +1,6 @@
+package b
+
+type Resetter interface {
+	Reset()
+	Flush() error
+}
@@ -0,0 +1,5 @@
+package c
+
+type Resetter interface {
+	Reset() error
+}
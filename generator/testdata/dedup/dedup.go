@@ -0,0 +1,17 @@
+package dedup
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/dedup/a"
+	"github.com/krnowak/wrappergen/generator/testdata/dedup/b"
+	"github.com/krnowak/wrappergen/generator/testdata/dedup/c"
+)
+
+type Base interface {
+	Close() error
+}
+
+var (
+	_ a.Resetter
+	_ b.Resetter
+	_ c.Resetter
+)
@@ -0,0 +1,30 @@
+package driverconn
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+func realPrepare(r driver.Conn, query string) (driver.Stmt, error) {
+	return r.Prepare(query)
+}
+
+func realClose(r driver.Conn) error {
+	return r.Close()
+}
+
+func realBegin(r driver.Conn) (driver.Tx, error) {
+	return r.Begin()
+}
+
+func realPing(r driver.Pinger, ctx context.Context) error {
+	return r.Ping(ctx)
+}
+
+func realResetSession(r driver.SessionResetter, ctx context.Context) error {
+	return r.ResetSession(ctx)
+}
+
+func realIsValid(r driver.Validator) bool {
+	return r.IsValid()
+}
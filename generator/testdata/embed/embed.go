@@ -0,0 +1,19 @@
+package embed
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/embed/shared"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Describer interface {
+	Describe() string
+}
+
+type Namer interface {
+	Name() string
+}
+
+var _ shared.Common
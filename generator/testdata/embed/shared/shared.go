@@ -0,0 +1,11 @@
+package shared
+
+type Common struct {
+	Name string
+}
+
+func (c *Common) Describe() string {
+	return c.Name
+}
+
+type NotAStruct int
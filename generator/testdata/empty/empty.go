@@ -0,0 +1,10 @@
+package empty
+
+type Base interface {
+	Set(key string, value interface{})
+	Get(key string) any
+}
+
+type Cache interface {
+	Lookup(k string) (interface{}, bool)
+}
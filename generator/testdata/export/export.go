@@ -0,0 +1,23 @@
+package export
+
+import (
+	"github.com/krnowak/wrappergen/generator/testdata/dedup/a"
+	"github.com/krnowak/wrappergen/generator/testdata/dedup/b"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Pinger interface {
+	Ping() error
+}
+
+// BaseWithPinger takes the name that would be used for the
+// combination of Base and Pinger.
+type BaseWithPinger struct{}
+
+var (
+	_ a.Resetter
+	_ b.Resetter
+)
@@ -0,0 +1,13 @@
+package extrafields
+
+import (
+	"database/sql/driver"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Local struct{}
+
+var _ driver.Value
@@ -0,0 +1,14 @@
+package fieldmethod
+
+type Base interface {
+	Close() error
+	extra() int
+}
+
+type Reader interface {
+	r() []byte
+}
+
+type Capper interface {
+	caps() uint64
+}
@@ -0,0 +1,16 @@
+package forward
+
+type Reader interface {
+	Read(p []byte) (int, error)
+	ReadBytes(p []byte) (int, error)
+	Close() error
+	CloseNow() error
+}
+
+type Conn interface {
+	Close() error
+}
+
+type NowCloser interface {
+	CloseNow() error
+}
@@ -0,0 +1,9 @@
+package generic
+
+type Store[T any] interface {
+	Get(id string) (T, error)
+}
+
+type IntStore interface {
+	Store[int]
+}
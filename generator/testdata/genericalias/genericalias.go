@@ -0,0 +1,20 @@
+// Package genericalias has its own module, because generic type
+// aliases need Go 1.24.
+package genericalias
+
+import (
+	"example.com/genericalias/internal/set"
+)
+
+type Set[T comparable] = set.Set[T]
+
+type Pair[K comparable, V any] = set.Pair[K, V]
+
+type Clearer interface {
+	Clear()
+}
+
+type Lister interface {
+	Strings() Set[string]
+	Pairs() []Pair[string, int]
+}
@@ -0,0 +1,12 @@
+package set
+
+type Set[T comparable] interface {
+	Add(item T)
+	Has(item T) bool
+	Len() int
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
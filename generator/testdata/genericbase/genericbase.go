@@ -0,0 +1,27 @@
+package genericbase
+
+import (
+	"context"
+	"io"
+)
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+type Repo[K comparable, V Number] interface {
+	io.Closer
+	Get(ctx context.Context, key K) (V, error)
+}
+
+type Lister[K comparable, V Number] interface {
+	List() map[K]V
+}
+
+type Flusher interface {
+	Flush() error
+}
+
+type Single[T any] interface {
+	Get() T
+}
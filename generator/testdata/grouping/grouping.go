@@ -0,0 +1,30 @@
+package grouping
+
+import (
+	"io"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Pinger interface {
+	Ping() error
+}
+
+type Resetter interface {
+	Reset()
+}
+
+// PingResetter only groups other interfaces, it has no methods of
+// its own.
+type PingResetter interface {
+	Pinger
+	Resetter
+}
+
+// ReadPinger groups an interface from another package too.
+type ReadPinger interface {
+	io.Reader
+	PingResetter
+}
@@ -0,0 +1,27 @@
+package hooks
+
+import (
+	"context"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type myHooks interface {
+	Close(r Base, name string) error
+	Ping(r Pinger, name string, ctx context.Context) error
+}
+
+type partialHooks interface {
+	Close(r Base, name string) error
+}
+
+type badHooks interface {
+	Close(r Base) error
+	Ping(r Pinger, name string, ctx context.Context) error
+}
@@ -0,0 +1,14 @@
+package indent
+
+type Base interface {
+	Close() error
+}
+
+type Querier interface {
+	/*
+		Query runs the query, like:
+
+			SELECT id FROM users
+	*/
+	Query(query string) error
+}
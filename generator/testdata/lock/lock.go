@@ -0,0 +1,12 @@
+package lock
+
+import (
+	"sync"
+)
+
+type Base interface {
+	Get(key string) (int, error)
+	Close()
+}
+
+var _ sync.Locker
@@ -0,0 +1,13 @@
+package metrics
+
+type Base interface {
+	Close() error
+}
+
+type Counter interface {
+	Count() int
+}
+
+func realClose(r Base) error {
+	return r.Close()
+}
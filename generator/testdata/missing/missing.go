@@ -0,0 +1,21 @@
+package missing
+
+import (
+	"context"
+)
+
+type Base interface {
+	Close() error
+}
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Resetter interface {
+	Reset()
+}
+
+func realClose(r Base, count int) error {
+	return r.Close()
+}
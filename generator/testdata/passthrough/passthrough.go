@@ -0,0 +1,11 @@
+package passthrough
+
+import (
+	"fmt"
+)
+
+type Base interface {
+	error
+	fmt.Stringer
+	Close() error
+}
@@ -0,0 +1,19 @@
+package pattern
+
+type ConnReader interface {
+	Read(p []byte) (int, error)
+}
+
+type ConnWriter interface {
+	Write(p []byte) (int, error)
+}
+
+type ConnConfig struct {
+	Addr string
+}
+
+type ConnAlias = ConnReader
+
+type Closer interface {
+	Close() error
+}
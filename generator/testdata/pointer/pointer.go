@@ -0,0 +1,13 @@
+package pointer
+
+import (
+	"io"
+)
+
+type Base interface {
+	Fill(r *io.Reader) error
+}
+
+type Swapper interface {
+	Swap(old *Swapper) *Swapper
+}
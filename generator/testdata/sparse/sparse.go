@@ -0,0 +1,105 @@
+package sparse
+
+type Base interface {
+	Close() error
+}
+
+type Ext1 interface {
+	Method1(x int) (int, error)
+}
+
+type Ext2 interface {
+	Method2(x int) (int, error)
+}
+
+type Ext3 interface {
+	Method3(x int) (int, error)
+}
+
+type Ext4 interface {
+	Method4(x int) (int, error)
+}
+
+type Ext5 interface {
+	Method5(x int) (int, error)
+}
+
+type Ext6 interface {
+	Method6(x int) (int, error)
+}
+
+type Ext7 interface {
+	Method7(x int) (int, error)
+}
+
+type Ext8 interface {
+	Method8(x int) (int, error)
+}
+
+type Ext9 interface {
+	Method9(x int) (int, error)
+}
+
+type Ext10 interface {
+	Method10(x int) (int, error)
+}
+
+type Ext11 interface {
+	Method11(x int) (int, error)
+}
+
+type Ext12 interface {
+	Method12(x int) (int, error)
+}
+
+func realClose(r Base) error {
+	return r.Close()
+}
+
+func realMethod1(r Ext1, x int) (int, error) {
+	return r.Method1(x)
+}
+
+func realMethod2(r Ext2, x int) (int, error) {
+	return r.Method2(x)
+}
+
+func realMethod3(r Ext3, x int) (int, error) {
+	return r.Method3(x)
+}
+
+func realMethod4(r Ext4, x int) (int, error) {
+	return r.Method4(x)
+}
+
+func realMethod5(r Ext5, x int) (int, error) {
+	return r.Method5(x)
+}
+
+func realMethod6(r Ext6, x int) (int, error) {
+	return r.Method6(x)
+}
+
+func realMethod7(r Ext7, x int) (int, error) {
+	return r.Method7(x)
+}
+
+func realMethod8(r Ext8, x int) (int, error) {
+	return r.Method8(x)
+}
+
+func realMethod9(r Ext9, x int) (int, error) {
+	return r.Method9(x)
+}
+
+func realMethod10(r Ext10, x int) (int, error) {
+	return r.Method10(x)
+}
+
+func realMethod11(r Ext11, x int) (int, error) {
+	return r.Method11(x)
+}
+
+func realMethod12(r Ext12, x int) (int, error) {
+	return r.Method12(x)
+}
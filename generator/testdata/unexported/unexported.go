@@ -0,0 +1,10 @@
+package unexported
+
+type Base interface {
+	Close() error
+}
+
+type Flusher interface {
+	Flush() error
+	flushLocked() error
+}
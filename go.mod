@@ -1,8 +1,16 @@
 module github.com/krnowak/wrappergen
 
-go 1.14
+go 1.23.0
 
 require (
 	github.com/stretchr/testify v1.5.1
-	golang.org/x/tools v0.0.0-20200427153019-a90b7300be7c
+	golang.org/x/tools v0.26.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
+	gopkg.in/yaml.v2 v2.2.2 // indirect
 )
@@ -0,0 +1,27 @@
+// Copyright Krzesimir Nowak
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package stringset
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the strings of the set in no
+// particular order, for use with range.
+func (s StringSet) All() iter.Seq[string] {
+	return s.Range
+}
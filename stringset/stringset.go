@@ -12,43 +12,55 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+// Package stringset provides a set of strings.
+package stringset
 
 import (
 	"sort"
 )
 
+// StringSet is a set of strings. The zero value is not usable for
+// adding strings, but it is an empty set otherwise. The strings are
+// sorted only when the set is converted to a slice.
 type StringSet map[string]struct{}
 
+// Add adds the string to the set.
 func (s StringSet) Add(str string) {
 	s[str] = struct{}{}
 }
 
+// AddSome adds the strings to the set.
 func (s StringSet) AddSome(strs ...string) {
 	s.AddSlice(strs)
 }
 
+// AddSet adds the strings of the other set to the set.
 func (s StringSet) AddSet(other StringSet) {
 	for str := range other {
 		s.Add(str)
 	}
 }
 
+// AddSlice adds the strings from the slice to the set.
 func (s StringSet) AddSlice(other []string) {
 	for _, str := range other {
 		s.Add(str)
 	}
 }
 
+// Has tells whether the string is in the set.
 func (s StringSet) Has(str string) bool {
 	_, ok := s[str]
 	return ok
 }
 
+// Len returns the number of the strings in the set.
 func (s StringSet) Len() int {
 	return len(s)
 }
 
+// Diff returns a new set with the strings of s that are not in
+// other.
 func (s StringSet) Diff(other StringSet) StringSet {
 	diff := StringSet{}
 	for str := range s {
@@ -59,6 +71,38 @@ func (s StringSet) Diff(other StringSet) StringSet {
 	return diff
 }
 
+// Intersect returns a new set with the strings that are both in s
+// and in other.
+func (s StringSet) Intersect(other StringSet) StringSet {
+	intersection := StringSet{}
+	for str := range s {
+		if other.Has(str) {
+			intersection.Add(str)
+		}
+	}
+	return intersection
+}
+
+// Union returns a new set with the strings that are in s or in
+// other.
+func (s StringSet) Union(other StringSet) StringSet {
+	union := StringSet{}
+	union.AddSet(s)
+	union.AddSet(other)
+	return union
+}
+
+// Range calls f for each string of the set in no particular order,
+// until f returns false.
+func (s StringSet) Range(f func(string) bool) {
+	for str := range s {
+		if !f(str) {
+			return
+		}
+	}
+}
+
+// ToSlice returns the strings of the set, sorted.
 func (s StringSet) ToSlice() []string {
 	slice := make([]string, 0, len(s))
 	for str := range s {
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package stringset
 
 import (
 	"testing"
@@ -67,4 +67,24 @@ func TestStringSet(t *testing.T) {
 
 	s4s := s4.ToSlice()
 	assert.Equal(t, slice, s4s)
+
+	assert.Equal(t, []string{"b", "c"}, s4.Intersect(s5).ToSlice())
+	assert.Equal(t, []string{"a", "b", "c", "d"}, s4.Union(s5).ToSlice())
+	assert.Equal(t, 3, s4.Len(), "union modified the set")
+	var nilSet StringSet
+	assert.Equal(t, 0, nilSet.Intersect(s4).Len())
+	assert.Equal(t, s4, nilSet.Union(s4))
+
+	var ranged []string
+	s4.Range(func(str string) bool {
+		ranged = append(ranged, str)
+		return true
+	})
+	assert.ElementsMatch(t, slice, ranged)
+	calls := 0
+	s4.Range(func(string) bool {
+		calls++
+		return false
+	})
+	assert.Equal(t, 1, calls)
 }
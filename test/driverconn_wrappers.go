@@ -0,0 +1,24337 @@
+// Code generated by "wrappergen -basetype=driver.Conn -exttypes=driver.ConnBeginTx;driver.ConnPrepareContext;driver.Execer;driver.ExecerContext;driver.NamedValueChecker;driver.Pinger;driver.Queryer;driver.QueryerContext;driver.SessionResetter -extrafields=extra,interface{} -prefix=realDC -newfuncname=newConn"; DO NOT EDIT.
+
+package test
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+type (
+	idriverConn0 interface {
+		driver.Conn
+	}
+
+	tdriverConn0 struct {
+		r     idriverConn0
+		extra interface{}
+	}
+
+	idriverConn1 interface {
+		driver.Conn
+		driver.ConnBeginTx
+	}
+
+	tdriverConn1 struct {
+		r     idriverConn1
+		extra interface{}
+	}
+
+	idriverConn2 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+	}
+
+	tdriverConn2 struct {
+		r     idriverConn2
+		extra interface{}
+	}
+
+	idriverConn3 interface {
+		driver.Conn
+		driver.Execer
+	}
+
+	tdriverConn3 struct {
+		r     idriverConn3
+		extra interface{}
+	}
+
+	idriverConn4 interface {
+		driver.Conn
+		driver.ExecerContext
+	}
+
+	tdriverConn4 struct {
+		r     idriverConn4
+		extra interface{}
+	}
+
+	idriverConn5 interface {
+		driver.Conn
+		driver.NamedValueChecker
+	}
+
+	tdriverConn5 struct {
+		r     idriverConn5
+		extra interface{}
+	}
+
+	idriverConn6 interface {
+		driver.Conn
+		driver.Pinger
+	}
+
+	tdriverConn6 struct {
+		r     idriverConn6
+		extra interface{}
+	}
+
+	idriverConn7 interface {
+		driver.Conn
+		driver.Queryer
+	}
+
+	tdriverConn7 struct {
+		r     idriverConn7
+		extra interface{}
+	}
+
+	idriverConn8 interface {
+		driver.Conn
+		driver.QueryerContext
+	}
+
+	tdriverConn8 struct {
+		r     idriverConn8
+		extra interface{}
+	}
+
+	idriverConn9 interface {
+		driver.Conn
+		driver.SessionResetter
+	}
+
+	tdriverConn9 struct {
+		r     idriverConn9
+		extra interface{}
+	}
+
+	idriverConn10 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+	}
+
+	tdriverConn10 struct {
+		r     idriverConn10
+		extra interface{}
+	}
+
+	idriverConn11 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+	}
+
+	tdriverConn11 struct {
+		r     idriverConn11
+		extra interface{}
+	}
+
+	idriverConn12 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+	}
+
+	tdriverConn12 struct {
+		r     idriverConn12
+		extra interface{}
+	}
+
+	idriverConn13 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+	}
+
+	tdriverConn13 struct {
+		r     idriverConn13
+		extra interface{}
+	}
+
+	idriverConn14 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+	}
+
+	tdriverConn14 struct {
+		r     idriverConn14
+		extra interface{}
+	}
+
+	idriverConn15 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Queryer
+	}
+
+	tdriverConn15 struct {
+		r     idriverConn15
+		extra interface{}
+	}
+
+	idriverConn16 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.QueryerContext
+	}
+
+	tdriverConn16 struct {
+		r     idriverConn16
+		extra interface{}
+	}
+
+	idriverConn17 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.SessionResetter
+	}
+
+	tdriverConn17 struct {
+		r     idriverConn17
+		extra interface{}
+	}
+
+	idriverConn18 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+	}
+
+	tdriverConn18 struct {
+		r     idriverConn18
+		extra interface{}
+	}
+
+	idriverConn19 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+	}
+
+	tdriverConn19 struct {
+		r     idriverConn19
+		extra interface{}
+	}
+
+	idriverConn20 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn20 struct {
+		r     idriverConn20
+		extra interface{}
+	}
+
+	idriverConn21 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+	}
+
+	tdriverConn21 struct {
+		r     idriverConn21
+		extra interface{}
+	}
+
+	idriverConn22 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Queryer
+	}
+
+	tdriverConn22 struct {
+		r     idriverConn22
+		extra interface{}
+	}
+
+	idriverConn23 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.QueryerContext
+	}
+
+	tdriverConn23 struct {
+		r     idriverConn23
+		extra interface{}
+	}
+
+	idriverConn24 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.SessionResetter
+	}
+
+	tdriverConn24 struct {
+		r     idriverConn24
+		extra interface{}
+	}
+
+	idriverConn25 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+	}
+
+	tdriverConn25 struct {
+		r     idriverConn25
+		extra interface{}
+	}
+
+	idriverConn26 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+	}
+
+	tdriverConn26 struct {
+		r     idriverConn26
+		extra interface{}
+	}
+
+	idriverConn27 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+	}
+
+	tdriverConn27 struct {
+		r     idriverConn27
+		extra interface{}
+	}
+
+	idriverConn28 interface {
+		driver.Conn
+		driver.Execer
+		driver.Queryer
+	}
+
+	tdriverConn28 struct {
+		r     idriverConn28
+		extra interface{}
+	}
+
+	idriverConn29 interface {
+		driver.Conn
+		driver.Execer
+		driver.QueryerContext
+	}
+
+	tdriverConn29 struct {
+		r     idriverConn29
+		extra interface{}
+	}
+
+	idriverConn30 interface {
+		driver.Conn
+		driver.Execer
+		driver.SessionResetter
+	}
+
+	tdriverConn30 struct {
+		r     idriverConn30
+		extra interface{}
+	}
+
+	idriverConn31 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn31 struct {
+		r     idriverConn31
+		extra interface{}
+	}
+
+	idriverConn32 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn32 struct {
+		r     idriverConn32
+		extra interface{}
+	}
+
+	idriverConn33 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn33 struct {
+		r     idriverConn33
+		extra interface{}
+	}
+
+	idriverConn34 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn34 struct {
+		r     idriverConn34
+		extra interface{}
+	}
+
+	idriverConn35 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn35 struct {
+		r     idriverConn35
+		extra interface{}
+	}
+
+	idriverConn36 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn36 struct {
+		r     idriverConn36
+		extra interface{}
+	}
+
+	idriverConn37 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn37 struct {
+		r     idriverConn37
+		extra interface{}
+	}
+
+	idriverConn38 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn38 struct {
+		r     idriverConn38
+		extra interface{}
+	}
+
+	idriverConn39 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn39 struct {
+		r     idriverConn39
+		extra interface{}
+	}
+
+	idriverConn40 interface {
+		driver.Conn
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn40 struct {
+		r     idriverConn40
+		extra interface{}
+	}
+
+	idriverConn41 interface {
+		driver.Conn
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn41 struct {
+		r     idriverConn41
+		extra interface{}
+	}
+
+	idriverConn42 interface {
+		driver.Conn
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn42 struct {
+		r     idriverConn42
+		extra interface{}
+	}
+
+	idriverConn43 interface {
+		driver.Conn
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn43 struct {
+		r     idriverConn43
+		extra interface{}
+	}
+
+	idriverConn44 interface {
+		driver.Conn
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn44 struct {
+		r     idriverConn44
+		extra interface{}
+	}
+
+	idriverConn45 interface {
+		driver.Conn
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn45 struct {
+		r     idriverConn45
+		extra interface{}
+	}
+
+	idriverConn46 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+	}
+
+	tdriverConn46 struct {
+		r     idriverConn46
+		extra interface{}
+	}
+
+	idriverConn47 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+	}
+
+	tdriverConn47 struct {
+		r     idriverConn47
+		extra interface{}
+	}
+
+	idriverConn48 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn48 struct {
+		r     idriverConn48
+		extra interface{}
+	}
+
+	idriverConn49 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+	}
+
+	tdriverConn49 struct {
+		r     idriverConn49
+		extra interface{}
+	}
+
+	idriverConn50 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Queryer
+	}
+
+	tdriverConn50 struct {
+		r     idriverConn50
+		extra interface{}
+	}
+
+	idriverConn51 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.QueryerContext
+	}
+
+	tdriverConn51 struct {
+		r     idriverConn51
+		extra interface{}
+	}
+
+	idriverConn52 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.SessionResetter
+	}
+
+	tdriverConn52 struct {
+		r     idriverConn52
+		extra interface{}
+	}
+
+	idriverConn53 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+	}
+
+	tdriverConn53 struct {
+		r     idriverConn53
+		extra interface{}
+	}
+
+	idriverConn54 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+	}
+
+	tdriverConn54 struct {
+		r     idriverConn54
+		extra interface{}
+	}
+
+	idriverConn55 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+	}
+
+	tdriverConn55 struct {
+		r     idriverConn55
+		extra interface{}
+	}
+
+	idriverConn56 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Queryer
+	}
+
+	tdriverConn56 struct {
+		r     idriverConn56
+		extra interface{}
+	}
+
+	idriverConn57 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.QueryerContext
+	}
+
+	tdriverConn57 struct {
+		r     idriverConn57
+		extra interface{}
+	}
+
+	idriverConn58 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.SessionResetter
+	}
+
+	tdriverConn58 struct {
+		r     idriverConn58
+		extra interface{}
+	}
+
+	idriverConn59 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn59 struct {
+		r     idriverConn59
+		extra interface{}
+	}
+
+	idriverConn60 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn60 struct {
+		r     idriverConn60
+		extra interface{}
+	}
+
+	idriverConn61 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn61 struct {
+		r     idriverConn61
+		extra interface{}
+	}
+
+	idriverConn62 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn62 struct {
+		r     idriverConn62
+		extra interface{}
+	}
+
+	idriverConn63 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn63 struct {
+		r     idriverConn63
+		extra interface{}
+	}
+
+	idriverConn64 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn64 struct {
+		r     idriverConn64
+		extra interface{}
+	}
+
+	idriverConn65 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn65 struct {
+		r     idriverConn65
+		extra interface{}
+	}
+
+	idriverConn66 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn66 struct {
+		r     idriverConn66
+		extra interface{}
+	}
+
+	idriverConn67 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn67 struct {
+		r     idriverConn67
+		extra interface{}
+	}
+
+	idriverConn68 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn68 struct {
+		r     idriverConn68
+		extra interface{}
+	}
+
+	idriverConn69 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn69 struct {
+		r     idriverConn69
+		extra interface{}
+	}
+
+	idriverConn70 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn70 struct {
+		r     idriverConn70
+		extra interface{}
+	}
+
+	idriverConn71 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn71 struct {
+		r     idriverConn71
+		extra interface{}
+	}
+
+	idriverConn72 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn72 struct {
+		r     idriverConn72
+		extra interface{}
+	}
+
+	idriverConn73 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn73 struct {
+		r     idriverConn73
+		extra interface{}
+	}
+
+	idriverConn74 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+	}
+
+	tdriverConn74 struct {
+		r     idriverConn74
+		extra interface{}
+	}
+
+	idriverConn75 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+	}
+
+	tdriverConn75 struct {
+		r     idriverConn75
+		extra interface{}
+	}
+
+	idriverConn76 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+	}
+
+	tdriverConn76 struct {
+		r     idriverConn76
+		extra interface{}
+	}
+
+	idriverConn77 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+	}
+
+	tdriverConn77 struct {
+		r     idriverConn77
+		extra interface{}
+	}
+
+	idriverConn78 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.QueryerContext
+	}
+
+	tdriverConn78 struct {
+		r     idriverConn78
+		extra interface{}
+	}
+
+	idriverConn79 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.SessionResetter
+	}
+
+	tdriverConn79 struct {
+		r     idriverConn79
+		extra interface{}
+	}
+
+	idriverConn80 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn80 struct {
+		r     idriverConn80
+		extra interface{}
+	}
+
+	idriverConn81 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn81 struct {
+		r     idriverConn81
+		extra interface{}
+	}
+
+	idriverConn82 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn82 struct {
+		r     idriverConn82
+		extra interface{}
+	}
+
+	idriverConn83 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn83 struct {
+		r     idriverConn83
+		extra interface{}
+	}
+
+	idriverConn84 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn84 struct {
+		r     idriverConn84
+		extra interface{}
+	}
+
+	idriverConn85 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn85 struct {
+		r     idriverConn85
+		extra interface{}
+	}
+
+	idriverConn86 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn86 struct {
+		r     idriverConn86
+		extra interface{}
+	}
+
+	idriverConn87 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn87 struct {
+		r     idriverConn87
+		extra interface{}
+	}
+
+	idriverConn88 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn88 struct {
+		r     idriverConn88
+		extra interface{}
+	}
+
+	idriverConn89 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn89 struct {
+		r     idriverConn89
+		extra interface{}
+	}
+
+	idriverConn90 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn90 struct {
+		r     idriverConn90
+		extra interface{}
+	}
+
+	idriverConn91 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn91 struct {
+		r     idriverConn91
+		extra interface{}
+	}
+
+	idriverConn92 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn92 struct {
+		r     idriverConn92
+		extra interface{}
+	}
+
+	idriverConn93 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn93 struct {
+		r     idriverConn93
+		extra interface{}
+	}
+
+	idriverConn94 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn94 struct {
+		r     idriverConn94
+		extra interface{}
+	}
+
+	idriverConn95 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn95 struct {
+		r     idriverConn95
+		extra interface{}
+	}
+
+	idriverConn96 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn96 struct {
+		r     idriverConn96
+		extra interface{}
+	}
+
+	idriverConn97 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn97 struct {
+		r     idriverConn97
+		extra interface{}
+	}
+
+	idriverConn98 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn98 struct {
+		r     idriverConn98
+		extra interface{}
+	}
+
+	idriverConn99 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn99 struct {
+		r     idriverConn99
+		extra interface{}
+	}
+
+	idriverConn100 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn100 struct {
+		r     idriverConn100
+		extra interface{}
+	}
+
+	idriverConn101 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn101 struct {
+		r     idriverConn101
+		extra interface{}
+	}
+
+	idriverConn102 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn102 struct {
+		r     idriverConn102
+		extra interface{}
+	}
+
+	idriverConn103 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn103 struct {
+		r     idriverConn103
+		extra interface{}
+	}
+
+	idriverConn104 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn104 struct {
+		r     idriverConn104
+		extra interface{}
+	}
+
+	idriverConn105 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn105 struct {
+		r     idriverConn105
+		extra interface{}
+	}
+
+	idriverConn106 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn106 struct {
+		r     idriverConn106
+		extra interface{}
+	}
+
+	idriverConn107 interface {
+		driver.Conn
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn107 struct {
+		r     idriverConn107
+		extra interface{}
+	}
+
+	idriverConn108 interface {
+		driver.Conn
+		driver.Execer
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn108 struct {
+		r     idriverConn108
+		extra interface{}
+	}
+
+	idriverConn109 interface {
+		driver.Conn
+		driver.Execer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn109 struct {
+		r     idriverConn109
+		extra interface{}
+	}
+
+	idriverConn110 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn110 struct {
+		r     idriverConn110
+		extra interface{}
+	}
+
+	idriverConn111 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn111 struct {
+		r     idriverConn111
+		extra interface{}
+	}
+
+	idriverConn112 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn112 struct {
+		r     idriverConn112
+		extra interface{}
+	}
+
+	idriverConn113 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn113 struct {
+		r     idriverConn113
+		extra interface{}
+	}
+
+	idriverConn114 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn114 struct {
+		r     idriverConn114
+		extra interface{}
+	}
+
+	idriverConn115 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn115 struct {
+		r     idriverConn115
+		extra interface{}
+	}
+
+	idriverConn116 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn116 struct {
+		r     idriverConn116
+		extra interface{}
+	}
+
+	idriverConn117 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn117 struct {
+		r     idriverConn117
+		extra interface{}
+	}
+
+	idriverConn118 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn118 struct {
+		r     idriverConn118
+		extra interface{}
+	}
+
+	idriverConn119 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn119 struct {
+		r     idriverConn119
+		extra interface{}
+	}
+
+	idriverConn120 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn120 struct {
+		r     idriverConn120
+		extra interface{}
+	}
+
+	idriverConn121 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn121 struct {
+		r     idriverConn121
+		extra interface{}
+	}
+
+	idriverConn122 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn122 struct {
+		r     idriverConn122
+		extra interface{}
+	}
+
+	idriverConn123 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn123 struct {
+		r     idriverConn123
+		extra interface{}
+	}
+
+	idriverConn124 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn124 struct {
+		r     idriverConn124
+		extra interface{}
+	}
+
+	idriverConn125 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn125 struct {
+		r     idriverConn125
+		extra interface{}
+	}
+
+	idriverConn126 interface {
+		driver.Conn
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn126 struct {
+		r     idriverConn126
+		extra interface{}
+	}
+
+	idriverConn127 interface {
+		driver.Conn
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn127 struct {
+		r     idriverConn127
+		extra interface{}
+	}
+
+	idriverConn128 interface {
+		driver.Conn
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn128 struct {
+		r     idriverConn128
+		extra interface{}
+	}
+
+	idriverConn129 interface {
+		driver.Conn
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn129 struct {
+		r     idriverConn129
+		extra interface{}
+	}
+
+	idriverConn130 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+	}
+
+	tdriverConn130 struct {
+		r     idriverConn130
+		extra interface{}
+	}
+
+	idriverConn131 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+	}
+
+	tdriverConn131 struct {
+		r     idriverConn131
+		extra interface{}
+	}
+
+	idriverConn132 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+	}
+
+	tdriverConn132 struct {
+		r     idriverConn132
+		extra interface{}
+	}
+
+	idriverConn133 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+	}
+
+	tdriverConn133 struct {
+		r     idriverConn133
+		extra interface{}
+	}
+
+	idriverConn134 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.QueryerContext
+	}
+
+	tdriverConn134 struct {
+		r     idriverConn134
+		extra interface{}
+	}
+
+	idriverConn135 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.SessionResetter
+	}
+
+	tdriverConn135 struct {
+		r     idriverConn135
+		extra interface{}
+	}
+
+	idriverConn136 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn136 struct {
+		r     idriverConn136
+		extra interface{}
+	}
+
+	idriverConn137 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn137 struct {
+		r     idriverConn137
+		extra interface{}
+	}
+
+	idriverConn138 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn138 struct {
+		r     idriverConn138
+		extra interface{}
+	}
+
+	idriverConn139 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn139 struct {
+		r     idriverConn139
+		extra interface{}
+	}
+
+	idriverConn140 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn140 struct {
+		r     idriverConn140
+		extra interface{}
+	}
+
+	idriverConn141 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn141 struct {
+		r     idriverConn141
+		extra interface{}
+	}
+
+	idriverConn142 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn142 struct {
+		r     idriverConn142
+		extra interface{}
+	}
+
+	idriverConn143 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn143 struct {
+		r     idriverConn143
+		extra interface{}
+	}
+
+	idriverConn144 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn144 struct {
+		r     idriverConn144
+		extra interface{}
+	}
+
+	idriverConn145 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn145 struct {
+		r     idriverConn145
+		extra interface{}
+	}
+
+	idriverConn146 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn146 struct {
+		r     idriverConn146
+		extra interface{}
+	}
+
+	idriverConn147 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn147 struct {
+		r     idriverConn147
+		extra interface{}
+	}
+
+	idriverConn148 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn148 struct {
+		r     idriverConn148
+		extra interface{}
+	}
+
+	idriverConn149 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn149 struct {
+		r     idriverConn149
+		extra interface{}
+	}
+
+	idriverConn150 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn150 struct {
+		r     idriverConn150
+		extra interface{}
+	}
+
+	idriverConn151 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn151 struct {
+		r     idriverConn151
+		extra interface{}
+	}
+
+	idriverConn152 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn152 struct {
+		r     idriverConn152
+		extra interface{}
+	}
+
+	idriverConn153 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn153 struct {
+		r     idriverConn153
+		extra interface{}
+	}
+
+	idriverConn154 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn154 struct {
+		r     idriverConn154
+		extra interface{}
+	}
+
+	idriverConn155 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn155 struct {
+		r     idriverConn155
+		extra interface{}
+	}
+
+	idriverConn156 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn156 struct {
+		r     idriverConn156
+		extra interface{}
+	}
+
+	idriverConn157 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn157 struct {
+		r     idriverConn157
+		extra interface{}
+	}
+
+	idriverConn158 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn158 struct {
+		r     idriverConn158
+		extra interface{}
+	}
+
+	idriverConn159 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn159 struct {
+		r     idriverConn159
+		extra interface{}
+	}
+
+	idriverConn160 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn160 struct {
+		r     idriverConn160
+		extra interface{}
+	}
+
+	idriverConn161 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn161 struct {
+		r     idriverConn161
+		extra interface{}
+	}
+
+	idriverConn162 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn162 struct {
+		r     idriverConn162
+		extra interface{}
+	}
+
+	idriverConn163 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn163 struct {
+		r     idriverConn163
+		extra interface{}
+	}
+
+	idriverConn164 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn164 struct {
+		r     idriverConn164
+		extra interface{}
+	}
+
+	idriverConn165 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn165 struct {
+		r     idriverConn165
+		extra interface{}
+	}
+
+	idriverConn166 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn166 struct {
+		r     idriverConn166
+		extra interface{}
+	}
+
+	idriverConn167 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn167 struct {
+		r     idriverConn167
+		extra interface{}
+	}
+
+	idriverConn168 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn168 struct {
+		r     idriverConn168
+		extra interface{}
+	}
+
+	idriverConn169 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn169 struct {
+		r     idriverConn169
+		extra interface{}
+	}
+
+	idriverConn170 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn170 struct {
+		r     idriverConn170
+		extra interface{}
+	}
+
+	idriverConn171 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn171 struct {
+		r     idriverConn171
+		extra interface{}
+	}
+
+	idriverConn172 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn172 struct {
+		r     idriverConn172
+		extra interface{}
+	}
+
+	idriverConn173 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn173 struct {
+		r     idriverConn173
+		extra interface{}
+	}
+
+	idriverConn174 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn174 struct {
+		r     idriverConn174
+		extra interface{}
+	}
+
+	idriverConn175 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn175 struct {
+		r     idriverConn175
+		extra interface{}
+	}
+
+	idriverConn176 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn176 struct {
+		r     idriverConn176
+		extra interface{}
+	}
+
+	idriverConn177 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn177 struct {
+		r     idriverConn177
+		extra interface{}
+	}
+
+	idriverConn178 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn178 struct {
+		r     idriverConn178
+		extra interface{}
+	}
+
+	idriverConn179 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn179 struct {
+		r     idriverConn179
+		extra interface{}
+	}
+
+	idriverConn180 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn180 struct {
+		r     idriverConn180
+		extra interface{}
+	}
+
+	idriverConn181 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn181 struct {
+		r     idriverConn181
+		extra interface{}
+	}
+
+	idriverConn182 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn182 struct {
+		r     idriverConn182
+		extra interface{}
+	}
+
+	idriverConn183 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn183 struct {
+		r     idriverConn183
+		extra interface{}
+	}
+
+	idriverConn184 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn184 struct {
+		r     idriverConn184
+		extra interface{}
+	}
+
+	idriverConn185 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn185 struct {
+		r     idriverConn185
+		extra interface{}
+	}
+
+	idriverConn186 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn186 struct {
+		r     idriverConn186
+		extra interface{}
+	}
+
+	idriverConn187 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn187 struct {
+		r     idriverConn187
+		extra interface{}
+	}
+
+	idriverConn188 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn188 struct {
+		r     idriverConn188
+		extra interface{}
+	}
+
+	idriverConn189 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn189 struct {
+		r     idriverConn189
+		extra interface{}
+	}
+
+	idriverConn190 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn190 struct {
+		r     idriverConn190
+		extra interface{}
+	}
+
+	idriverConn191 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn191 struct {
+		r     idriverConn191
+		extra interface{}
+	}
+
+	idriverConn192 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn192 struct {
+		r     idriverConn192
+		extra interface{}
+	}
+
+	idriverConn193 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn193 struct {
+		r     idriverConn193
+		extra interface{}
+	}
+
+	idriverConn194 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn194 struct {
+		r     idriverConn194
+		extra interface{}
+	}
+
+	idriverConn195 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn195 struct {
+		r     idriverConn195
+		extra interface{}
+	}
+
+	idriverConn196 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn196 struct {
+		r     idriverConn196
+		extra interface{}
+	}
+
+	idriverConn197 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn197 struct {
+		r     idriverConn197
+		extra interface{}
+	}
+
+	idriverConn198 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn198 struct {
+		r     idriverConn198
+		extra interface{}
+	}
+
+	idriverConn199 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn199 struct {
+		r     idriverConn199
+		extra interface{}
+	}
+
+	idriverConn200 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn200 struct {
+		r     idriverConn200
+		extra interface{}
+	}
+
+	idriverConn201 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn201 struct {
+		r     idriverConn201
+		extra interface{}
+	}
+
+	idriverConn202 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn202 struct {
+		r     idriverConn202
+		extra interface{}
+	}
+
+	idriverConn203 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn203 struct {
+		r     idriverConn203
+		extra interface{}
+	}
+
+	idriverConn204 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn204 struct {
+		r     idriverConn204
+		extra interface{}
+	}
+
+	idriverConn205 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn205 struct {
+		r     idriverConn205
+		extra interface{}
+	}
+
+	idriverConn206 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn206 struct {
+		r     idriverConn206
+		extra interface{}
+	}
+
+	idriverConn207 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn207 struct {
+		r     idriverConn207
+		extra interface{}
+	}
+
+	idriverConn208 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn208 struct {
+		r     idriverConn208
+		extra interface{}
+	}
+
+	idriverConn209 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn209 struct {
+		r     idriverConn209
+		extra interface{}
+	}
+
+	idriverConn210 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn210 struct {
+		r     idriverConn210
+		extra interface{}
+	}
+
+	idriverConn211 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn211 struct {
+		r     idriverConn211
+		extra interface{}
+	}
+
+	idriverConn212 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn212 struct {
+		r     idriverConn212
+		extra interface{}
+	}
+
+	idriverConn213 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn213 struct {
+		r     idriverConn213
+		extra interface{}
+	}
+
+	idriverConn214 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn214 struct {
+		r     idriverConn214
+		extra interface{}
+	}
+
+	idriverConn215 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn215 struct {
+		r     idriverConn215
+		extra interface{}
+	}
+
+	idriverConn216 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn216 struct {
+		r     idriverConn216
+		extra interface{}
+	}
+
+	idriverConn217 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn217 struct {
+		r     idriverConn217
+		extra interface{}
+	}
+
+	idriverConn218 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn218 struct {
+		r     idriverConn218
+		extra interface{}
+	}
+
+	idriverConn219 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn219 struct {
+		r     idriverConn219
+		extra interface{}
+	}
+
+	idriverConn220 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn220 struct {
+		r     idriverConn220
+		extra interface{}
+	}
+
+	idriverConn221 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn221 struct {
+		r     idriverConn221
+		extra interface{}
+	}
+
+	idriverConn222 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn222 struct {
+		r     idriverConn222
+		extra interface{}
+	}
+
+	idriverConn223 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn223 struct {
+		r     idriverConn223
+		extra interface{}
+	}
+
+	idriverConn224 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn224 struct {
+		r     idriverConn224
+		extra interface{}
+	}
+
+	idriverConn225 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn225 struct {
+		r     idriverConn225
+		extra interface{}
+	}
+
+	idriverConn226 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn226 struct {
+		r     idriverConn226
+		extra interface{}
+	}
+
+	idriverConn227 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn227 struct {
+		r     idriverConn227
+		extra interface{}
+	}
+
+	idriverConn228 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn228 struct {
+		r     idriverConn228
+		extra interface{}
+	}
+
+	idriverConn229 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn229 struct {
+		r     idriverConn229
+		extra interface{}
+	}
+
+	idriverConn230 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn230 struct {
+		r     idriverConn230
+		extra interface{}
+	}
+
+	idriverConn231 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn231 struct {
+		r     idriverConn231
+		extra interface{}
+	}
+
+	idriverConn232 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn232 struct {
+		r     idriverConn232
+		extra interface{}
+	}
+
+	idriverConn233 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn233 struct {
+		r     idriverConn233
+		extra interface{}
+	}
+
+	idriverConn234 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn234 struct {
+		r     idriverConn234
+		extra interface{}
+	}
+
+	idriverConn235 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn235 struct {
+		r     idriverConn235
+		extra interface{}
+	}
+
+	idriverConn236 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn236 struct {
+		r     idriverConn236
+		extra interface{}
+	}
+
+	idriverConn237 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn237 struct {
+		r     idriverConn237
+		extra interface{}
+	}
+
+	idriverConn238 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn238 struct {
+		r     idriverConn238
+		extra interface{}
+	}
+
+	idriverConn239 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn239 struct {
+		r     idriverConn239
+		extra interface{}
+	}
+
+	idriverConn240 interface {
+		driver.Conn
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn240 struct {
+		r     idriverConn240
+		extra interface{}
+	}
+
+	idriverConn241 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn241 struct {
+		r     idriverConn241
+		extra interface{}
+	}
+
+	idriverConn242 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn242 struct {
+		r     idriverConn242
+		extra interface{}
+	}
+
+	idriverConn243 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn243 struct {
+		r     idriverConn243
+		extra interface{}
+	}
+
+	idriverConn244 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn244 struct {
+		r     idriverConn244
+		extra interface{}
+	}
+
+	idriverConn245 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn245 struct {
+		r     idriverConn245
+		extra interface{}
+	}
+
+	idriverConn246 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn246 struct {
+		r     idriverConn246
+		extra interface{}
+	}
+
+	idriverConn247 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn247 struct {
+		r     idriverConn247
+		extra interface{}
+	}
+
+	idriverConn248 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn248 struct {
+		r     idriverConn248
+		extra interface{}
+	}
+
+	idriverConn249 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn249 struct {
+		r     idriverConn249
+		extra interface{}
+	}
+
+	idriverConn250 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn250 struct {
+		r     idriverConn250
+		extra interface{}
+	}
+
+	idriverConn251 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn251 struct {
+		r     idriverConn251
+		extra interface{}
+	}
+
+	idriverConn252 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn252 struct {
+		r     idriverConn252
+		extra interface{}
+	}
+
+	idriverConn253 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn253 struct {
+		r     idriverConn253
+		extra interface{}
+	}
+
+	idriverConn254 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn254 struct {
+		r     idriverConn254
+		extra interface{}
+	}
+
+	idriverConn255 interface {
+		driver.Conn
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn255 struct {
+		r     idriverConn255
+		extra interface{}
+	}
+
+	idriverConn256 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+	}
+
+	tdriverConn256 struct {
+		r     idriverConn256
+		extra interface{}
+	}
+
+	idriverConn257 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+	}
+
+	tdriverConn257 struct {
+		r     idriverConn257
+		extra interface{}
+	}
+
+	idriverConn258 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+	}
+
+	tdriverConn258 struct {
+		r     idriverConn258
+		extra interface{}
+	}
+
+	idriverConn259 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+	}
+
+	tdriverConn259 struct {
+		r     idriverConn259
+		extra interface{}
+	}
+
+	idriverConn260 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn260 struct {
+		r     idriverConn260
+		extra interface{}
+	}
+
+	idriverConn261 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn261 struct {
+		r     idriverConn261
+		extra interface{}
+	}
+
+	idriverConn262 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn262 struct {
+		r     idriverConn262
+		extra interface{}
+	}
+
+	idriverConn263 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn263 struct {
+		r     idriverConn263
+		extra interface{}
+	}
+
+	idriverConn264 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn264 struct {
+		r     idriverConn264
+		extra interface{}
+	}
+
+	idriverConn265 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn265 struct {
+		r     idriverConn265
+		extra interface{}
+	}
+
+	idriverConn266 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn266 struct {
+		r     idriverConn266
+		extra interface{}
+	}
+
+	idriverConn267 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn267 struct {
+		r     idriverConn267
+		extra interface{}
+	}
+
+	idriverConn268 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn268 struct {
+		r     idriverConn268
+		extra interface{}
+	}
+
+	idriverConn269 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn269 struct {
+		r     idriverConn269
+		extra interface{}
+	}
+
+	idriverConn270 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn270 struct {
+		r     idriverConn270
+		extra interface{}
+	}
+
+	idriverConn271 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn271 struct {
+		r     idriverConn271
+		extra interface{}
+	}
+
+	idriverConn272 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn272 struct {
+		r     idriverConn272
+		extra interface{}
+	}
+
+	idriverConn273 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn273 struct {
+		r     idriverConn273
+		extra interface{}
+	}
+
+	idriverConn274 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn274 struct {
+		r     idriverConn274
+		extra interface{}
+	}
+
+	idriverConn275 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn275 struct {
+		r     idriverConn275
+		extra interface{}
+	}
+
+	idriverConn276 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn276 struct {
+		r     idriverConn276
+		extra interface{}
+	}
+
+	idriverConn277 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn277 struct {
+		r     idriverConn277
+		extra interface{}
+	}
+
+	idriverConn278 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn278 struct {
+		r     idriverConn278
+		extra interface{}
+	}
+
+	idriverConn279 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn279 struct {
+		r     idriverConn279
+		extra interface{}
+	}
+
+	idriverConn280 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn280 struct {
+		r     idriverConn280
+		extra interface{}
+	}
+
+	idriverConn281 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn281 struct {
+		r     idriverConn281
+		extra interface{}
+	}
+
+	idriverConn282 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn282 struct {
+		r     idriverConn282
+		extra interface{}
+	}
+
+	idriverConn283 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn283 struct {
+		r     idriverConn283
+		extra interface{}
+	}
+
+	idriverConn284 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn284 struct {
+		r     idriverConn284
+		extra interface{}
+	}
+
+	idriverConn285 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn285 struct {
+		r     idriverConn285
+		extra interface{}
+	}
+
+	idriverConn286 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn286 struct {
+		r     idriverConn286
+		extra interface{}
+	}
+
+	idriverConn287 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn287 struct {
+		r     idriverConn287
+		extra interface{}
+	}
+
+	idriverConn288 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn288 struct {
+		r     idriverConn288
+		extra interface{}
+	}
+
+	idriverConn289 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn289 struct {
+		r     idriverConn289
+		extra interface{}
+	}
+
+	idriverConn290 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn290 struct {
+		r     idriverConn290
+		extra interface{}
+	}
+
+	idriverConn291 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn291 struct {
+		r     idriverConn291
+		extra interface{}
+	}
+
+	idriverConn292 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn292 struct {
+		r     idriverConn292
+		extra interface{}
+	}
+
+	idriverConn293 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn293 struct {
+		r     idriverConn293
+		extra interface{}
+	}
+
+	idriverConn294 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn294 struct {
+		r     idriverConn294
+		extra interface{}
+	}
+
+	idriverConn295 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn295 struct {
+		r     idriverConn295
+		extra interface{}
+	}
+
+	idriverConn296 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn296 struct {
+		r     idriverConn296
+		extra interface{}
+	}
+
+	idriverConn297 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn297 struct {
+		r     idriverConn297
+		extra interface{}
+	}
+
+	idriverConn298 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn298 struct {
+		r     idriverConn298
+		extra interface{}
+	}
+
+	idriverConn299 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn299 struct {
+		r     idriverConn299
+		extra interface{}
+	}
+
+	idriverConn300 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn300 struct {
+		r     idriverConn300
+		extra interface{}
+	}
+
+	idriverConn301 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn301 struct {
+		r     idriverConn301
+		extra interface{}
+	}
+
+	idriverConn302 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn302 struct {
+		r     idriverConn302
+		extra interface{}
+	}
+
+	idriverConn303 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn303 struct {
+		r     idriverConn303
+		extra interface{}
+	}
+
+	idriverConn304 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn304 struct {
+		r     idriverConn304
+		extra interface{}
+	}
+
+	idriverConn305 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn305 struct {
+		r     idriverConn305
+		extra interface{}
+	}
+
+	idriverConn306 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn306 struct {
+		r     idriverConn306
+		extra interface{}
+	}
+
+	idriverConn307 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn307 struct {
+		r     idriverConn307
+		extra interface{}
+	}
+
+	idriverConn308 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn308 struct {
+		r     idriverConn308
+		extra interface{}
+	}
+
+	idriverConn309 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn309 struct {
+		r     idriverConn309
+		extra interface{}
+	}
+
+	idriverConn310 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn310 struct {
+		r     idriverConn310
+		extra interface{}
+	}
+
+	idriverConn311 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn311 struct {
+		r     idriverConn311
+		extra interface{}
+	}
+
+	idriverConn312 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn312 struct {
+		r     idriverConn312
+		extra interface{}
+	}
+
+	idriverConn313 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn313 struct {
+		r     idriverConn313
+		extra interface{}
+	}
+
+	idriverConn314 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn314 struct {
+		r     idriverConn314
+		extra interface{}
+	}
+
+	idriverConn315 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn315 struct {
+		r     idriverConn315
+		extra interface{}
+	}
+
+	idriverConn316 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn316 struct {
+		r     idriverConn316
+		extra interface{}
+	}
+
+	idriverConn317 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn317 struct {
+		r     idriverConn317
+		extra interface{}
+	}
+
+	idriverConn318 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn318 struct {
+		r     idriverConn318
+		extra interface{}
+	}
+
+	idriverConn319 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn319 struct {
+		r     idriverConn319
+		extra interface{}
+	}
+
+	idriverConn320 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn320 struct {
+		r     idriverConn320
+		extra interface{}
+	}
+
+	idriverConn321 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn321 struct {
+		r     idriverConn321
+		extra interface{}
+	}
+
+	idriverConn322 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn322 struct {
+		r     idriverConn322
+		extra interface{}
+	}
+
+	idriverConn323 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn323 struct {
+		r     idriverConn323
+		extra interface{}
+	}
+
+	idriverConn324 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn324 struct {
+		r     idriverConn324
+		extra interface{}
+	}
+
+	idriverConn325 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn325 struct {
+		r     idriverConn325
+		extra interface{}
+	}
+
+	idriverConn326 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn326 struct {
+		r     idriverConn326
+		extra interface{}
+	}
+
+	idriverConn327 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn327 struct {
+		r     idriverConn327
+		extra interface{}
+	}
+
+	idriverConn328 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn328 struct {
+		r     idriverConn328
+		extra interface{}
+	}
+
+	idriverConn329 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn329 struct {
+		r     idriverConn329
+		extra interface{}
+	}
+
+	idriverConn330 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn330 struct {
+		r     idriverConn330
+		extra interface{}
+	}
+
+	idriverConn331 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn331 struct {
+		r     idriverConn331
+		extra interface{}
+	}
+
+	idriverConn332 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn332 struct {
+		r     idriverConn332
+		extra interface{}
+	}
+
+	idriverConn333 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn333 struct {
+		r     idriverConn333
+		extra interface{}
+	}
+
+	idriverConn334 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn334 struct {
+		r     idriverConn334
+		extra interface{}
+	}
+
+	idriverConn335 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn335 struct {
+		r     idriverConn335
+		extra interface{}
+	}
+
+	idriverConn336 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn336 struct {
+		r     idriverConn336
+		extra interface{}
+	}
+
+	idriverConn337 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn337 struct {
+		r     idriverConn337
+		extra interface{}
+	}
+
+	idriverConn338 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn338 struct {
+		r     idriverConn338
+		extra interface{}
+	}
+
+	idriverConn339 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn339 struct {
+		r     idriverConn339
+		extra interface{}
+	}
+
+	idriverConn340 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn340 struct {
+		r     idriverConn340
+		extra interface{}
+	}
+
+	idriverConn341 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn341 struct {
+		r     idriverConn341
+		extra interface{}
+	}
+
+	idriverConn342 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn342 struct {
+		r     idriverConn342
+		extra interface{}
+	}
+
+	idriverConn343 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn343 struct {
+		r     idriverConn343
+		extra interface{}
+	}
+
+	idriverConn344 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn344 struct {
+		r     idriverConn344
+		extra interface{}
+	}
+
+	idriverConn345 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn345 struct {
+		r     idriverConn345
+		extra interface{}
+	}
+
+	idriverConn346 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn346 struct {
+		r     idriverConn346
+		extra interface{}
+	}
+
+	idriverConn347 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn347 struct {
+		r     idriverConn347
+		extra interface{}
+	}
+
+	idriverConn348 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn348 struct {
+		r     idriverConn348
+		extra interface{}
+	}
+
+	idriverConn349 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn349 struct {
+		r     idriverConn349
+		extra interface{}
+	}
+
+	idriverConn350 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn350 struct {
+		r     idriverConn350
+		extra interface{}
+	}
+
+	idriverConn351 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn351 struct {
+		r     idriverConn351
+		extra interface{}
+	}
+
+	idriverConn352 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn352 struct {
+		r     idriverConn352
+		extra interface{}
+	}
+
+	idriverConn353 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn353 struct {
+		r     idriverConn353
+		extra interface{}
+	}
+
+	idriverConn354 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn354 struct {
+		r     idriverConn354
+		extra interface{}
+	}
+
+	idriverConn355 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn355 struct {
+		r     idriverConn355
+		extra interface{}
+	}
+
+	idriverConn356 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn356 struct {
+		r     idriverConn356
+		extra interface{}
+	}
+
+	idriverConn357 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn357 struct {
+		r     idriverConn357
+		extra interface{}
+	}
+
+	idriverConn358 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn358 struct {
+		r     idriverConn358
+		extra interface{}
+	}
+
+	idriverConn359 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn359 struct {
+		r     idriverConn359
+		extra interface{}
+	}
+
+	idriverConn360 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn360 struct {
+		r     idriverConn360
+		extra interface{}
+	}
+
+	idriverConn361 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn361 struct {
+		r     idriverConn361
+		extra interface{}
+	}
+
+	idriverConn362 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn362 struct {
+		r     idriverConn362
+		extra interface{}
+	}
+
+	idriverConn363 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn363 struct {
+		r     idriverConn363
+		extra interface{}
+	}
+
+	idriverConn364 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn364 struct {
+		r     idriverConn364
+		extra interface{}
+	}
+
+	idriverConn365 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn365 struct {
+		r     idriverConn365
+		extra interface{}
+	}
+
+	idriverConn366 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn366 struct {
+		r     idriverConn366
+		extra interface{}
+	}
+
+	idriverConn367 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn367 struct {
+		r     idriverConn367
+		extra interface{}
+	}
+
+	idriverConn368 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn368 struct {
+		r     idriverConn368
+		extra interface{}
+	}
+
+	idriverConn369 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn369 struct {
+		r     idriverConn369
+		extra interface{}
+	}
+
+	idriverConn370 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn370 struct {
+		r     idriverConn370
+		extra interface{}
+	}
+
+	idriverConn371 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn371 struct {
+		r     idriverConn371
+		extra interface{}
+	}
+
+	idriverConn372 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn372 struct {
+		r     idriverConn372
+		extra interface{}
+	}
+
+	idriverConn373 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn373 struct {
+		r     idriverConn373
+		extra interface{}
+	}
+
+	idriverConn374 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn374 struct {
+		r     idriverConn374
+		extra interface{}
+	}
+
+	idriverConn375 interface {
+		driver.Conn
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn375 struct {
+		r     idriverConn375
+		extra interface{}
+	}
+
+	idriverConn376 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn376 struct {
+		r     idriverConn376
+		extra interface{}
+	}
+
+	idriverConn377 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn377 struct {
+		r     idriverConn377
+		extra interface{}
+	}
+
+	idriverConn378 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn378 struct {
+		r     idriverConn378
+		extra interface{}
+	}
+
+	idriverConn379 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn379 struct {
+		r     idriverConn379
+		extra interface{}
+	}
+
+	idriverConn380 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn380 struct {
+		r     idriverConn380
+		extra interface{}
+	}
+
+	idriverConn381 interface {
+		driver.Conn
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn381 struct {
+		r     idriverConn381
+		extra interface{}
+	}
+
+	idriverConn382 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+	}
+
+	tdriverConn382 struct {
+		r     idriverConn382
+		extra interface{}
+	}
+
+	idriverConn383 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+	}
+
+	tdriverConn383 struct {
+		r     idriverConn383
+		extra interface{}
+	}
+
+	idriverConn384 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+	}
+
+	tdriverConn384 struct {
+		r     idriverConn384
+		extra interface{}
+	}
+
+	idriverConn385 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.SessionResetter
+	}
+
+	tdriverConn385 struct {
+		r     idriverConn385
+		extra interface{}
+	}
+
+	idriverConn386 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn386 struct {
+		r     idriverConn386
+		extra interface{}
+	}
+
+	idriverConn387 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn387 struct {
+		r     idriverConn387
+		extra interface{}
+	}
+
+	idriverConn388 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn388 struct {
+		r     idriverConn388
+		extra interface{}
+	}
+
+	idriverConn389 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn389 struct {
+		r     idriverConn389
+		extra interface{}
+	}
+
+	idriverConn390 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn390 struct {
+		r     idriverConn390
+		extra interface{}
+	}
+
+	idriverConn391 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn391 struct {
+		r     idriverConn391
+		extra interface{}
+	}
+
+	idriverConn392 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn392 struct {
+		r     idriverConn392
+		extra interface{}
+	}
+
+	idriverConn393 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn393 struct {
+		r     idriverConn393
+		extra interface{}
+	}
+
+	idriverConn394 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn394 struct {
+		r     idriverConn394
+		extra interface{}
+	}
+
+	idriverConn395 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn395 struct {
+		r     idriverConn395
+		extra interface{}
+	}
+
+	idriverConn396 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn396 struct {
+		r     idriverConn396
+		extra interface{}
+	}
+
+	idriverConn397 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn397 struct {
+		r     idriverConn397
+		extra interface{}
+	}
+
+	idriverConn398 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn398 struct {
+		r     idriverConn398
+		extra interface{}
+	}
+
+	idriverConn399 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn399 struct {
+		r     idriverConn399
+		extra interface{}
+	}
+
+	idriverConn400 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn400 struct {
+		r     idriverConn400
+		extra interface{}
+	}
+
+	idriverConn401 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn401 struct {
+		r     idriverConn401
+		extra interface{}
+	}
+
+	idriverConn402 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn402 struct {
+		r     idriverConn402
+		extra interface{}
+	}
+
+	idriverConn403 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn403 struct {
+		r     idriverConn403
+		extra interface{}
+	}
+
+	idriverConn404 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn404 struct {
+		r     idriverConn404
+		extra interface{}
+	}
+
+	idriverConn405 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn405 struct {
+		r     idriverConn405
+		extra interface{}
+	}
+
+	idriverConn406 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn406 struct {
+		r     idriverConn406
+		extra interface{}
+	}
+
+	idriverConn407 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn407 struct {
+		r     idriverConn407
+		extra interface{}
+	}
+
+	idriverConn408 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn408 struct {
+		r     idriverConn408
+		extra interface{}
+	}
+
+	idriverConn409 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn409 struct {
+		r     idriverConn409
+		extra interface{}
+	}
+
+	idriverConn410 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn410 struct {
+		r     idriverConn410
+		extra interface{}
+	}
+
+	idriverConn411 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn411 struct {
+		r     idriverConn411
+		extra interface{}
+	}
+
+	idriverConn412 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn412 struct {
+		r     idriverConn412
+		extra interface{}
+	}
+
+	idriverConn413 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn413 struct {
+		r     idriverConn413
+		extra interface{}
+	}
+
+	idriverConn414 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn414 struct {
+		r     idriverConn414
+		extra interface{}
+	}
+
+	idriverConn415 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn415 struct {
+		r     idriverConn415
+		extra interface{}
+	}
+
+	idriverConn416 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn416 struct {
+		r     idriverConn416
+		extra interface{}
+	}
+
+	idriverConn417 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn417 struct {
+		r     idriverConn417
+		extra interface{}
+	}
+
+	idriverConn418 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn418 struct {
+		r     idriverConn418
+		extra interface{}
+	}
+
+	idriverConn419 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn419 struct {
+		r     idriverConn419
+		extra interface{}
+	}
+
+	idriverConn420 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn420 struct {
+		r     idriverConn420
+		extra interface{}
+	}
+
+	idriverConn421 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn421 struct {
+		r     idriverConn421
+		extra interface{}
+	}
+
+	idriverConn422 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn422 struct {
+		r     idriverConn422
+		extra interface{}
+	}
+
+	idriverConn423 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn423 struct {
+		r     idriverConn423
+		extra interface{}
+	}
+
+	idriverConn424 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn424 struct {
+		r     idriverConn424
+		extra interface{}
+	}
+
+	idriverConn425 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn425 struct {
+		r     idriverConn425
+		extra interface{}
+	}
+
+	idriverConn426 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn426 struct {
+		r     idriverConn426
+		extra interface{}
+	}
+
+	idriverConn427 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn427 struct {
+		r     idriverConn427
+		extra interface{}
+	}
+
+	idriverConn428 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn428 struct {
+		r     idriverConn428
+		extra interface{}
+	}
+
+	idriverConn429 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn429 struct {
+		r     idriverConn429
+		extra interface{}
+	}
+
+	idriverConn430 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn430 struct {
+		r     idriverConn430
+		extra interface{}
+	}
+
+	idriverConn431 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn431 struct {
+		r     idriverConn431
+		extra interface{}
+	}
+
+	idriverConn432 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn432 struct {
+		r     idriverConn432
+		extra interface{}
+	}
+
+	idriverConn433 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn433 struct {
+		r     idriverConn433
+		extra interface{}
+	}
+
+	idriverConn434 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn434 struct {
+		r     idriverConn434
+		extra interface{}
+	}
+
+	idriverConn435 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn435 struct {
+		r     idriverConn435
+		extra interface{}
+	}
+
+	idriverConn436 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn436 struct {
+		r     idriverConn436
+		extra interface{}
+	}
+
+	idriverConn437 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn437 struct {
+		r     idriverConn437
+		extra interface{}
+	}
+
+	idriverConn438 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn438 struct {
+		r     idriverConn438
+		extra interface{}
+	}
+
+	idriverConn439 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn439 struct {
+		r     idriverConn439
+		extra interface{}
+	}
+
+	idriverConn440 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn440 struct {
+		r     idriverConn440
+		extra interface{}
+	}
+
+	idriverConn441 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn441 struct {
+		r     idriverConn441
+		extra interface{}
+	}
+
+	idriverConn442 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn442 struct {
+		r     idriverConn442
+		extra interface{}
+	}
+
+	idriverConn443 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn443 struct {
+		r     idriverConn443
+		extra interface{}
+	}
+
+	idriverConn444 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn444 struct {
+		r     idriverConn444
+		extra interface{}
+	}
+
+	idriverConn445 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn445 struct {
+		r     idriverConn445
+		extra interface{}
+	}
+
+	idriverConn446 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn446 struct {
+		r     idriverConn446
+		extra interface{}
+	}
+
+	idriverConn447 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn447 struct {
+		r     idriverConn447
+		extra interface{}
+	}
+
+	idriverConn448 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn448 struct {
+		r     idriverConn448
+		extra interface{}
+	}
+
+	idriverConn449 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn449 struct {
+		r     idriverConn449
+		extra interface{}
+	}
+
+	idriverConn450 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn450 struct {
+		r     idriverConn450
+		extra interface{}
+	}
+
+	idriverConn451 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn451 struct {
+		r     idriverConn451
+		extra interface{}
+	}
+
+	idriverConn452 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn452 struct {
+		r     idriverConn452
+		extra interface{}
+	}
+
+	idriverConn453 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn453 struct {
+		r     idriverConn453
+		extra interface{}
+	}
+
+	idriverConn454 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn454 struct {
+		r     idriverConn454
+		extra interface{}
+	}
+
+	idriverConn455 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn455 struct {
+		r     idriverConn455
+		extra interface{}
+	}
+
+	idriverConn456 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn456 struct {
+		r     idriverConn456
+		extra interface{}
+	}
+
+	idriverConn457 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn457 struct {
+		r     idriverConn457
+		extra interface{}
+	}
+
+	idriverConn458 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn458 struct {
+		r     idriverConn458
+		extra interface{}
+	}
+
+	idriverConn459 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn459 struct {
+		r     idriverConn459
+		extra interface{}
+	}
+
+	idriverConn460 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn460 struct {
+		r     idriverConn460
+		extra interface{}
+	}
+
+	idriverConn461 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn461 struct {
+		r     idriverConn461
+		extra interface{}
+	}
+
+	idriverConn462 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn462 struct {
+		r     idriverConn462
+		extra interface{}
+	}
+
+	idriverConn463 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn463 struct {
+		r     idriverConn463
+		extra interface{}
+	}
+
+	idriverConn464 interface {
+		driver.Conn
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn464 struct {
+		r     idriverConn464
+		extra interface{}
+	}
+
+	idriverConn465 interface {
+		driver.Conn
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn465 struct {
+		r     idriverConn465
+		extra interface{}
+	}
+
+	idriverConn466 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+	}
+
+	tdriverConn466 struct {
+		r     idriverConn466
+		extra interface{}
+	}
+
+	idriverConn467 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+	}
+
+	tdriverConn467 struct {
+		r     idriverConn467
+		extra interface{}
+	}
+
+	idriverConn468 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.SessionResetter
+	}
+
+	tdriverConn468 struct {
+		r     idriverConn468
+		extra interface{}
+	}
+
+	idriverConn469 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn469 struct {
+		r     idriverConn469
+		extra interface{}
+	}
+
+	idriverConn470 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn470 struct {
+		r     idriverConn470
+		extra interface{}
+	}
+
+	idriverConn471 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn471 struct {
+		r     idriverConn471
+		extra interface{}
+	}
+
+	idriverConn472 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn472 struct {
+		r     idriverConn472
+		extra interface{}
+	}
+
+	idriverConn473 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn473 struct {
+		r     idriverConn473
+		extra interface{}
+	}
+
+	idriverConn474 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn474 struct {
+		r     idriverConn474
+		extra interface{}
+	}
+
+	idriverConn475 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn475 struct {
+		r     idriverConn475
+		extra interface{}
+	}
+
+	idriverConn476 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn476 struct {
+		r     idriverConn476
+		extra interface{}
+	}
+
+	idriverConn477 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn477 struct {
+		r     idriverConn477
+		extra interface{}
+	}
+
+	idriverConn478 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn478 struct {
+		r     idriverConn478
+		extra interface{}
+	}
+
+	idriverConn479 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn479 struct {
+		r     idriverConn479
+		extra interface{}
+	}
+
+	idriverConn480 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn480 struct {
+		r     idriverConn480
+		extra interface{}
+	}
+
+	idriverConn481 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn481 struct {
+		r     idriverConn481
+		extra interface{}
+	}
+
+	idriverConn482 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn482 struct {
+		r     idriverConn482
+		extra interface{}
+	}
+
+	idriverConn483 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn483 struct {
+		r     idriverConn483
+		extra interface{}
+	}
+
+	idriverConn484 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn484 struct {
+		r     idriverConn484
+		extra interface{}
+	}
+
+	idriverConn485 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn485 struct {
+		r     idriverConn485
+		extra interface{}
+	}
+
+	idriverConn486 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn486 struct {
+		r     idriverConn486
+		extra interface{}
+	}
+
+	idriverConn487 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn487 struct {
+		r     idriverConn487
+		extra interface{}
+	}
+
+	idriverConn488 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn488 struct {
+		r     idriverConn488
+		extra interface{}
+	}
+
+	idriverConn489 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn489 struct {
+		r     idriverConn489
+		extra interface{}
+	}
+
+	idriverConn490 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn490 struct {
+		r     idriverConn490
+		extra interface{}
+	}
+
+	idriverConn491 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn491 struct {
+		r     idriverConn491
+		extra interface{}
+	}
+
+	idriverConn492 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn492 struct {
+		r     idriverConn492
+		extra interface{}
+	}
+
+	idriverConn493 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn493 struct {
+		r     idriverConn493
+		extra interface{}
+	}
+
+	idriverConn494 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn494 struct {
+		r     idriverConn494
+		extra interface{}
+	}
+
+	idriverConn495 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn495 struct {
+		r     idriverConn495
+		extra interface{}
+	}
+
+	idriverConn496 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn496 struct {
+		r     idriverConn496
+		extra interface{}
+	}
+
+	idriverConn497 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn497 struct {
+		r     idriverConn497
+		extra interface{}
+	}
+
+	idriverConn498 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn498 struct {
+		r     idriverConn498
+		extra interface{}
+	}
+
+	idriverConn499 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn499 struct {
+		r     idriverConn499
+		extra interface{}
+	}
+
+	idriverConn500 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn500 struct {
+		r     idriverConn500
+		extra interface{}
+	}
+
+	idriverConn501 interface {
+		driver.Conn
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn501 struct {
+		r     idriverConn501
+		extra interface{}
+	}
+
+	idriverConn502 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+	}
+
+	tdriverConn502 struct {
+		r     idriverConn502
+		extra interface{}
+	}
+
+	idriverConn503 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.SessionResetter
+	}
+
+	tdriverConn503 struct {
+		r     idriverConn503
+		extra interface{}
+	}
+
+	idriverConn504 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn504 struct {
+		r     idriverConn504
+		extra interface{}
+	}
+
+	idriverConn505 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn505 struct {
+		r     idriverConn505
+		extra interface{}
+	}
+
+	idriverConn506 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn506 struct {
+		r     idriverConn506
+		extra interface{}
+	}
+
+	idriverConn507 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn507 struct {
+		r     idriverConn507
+		extra interface{}
+	}
+
+	idriverConn508 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn508 struct {
+		r     idriverConn508
+		extra interface{}
+	}
+
+	idriverConn509 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn509 struct {
+		r     idriverConn509
+		extra interface{}
+	}
+
+	idriverConn510 interface {
+		driver.Conn
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn510 struct {
+		r     idriverConn510
+		extra interface{}
+	}
+
+	idriverConn511 interface {
+		driver.Conn
+		driver.ConnBeginTx
+		driver.ConnPrepareContext
+		driver.Execer
+		driver.ExecerContext
+		driver.NamedValueChecker
+		driver.Pinger
+		driver.Queryer
+		driver.QueryerContext
+		driver.SessionResetter
+	}
+
+	tdriverConn511 struct {
+		r     idriverConn511
+		extra interface{}
+	}
+)
+
+var (
+	_ driver.Conn               = &tdriverConn0{}
+	_ driver.Conn               = &tdriverConn1{}
+	_ driver.ConnBeginTx        = &tdriverConn1{}
+	_ driver.Conn               = &tdriverConn2{}
+	_ driver.ConnPrepareContext = &tdriverConn2{}
+	_ driver.Conn               = &tdriverConn3{}
+	_ driver.Execer             = &tdriverConn3{}
+	_ driver.Conn               = &tdriverConn4{}
+	_ driver.ExecerContext      = &tdriverConn4{}
+	_ driver.Conn               = &tdriverConn5{}
+	_ driver.NamedValueChecker  = &tdriverConn5{}
+	_ driver.Conn               = &tdriverConn6{}
+	_ driver.Pinger             = &tdriverConn6{}
+	_ driver.Conn               = &tdriverConn7{}
+	_ driver.Queryer            = &tdriverConn7{}
+	_ driver.Conn               = &tdriverConn8{}
+	_ driver.QueryerContext     = &tdriverConn8{}
+	_ driver.Conn               = &tdriverConn9{}
+	_ driver.SessionResetter    = &tdriverConn9{}
+	_ driver.Conn               = &tdriverConn10{}
+	_ driver.ConnBeginTx        = &tdriverConn10{}
+	_ driver.ConnPrepareContext = &tdriverConn10{}
+	_ driver.Conn               = &tdriverConn11{}
+	_ driver.ConnBeginTx        = &tdriverConn11{}
+	_ driver.Execer             = &tdriverConn11{}
+	_ driver.Conn               = &tdriverConn12{}
+	_ driver.ConnBeginTx        = &tdriverConn12{}
+	_ driver.ExecerContext      = &tdriverConn12{}
+	_ driver.Conn               = &tdriverConn13{}
+	_ driver.ConnBeginTx        = &tdriverConn13{}
+	_ driver.NamedValueChecker  = &tdriverConn13{}
+	_ driver.Conn               = &tdriverConn14{}
+	_ driver.ConnBeginTx        = &tdriverConn14{}
+	_ driver.Pinger             = &tdriverConn14{}
+	_ driver.Conn               = &tdriverConn15{}
+	_ driver.ConnBeginTx        = &tdriverConn15{}
+	_ driver.Queryer            = &tdriverConn15{}
+	_ driver.Conn               = &tdriverConn16{}
+	_ driver.ConnBeginTx        = &tdriverConn16{}
+	_ driver.QueryerContext     = &tdriverConn16{}
+	_ driver.Conn               = &tdriverConn17{}
+	_ driver.ConnBeginTx        = &tdriverConn17{}
+	_ driver.SessionResetter    = &tdriverConn17{}
+	_ driver.Conn               = &tdriverConn18{}
+	_ driver.ConnPrepareContext = &tdriverConn18{}
+	_ driver.Execer             = &tdriverConn18{}
+	_ driver.Conn               = &tdriverConn19{}
+	_ driver.ConnPrepareContext = &tdriverConn19{}
+	_ driver.ExecerContext      = &tdriverConn19{}
+	_ driver.Conn               = &tdriverConn20{}
+	_ driver.ConnPrepareContext = &tdriverConn20{}
+	_ driver.NamedValueChecker  = &tdriverConn20{}
+	_ driver.Conn               = &tdriverConn21{}
+	_ driver.ConnPrepareContext = &tdriverConn21{}
+	_ driver.Pinger             = &tdriverConn21{}
+	_ driver.Conn               = &tdriverConn22{}
+	_ driver.ConnPrepareContext = &tdriverConn22{}
+	_ driver.Queryer            = &tdriverConn22{}
+	_ driver.Conn               = &tdriverConn23{}
+	_ driver.ConnPrepareContext = &tdriverConn23{}
+	_ driver.QueryerContext     = &tdriverConn23{}
+	_ driver.Conn               = &tdriverConn24{}
+	_ driver.ConnPrepareContext = &tdriverConn24{}
+	_ driver.SessionResetter    = &tdriverConn24{}
+	_ driver.Conn               = &tdriverConn25{}
+	_ driver.Execer             = &tdriverConn25{}
+	_ driver.ExecerContext      = &tdriverConn25{}
+	_ driver.Conn               = &tdriverConn26{}
+	_ driver.Execer             = &tdriverConn26{}
+	_ driver.NamedValueChecker  = &tdriverConn26{}
+	_ driver.Conn               = &tdriverConn27{}
+	_ driver.Execer             = &tdriverConn27{}
+	_ driver.Pinger             = &tdriverConn27{}
+	_ driver.Conn               = &tdriverConn28{}
+	_ driver.Execer             = &tdriverConn28{}
+	_ driver.Queryer            = &tdriverConn28{}
+	_ driver.Conn               = &tdriverConn29{}
+	_ driver.Execer             = &tdriverConn29{}
+	_ driver.QueryerContext     = &tdriverConn29{}
+	_ driver.Conn               = &tdriverConn30{}
+	_ driver.Execer             = &tdriverConn30{}
+	_ driver.SessionResetter    = &tdriverConn30{}
+	_ driver.Conn               = &tdriverConn31{}
+	_ driver.ExecerContext      = &tdriverConn31{}
+	_ driver.NamedValueChecker  = &tdriverConn31{}
+	_ driver.Conn               = &tdriverConn32{}
+	_ driver.ExecerContext      = &tdriverConn32{}
+	_ driver.Pinger             = &tdriverConn32{}
+	_ driver.Conn               = &tdriverConn33{}
+	_ driver.ExecerContext      = &tdriverConn33{}
+	_ driver.Queryer            = &tdriverConn33{}
+	_ driver.Conn               = &tdriverConn34{}
+	_ driver.ExecerContext      = &tdriverConn34{}
+	_ driver.QueryerContext     = &tdriverConn34{}
+	_ driver.Conn               = &tdriverConn35{}
+	_ driver.ExecerContext      = &tdriverConn35{}
+	_ driver.SessionResetter    = &tdriverConn35{}
+	_ driver.Conn               = &tdriverConn36{}
+	_ driver.NamedValueChecker  = &tdriverConn36{}
+	_ driver.Pinger             = &tdriverConn36{}
+	_ driver.Conn               = &tdriverConn37{}
+	_ driver.NamedValueChecker  = &tdriverConn37{}
+	_ driver.Queryer            = &tdriverConn37{}
+	_ driver.Conn               = &tdriverConn38{}
+	_ driver.NamedValueChecker  = &tdriverConn38{}
+	_ driver.QueryerContext     = &tdriverConn38{}
+	_ driver.Conn               = &tdriverConn39{}
+	_ driver.NamedValueChecker  = &tdriverConn39{}
+	_ driver.SessionResetter    = &tdriverConn39{}
+	_ driver.Conn               = &tdriverConn40{}
+	_ driver.Pinger             = &tdriverConn40{}
+	_ driver.Queryer            = &tdriverConn40{}
+	_ driver.Conn               = &tdriverConn41{}
+	_ driver.Pinger             = &tdriverConn41{}
+	_ driver.QueryerContext     = &tdriverConn41{}
+	_ driver.Conn               = &tdriverConn42{}
+	_ driver.Pinger             = &tdriverConn42{}
+	_ driver.SessionResetter    = &tdriverConn42{}
+	_ driver.Conn               = &tdriverConn43{}
+	_ driver.Queryer            = &tdriverConn43{}
+	_ driver.QueryerContext     = &tdriverConn43{}
+	_ driver.Conn               = &tdriverConn44{}
+	_ driver.Queryer            = &tdriverConn44{}
+	_ driver.SessionResetter    = &tdriverConn44{}
+	_ driver.Conn               = &tdriverConn45{}
+	_ driver.QueryerContext     = &tdriverConn45{}
+	_ driver.SessionResetter    = &tdriverConn45{}
+	_ driver.Conn               = &tdriverConn46{}
+	_ driver.ConnBeginTx        = &tdriverConn46{}
+	_ driver.ConnPrepareContext = &tdriverConn46{}
+	_ driver.Execer             = &tdriverConn46{}
+	_ driver.Conn               = &tdriverConn47{}
+	_ driver.ConnBeginTx        = &tdriverConn47{}
+	_ driver.ConnPrepareContext = &tdriverConn47{}
+	_ driver.ExecerContext      = &tdriverConn47{}
+	_ driver.Conn               = &tdriverConn48{}
+	_ driver.ConnBeginTx        = &tdriverConn48{}
+	_ driver.ConnPrepareContext = &tdriverConn48{}
+	_ driver.NamedValueChecker  = &tdriverConn48{}
+	_ driver.Conn               = &tdriverConn49{}
+	_ driver.ConnBeginTx        = &tdriverConn49{}
+	_ driver.ConnPrepareContext = &tdriverConn49{}
+	_ driver.Pinger             = &tdriverConn49{}
+	_ driver.Conn               = &tdriverConn50{}
+	_ driver.ConnBeginTx        = &tdriverConn50{}
+	_ driver.ConnPrepareContext = &tdriverConn50{}
+	_ driver.Queryer            = &tdriverConn50{}
+	_ driver.Conn               = &tdriverConn51{}
+	_ driver.ConnBeginTx        = &tdriverConn51{}
+	_ driver.ConnPrepareContext = &tdriverConn51{}
+	_ driver.QueryerContext     = &tdriverConn51{}
+	_ driver.Conn               = &tdriverConn52{}
+	_ driver.ConnBeginTx        = &tdriverConn52{}
+	_ driver.ConnPrepareContext = &tdriverConn52{}
+	_ driver.SessionResetter    = &tdriverConn52{}
+	_ driver.Conn               = &tdriverConn53{}
+	_ driver.ConnBeginTx        = &tdriverConn53{}
+	_ driver.Execer             = &tdriverConn53{}
+	_ driver.ExecerContext      = &tdriverConn53{}
+	_ driver.Conn               = &tdriverConn54{}
+	_ driver.ConnBeginTx        = &tdriverConn54{}
+	_ driver.Execer             = &tdriverConn54{}
+	_ driver.NamedValueChecker  = &tdriverConn54{}
+	_ driver.Conn               = &tdriverConn55{}
+	_ driver.ConnBeginTx        = &tdriverConn55{}
+	_ driver.Execer             = &tdriverConn55{}
+	_ driver.Pinger             = &tdriverConn55{}
+	_ driver.Conn               = &tdriverConn56{}
+	_ driver.ConnBeginTx        = &tdriverConn56{}
+	_ driver.Execer             = &tdriverConn56{}
+	_ driver.Queryer            = &tdriverConn56{}
+	_ driver.Conn               = &tdriverConn57{}
+	_ driver.ConnBeginTx        = &tdriverConn57{}
+	_ driver.Execer             = &tdriverConn57{}
+	_ driver.QueryerContext     = &tdriverConn57{}
+	_ driver.Conn               = &tdriverConn58{}
+	_ driver.ConnBeginTx        = &tdriverConn58{}
+	_ driver.Execer             = &tdriverConn58{}
+	_ driver.SessionResetter    = &tdriverConn58{}
+	_ driver.Conn               = &tdriverConn59{}
+	_ driver.ConnBeginTx        = &tdriverConn59{}
+	_ driver.ExecerContext      = &tdriverConn59{}
+	_ driver.NamedValueChecker  = &tdriverConn59{}
+	_ driver.Conn               = &tdriverConn60{}
+	_ driver.ConnBeginTx        = &tdriverConn60{}
+	_ driver.ExecerContext      = &tdriverConn60{}
+	_ driver.Pinger             = &tdriverConn60{}
+	_ driver.Conn               = &tdriverConn61{}
+	_ driver.ConnBeginTx        = &tdriverConn61{}
+	_ driver.ExecerContext      = &tdriverConn61{}
+	_ driver.Queryer            = &tdriverConn61{}
+	_ driver.Conn               = &tdriverConn62{}
+	_ driver.ConnBeginTx        = &tdriverConn62{}
+	_ driver.ExecerContext      = &tdriverConn62{}
+	_ driver.QueryerContext     = &tdriverConn62{}
+	_ driver.Conn               = &tdriverConn63{}
+	_ driver.ConnBeginTx        = &tdriverConn63{}
+	_ driver.ExecerContext      = &tdriverConn63{}
+	_ driver.SessionResetter    = &tdriverConn63{}
+	_ driver.Conn               = &tdriverConn64{}
+	_ driver.ConnBeginTx        = &tdriverConn64{}
+	_ driver.NamedValueChecker  = &tdriverConn64{}
+	_ driver.Pinger             = &tdriverConn64{}
+	_ driver.Conn               = &tdriverConn65{}
+	_ driver.ConnBeginTx        = &tdriverConn65{}
+	_ driver.NamedValueChecker  = &tdriverConn65{}
+	_ driver.Queryer            = &tdriverConn65{}
+	_ driver.Conn               = &tdriverConn66{}
+	_ driver.ConnBeginTx        = &tdriverConn66{}
+	_ driver.NamedValueChecker  = &tdriverConn66{}
+	_ driver.QueryerContext     = &tdriverConn66{}
+	_ driver.Conn               = &tdriverConn67{}
+	_ driver.ConnBeginTx        = &tdriverConn67{}
+	_ driver.NamedValueChecker  = &tdriverConn67{}
+	_ driver.SessionResetter    = &tdriverConn67{}
+	_ driver.Conn               = &tdriverConn68{}
+	_ driver.ConnBeginTx        = &tdriverConn68{}
+	_ driver.Pinger             = &tdriverConn68{}
+	_ driver.Queryer            = &tdriverConn68{}
+	_ driver.Conn               = &tdriverConn69{}
+	_ driver.ConnBeginTx        = &tdriverConn69{}
+	_ driver.Pinger             = &tdriverConn69{}
+	_ driver.QueryerContext     = &tdriverConn69{}
+	_ driver.Conn               = &tdriverConn70{}
+	_ driver.ConnBeginTx        = &tdriverConn70{}
+	_ driver.Pinger             = &tdriverConn70{}
+	_ driver.SessionResetter    = &tdriverConn70{}
+	_ driver.Conn               = &tdriverConn71{}
+	_ driver.ConnBeginTx        = &tdriverConn71{}
+	_ driver.Queryer            = &tdriverConn71{}
+	_ driver.QueryerContext     = &tdriverConn71{}
+	_ driver.Conn               = &tdriverConn72{}
+	_ driver.ConnBeginTx        = &tdriverConn72{}
+	_ driver.Queryer            = &tdriverConn72{}
+	_ driver.SessionResetter    = &tdriverConn72{}
+	_ driver.Conn               = &tdriverConn73{}
+	_ driver.ConnBeginTx        = &tdriverConn73{}
+	_ driver.QueryerContext     = &tdriverConn73{}
+	_ driver.SessionResetter    = &tdriverConn73{}
+	_ driver.Conn               = &tdriverConn74{}
+	_ driver.ConnPrepareContext = &tdriverConn74{}
+	_ driver.Execer             = &tdriverConn74{}
+	_ driver.ExecerContext      = &tdriverConn74{}
+	_ driver.Conn               = &tdriverConn75{}
+	_ driver.ConnPrepareContext = &tdriverConn75{}
+	_ driver.Execer             = &tdriverConn75{}
+	_ driver.NamedValueChecker  = &tdriverConn75{}
+	_ driver.Conn               = &tdriverConn76{}
+	_ driver.ConnPrepareContext = &tdriverConn76{}
+	_ driver.Execer             = &tdriverConn76{}
+	_ driver.Pinger             = &tdriverConn76{}
+	_ driver.Conn               = &tdriverConn77{}
+	_ driver.ConnPrepareContext = &tdriverConn77{}
+	_ driver.Execer             = &tdriverConn77{}
+	_ driver.Queryer            = &tdriverConn77{}
+	_ driver.Conn               = &tdriverConn78{}
+	_ driver.ConnPrepareContext = &tdriverConn78{}
+	_ driver.Execer             = &tdriverConn78{}
+	_ driver.QueryerContext     = &tdriverConn78{}
+	_ driver.Conn               = &tdriverConn79{}
+	_ driver.ConnPrepareContext = &tdriverConn79{}
+	_ driver.Execer             = &tdriverConn79{}
+	_ driver.SessionResetter    = &tdriverConn79{}
+	_ driver.Conn               = &tdriverConn80{}
+	_ driver.ConnPrepareContext = &tdriverConn80{}
+	_ driver.ExecerContext      = &tdriverConn80{}
+	_ driver.NamedValueChecker  = &tdriverConn80{}
+	_ driver.Conn               = &tdriverConn81{}
+	_ driver.ConnPrepareContext = &tdriverConn81{}
+	_ driver.ExecerContext      = &tdriverConn81{}
+	_ driver.Pinger             = &tdriverConn81{}
+	_ driver.Conn               = &tdriverConn82{}
+	_ driver.ConnPrepareContext = &tdriverConn82{}
+	_ driver.ExecerContext      = &tdriverConn82{}
+	_ driver.Queryer            = &tdriverConn82{}
+	_ driver.Conn               = &tdriverConn83{}
+	_ driver.ConnPrepareContext = &tdriverConn83{}
+	_ driver.ExecerContext      = &tdriverConn83{}
+	_ driver.QueryerContext     = &tdriverConn83{}
+	_ driver.Conn               = &tdriverConn84{}
+	_ driver.ConnPrepareContext = &tdriverConn84{}
+	_ driver.ExecerContext      = &tdriverConn84{}
+	_ driver.SessionResetter    = &tdriverConn84{}
+	_ driver.Conn               = &tdriverConn85{}
+	_ driver.ConnPrepareContext = &tdriverConn85{}
+	_ driver.NamedValueChecker  = &tdriverConn85{}
+	_ driver.Pinger             = &tdriverConn85{}
+	_ driver.Conn               = &tdriverConn86{}
+	_ driver.ConnPrepareContext = &tdriverConn86{}
+	_ driver.NamedValueChecker  = &tdriverConn86{}
+	_ driver.Queryer            = &tdriverConn86{}
+	_ driver.Conn               = &tdriverConn87{}
+	_ driver.ConnPrepareContext = &tdriverConn87{}
+	_ driver.NamedValueChecker  = &tdriverConn87{}
+	_ driver.QueryerContext     = &tdriverConn87{}
+	_ driver.Conn               = &tdriverConn88{}
+	_ driver.ConnPrepareContext = &tdriverConn88{}
+	_ driver.NamedValueChecker  = &tdriverConn88{}
+	_ driver.SessionResetter    = &tdriverConn88{}
+	_ driver.Conn               = &tdriverConn89{}
+	_ driver.ConnPrepareContext = &tdriverConn89{}
+	_ driver.Pinger             = &tdriverConn89{}
+	_ driver.Queryer            = &tdriverConn89{}
+	_ driver.Conn               = &tdriverConn90{}
+	_ driver.ConnPrepareContext = &tdriverConn90{}
+	_ driver.Pinger             = &tdriverConn90{}
+	_ driver.QueryerContext     = &tdriverConn90{}
+	_ driver.Conn               = &tdriverConn91{}
+	_ driver.ConnPrepareContext = &tdriverConn91{}
+	_ driver.Pinger             = &tdriverConn91{}
+	_ driver.SessionResetter    = &tdriverConn91{}
+	_ driver.Conn               = &tdriverConn92{}
+	_ driver.ConnPrepareContext = &tdriverConn92{}
+	_ driver.Queryer            = &tdriverConn92{}
+	_ driver.QueryerContext     = &tdriverConn92{}
+	_ driver.Conn               = &tdriverConn93{}
+	_ driver.ConnPrepareContext = &tdriverConn93{}
+	_ driver.Queryer            = &tdriverConn93{}
+	_ driver.SessionResetter    = &tdriverConn93{}
+	_ driver.Conn               = &tdriverConn94{}
+	_ driver.ConnPrepareContext = &tdriverConn94{}
+	_ driver.QueryerContext     = &tdriverConn94{}
+	_ driver.SessionResetter    = &tdriverConn94{}
+	_ driver.Conn               = &tdriverConn95{}
+	_ driver.Execer             = &tdriverConn95{}
+	_ driver.ExecerContext      = &tdriverConn95{}
+	_ driver.NamedValueChecker  = &tdriverConn95{}
+	_ driver.Conn               = &tdriverConn96{}
+	_ driver.Execer             = &tdriverConn96{}
+	_ driver.ExecerContext      = &tdriverConn96{}
+	_ driver.Pinger             = &tdriverConn96{}
+	_ driver.Conn               = &tdriverConn97{}
+	_ driver.Execer             = &tdriverConn97{}
+	_ driver.ExecerContext      = &tdriverConn97{}
+	_ driver.Queryer            = &tdriverConn97{}
+	_ driver.Conn               = &tdriverConn98{}
+	_ driver.Execer             = &tdriverConn98{}
+	_ driver.ExecerContext      = &tdriverConn98{}
+	_ driver.QueryerContext     = &tdriverConn98{}
+	_ driver.Conn               = &tdriverConn99{}
+	_ driver.Execer             = &tdriverConn99{}
+	_ driver.ExecerContext      = &tdriverConn99{}
+	_ driver.SessionResetter    = &tdriverConn99{}
+	_ driver.Conn               = &tdriverConn100{}
+	_ driver.Execer             = &tdriverConn100{}
+	_ driver.NamedValueChecker  = &tdriverConn100{}
+	_ driver.Pinger             = &tdriverConn100{}
+	_ driver.Conn               = &tdriverConn101{}
+	_ driver.Execer             = &tdriverConn101{}
+	_ driver.NamedValueChecker  = &tdriverConn101{}
+	_ driver.Queryer            = &tdriverConn101{}
+	_ driver.Conn               = &tdriverConn102{}
+	_ driver.Execer             = &tdriverConn102{}
+	_ driver.NamedValueChecker  = &tdriverConn102{}
+	_ driver.QueryerContext     = &tdriverConn102{}
+	_ driver.Conn               = &tdriverConn103{}
+	_ driver.Execer             = &tdriverConn103{}
+	_ driver.NamedValueChecker  = &tdriverConn103{}
+	_ driver.SessionResetter    = &tdriverConn103{}
+	_ driver.Conn               = &tdriverConn104{}
+	_ driver.Execer             = &tdriverConn104{}
+	_ driver.Pinger             = &tdriverConn104{}
+	_ driver.Queryer            = &tdriverConn104{}
+	_ driver.Conn               = &tdriverConn105{}
+	_ driver.Execer             = &tdriverConn105{}
+	_ driver.Pinger             = &tdriverConn105{}
+	_ driver.QueryerContext     = &tdriverConn105{}
+	_ driver.Conn               = &tdriverConn106{}
+	_ driver.Execer             = &tdriverConn106{}
+	_ driver.Pinger             = &tdriverConn106{}
+	_ driver.SessionResetter    = &tdriverConn106{}
+	_ driver.Conn               = &tdriverConn107{}
+	_ driver.Execer             = &tdriverConn107{}
+	_ driver.Queryer            = &tdriverConn107{}
+	_ driver.QueryerContext     = &tdriverConn107{}
+	_ driver.Conn               = &tdriverConn108{}
+	_ driver.Execer             = &tdriverConn108{}
+	_ driver.Queryer            = &tdriverConn108{}
+	_ driver.SessionResetter    = &tdriverConn108{}
+	_ driver.Conn               = &tdriverConn109{}
+	_ driver.Execer             = &tdriverConn109{}
+	_ driver.QueryerContext     = &tdriverConn109{}
+	_ driver.SessionResetter    = &tdriverConn109{}
+	_ driver.Conn               = &tdriverConn110{}
+	_ driver.ExecerContext      = &tdriverConn110{}
+	_ driver.NamedValueChecker  = &tdriverConn110{}
+	_ driver.Pinger             = &tdriverConn110{}
+	_ driver.Conn               = &tdriverConn111{}
+	_ driver.ExecerContext      = &tdriverConn111{}
+	_ driver.NamedValueChecker  = &tdriverConn111{}
+	_ driver.Queryer            = &tdriverConn111{}
+	_ driver.Conn               = &tdriverConn112{}
+	_ driver.ExecerContext      = &tdriverConn112{}
+	_ driver.NamedValueChecker  = &tdriverConn112{}
+	_ driver.QueryerContext     = &tdriverConn112{}
+	_ driver.Conn               = &tdriverConn113{}
+	_ driver.ExecerContext      = &tdriverConn113{}
+	_ driver.NamedValueChecker  = &tdriverConn113{}
+	_ driver.SessionResetter    = &tdriverConn113{}
+	_ driver.Conn               = &tdriverConn114{}
+	_ driver.ExecerContext      = &tdriverConn114{}
+	_ driver.Pinger             = &tdriverConn114{}
+	_ driver.Queryer            = &tdriverConn114{}
+	_ driver.Conn               = &tdriverConn115{}
+	_ driver.ExecerContext      = &tdriverConn115{}
+	_ driver.Pinger             = &tdriverConn115{}
+	_ driver.QueryerContext     = &tdriverConn115{}
+	_ driver.Conn               = &tdriverConn116{}
+	_ driver.ExecerContext      = &tdriverConn116{}
+	_ driver.Pinger             = &tdriverConn116{}
+	_ driver.SessionResetter    = &tdriverConn116{}
+	_ driver.Conn               = &tdriverConn117{}
+	_ driver.ExecerContext      = &tdriverConn117{}
+	_ driver.Queryer            = &tdriverConn117{}
+	_ driver.QueryerContext     = &tdriverConn117{}
+	_ driver.Conn               = &tdriverConn118{}
+	_ driver.ExecerContext      = &tdriverConn118{}
+	_ driver.Queryer            = &tdriverConn118{}
+	_ driver.SessionResetter    = &tdriverConn118{}
+	_ driver.Conn               = &tdriverConn119{}
+	_ driver.ExecerContext      = &tdriverConn119{}
+	_ driver.QueryerContext     = &tdriverConn119{}
+	_ driver.SessionResetter    = &tdriverConn119{}
+	_ driver.Conn               = &tdriverConn120{}
+	_ driver.NamedValueChecker  = &tdriverConn120{}
+	_ driver.Pinger             = &tdriverConn120{}
+	_ driver.Queryer            = &tdriverConn120{}
+	_ driver.Conn               = &tdriverConn121{}
+	_ driver.NamedValueChecker  = &tdriverConn121{}
+	_ driver.Pinger             = &tdriverConn121{}
+	_ driver.QueryerContext     = &tdriverConn121{}
+	_ driver.Conn               = &tdriverConn122{}
+	_ driver.NamedValueChecker  = &tdriverConn122{}
+	_ driver.Pinger             = &tdriverConn122{}
+	_ driver.SessionResetter    = &tdriverConn122{}
+	_ driver.Conn               = &tdriverConn123{}
+	_ driver.NamedValueChecker  = &tdriverConn123{}
+	_ driver.Queryer            = &tdriverConn123{}
+	_ driver.QueryerContext     = &tdriverConn123{}
+	_ driver.Conn               = &tdriverConn124{}
+	_ driver.NamedValueChecker  = &tdriverConn124{}
+	_ driver.Queryer            = &tdriverConn124{}
+	_ driver.SessionResetter    = &tdriverConn124{}
+	_ driver.Conn               = &tdriverConn125{}
+	_ driver.NamedValueChecker  = &tdriverConn125{}
+	_ driver.QueryerContext     = &tdriverConn125{}
+	_ driver.SessionResetter    = &tdriverConn125{}
+	_ driver.Conn               = &tdriverConn126{}
+	_ driver.Pinger             = &tdriverConn126{}
+	_ driver.Queryer            = &tdriverConn126{}
+	_ driver.QueryerContext     = &tdriverConn126{}
+	_ driver.Conn               = &tdriverConn127{}
+	_ driver.Pinger             = &tdriverConn127{}
+	_ driver.Queryer            = &tdriverConn127{}
+	_ driver.SessionResetter    = &tdriverConn127{}
+	_ driver.Conn               = &tdriverConn128{}
+	_ driver.Pinger             = &tdriverConn128{}
+	_ driver.QueryerContext     = &tdriverConn128{}
+	_ driver.SessionResetter    = &tdriverConn128{}
+	_ driver.Conn               = &tdriverConn129{}
+	_ driver.Queryer            = &tdriverConn129{}
+	_ driver.QueryerContext     = &tdriverConn129{}
+	_ driver.SessionResetter    = &tdriverConn129{}
+	_ driver.Conn               = &tdriverConn130{}
+	_ driver.ConnBeginTx        = &tdriverConn130{}
+	_ driver.ConnPrepareContext = &tdriverConn130{}
+	_ driver.Execer             = &tdriverConn130{}
+	_ driver.ExecerContext      = &tdriverConn130{}
+	_ driver.Conn               = &tdriverConn131{}
+	_ driver.ConnBeginTx        = &tdriverConn131{}
+	_ driver.ConnPrepareContext = &tdriverConn131{}
+	_ driver.Execer             = &tdriverConn131{}
+	_ driver.NamedValueChecker  = &tdriverConn131{}
+	_ driver.Conn               = &tdriverConn132{}
+	_ driver.ConnBeginTx        = &tdriverConn132{}
+	_ driver.ConnPrepareContext = &tdriverConn132{}
+	_ driver.Execer             = &tdriverConn132{}
+	_ driver.Pinger             = &tdriverConn132{}
+	_ driver.Conn               = &tdriverConn133{}
+	_ driver.ConnBeginTx        = &tdriverConn133{}
+	_ driver.ConnPrepareContext = &tdriverConn133{}
+	_ driver.Execer             = &tdriverConn133{}
+	_ driver.Queryer            = &tdriverConn133{}
+	_ driver.Conn               = &tdriverConn134{}
+	_ driver.ConnBeginTx        = &tdriverConn134{}
+	_ driver.ConnPrepareContext = &tdriverConn134{}
+	_ driver.Execer             = &tdriverConn134{}
+	_ driver.QueryerContext     = &tdriverConn134{}
+	_ driver.Conn               = &tdriverConn135{}
+	_ driver.ConnBeginTx        = &tdriverConn135{}
+	_ driver.ConnPrepareContext = &tdriverConn135{}
+	_ driver.Execer             = &tdriverConn135{}
+	_ driver.SessionResetter    = &tdriverConn135{}
+	_ driver.Conn               = &tdriverConn136{}
+	_ driver.ConnBeginTx        = &tdriverConn136{}
+	_ driver.ConnPrepareContext = &tdriverConn136{}
+	_ driver.ExecerContext      = &tdriverConn136{}
+	_ driver.NamedValueChecker  = &tdriverConn136{}
+	_ driver.Conn               = &tdriverConn137{}
+	_ driver.ConnBeginTx        = &tdriverConn137{}
+	_ driver.ConnPrepareContext = &tdriverConn137{}
+	_ driver.ExecerContext      = &tdriverConn137{}
+	_ driver.Pinger             = &tdriverConn137{}
+	_ driver.Conn               = &tdriverConn138{}
+	_ driver.ConnBeginTx        = &tdriverConn138{}
+	_ driver.ConnPrepareContext = &tdriverConn138{}
+	_ driver.ExecerContext      = &tdriverConn138{}
+	_ driver.Queryer            = &tdriverConn138{}
+	_ driver.Conn               = &tdriverConn139{}
+	_ driver.ConnBeginTx        = &tdriverConn139{}
+	_ driver.ConnPrepareContext = &tdriverConn139{}
+	_ driver.ExecerContext      = &tdriverConn139{}
+	_ driver.QueryerContext     = &tdriverConn139{}
+	_ driver.Conn               = &tdriverConn140{}
+	_ driver.ConnBeginTx        = &tdriverConn140{}
+	_ driver.ConnPrepareContext = &tdriverConn140{}
+	_ driver.ExecerContext      = &tdriverConn140{}
+	_ driver.SessionResetter    = &tdriverConn140{}
+	_ driver.Conn               = &tdriverConn141{}
+	_ driver.ConnBeginTx        = &tdriverConn141{}
+	_ driver.ConnPrepareContext = &tdriverConn141{}
+	_ driver.NamedValueChecker  = &tdriverConn141{}
+	_ driver.Pinger             = &tdriverConn141{}
+	_ driver.Conn               = &tdriverConn142{}
+	_ driver.ConnBeginTx        = &tdriverConn142{}
+	_ driver.ConnPrepareContext = &tdriverConn142{}
+	_ driver.NamedValueChecker  = &tdriverConn142{}
+	_ driver.Queryer            = &tdriverConn142{}
+	_ driver.Conn               = &tdriverConn143{}
+	_ driver.ConnBeginTx        = &tdriverConn143{}
+	_ driver.ConnPrepareContext = &tdriverConn143{}
+	_ driver.NamedValueChecker  = &tdriverConn143{}
+	_ driver.QueryerContext     = &tdriverConn143{}
+	_ driver.Conn               = &tdriverConn144{}
+	_ driver.ConnBeginTx        = &tdriverConn144{}
+	_ driver.ConnPrepareContext = &tdriverConn144{}
+	_ driver.NamedValueChecker  = &tdriverConn144{}
+	_ driver.SessionResetter    = &tdriverConn144{}
+	_ driver.Conn               = &tdriverConn145{}
+	_ driver.ConnBeginTx        = &tdriverConn145{}
+	_ driver.ConnPrepareContext = &tdriverConn145{}
+	_ driver.Pinger             = &tdriverConn145{}
+	_ driver.Queryer            = &tdriverConn145{}
+	_ driver.Conn               = &tdriverConn146{}
+	_ driver.ConnBeginTx        = &tdriverConn146{}
+	_ driver.ConnPrepareContext = &tdriverConn146{}
+	_ driver.Pinger             = &tdriverConn146{}
+	_ driver.QueryerContext     = &tdriverConn146{}
+	_ driver.Conn               = &tdriverConn147{}
+	_ driver.ConnBeginTx        = &tdriverConn147{}
+	_ driver.ConnPrepareContext = &tdriverConn147{}
+	_ driver.Pinger             = &tdriverConn147{}
+	_ driver.SessionResetter    = &tdriverConn147{}
+	_ driver.Conn               = &tdriverConn148{}
+	_ driver.ConnBeginTx        = &tdriverConn148{}
+	_ driver.ConnPrepareContext = &tdriverConn148{}
+	_ driver.Queryer            = &tdriverConn148{}
+	_ driver.QueryerContext     = &tdriverConn148{}
+	_ driver.Conn               = &tdriverConn149{}
+	_ driver.ConnBeginTx        = &tdriverConn149{}
+	_ driver.ConnPrepareContext = &tdriverConn149{}
+	_ driver.Queryer            = &tdriverConn149{}
+	_ driver.SessionResetter    = &tdriverConn149{}
+	_ driver.Conn               = &tdriverConn150{}
+	_ driver.ConnBeginTx        = &tdriverConn150{}
+	_ driver.ConnPrepareContext = &tdriverConn150{}
+	_ driver.QueryerContext     = &tdriverConn150{}
+	_ driver.SessionResetter    = &tdriverConn150{}
+	_ driver.Conn               = &tdriverConn151{}
+	_ driver.ConnBeginTx        = &tdriverConn151{}
+	_ driver.Execer             = &tdriverConn151{}
+	_ driver.ExecerContext      = &tdriverConn151{}
+	_ driver.NamedValueChecker  = &tdriverConn151{}
+	_ driver.Conn               = &tdriverConn152{}
+	_ driver.ConnBeginTx        = &tdriverConn152{}
+	_ driver.Execer             = &tdriverConn152{}
+	_ driver.ExecerContext      = &tdriverConn152{}
+	_ driver.Pinger             = &tdriverConn152{}
+	_ driver.Conn               = &tdriverConn153{}
+	_ driver.ConnBeginTx        = &tdriverConn153{}
+	_ driver.Execer             = &tdriverConn153{}
+	_ driver.ExecerContext      = &tdriverConn153{}
+	_ driver.Queryer            = &tdriverConn153{}
+	_ driver.Conn               = &tdriverConn154{}
+	_ driver.ConnBeginTx        = &tdriverConn154{}
+	_ driver.Execer             = &tdriverConn154{}
+	_ driver.ExecerContext      = &tdriverConn154{}
+	_ driver.QueryerContext     = &tdriverConn154{}
+	_ driver.Conn               = &tdriverConn155{}
+	_ driver.ConnBeginTx        = &tdriverConn155{}
+	_ driver.Execer             = &tdriverConn155{}
+	_ driver.ExecerContext      = &tdriverConn155{}
+	_ driver.SessionResetter    = &tdriverConn155{}
+	_ driver.Conn               = &tdriverConn156{}
+	_ driver.ConnBeginTx        = &tdriverConn156{}
+	_ driver.Execer             = &tdriverConn156{}
+	_ driver.NamedValueChecker  = &tdriverConn156{}
+	_ driver.Pinger             = &tdriverConn156{}
+	_ driver.Conn               = &tdriverConn157{}
+	_ driver.ConnBeginTx        = &tdriverConn157{}
+	_ driver.Execer             = &tdriverConn157{}
+	_ driver.NamedValueChecker  = &tdriverConn157{}
+	_ driver.Queryer            = &tdriverConn157{}
+	_ driver.Conn               = &tdriverConn158{}
+	_ driver.ConnBeginTx        = &tdriverConn158{}
+	_ driver.Execer             = &tdriverConn158{}
+	_ driver.NamedValueChecker  = &tdriverConn158{}
+	_ driver.QueryerContext     = &tdriverConn158{}
+	_ driver.Conn               = &tdriverConn159{}
+	_ driver.ConnBeginTx        = &tdriverConn159{}
+	_ driver.Execer             = &tdriverConn159{}
+	_ driver.NamedValueChecker  = &tdriverConn159{}
+	_ driver.SessionResetter    = &tdriverConn159{}
+	_ driver.Conn               = &tdriverConn160{}
+	_ driver.ConnBeginTx        = &tdriverConn160{}
+	_ driver.Execer             = &tdriverConn160{}
+	_ driver.Pinger             = &tdriverConn160{}
+	_ driver.Queryer            = &tdriverConn160{}
+	_ driver.Conn               = &tdriverConn161{}
+	_ driver.ConnBeginTx        = &tdriverConn161{}
+	_ driver.Execer             = &tdriverConn161{}
+	_ driver.Pinger             = &tdriverConn161{}
+	_ driver.QueryerContext     = &tdriverConn161{}
+	_ driver.Conn               = &tdriverConn162{}
+	_ driver.ConnBeginTx        = &tdriverConn162{}
+	_ driver.Execer             = &tdriverConn162{}
+	_ driver.Pinger             = &tdriverConn162{}
+	_ driver.SessionResetter    = &tdriverConn162{}
+	_ driver.Conn               = &tdriverConn163{}
+	_ driver.ConnBeginTx        = &tdriverConn163{}
+	_ driver.Execer             = &tdriverConn163{}
+	_ driver.Queryer            = &tdriverConn163{}
+	_ driver.QueryerContext     = &tdriverConn163{}
+	_ driver.Conn               = &tdriverConn164{}
+	_ driver.ConnBeginTx        = &tdriverConn164{}
+	_ driver.Execer             = &tdriverConn164{}
+	_ driver.Queryer            = &tdriverConn164{}
+	_ driver.SessionResetter    = &tdriverConn164{}
+	_ driver.Conn               = &tdriverConn165{}
+	_ driver.ConnBeginTx        = &tdriverConn165{}
+	_ driver.Execer             = &tdriverConn165{}
+	_ driver.QueryerContext     = &tdriverConn165{}
+	_ driver.SessionResetter    = &tdriverConn165{}
+	_ driver.Conn               = &tdriverConn166{}
+	_ driver.ConnBeginTx        = &tdriverConn166{}
+	_ driver.ExecerContext      = &tdriverConn166{}
+	_ driver.NamedValueChecker  = &tdriverConn166{}
+	_ driver.Pinger             = &tdriverConn166{}
+	_ driver.Conn               = &tdriverConn167{}
+	_ driver.ConnBeginTx        = &tdriverConn167{}
+	_ driver.ExecerContext      = &tdriverConn167{}
+	_ driver.NamedValueChecker  = &tdriverConn167{}
+	_ driver.Queryer            = &tdriverConn167{}
+	_ driver.Conn               = &tdriverConn168{}
+	_ driver.ConnBeginTx        = &tdriverConn168{}
+	_ driver.ExecerContext      = &tdriverConn168{}
+	_ driver.NamedValueChecker  = &tdriverConn168{}
+	_ driver.QueryerContext     = &tdriverConn168{}
+	_ driver.Conn               = &tdriverConn169{}
+	_ driver.ConnBeginTx        = &tdriverConn169{}
+	_ driver.ExecerContext      = &tdriverConn169{}
+	_ driver.NamedValueChecker  = &tdriverConn169{}
+	_ driver.SessionResetter    = &tdriverConn169{}
+	_ driver.Conn               = &tdriverConn170{}
+	_ driver.ConnBeginTx        = &tdriverConn170{}
+	_ driver.ExecerContext      = &tdriverConn170{}
+	_ driver.Pinger             = &tdriverConn170{}
+	_ driver.Queryer            = &tdriverConn170{}
+	_ driver.Conn               = &tdriverConn171{}
+	_ driver.ConnBeginTx        = &tdriverConn171{}
+	_ driver.ExecerContext      = &tdriverConn171{}
+	_ driver.Pinger             = &tdriverConn171{}
+	_ driver.QueryerContext     = &tdriverConn171{}
+	_ driver.Conn               = &tdriverConn172{}
+	_ driver.ConnBeginTx        = &tdriverConn172{}
+	_ driver.ExecerContext      = &tdriverConn172{}
+	_ driver.Pinger             = &tdriverConn172{}
+	_ driver.SessionResetter    = &tdriverConn172{}
+	_ driver.Conn               = &tdriverConn173{}
+	_ driver.ConnBeginTx        = &tdriverConn173{}
+	_ driver.ExecerContext      = &tdriverConn173{}
+	_ driver.Queryer            = &tdriverConn173{}
+	_ driver.QueryerContext     = &tdriverConn173{}
+	_ driver.Conn               = &tdriverConn174{}
+	_ driver.ConnBeginTx        = &tdriverConn174{}
+	_ driver.ExecerContext      = &tdriverConn174{}
+	_ driver.Queryer            = &tdriverConn174{}
+	_ driver.SessionResetter    = &tdriverConn174{}
+	_ driver.Conn               = &tdriverConn175{}
+	_ driver.ConnBeginTx        = &tdriverConn175{}
+	_ driver.ExecerContext      = &tdriverConn175{}
+	_ driver.QueryerContext     = &tdriverConn175{}
+	_ driver.SessionResetter    = &tdriverConn175{}
+	_ driver.Conn               = &tdriverConn176{}
+	_ driver.ConnBeginTx        = &tdriverConn176{}
+	_ driver.NamedValueChecker  = &tdriverConn176{}
+	_ driver.Pinger             = &tdriverConn176{}
+	_ driver.Queryer            = &tdriverConn176{}
+	_ driver.Conn               = &tdriverConn177{}
+	_ driver.ConnBeginTx        = &tdriverConn177{}
+	_ driver.NamedValueChecker  = &tdriverConn177{}
+	_ driver.Pinger             = &tdriverConn177{}
+	_ driver.QueryerContext     = &tdriverConn177{}
+	_ driver.Conn               = &tdriverConn178{}
+	_ driver.ConnBeginTx        = &tdriverConn178{}
+	_ driver.NamedValueChecker  = &tdriverConn178{}
+	_ driver.Pinger             = &tdriverConn178{}
+	_ driver.SessionResetter    = &tdriverConn178{}
+	_ driver.Conn               = &tdriverConn179{}
+	_ driver.ConnBeginTx        = &tdriverConn179{}
+	_ driver.NamedValueChecker  = &tdriverConn179{}
+	_ driver.Queryer            = &tdriverConn179{}
+	_ driver.QueryerContext     = &tdriverConn179{}
+	_ driver.Conn               = &tdriverConn180{}
+	_ driver.ConnBeginTx        = &tdriverConn180{}
+	_ driver.NamedValueChecker  = &tdriverConn180{}
+	_ driver.Queryer            = &tdriverConn180{}
+	_ driver.SessionResetter    = &tdriverConn180{}
+	_ driver.Conn               = &tdriverConn181{}
+	_ driver.ConnBeginTx        = &tdriverConn181{}
+	_ driver.NamedValueChecker  = &tdriverConn181{}
+	_ driver.QueryerContext     = &tdriverConn181{}
+	_ driver.SessionResetter    = &tdriverConn181{}
+	_ driver.Conn               = &tdriverConn182{}
+	_ driver.ConnBeginTx        = &tdriverConn182{}
+	_ driver.Pinger             = &tdriverConn182{}
+	_ driver.Queryer            = &tdriverConn182{}
+	_ driver.QueryerContext     = &tdriverConn182{}
+	_ driver.Conn               = &tdriverConn183{}
+	_ driver.ConnBeginTx        = &tdriverConn183{}
+	_ driver.Pinger             = &tdriverConn183{}
+	_ driver.Queryer            = &tdriverConn183{}
+	_ driver.SessionResetter    = &tdriverConn183{}
+	_ driver.Conn               = &tdriverConn184{}
+	_ driver.ConnBeginTx        = &tdriverConn184{}
+	_ driver.Pinger             = &tdriverConn184{}
+	_ driver.QueryerContext     = &tdriverConn184{}
+	_ driver.SessionResetter    = &tdriverConn184{}
+	_ driver.Conn               = &tdriverConn185{}
+	_ driver.ConnBeginTx        = &tdriverConn185{}
+	_ driver.Queryer            = &tdriverConn185{}
+	_ driver.QueryerContext     = &tdriverConn185{}
+	_ driver.SessionResetter    = &tdriverConn185{}
+	_ driver.Conn               = &tdriverConn186{}
+	_ driver.ConnPrepareContext = &tdriverConn186{}
+	_ driver.Execer             = &tdriverConn186{}
+	_ driver.ExecerContext      = &tdriverConn186{}
+	_ driver.NamedValueChecker  = &tdriverConn186{}
+	_ driver.Conn               = &tdriverConn187{}
+	_ driver.ConnPrepareContext = &tdriverConn187{}
+	_ driver.Execer             = &tdriverConn187{}
+	_ driver.ExecerContext      = &tdriverConn187{}
+	_ driver.Pinger             = &tdriverConn187{}
+	_ driver.Conn               = &tdriverConn188{}
+	_ driver.ConnPrepareContext = &tdriverConn188{}
+	_ driver.Execer             = &tdriverConn188{}
+	_ driver.ExecerContext      = &tdriverConn188{}
+	_ driver.Queryer            = &tdriverConn188{}
+	_ driver.Conn               = &tdriverConn189{}
+	_ driver.ConnPrepareContext = &tdriverConn189{}
+	_ driver.Execer             = &tdriverConn189{}
+	_ driver.ExecerContext      = &tdriverConn189{}
+	_ driver.QueryerContext     = &tdriverConn189{}
+	_ driver.Conn               = &tdriverConn190{}
+	_ driver.ConnPrepareContext = &tdriverConn190{}
+	_ driver.Execer             = &tdriverConn190{}
+	_ driver.ExecerContext      = &tdriverConn190{}
+	_ driver.SessionResetter    = &tdriverConn190{}
+	_ driver.Conn               = &tdriverConn191{}
+	_ driver.ConnPrepareContext = &tdriverConn191{}
+	_ driver.Execer             = &tdriverConn191{}
+	_ driver.NamedValueChecker  = &tdriverConn191{}
+	_ driver.Pinger             = &tdriverConn191{}
+	_ driver.Conn               = &tdriverConn192{}
+	_ driver.ConnPrepareContext = &tdriverConn192{}
+	_ driver.Execer             = &tdriverConn192{}
+	_ driver.NamedValueChecker  = &tdriverConn192{}
+	_ driver.Queryer            = &tdriverConn192{}
+	_ driver.Conn               = &tdriverConn193{}
+	_ driver.ConnPrepareContext = &tdriverConn193{}
+	_ driver.Execer             = &tdriverConn193{}
+	_ driver.NamedValueChecker  = &tdriverConn193{}
+	_ driver.QueryerContext     = &tdriverConn193{}
+	_ driver.Conn               = &tdriverConn194{}
+	_ driver.ConnPrepareContext = &tdriverConn194{}
+	_ driver.Execer             = &tdriverConn194{}
+	_ driver.NamedValueChecker  = &tdriverConn194{}
+	_ driver.SessionResetter    = &tdriverConn194{}
+	_ driver.Conn               = &tdriverConn195{}
+	_ driver.ConnPrepareContext = &tdriverConn195{}
+	_ driver.Execer             = &tdriverConn195{}
+	_ driver.Pinger             = &tdriverConn195{}
+	_ driver.Queryer            = &tdriverConn195{}
+	_ driver.Conn               = &tdriverConn196{}
+	_ driver.ConnPrepareContext = &tdriverConn196{}
+	_ driver.Execer             = &tdriverConn196{}
+	_ driver.Pinger             = &tdriverConn196{}
+	_ driver.QueryerContext     = &tdriverConn196{}
+	_ driver.Conn               = &tdriverConn197{}
+	_ driver.ConnPrepareContext = &tdriverConn197{}
+	_ driver.Execer             = &tdriverConn197{}
+	_ driver.Pinger             = &tdriverConn197{}
+	_ driver.SessionResetter    = &tdriverConn197{}
+	_ driver.Conn               = &tdriverConn198{}
+	_ driver.ConnPrepareContext = &tdriverConn198{}
+	_ driver.Execer             = &tdriverConn198{}
+	_ driver.Queryer            = &tdriverConn198{}
+	_ driver.QueryerContext     = &tdriverConn198{}
+	_ driver.Conn               = &tdriverConn199{}
+	_ driver.ConnPrepareContext = &tdriverConn199{}
+	_ driver.Execer             = &tdriverConn199{}
+	_ driver.Queryer            = &tdriverConn199{}
+	_ driver.SessionResetter    = &tdriverConn199{}
+	_ driver.Conn               = &tdriverConn200{}
+	_ driver.ConnPrepareContext = &tdriverConn200{}
+	_ driver.Execer             = &tdriverConn200{}
+	_ driver.QueryerContext     = &tdriverConn200{}
+	_ driver.SessionResetter    = &tdriverConn200{}
+	_ driver.Conn               = &tdriverConn201{}
+	_ driver.ConnPrepareContext = &tdriverConn201{}
+	_ driver.ExecerContext      = &tdriverConn201{}
+	_ driver.NamedValueChecker  = &tdriverConn201{}
+	_ driver.Pinger             = &tdriverConn201{}
+	_ driver.Conn               = &tdriverConn202{}
+	_ driver.ConnPrepareContext = &tdriverConn202{}
+	_ driver.ExecerContext      = &tdriverConn202{}
+	_ driver.NamedValueChecker  = &tdriverConn202{}
+	_ driver.Queryer            = &tdriverConn202{}
+	_ driver.Conn               = &tdriverConn203{}
+	_ driver.ConnPrepareContext = &tdriverConn203{}
+	_ driver.ExecerContext      = &tdriverConn203{}
+	_ driver.NamedValueChecker  = &tdriverConn203{}
+	_ driver.QueryerContext     = &tdriverConn203{}
+	_ driver.Conn               = &tdriverConn204{}
+	_ driver.ConnPrepareContext = &tdriverConn204{}
+	_ driver.ExecerContext      = &tdriverConn204{}
+	_ driver.NamedValueChecker  = &tdriverConn204{}
+	_ driver.SessionResetter    = &tdriverConn204{}
+	_ driver.Conn               = &tdriverConn205{}
+	_ driver.ConnPrepareContext = &tdriverConn205{}
+	_ driver.ExecerContext      = &tdriverConn205{}
+	_ driver.Pinger             = &tdriverConn205{}
+	_ driver.Queryer            = &tdriverConn205{}
+	_ driver.Conn               = &tdriverConn206{}
+	_ driver.ConnPrepareContext = &tdriverConn206{}
+	_ driver.ExecerContext      = &tdriverConn206{}
+	_ driver.Pinger             = &tdriverConn206{}
+	_ driver.QueryerContext     = &tdriverConn206{}
+	_ driver.Conn               = &tdriverConn207{}
+	_ driver.ConnPrepareContext = &tdriverConn207{}
+	_ driver.ExecerContext      = &tdriverConn207{}
+	_ driver.Pinger             = &tdriverConn207{}
+	_ driver.SessionResetter    = &tdriverConn207{}
+	_ driver.Conn               = &tdriverConn208{}
+	_ driver.ConnPrepareContext = &tdriverConn208{}
+	_ driver.ExecerContext      = &tdriverConn208{}
+	_ driver.Queryer            = &tdriverConn208{}
+	_ driver.QueryerContext     = &tdriverConn208{}
+	_ driver.Conn               = &tdriverConn209{}
+	_ driver.ConnPrepareContext = &tdriverConn209{}
+	_ driver.ExecerContext      = &tdriverConn209{}
+	_ driver.Queryer            = &tdriverConn209{}
+	_ driver.SessionResetter    = &tdriverConn209{}
+	_ driver.Conn               = &tdriverConn210{}
+	_ driver.ConnPrepareContext = &tdriverConn210{}
+	_ driver.ExecerContext      = &tdriverConn210{}
+	_ driver.QueryerContext     = &tdriverConn210{}
+	_ driver.SessionResetter    = &tdriverConn210{}
+	_ driver.Conn               = &tdriverConn211{}
+	_ driver.ConnPrepareContext = &tdriverConn211{}
+	_ driver.NamedValueChecker  = &tdriverConn211{}
+	_ driver.Pinger             = &tdriverConn211{}
+	_ driver.Queryer            = &tdriverConn211{}
+	_ driver.Conn               = &tdriverConn212{}
+	_ driver.ConnPrepareContext = &tdriverConn212{}
+	_ driver.NamedValueChecker  = &tdriverConn212{}
+	_ driver.Pinger             = &tdriverConn212{}
+	_ driver.QueryerContext     = &tdriverConn212{}
+	_ driver.Conn               = &tdriverConn213{}
+	_ driver.ConnPrepareContext = &tdriverConn213{}
+	_ driver.NamedValueChecker  = &tdriverConn213{}
+	_ driver.Pinger             = &tdriverConn213{}
+	_ driver.SessionResetter    = &tdriverConn213{}
+	_ driver.Conn               = &tdriverConn214{}
+	_ driver.ConnPrepareContext = &tdriverConn214{}
+	_ driver.NamedValueChecker  = &tdriverConn214{}
+	_ driver.Queryer            = &tdriverConn214{}
+	_ driver.QueryerContext     = &tdriverConn214{}
+	_ driver.Conn               = &tdriverConn215{}
+	_ driver.ConnPrepareContext = &tdriverConn215{}
+	_ driver.NamedValueChecker  = &tdriverConn215{}
+	_ driver.Queryer            = &tdriverConn215{}
+	_ driver.SessionResetter    = &tdriverConn215{}
+	_ driver.Conn               = &tdriverConn216{}
+	_ driver.ConnPrepareContext = &tdriverConn216{}
+	_ driver.NamedValueChecker  = &tdriverConn216{}
+	_ driver.QueryerContext     = &tdriverConn216{}
+	_ driver.SessionResetter    = &tdriverConn216{}
+	_ driver.Conn               = &tdriverConn217{}
+	_ driver.ConnPrepareContext = &tdriverConn217{}
+	_ driver.Pinger             = &tdriverConn217{}
+	_ driver.Queryer            = &tdriverConn217{}
+	_ driver.QueryerContext     = &tdriverConn217{}
+	_ driver.Conn               = &tdriverConn218{}
+	_ driver.ConnPrepareContext = &tdriverConn218{}
+	_ driver.Pinger             = &tdriverConn218{}
+	_ driver.Queryer            = &tdriverConn218{}
+	_ driver.SessionResetter    = &tdriverConn218{}
+	_ driver.Conn               = &tdriverConn219{}
+	_ driver.ConnPrepareContext = &tdriverConn219{}
+	_ driver.Pinger             = &tdriverConn219{}
+	_ driver.QueryerContext     = &tdriverConn219{}
+	_ driver.SessionResetter    = &tdriverConn219{}
+	_ driver.Conn               = &tdriverConn220{}
+	_ driver.ConnPrepareContext = &tdriverConn220{}
+	_ driver.Queryer            = &tdriverConn220{}
+	_ driver.QueryerContext     = &tdriverConn220{}
+	_ driver.SessionResetter    = &tdriverConn220{}
+	_ driver.Conn               = &tdriverConn221{}
+	_ driver.Execer             = &tdriverConn221{}
+	_ driver.ExecerContext      = &tdriverConn221{}
+	_ driver.NamedValueChecker  = &tdriverConn221{}
+	_ driver.Pinger             = &tdriverConn221{}
+	_ driver.Conn               = &tdriverConn222{}
+	_ driver.Execer             = &tdriverConn222{}
+	_ driver.ExecerContext      = &tdriverConn222{}
+	_ driver.NamedValueChecker  = &tdriverConn222{}
+	_ driver.Queryer            = &tdriverConn222{}
+	_ driver.Conn               = &tdriverConn223{}
+	_ driver.Execer             = &tdriverConn223{}
+	_ driver.ExecerContext      = &tdriverConn223{}
+	_ driver.NamedValueChecker  = &tdriverConn223{}
+	_ driver.QueryerContext     = &tdriverConn223{}
+	_ driver.Conn               = &tdriverConn224{}
+	_ driver.Execer             = &tdriverConn224{}
+	_ driver.ExecerContext      = &tdriverConn224{}
+	_ driver.NamedValueChecker  = &tdriverConn224{}
+	_ driver.SessionResetter    = &tdriverConn224{}
+	_ driver.Conn               = &tdriverConn225{}
+	_ driver.Execer             = &tdriverConn225{}
+	_ driver.ExecerContext      = &tdriverConn225{}
+	_ driver.Pinger             = &tdriverConn225{}
+	_ driver.Queryer            = &tdriverConn225{}
+	_ driver.Conn               = &tdriverConn226{}
+	_ driver.Execer             = &tdriverConn226{}
+	_ driver.ExecerContext      = &tdriverConn226{}
+	_ driver.Pinger             = &tdriverConn226{}
+	_ driver.QueryerContext     = &tdriverConn226{}
+	_ driver.Conn               = &tdriverConn227{}
+	_ driver.Execer             = &tdriverConn227{}
+	_ driver.ExecerContext      = &tdriverConn227{}
+	_ driver.Pinger             = &tdriverConn227{}
+	_ driver.SessionResetter    = &tdriverConn227{}
+	_ driver.Conn               = &tdriverConn228{}
+	_ driver.Execer             = &tdriverConn228{}
+	_ driver.ExecerContext      = &tdriverConn228{}
+	_ driver.Queryer            = &tdriverConn228{}
+	_ driver.QueryerContext     = &tdriverConn228{}
+	_ driver.Conn               = &tdriverConn229{}
+	_ driver.Execer             = &tdriverConn229{}
+	_ driver.ExecerContext      = &tdriverConn229{}
+	_ driver.Queryer            = &tdriverConn229{}
+	_ driver.SessionResetter    = &tdriverConn229{}
+	_ driver.Conn               = &tdriverConn230{}
+	_ driver.Execer             = &tdriverConn230{}
+	_ driver.ExecerContext      = &tdriverConn230{}
+	_ driver.QueryerContext     = &tdriverConn230{}
+	_ driver.SessionResetter    = &tdriverConn230{}
+	_ driver.Conn               = &tdriverConn231{}
+	_ driver.Execer             = &tdriverConn231{}
+	_ driver.NamedValueChecker  = &tdriverConn231{}
+	_ driver.Pinger             = &tdriverConn231{}
+	_ driver.Queryer            = &tdriverConn231{}
+	_ driver.Conn               = &tdriverConn232{}
+	_ driver.Execer             = &tdriverConn232{}
+	_ driver.NamedValueChecker  = &tdriverConn232{}
+	_ driver.Pinger             = &tdriverConn232{}
+	_ driver.QueryerContext     = &tdriverConn232{}
+	_ driver.Conn               = &tdriverConn233{}
+	_ driver.Execer             = &tdriverConn233{}
+	_ driver.NamedValueChecker  = &tdriverConn233{}
+	_ driver.Pinger             = &tdriverConn233{}
+	_ driver.SessionResetter    = &tdriverConn233{}
+	_ driver.Conn               = &tdriverConn234{}
+	_ driver.Execer             = &tdriverConn234{}
+	_ driver.NamedValueChecker  = &tdriverConn234{}
+	_ driver.Queryer            = &tdriverConn234{}
+	_ driver.QueryerContext     = &tdriverConn234{}
+	_ driver.Conn               = &tdriverConn235{}
+	_ driver.Execer             = &tdriverConn235{}
+	_ driver.NamedValueChecker  = &tdriverConn235{}
+	_ driver.Queryer            = &tdriverConn235{}
+	_ driver.SessionResetter    = &tdriverConn235{}
+	_ driver.Conn               = &tdriverConn236{}
+	_ driver.Execer             = &tdriverConn236{}
+	_ driver.NamedValueChecker  = &tdriverConn236{}
+	_ driver.QueryerContext     = &tdriverConn236{}
+	_ driver.SessionResetter    = &tdriverConn236{}
+	_ driver.Conn               = &tdriverConn237{}
+	_ driver.Execer             = &tdriverConn237{}
+	_ driver.Pinger             = &tdriverConn237{}
+	_ driver.Queryer            = &tdriverConn237{}
+	_ driver.QueryerContext     = &tdriverConn237{}
+	_ driver.Conn               = &tdriverConn238{}
+	_ driver.Execer             = &tdriverConn238{}
+	_ driver.Pinger             = &tdriverConn238{}
+	_ driver.Queryer            = &tdriverConn238{}
+	_ driver.SessionResetter    = &tdriverConn238{}
+	_ driver.Conn               = &tdriverConn239{}
+	_ driver.Execer             = &tdriverConn239{}
+	_ driver.Pinger             = &tdriverConn239{}
+	_ driver.QueryerContext     = &tdriverConn239{}
+	_ driver.SessionResetter    = &tdriverConn239{}
+	_ driver.Conn               = &tdriverConn240{}
+	_ driver.Execer             = &tdriverConn240{}
+	_ driver.Queryer            = &tdriverConn240{}
+	_ driver.QueryerContext     = &tdriverConn240{}
+	_ driver.SessionResetter    = &tdriverConn240{}
+	_ driver.Conn               = &tdriverConn241{}
+	_ driver.ExecerContext      = &tdriverConn241{}
+	_ driver.NamedValueChecker  = &tdriverConn241{}
+	_ driver.Pinger             = &tdriverConn241{}
+	_ driver.Queryer            = &tdriverConn241{}
+	_ driver.Conn               = &tdriverConn242{}
+	_ driver.ExecerContext      = &tdriverConn242{}
+	_ driver.NamedValueChecker  = &tdriverConn242{}
+	_ driver.Pinger             = &tdriverConn242{}
+	_ driver.QueryerContext     = &tdriverConn242{}
+	_ driver.Conn               = &tdriverConn243{}
+	_ driver.ExecerContext      = &tdriverConn243{}
+	_ driver.NamedValueChecker  = &tdriverConn243{}
+	_ driver.Pinger             = &tdriverConn243{}
+	_ driver.SessionResetter    = &tdriverConn243{}
+	_ driver.Conn               = &tdriverConn244{}
+	_ driver.ExecerContext      = &tdriverConn244{}
+	_ driver.NamedValueChecker  = &tdriverConn244{}
+	_ driver.Queryer            = &tdriverConn244{}
+	_ driver.QueryerContext     = &tdriverConn244{}
+	_ driver.Conn               = &tdriverConn245{}
+	_ driver.ExecerContext      = &tdriverConn245{}
+	_ driver.NamedValueChecker  = &tdriverConn245{}
+	_ driver.Queryer            = &tdriverConn245{}
+	_ driver.SessionResetter    = &tdriverConn245{}
+	_ driver.Conn               = &tdriverConn246{}
+	_ driver.ExecerContext      = &tdriverConn246{}
+	_ driver.NamedValueChecker  = &tdriverConn246{}
+	_ driver.QueryerContext     = &tdriverConn246{}
+	_ driver.SessionResetter    = &tdriverConn246{}
+	_ driver.Conn               = &tdriverConn247{}
+	_ driver.ExecerContext      = &tdriverConn247{}
+	_ driver.Pinger             = &tdriverConn247{}
+	_ driver.Queryer            = &tdriverConn247{}
+	_ driver.QueryerContext     = &tdriverConn247{}
+	_ driver.Conn               = &tdriverConn248{}
+	_ driver.ExecerContext      = &tdriverConn248{}
+	_ driver.Pinger             = &tdriverConn248{}
+	_ driver.Queryer            = &tdriverConn248{}
+	_ driver.SessionResetter    = &tdriverConn248{}
+	_ driver.Conn               = &tdriverConn249{}
+	_ driver.ExecerContext      = &tdriverConn249{}
+	_ driver.Pinger             = &tdriverConn249{}
+	_ driver.QueryerContext     = &tdriverConn249{}
+	_ driver.SessionResetter    = &tdriverConn249{}
+	_ driver.Conn               = &tdriverConn250{}
+	_ driver.ExecerContext      = &tdriverConn250{}
+	_ driver.Queryer            = &tdriverConn250{}
+	_ driver.QueryerContext     = &tdriverConn250{}
+	_ driver.SessionResetter    = &tdriverConn250{}
+	_ driver.Conn               = &tdriverConn251{}
+	_ driver.NamedValueChecker  = &tdriverConn251{}
+	_ driver.Pinger             = &tdriverConn251{}
+	_ driver.Queryer            = &tdriverConn251{}
+	_ driver.QueryerContext     = &tdriverConn251{}
+	_ driver.Conn               = &tdriverConn252{}
+	_ driver.NamedValueChecker  = &tdriverConn252{}
+	_ driver.Pinger             = &tdriverConn252{}
+	_ driver.Queryer            = &tdriverConn252{}
+	_ driver.SessionResetter    = &tdriverConn252{}
+	_ driver.Conn               = &tdriverConn253{}
+	_ driver.NamedValueChecker  = &tdriverConn253{}
+	_ driver.Pinger             = &tdriverConn253{}
+	_ driver.QueryerContext     = &tdriverConn253{}
+	_ driver.SessionResetter    = &tdriverConn253{}
+	_ driver.Conn               = &tdriverConn254{}
+	_ driver.NamedValueChecker  = &tdriverConn254{}
+	_ driver.Queryer            = &tdriverConn254{}
+	_ driver.QueryerContext     = &tdriverConn254{}
+	_ driver.SessionResetter    = &tdriverConn254{}
+	_ driver.Conn               = &tdriverConn255{}
+	_ driver.Pinger             = &tdriverConn255{}
+	_ driver.Queryer            = &tdriverConn255{}
+	_ driver.QueryerContext     = &tdriverConn255{}
+	_ driver.SessionResetter    = &tdriverConn255{}
+	_ driver.Conn               = &tdriverConn256{}
+	_ driver.ConnBeginTx        = &tdriverConn256{}
+	_ driver.ConnPrepareContext = &tdriverConn256{}
+	_ driver.Execer             = &tdriverConn256{}
+	_ driver.ExecerContext      = &tdriverConn256{}
+	_ driver.NamedValueChecker  = &tdriverConn256{}
+	_ driver.Conn               = &tdriverConn257{}
+	_ driver.ConnBeginTx        = &tdriverConn257{}
+	_ driver.ConnPrepareContext = &tdriverConn257{}
+	_ driver.Execer             = &tdriverConn257{}
+	_ driver.ExecerContext      = &tdriverConn257{}
+	_ driver.Pinger             = &tdriverConn257{}
+	_ driver.Conn               = &tdriverConn258{}
+	_ driver.ConnBeginTx        = &tdriverConn258{}
+	_ driver.ConnPrepareContext = &tdriverConn258{}
+	_ driver.Execer             = &tdriverConn258{}
+	_ driver.ExecerContext      = &tdriverConn258{}
+	_ driver.Queryer            = &tdriverConn258{}
+	_ driver.Conn               = &tdriverConn259{}
+	_ driver.ConnBeginTx        = &tdriverConn259{}
+	_ driver.ConnPrepareContext = &tdriverConn259{}
+	_ driver.Execer             = &tdriverConn259{}
+	_ driver.ExecerContext      = &tdriverConn259{}
+	_ driver.QueryerContext     = &tdriverConn259{}
+	_ driver.Conn               = &tdriverConn260{}
+	_ driver.ConnBeginTx        = &tdriverConn260{}
+	_ driver.ConnPrepareContext = &tdriverConn260{}
+	_ driver.Execer             = &tdriverConn260{}
+	_ driver.ExecerContext      = &tdriverConn260{}
+	_ driver.SessionResetter    = &tdriverConn260{}
+	_ driver.Conn               = &tdriverConn261{}
+	_ driver.ConnBeginTx        = &tdriverConn261{}
+	_ driver.ConnPrepareContext = &tdriverConn261{}
+	_ driver.Execer             = &tdriverConn261{}
+	_ driver.NamedValueChecker  = &tdriverConn261{}
+	_ driver.Pinger             = &tdriverConn261{}
+	_ driver.Conn               = &tdriverConn262{}
+	_ driver.ConnBeginTx        = &tdriverConn262{}
+	_ driver.ConnPrepareContext = &tdriverConn262{}
+	_ driver.Execer             = &tdriverConn262{}
+	_ driver.NamedValueChecker  = &tdriverConn262{}
+	_ driver.Queryer            = &tdriverConn262{}
+	_ driver.Conn               = &tdriverConn263{}
+	_ driver.ConnBeginTx        = &tdriverConn263{}
+	_ driver.ConnPrepareContext = &tdriverConn263{}
+	_ driver.Execer             = &tdriverConn263{}
+	_ driver.NamedValueChecker  = &tdriverConn263{}
+	_ driver.QueryerContext     = &tdriverConn263{}
+	_ driver.Conn               = &tdriverConn264{}
+	_ driver.ConnBeginTx        = &tdriverConn264{}
+	_ driver.ConnPrepareContext = &tdriverConn264{}
+	_ driver.Execer             = &tdriverConn264{}
+	_ driver.NamedValueChecker  = &tdriverConn264{}
+	_ driver.SessionResetter    = &tdriverConn264{}
+	_ driver.Conn               = &tdriverConn265{}
+	_ driver.ConnBeginTx        = &tdriverConn265{}
+	_ driver.ConnPrepareContext = &tdriverConn265{}
+	_ driver.Execer             = &tdriverConn265{}
+	_ driver.Pinger             = &tdriverConn265{}
+	_ driver.Queryer            = &tdriverConn265{}
+	_ driver.Conn               = &tdriverConn266{}
+	_ driver.ConnBeginTx        = &tdriverConn266{}
+	_ driver.ConnPrepareContext = &tdriverConn266{}
+	_ driver.Execer             = &tdriverConn266{}
+	_ driver.Pinger             = &tdriverConn266{}
+	_ driver.QueryerContext     = &tdriverConn266{}
+	_ driver.Conn               = &tdriverConn267{}
+	_ driver.ConnBeginTx        = &tdriverConn267{}
+	_ driver.ConnPrepareContext = &tdriverConn267{}
+	_ driver.Execer             = &tdriverConn267{}
+	_ driver.Pinger             = &tdriverConn267{}
+	_ driver.SessionResetter    = &tdriverConn267{}
+	_ driver.Conn               = &tdriverConn268{}
+	_ driver.ConnBeginTx        = &tdriverConn268{}
+	_ driver.ConnPrepareContext = &tdriverConn268{}
+	_ driver.Execer             = &tdriverConn268{}
+	_ driver.Queryer            = &tdriverConn268{}
+	_ driver.QueryerContext     = &tdriverConn268{}
+	_ driver.Conn               = &tdriverConn269{}
+	_ driver.ConnBeginTx        = &tdriverConn269{}
+	_ driver.ConnPrepareContext = &tdriverConn269{}
+	_ driver.Execer             = &tdriverConn269{}
+	_ driver.Queryer            = &tdriverConn269{}
+	_ driver.SessionResetter    = &tdriverConn269{}
+	_ driver.Conn               = &tdriverConn270{}
+	_ driver.ConnBeginTx        = &tdriverConn270{}
+	_ driver.ConnPrepareContext = &tdriverConn270{}
+	_ driver.Execer             = &tdriverConn270{}
+	_ driver.QueryerContext     = &tdriverConn270{}
+	_ driver.SessionResetter    = &tdriverConn270{}
+	_ driver.Conn               = &tdriverConn271{}
+	_ driver.ConnBeginTx        = &tdriverConn271{}
+	_ driver.ConnPrepareContext = &tdriverConn271{}
+	_ driver.ExecerContext      = &tdriverConn271{}
+	_ driver.NamedValueChecker  = &tdriverConn271{}
+	_ driver.Pinger             = &tdriverConn271{}
+	_ driver.Conn               = &tdriverConn272{}
+	_ driver.ConnBeginTx        = &tdriverConn272{}
+	_ driver.ConnPrepareContext = &tdriverConn272{}
+	_ driver.ExecerContext      = &tdriverConn272{}
+	_ driver.NamedValueChecker  = &tdriverConn272{}
+	_ driver.Queryer            = &tdriverConn272{}
+	_ driver.Conn               = &tdriverConn273{}
+	_ driver.ConnBeginTx        = &tdriverConn273{}
+	_ driver.ConnPrepareContext = &tdriverConn273{}
+	_ driver.ExecerContext      = &tdriverConn273{}
+	_ driver.NamedValueChecker  = &tdriverConn273{}
+	_ driver.QueryerContext     = &tdriverConn273{}
+	_ driver.Conn               = &tdriverConn274{}
+	_ driver.ConnBeginTx        = &tdriverConn274{}
+	_ driver.ConnPrepareContext = &tdriverConn274{}
+	_ driver.ExecerContext      = &tdriverConn274{}
+	_ driver.NamedValueChecker  = &tdriverConn274{}
+	_ driver.SessionResetter    = &tdriverConn274{}
+	_ driver.Conn               = &tdriverConn275{}
+	_ driver.ConnBeginTx        = &tdriverConn275{}
+	_ driver.ConnPrepareContext = &tdriverConn275{}
+	_ driver.ExecerContext      = &tdriverConn275{}
+	_ driver.Pinger             = &tdriverConn275{}
+	_ driver.Queryer            = &tdriverConn275{}
+	_ driver.Conn               = &tdriverConn276{}
+	_ driver.ConnBeginTx        = &tdriverConn276{}
+	_ driver.ConnPrepareContext = &tdriverConn276{}
+	_ driver.ExecerContext      = &tdriverConn276{}
+	_ driver.Pinger             = &tdriverConn276{}
+	_ driver.QueryerContext     = &tdriverConn276{}
+	_ driver.Conn               = &tdriverConn277{}
+	_ driver.ConnBeginTx        = &tdriverConn277{}
+	_ driver.ConnPrepareContext = &tdriverConn277{}
+	_ driver.ExecerContext      = &tdriverConn277{}
+	_ driver.Pinger             = &tdriverConn277{}
+	_ driver.SessionResetter    = &tdriverConn277{}
+	_ driver.Conn               = &tdriverConn278{}
+	_ driver.ConnBeginTx        = &tdriverConn278{}
+	_ driver.ConnPrepareContext = &tdriverConn278{}
+	_ driver.ExecerContext      = &tdriverConn278{}
+	_ driver.Queryer            = &tdriverConn278{}
+	_ driver.QueryerContext     = &tdriverConn278{}
+	_ driver.Conn               = &tdriverConn279{}
+	_ driver.ConnBeginTx        = &tdriverConn279{}
+	_ driver.ConnPrepareContext = &tdriverConn279{}
+	_ driver.ExecerContext      = &tdriverConn279{}
+	_ driver.Queryer            = &tdriverConn279{}
+	_ driver.SessionResetter    = &tdriverConn279{}
+	_ driver.Conn               = &tdriverConn280{}
+	_ driver.ConnBeginTx        = &tdriverConn280{}
+	_ driver.ConnPrepareContext = &tdriverConn280{}
+	_ driver.ExecerContext      = &tdriverConn280{}
+	_ driver.QueryerContext     = &tdriverConn280{}
+	_ driver.SessionResetter    = &tdriverConn280{}
+	_ driver.Conn               = &tdriverConn281{}
+	_ driver.ConnBeginTx        = &tdriverConn281{}
+	_ driver.ConnPrepareContext = &tdriverConn281{}
+	_ driver.NamedValueChecker  = &tdriverConn281{}
+	_ driver.Pinger             = &tdriverConn281{}
+	_ driver.Queryer            = &tdriverConn281{}
+	_ driver.Conn               = &tdriverConn282{}
+	_ driver.ConnBeginTx        = &tdriverConn282{}
+	_ driver.ConnPrepareContext = &tdriverConn282{}
+	_ driver.NamedValueChecker  = &tdriverConn282{}
+	_ driver.Pinger             = &tdriverConn282{}
+	_ driver.QueryerContext     = &tdriverConn282{}
+	_ driver.Conn               = &tdriverConn283{}
+	_ driver.ConnBeginTx        = &tdriverConn283{}
+	_ driver.ConnPrepareContext = &tdriverConn283{}
+	_ driver.NamedValueChecker  = &tdriverConn283{}
+	_ driver.Pinger             = &tdriverConn283{}
+	_ driver.SessionResetter    = &tdriverConn283{}
+	_ driver.Conn               = &tdriverConn284{}
+	_ driver.ConnBeginTx        = &tdriverConn284{}
+	_ driver.ConnPrepareContext = &tdriverConn284{}
+	_ driver.NamedValueChecker  = &tdriverConn284{}
+	_ driver.Queryer            = &tdriverConn284{}
+	_ driver.QueryerContext     = &tdriverConn284{}
+	_ driver.Conn               = &tdriverConn285{}
+	_ driver.ConnBeginTx        = &tdriverConn285{}
+	_ driver.ConnPrepareContext = &tdriverConn285{}
+	_ driver.NamedValueChecker  = &tdriverConn285{}
+	_ driver.Queryer            = &tdriverConn285{}
+	_ driver.SessionResetter    = &tdriverConn285{}
+	_ driver.Conn               = &tdriverConn286{}
+	_ driver.ConnBeginTx        = &tdriverConn286{}
+	_ driver.ConnPrepareContext = &tdriverConn286{}
+	_ driver.NamedValueChecker  = &tdriverConn286{}
+	_ driver.QueryerContext     = &tdriverConn286{}
+	_ driver.SessionResetter    = &tdriverConn286{}
+	_ driver.Conn               = &tdriverConn287{}
+	_ driver.ConnBeginTx        = &tdriverConn287{}
+	_ driver.ConnPrepareContext = &tdriverConn287{}
+	_ driver.Pinger             = &tdriverConn287{}
+	_ driver.Queryer            = &tdriverConn287{}
+	_ driver.QueryerContext     = &tdriverConn287{}
+	_ driver.Conn               = &tdriverConn288{}
+	_ driver.ConnBeginTx        = &tdriverConn288{}
+	_ driver.ConnPrepareContext = &tdriverConn288{}
+	_ driver.Pinger             = &tdriverConn288{}
+	_ driver.Queryer            = &tdriverConn288{}
+	_ driver.SessionResetter    = &tdriverConn288{}
+	_ driver.Conn               = &tdriverConn289{}
+	_ driver.ConnBeginTx        = &tdriverConn289{}
+	_ driver.ConnPrepareContext = &tdriverConn289{}
+	_ driver.Pinger             = &tdriverConn289{}
+	_ driver.QueryerContext     = &tdriverConn289{}
+	_ driver.SessionResetter    = &tdriverConn289{}
+	_ driver.Conn               = &tdriverConn290{}
+	_ driver.ConnBeginTx        = &tdriverConn290{}
+	_ driver.ConnPrepareContext = &tdriverConn290{}
+	_ driver.Queryer            = &tdriverConn290{}
+	_ driver.QueryerContext     = &tdriverConn290{}
+	_ driver.SessionResetter    = &tdriverConn290{}
+	_ driver.Conn               = &tdriverConn291{}
+	_ driver.ConnBeginTx        = &tdriverConn291{}
+	_ driver.Execer             = &tdriverConn291{}
+	_ driver.ExecerContext      = &tdriverConn291{}
+	_ driver.NamedValueChecker  = &tdriverConn291{}
+	_ driver.Pinger             = &tdriverConn291{}
+	_ driver.Conn               = &tdriverConn292{}
+	_ driver.ConnBeginTx        = &tdriverConn292{}
+	_ driver.Execer             = &tdriverConn292{}
+	_ driver.ExecerContext      = &tdriverConn292{}
+	_ driver.NamedValueChecker  = &tdriverConn292{}
+	_ driver.Queryer            = &tdriverConn292{}
+	_ driver.Conn               = &tdriverConn293{}
+	_ driver.ConnBeginTx        = &tdriverConn293{}
+	_ driver.Execer             = &tdriverConn293{}
+	_ driver.ExecerContext      = &tdriverConn293{}
+	_ driver.NamedValueChecker  = &tdriverConn293{}
+	_ driver.QueryerContext     = &tdriverConn293{}
+	_ driver.Conn               = &tdriverConn294{}
+	_ driver.ConnBeginTx        = &tdriverConn294{}
+	_ driver.Execer             = &tdriverConn294{}
+	_ driver.ExecerContext      = &tdriverConn294{}
+	_ driver.NamedValueChecker  = &tdriverConn294{}
+	_ driver.SessionResetter    = &tdriverConn294{}
+	_ driver.Conn               = &tdriverConn295{}
+	_ driver.ConnBeginTx        = &tdriverConn295{}
+	_ driver.Execer             = &tdriverConn295{}
+	_ driver.ExecerContext      = &tdriverConn295{}
+	_ driver.Pinger             = &tdriverConn295{}
+	_ driver.Queryer            = &tdriverConn295{}
+	_ driver.Conn               = &tdriverConn296{}
+	_ driver.ConnBeginTx        = &tdriverConn296{}
+	_ driver.Execer             = &tdriverConn296{}
+	_ driver.ExecerContext      = &tdriverConn296{}
+	_ driver.Pinger             = &tdriverConn296{}
+	_ driver.QueryerContext     = &tdriverConn296{}
+	_ driver.Conn               = &tdriverConn297{}
+	_ driver.ConnBeginTx        = &tdriverConn297{}
+	_ driver.Execer             = &tdriverConn297{}
+	_ driver.ExecerContext      = &tdriverConn297{}
+	_ driver.Pinger             = &tdriverConn297{}
+	_ driver.SessionResetter    = &tdriverConn297{}
+	_ driver.Conn               = &tdriverConn298{}
+	_ driver.ConnBeginTx        = &tdriverConn298{}
+	_ driver.Execer             = &tdriverConn298{}
+	_ driver.ExecerContext      = &tdriverConn298{}
+	_ driver.Queryer            = &tdriverConn298{}
+	_ driver.QueryerContext     = &tdriverConn298{}
+	_ driver.Conn               = &tdriverConn299{}
+	_ driver.ConnBeginTx        = &tdriverConn299{}
+	_ driver.Execer             = &tdriverConn299{}
+	_ driver.ExecerContext      = &tdriverConn299{}
+	_ driver.Queryer            = &tdriverConn299{}
+	_ driver.SessionResetter    = &tdriverConn299{}
+	_ driver.Conn               = &tdriverConn300{}
+	_ driver.ConnBeginTx        = &tdriverConn300{}
+	_ driver.Execer             = &tdriverConn300{}
+	_ driver.ExecerContext      = &tdriverConn300{}
+	_ driver.QueryerContext     = &tdriverConn300{}
+	_ driver.SessionResetter    = &tdriverConn300{}
+	_ driver.Conn               = &tdriverConn301{}
+	_ driver.ConnBeginTx        = &tdriverConn301{}
+	_ driver.Execer             = &tdriverConn301{}
+	_ driver.NamedValueChecker  = &tdriverConn301{}
+	_ driver.Pinger             = &tdriverConn301{}
+	_ driver.Queryer            = &tdriverConn301{}
+	_ driver.Conn               = &tdriverConn302{}
+	_ driver.ConnBeginTx        = &tdriverConn302{}
+	_ driver.Execer             = &tdriverConn302{}
+	_ driver.NamedValueChecker  = &tdriverConn302{}
+	_ driver.Pinger             = &tdriverConn302{}
+	_ driver.QueryerContext     = &tdriverConn302{}
+	_ driver.Conn               = &tdriverConn303{}
+	_ driver.ConnBeginTx        = &tdriverConn303{}
+	_ driver.Execer             = &tdriverConn303{}
+	_ driver.NamedValueChecker  = &tdriverConn303{}
+	_ driver.Pinger             = &tdriverConn303{}
+	_ driver.SessionResetter    = &tdriverConn303{}
+	_ driver.Conn               = &tdriverConn304{}
+	_ driver.ConnBeginTx        = &tdriverConn304{}
+	_ driver.Execer             = &tdriverConn304{}
+	_ driver.NamedValueChecker  = &tdriverConn304{}
+	_ driver.Queryer            = &tdriverConn304{}
+	_ driver.QueryerContext     = &tdriverConn304{}
+	_ driver.Conn               = &tdriverConn305{}
+	_ driver.ConnBeginTx        = &tdriverConn305{}
+	_ driver.Execer             = &tdriverConn305{}
+	_ driver.NamedValueChecker  = &tdriverConn305{}
+	_ driver.Queryer            = &tdriverConn305{}
+	_ driver.SessionResetter    = &tdriverConn305{}
+	_ driver.Conn               = &tdriverConn306{}
+	_ driver.ConnBeginTx        = &tdriverConn306{}
+	_ driver.Execer             = &tdriverConn306{}
+	_ driver.NamedValueChecker  = &tdriverConn306{}
+	_ driver.QueryerContext     = &tdriverConn306{}
+	_ driver.SessionResetter    = &tdriverConn306{}
+	_ driver.Conn               = &tdriverConn307{}
+	_ driver.ConnBeginTx        = &tdriverConn307{}
+	_ driver.Execer             = &tdriverConn307{}
+	_ driver.Pinger             = &tdriverConn307{}
+	_ driver.Queryer            = &tdriverConn307{}
+	_ driver.QueryerContext     = &tdriverConn307{}
+	_ driver.Conn               = &tdriverConn308{}
+	_ driver.ConnBeginTx        = &tdriverConn308{}
+	_ driver.Execer             = &tdriverConn308{}
+	_ driver.Pinger             = &tdriverConn308{}
+	_ driver.Queryer            = &tdriverConn308{}
+	_ driver.SessionResetter    = &tdriverConn308{}
+	_ driver.Conn               = &tdriverConn309{}
+	_ driver.ConnBeginTx        = &tdriverConn309{}
+	_ driver.Execer             = &tdriverConn309{}
+	_ driver.Pinger             = &tdriverConn309{}
+	_ driver.QueryerContext     = &tdriverConn309{}
+	_ driver.SessionResetter    = &tdriverConn309{}
+	_ driver.Conn               = &tdriverConn310{}
+	_ driver.ConnBeginTx        = &tdriverConn310{}
+	_ driver.Execer             = &tdriverConn310{}
+	_ driver.Queryer            = &tdriverConn310{}
+	_ driver.QueryerContext     = &tdriverConn310{}
+	_ driver.SessionResetter    = &tdriverConn310{}
+	_ driver.Conn               = &tdriverConn311{}
+	_ driver.ConnBeginTx        = &tdriverConn311{}
+	_ driver.ExecerContext      = &tdriverConn311{}
+	_ driver.NamedValueChecker  = &tdriverConn311{}
+	_ driver.Pinger             = &tdriverConn311{}
+	_ driver.Queryer            = &tdriverConn311{}
+	_ driver.Conn               = &tdriverConn312{}
+	_ driver.ConnBeginTx        = &tdriverConn312{}
+	_ driver.ExecerContext      = &tdriverConn312{}
+	_ driver.NamedValueChecker  = &tdriverConn312{}
+	_ driver.Pinger             = &tdriverConn312{}
+	_ driver.QueryerContext     = &tdriverConn312{}
+	_ driver.Conn               = &tdriverConn313{}
+	_ driver.ConnBeginTx        = &tdriverConn313{}
+	_ driver.ExecerContext      = &tdriverConn313{}
+	_ driver.NamedValueChecker  = &tdriverConn313{}
+	_ driver.Pinger             = &tdriverConn313{}
+	_ driver.SessionResetter    = &tdriverConn313{}
+	_ driver.Conn               = &tdriverConn314{}
+	_ driver.ConnBeginTx        = &tdriverConn314{}
+	_ driver.ExecerContext      = &tdriverConn314{}
+	_ driver.NamedValueChecker  = &tdriverConn314{}
+	_ driver.Queryer            = &tdriverConn314{}
+	_ driver.QueryerContext     = &tdriverConn314{}
+	_ driver.Conn               = &tdriverConn315{}
+	_ driver.ConnBeginTx        = &tdriverConn315{}
+	_ driver.ExecerContext      = &tdriverConn315{}
+	_ driver.NamedValueChecker  = &tdriverConn315{}
+	_ driver.Queryer            = &tdriverConn315{}
+	_ driver.SessionResetter    = &tdriverConn315{}
+	_ driver.Conn               = &tdriverConn316{}
+	_ driver.ConnBeginTx        = &tdriverConn316{}
+	_ driver.ExecerContext      = &tdriverConn316{}
+	_ driver.NamedValueChecker  = &tdriverConn316{}
+	_ driver.QueryerContext     = &tdriverConn316{}
+	_ driver.SessionResetter    = &tdriverConn316{}
+	_ driver.Conn               = &tdriverConn317{}
+	_ driver.ConnBeginTx        = &tdriverConn317{}
+	_ driver.ExecerContext      = &tdriverConn317{}
+	_ driver.Pinger             = &tdriverConn317{}
+	_ driver.Queryer            = &tdriverConn317{}
+	_ driver.QueryerContext     = &tdriverConn317{}
+	_ driver.Conn               = &tdriverConn318{}
+	_ driver.ConnBeginTx        = &tdriverConn318{}
+	_ driver.ExecerContext      = &tdriverConn318{}
+	_ driver.Pinger             = &tdriverConn318{}
+	_ driver.Queryer            = &tdriverConn318{}
+	_ driver.SessionResetter    = &tdriverConn318{}
+	_ driver.Conn               = &tdriverConn319{}
+	_ driver.ConnBeginTx        = &tdriverConn319{}
+	_ driver.ExecerContext      = &tdriverConn319{}
+	_ driver.Pinger             = &tdriverConn319{}
+	_ driver.QueryerContext     = &tdriverConn319{}
+	_ driver.SessionResetter    = &tdriverConn319{}
+	_ driver.Conn               = &tdriverConn320{}
+	_ driver.ConnBeginTx        = &tdriverConn320{}
+	_ driver.ExecerContext      = &tdriverConn320{}
+	_ driver.Queryer            = &tdriverConn320{}
+	_ driver.QueryerContext     = &tdriverConn320{}
+	_ driver.SessionResetter    = &tdriverConn320{}
+	_ driver.Conn               = &tdriverConn321{}
+	_ driver.ConnBeginTx        = &tdriverConn321{}
+	_ driver.NamedValueChecker  = &tdriverConn321{}
+	_ driver.Pinger             = &tdriverConn321{}
+	_ driver.Queryer            = &tdriverConn321{}
+	_ driver.QueryerContext     = &tdriverConn321{}
+	_ driver.Conn               = &tdriverConn322{}
+	_ driver.ConnBeginTx        = &tdriverConn322{}
+	_ driver.NamedValueChecker  = &tdriverConn322{}
+	_ driver.Pinger             = &tdriverConn322{}
+	_ driver.Queryer            = &tdriverConn322{}
+	_ driver.SessionResetter    = &tdriverConn322{}
+	_ driver.Conn               = &tdriverConn323{}
+	_ driver.ConnBeginTx        = &tdriverConn323{}
+	_ driver.NamedValueChecker  = &tdriverConn323{}
+	_ driver.Pinger             = &tdriverConn323{}
+	_ driver.QueryerContext     = &tdriverConn323{}
+	_ driver.SessionResetter    = &tdriverConn323{}
+	_ driver.Conn               = &tdriverConn324{}
+	_ driver.ConnBeginTx        = &tdriverConn324{}
+	_ driver.NamedValueChecker  = &tdriverConn324{}
+	_ driver.Queryer            = &tdriverConn324{}
+	_ driver.QueryerContext     = &tdriverConn324{}
+	_ driver.SessionResetter    = &tdriverConn324{}
+	_ driver.Conn               = &tdriverConn325{}
+	_ driver.ConnBeginTx        = &tdriverConn325{}
+	_ driver.Pinger             = &tdriverConn325{}
+	_ driver.Queryer            = &tdriverConn325{}
+	_ driver.QueryerContext     = &tdriverConn325{}
+	_ driver.SessionResetter    = &tdriverConn325{}
+	_ driver.Conn               = &tdriverConn326{}
+	_ driver.ConnPrepareContext = &tdriverConn326{}
+	_ driver.Execer             = &tdriverConn326{}
+	_ driver.ExecerContext      = &tdriverConn326{}
+	_ driver.NamedValueChecker  = &tdriverConn326{}
+	_ driver.Pinger             = &tdriverConn326{}
+	_ driver.Conn               = &tdriverConn327{}
+	_ driver.ConnPrepareContext = &tdriverConn327{}
+	_ driver.Execer             = &tdriverConn327{}
+	_ driver.ExecerContext      = &tdriverConn327{}
+	_ driver.NamedValueChecker  = &tdriverConn327{}
+	_ driver.Queryer            = &tdriverConn327{}
+	_ driver.Conn               = &tdriverConn328{}
+	_ driver.ConnPrepareContext = &tdriverConn328{}
+	_ driver.Execer             = &tdriverConn328{}
+	_ driver.ExecerContext      = &tdriverConn328{}
+	_ driver.NamedValueChecker  = &tdriverConn328{}
+	_ driver.QueryerContext     = &tdriverConn328{}
+	_ driver.Conn               = &tdriverConn329{}
+	_ driver.ConnPrepareContext = &tdriverConn329{}
+	_ driver.Execer             = &tdriverConn329{}
+	_ driver.ExecerContext      = &tdriverConn329{}
+	_ driver.NamedValueChecker  = &tdriverConn329{}
+	_ driver.SessionResetter    = &tdriverConn329{}
+	_ driver.Conn               = &tdriverConn330{}
+	_ driver.ConnPrepareContext = &tdriverConn330{}
+	_ driver.Execer             = &tdriverConn330{}
+	_ driver.ExecerContext      = &tdriverConn330{}
+	_ driver.Pinger             = &tdriverConn330{}
+	_ driver.Queryer            = &tdriverConn330{}
+	_ driver.Conn               = &tdriverConn331{}
+	_ driver.ConnPrepareContext = &tdriverConn331{}
+	_ driver.Execer             = &tdriverConn331{}
+	_ driver.ExecerContext      = &tdriverConn331{}
+	_ driver.Pinger             = &tdriverConn331{}
+	_ driver.QueryerContext     = &tdriverConn331{}
+	_ driver.Conn               = &tdriverConn332{}
+	_ driver.ConnPrepareContext = &tdriverConn332{}
+	_ driver.Execer             = &tdriverConn332{}
+	_ driver.ExecerContext      = &tdriverConn332{}
+	_ driver.Pinger             = &tdriverConn332{}
+	_ driver.SessionResetter    = &tdriverConn332{}
+	_ driver.Conn               = &tdriverConn333{}
+	_ driver.ConnPrepareContext = &tdriverConn333{}
+	_ driver.Execer             = &tdriverConn333{}
+	_ driver.ExecerContext      = &tdriverConn333{}
+	_ driver.Queryer            = &tdriverConn333{}
+	_ driver.QueryerContext     = &tdriverConn333{}
+	_ driver.Conn               = &tdriverConn334{}
+	_ driver.ConnPrepareContext = &tdriverConn334{}
+	_ driver.Execer             = &tdriverConn334{}
+	_ driver.ExecerContext      = &tdriverConn334{}
+	_ driver.Queryer            = &tdriverConn334{}
+	_ driver.SessionResetter    = &tdriverConn334{}
+	_ driver.Conn               = &tdriverConn335{}
+	_ driver.ConnPrepareContext = &tdriverConn335{}
+	_ driver.Execer             = &tdriverConn335{}
+	_ driver.ExecerContext      = &tdriverConn335{}
+	_ driver.QueryerContext     = &tdriverConn335{}
+	_ driver.SessionResetter    = &tdriverConn335{}
+	_ driver.Conn               = &tdriverConn336{}
+	_ driver.ConnPrepareContext = &tdriverConn336{}
+	_ driver.Execer             = &tdriverConn336{}
+	_ driver.NamedValueChecker  = &tdriverConn336{}
+	_ driver.Pinger             = &tdriverConn336{}
+	_ driver.Queryer            = &tdriverConn336{}
+	_ driver.Conn               = &tdriverConn337{}
+	_ driver.ConnPrepareContext = &tdriverConn337{}
+	_ driver.Execer             = &tdriverConn337{}
+	_ driver.NamedValueChecker  = &tdriverConn337{}
+	_ driver.Pinger             = &tdriverConn337{}
+	_ driver.QueryerContext     = &tdriverConn337{}
+	_ driver.Conn               = &tdriverConn338{}
+	_ driver.ConnPrepareContext = &tdriverConn338{}
+	_ driver.Execer             = &tdriverConn338{}
+	_ driver.NamedValueChecker  = &tdriverConn338{}
+	_ driver.Pinger             = &tdriverConn338{}
+	_ driver.SessionResetter    = &tdriverConn338{}
+	_ driver.Conn               = &tdriverConn339{}
+	_ driver.ConnPrepareContext = &tdriverConn339{}
+	_ driver.Execer             = &tdriverConn339{}
+	_ driver.NamedValueChecker  = &tdriverConn339{}
+	_ driver.Queryer            = &tdriverConn339{}
+	_ driver.QueryerContext     = &tdriverConn339{}
+	_ driver.Conn               = &tdriverConn340{}
+	_ driver.ConnPrepareContext = &tdriverConn340{}
+	_ driver.Execer             = &tdriverConn340{}
+	_ driver.NamedValueChecker  = &tdriverConn340{}
+	_ driver.Queryer            = &tdriverConn340{}
+	_ driver.SessionResetter    = &tdriverConn340{}
+	_ driver.Conn               = &tdriverConn341{}
+	_ driver.ConnPrepareContext = &tdriverConn341{}
+	_ driver.Execer             = &tdriverConn341{}
+	_ driver.NamedValueChecker  = &tdriverConn341{}
+	_ driver.QueryerContext     = &tdriverConn341{}
+	_ driver.SessionResetter    = &tdriverConn341{}
+	_ driver.Conn               = &tdriverConn342{}
+	_ driver.ConnPrepareContext = &tdriverConn342{}
+	_ driver.Execer             = &tdriverConn342{}
+	_ driver.Pinger             = &tdriverConn342{}
+	_ driver.Queryer            = &tdriverConn342{}
+	_ driver.QueryerContext     = &tdriverConn342{}
+	_ driver.Conn               = &tdriverConn343{}
+	_ driver.ConnPrepareContext = &tdriverConn343{}
+	_ driver.Execer             = &tdriverConn343{}
+	_ driver.Pinger             = &tdriverConn343{}
+	_ driver.Queryer            = &tdriverConn343{}
+	_ driver.SessionResetter    = &tdriverConn343{}
+	_ driver.Conn               = &tdriverConn344{}
+	_ driver.ConnPrepareContext = &tdriverConn344{}
+	_ driver.Execer             = &tdriverConn344{}
+	_ driver.Pinger             = &tdriverConn344{}
+	_ driver.QueryerContext     = &tdriverConn344{}
+	_ driver.SessionResetter    = &tdriverConn344{}
+	_ driver.Conn               = &tdriverConn345{}
+	_ driver.ConnPrepareContext = &tdriverConn345{}
+	_ driver.Execer             = &tdriverConn345{}
+	_ driver.Queryer            = &tdriverConn345{}
+	_ driver.QueryerContext     = &tdriverConn345{}
+	_ driver.SessionResetter    = &tdriverConn345{}
+	_ driver.Conn               = &tdriverConn346{}
+	_ driver.ConnPrepareContext = &tdriverConn346{}
+	_ driver.ExecerContext      = &tdriverConn346{}
+	_ driver.NamedValueChecker  = &tdriverConn346{}
+	_ driver.Pinger             = &tdriverConn346{}
+	_ driver.Queryer            = &tdriverConn346{}
+	_ driver.Conn               = &tdriverConn347{}
+	_ driver.ConnPrepareContext = &tdriverConn347{}
+	_ driver.ExecerContext      = &tdriverConn347{}
+	_ driver.NamedValueChecker  = &tdriverConn347{}
+	_ driver.Pinger             = &tdriverConn347{}
+	_ driver.QueryerContext     = &tdriverConn347{}
+	_ driver.Conn               = &tdriverConn348{}
+	_ driver.ConnPrepareContext = &tdriverConn348{}
+	_ driver.ExecerContext      = &tdriverConn348{}
+	_ driver.NamedValueChecker  = &tdriverConn348{}
+	_ driver.Pinger             = &tdriverConn348{}
+	_ driver.SessionResetter    = &tdriverConn348{}
+	_ driver.Conn               = &tdriverConn349{}
+	_ driver.ConnPrepareContext = &tdriverConn349{}
+	_ driver.ExecerContext      = &tdriverConn349{}
+	_ driver.NamedValueChecker  = &tdriverConn349{}
+	_ driver.Queryer            = &tdriverConn349{}
+	_ driver.QueryerContext     = &tdriverConn349{}
+	_ driver.Conn               = &tdriverConn350{}
+	_ driver.ConnPrepareContext = &tdriverConn350{}
+	_ driver.ExecerContext      = &tdriverConn350{}
+	_ driver.NamedValueChecker  = &tdriverConn350{}
+	_ driver.Queryer            = &tdriverConn350{}
+	_ driver.SessionResetter    = &tdriverConn350{}
+	_ driver.Conn               = &tdriverConn351{}
+	_ driver.ConnPrepareContext = &tdriverConn351{}
+	_ driver.ExecerContext      = &tdriverConn351{}
+	_ driver.NamedValueChecker  = &tdriverConn351{}
+	_ driver.QueryerContext     = &tdriverConn351{}
+	_ driver.SessionResetter    = &tdriverConn351{}
+	_ driver.Conn               = &tdriverConn352{}
+	_ driver.ConnPrepareContext = &tdriverConn352{}
+	_ driver.ExecerContext      = &tdriverConn352{}
+	_ driver.Pinger             = &tdriverConn352{}
+	_ driver.Queryer            = &tdriverConn352{}
+	_ driver.QueryerContext     = &tdriverConn352{}
+	_ driver.Conn               = &tdriverConn353{}
+	_ driver.ConnPrepareContext = &tdriverConn353{}
+	_ driver.ExecerContext      = &tdriverConn353{}
+	_ driver.Pinger             = &tdriverConn353{}
+	_ driver.Queryer            = &tdriverConn353{}
+	_ driver.SessionResetter    = &tdriverConn353{}
+	_ driver.Conn               = &tdriverConn354{}
+	_ driver.ConnPrepareContext = &tdriverConn354{}
+	_ driver.ExecerContext      = &tdriverConn354{}
+	_ driver.Pinger             = &tdriverConn354{}
+	_ driver.QueryerContext     = &tdriverConn354{}
+	_ driver.SessionResetter    = &tdriverConn354{}
+	_ driver.Conn               = &tdriverConn355{}
+	_ driver.ConnPrepareContext = &tdriverConn355{}
+	_ driver.ExecerContext      = &tdriverConn355{}
+	_ driver.Queryer            = &tdriverConn355{}
+	_ driver.QueryerContext     = &tdriverConn355{}
+	_ driver.SessionResetter    = &tdriverConn355{}
+	_ driver.Conn               = &tdriverConn356{}
+	_ driver.ConnPrepareContext = &tdriverConn356{}
+	_ driver.NamedValueChecker  = &tdriverConn356{}
+	_ driver.Pinger             = &tdriverConn356{}
+	_ driver.Queryer            = &tdriverConn356{}
+	_ driver.QueryerContext     = &tdriverConn356{}
+	_ driver.Conn               = &tdriverConn357{}
+	_ driver.ConnPrepareContext = &tdriverConn357{}
+	_ driver.NamedValueChecker  = &tdriverConn357{}
+	_ driver.Pinger             = &tdriverConn357{}
+	_ driver.Queryer            = &tdriverConn357{}
+	_ driver.SessionResetter    = &tdriverConn357{}
+	_ driver.Conn               = &tdriverConn358{}
+	_ driver.ConnPrepareContext = &tdriverConn358{}
+	_ driver.NamedValueChecker  = &tdriverConn358{}
+	_ driver.Pinger             = &tdriverConn358{}
+	_ driver.QueryerContext     = &tdriverConn358{}
+	_ driver.SessionResetter    = &tdriverConn358{}
+	_ driver.Conn               = &tdriverConn359{}
+	_ driver.ConnPrepareContext = &tdriverConn359{}
+	_ driver.NamedValueChecker  = &tdriverConn359{}
+	_ driver.Queryer            = &tdriverConn359{}
+	_ driver.QueryerContext     = &tdriverConn359{}
+	_ driver.SessionResetter    = &tdriverConn359{}
+	_ driver.Conn               = &tdriverConn360{}
+	_ driver.ConnPrepareContext = &tdriverConn360{}
+	_ driver.Pinger             = &tdriverConn360{}
+	_ driver.Queryer            = &tdriverConn360{}
+	_ driver.QueryerContext     = &tdriverConn360{}
+	_ driver.SessionResetter    = &tdriverConn360{}
+	_ driver.Conn               = &tdriverConn361{}
+	_ driver.Execer             = &tdriverConn361{}
+	_ driver.ExecerContext      = &tdriverConn361{}
+	_ driver.NamedValueChecker  = &tdriverConn361{}
+	_ driver.Pinger             = &tdriverConn361{}
+	_ driver.Queryer            = &tdriverConn361{}
+	_ driver.Conn               = &tdriverConn362{}
+	_ driver.Execer             = &tdriverConn362{}
+	_ driver.ExecerContext      = &tdriverConn362{}
+	_ driver.NamedValueChecker  = &tdriverConn362{}
+	_ driver.Pinger             = &tdriverConn362{}
+	_ driver.QueryerContext     = &tdriverConn362{}
+	_ driver.Conn               = &tdriverConn363{}
+	_ driver.Execer             = &tdriverConn363{}
+	_ driver.ExecerContext      = &tdriverConn363{}
+	_ driver.NamedValueChecker  = &tdriverConn363{}
+	_ driver.Pinger             = &tdriverConn363{}
+	_ driver.SessionResetter    = &tdriverConn363{}
+	_ driver.Conn               = &tdriverConn364{}
+	_ driver.Execer             = &tdriverConn364{}
+	_ driver.ExecerContext      = &tdriverConn364{}
+	_ driver.NamedValueChecker  = &tdriverConn364{}
+	_ driver.Queryer            = &tdriverConn364{}
+	_ driver.QueryerContext     = &tdriverConn364{}
+	_ driver.Conn               = &tdriverConn365{}
+	_ driver.Execer             = &tdriverConn365{}
+	_ driver.ExecerContext      = &tdriverConn365{}
+	_ driver.NamedValueChecker  = &tdriverConn365{}
+	_ driver.Queryer            = &tdriverConn365{}
+	_ driver.SessionResetter    = &tdriverConn365{}
+	_ driver.Conn               = &tdriverConn366{}
+	_ driver.Execer             = &tdriverConn366{}
+	_ driver.ExecerContext      = &tdriverConn366{}
+	_ driver.NamedValueChecker  = &tdriverConn366{}
+	_ driver.QueryerContext     = &tdriverConn366{}
+	_ driver.SessionResetter    = &tdriverConn366{}
+	_ driver.Conn               = &tdriverConn367{}
+	_ driver.Execer             = &tdriverConn367{}
+	_ driver.ExecerContext      = &tdriverConn367{}
+	_ driver.Pinger             = &tdriverConn367{}
+	_ driver.Queryer            = &tdriverConn367{}
+	_ driver.QueryerContext     = &tdriverConn367{}
+	_ driver.Conn               = &tdriverConn368{}
+	_ driver.Execer             = &tdriverConn368{}
+	_ driver.ExecerContext      = &tdriverConn368{}
+	_ driver.Pinger             = &tdriverConn368{}
+	_ driver.Queryer            = &tdriverConn368{}
+	_ driver.SessionResetter    = &tdriverConn368{}
+	_ driver.Conn               = &tdriverConn369{}
+	_ driver.Execer             = &tdriverConn369{}
+	_ driver.ExecerContext      = &tdriverConn369{}
+	_ driver.Pinger             = &tdriverConn369{}
+	_ driver.QueryerContext     = &tdriverConn369{}
+	_ driver.SessionResetter    = &tdriverConn369{}
+	_ driver.Conn               = &tdriverConn370{}
+	_ driver.Execer             = &tdriverConn370{}
+	_ driver.ExecerContext      = &tdriverConn370{}
+	_ driver.Queryer            = &tdriverConn370{}
+	_ driver.QueryerContext     = &tdriverConn370{}
+	_ driver.SessionResetter    = &tdriverConn370{}
+	_ driver.Conn               = &tdriverConn371{}
+	_ driver.Execer             = &tdriverConn371{}
+	_ driver.NamedValueChecker  = &tdriverConn371{}
+	_ driver.Pinger             = &tdriverConn371{}
+	_ driver.Queryer            = &tdriverConn371{}
+	_ driver.QueryerContext     = &tdriverConn371{}
+	_ driver.Conn               = &tdriverConn372{}
+	_ driver.Execer             = &tdriverConn372{}
+	_ driver.NamedValueChecker  = &tdriverConn372{}
+	_ driver.Pinger             = &tdriverConn372{}
+	_ driver.Queryer            = &tdriverConn372{}
+	_ driver.SessionResetter    = &tdriverConn372{}
+	_ driver.Conn               = &tdriverConn373{}
+	_ driver.Execer             = &tdriverConn373{}
+	_ driver.NamedValueChecker  = &tdriverConn373{}
+	_ driver.Pinger             = &tdriverConn373{}
+	_ driver.QueryerContext     = &tdriverConn373{}
+	_ driver.SessionResetter    = &tdriverConn373{}
+	_ driver.Conn               = &tdriverConn374{}
+	_ driver.Execer             = &tdriverConn374{}
+	_ driver.NamedValueChecker  = &tdriverConn374{}
+	_ driver.Queryer            = &tdriverConn374{}
+	_ driver.QueryerContext     = &tdriverConn374{}
+	_ driver.SessionResetter    = &tdriverConn374{}
+	_ driver.Conn               = &tdriverConn375{}
+	_ driver.Execer             = &tdriverConn375{}
+	_ driver.Pinger             = &tdriverConn375{}
+	_ driver.Queryer            = &tdriverConn375{}
+	_ driver.QueryerContext     = &tdriverConn375{}
+	_ driver.SessionResetter    = &tdriverConn375{}
+	_ driver.Conn               = &tdriverConn376{}
+	_ driver.ExecerContext      = &tdriverConn376{}
+	_ driver.NamedValueChecker  = &tdriverConn376{}
+	_ driver.Pinger             = &tdriverConn376{}
+	_ driver.Queryer            = &tdriverConn376{}
+	_ driver.QueryerContext     = &tdriverConn376{}
+	_ driver.Conn               = &tdriverConn377{}
+	_ driver.ExecerContext      = &tdriverConn377{}
+	_ driver.NamedValueChecker  = &tdriverConn377{}
+	_ driver.Pinger             = &tdriverConn377{}
+	_ driver.Queryer            = &tdriverConn377{}
+	_ driver.SessionResetter    = &tdriverConn377{}
+	_ driver.Conn               = &tdriverConn378{}
+	_ driver.ExecerContext      = &tdriverConn378{}
+	_ driver.NamedValueChecker  = &tdriverConn378{}
+	_ driver.Pinger             = &tdriverConn378{}
+	_ driver.QueryerContext     = &tdriverConn378{}
+	_ driver.SessionResetter    = &tdriverConn378{}
+	_ driver.Conn               = &tdriverConn379{}
+	_ driver.ExecerContext      = &tdriverConn379{}
+	_ driver.NamedValueChecker  = &tdriverConn379{}
+	_ driver.Queryer            = &tdriverConn379{}
+	_ driver.QueryerContext     = &tdriverConn379{}
+	_ driver.SessionResetter    = &tdriverConn379{}
+	_ driver.Conn               = &tdriverConn380{}
+	_ driver.ExecerContext      = &tdriverConn380{}
+	_ driver.Pinger             = &tdriverConn380{}
+	_ driver.Queryer            = &tdriverConn380{}
+	_ driver.QueryerContext     = &tdriverConn380{}
+	_ driver.SessionResetter    = &tdriverConn380{}
+	_ driver.Conn               = &tdriverConn381{}
+	_ driver.NamedValueChecker  = &tdriverConn381{}
+	_ driver.Pinger             = &tdriverConn381{}
+	_ driver.Queryer            = &tdriverConn381{}
+	_ driver.QueryerContext     = &tdriverConn381{}
+	_ driver.SessionResetter    = &tdriverConn381{}
+	_ driver.Conn               = &tdriverConn382{}
+	_ driver.ConnBeginTx        = &tdriverConn382{}
+	_ driver.ConnPrepareContext = &tdriverConn382{}
+	_ driver.Execer             = &tdriverConn382{}
+	_ driver.ExecerContext      = &tdriverConn382{}
+	_ driver.NamedValueChecker  = &tdriverConn382{}
+	_ driver.Pinger             = &tdriverConn382{}
+	_ driver.Conn               = &tdriverConn383{}
+	_ driver.ConnBeginTx        = &tdriverConn383{}
+	_ driver.ConnPrepareContext = &tdriverConn383{}
+	_ driver.Execer             = &tdriverConn383{}
+	_ driver.ExecerContext      = &tdriverConn383{}
+	_ driver.NamedValueChecker  = &tdriverConn383{}
+	_ driver.Queryer            = &tdriverConn383{}
+	_ driver.Conn               = &tdriverConn384{}
+	_ driver.ConnBeginTx        = &tdriverConn384{}
+	_ driver.ConnPrepareContext = &tdriverConn384{}
+	_ driver.Execer             = &tdriverConn384{}
+	_ driver.ExecerContext      = &tdriverConn384{}
+	_ driver.NamedValueChecker  = &tdriverConn384{}
+	_ driver.QueryerContext     = &tdriverConn384{}
+	_ driver.Conn               = &tdriverConn385{}
+	_ driver.ConnBeginTx        = &tdriverConn385{}
+	_ driver.ConnPrepareContext = &tdriverConn385{}
+	_ driver.Execer             = &tdriverConn385{}
+	_ driver.ExecerContext      = &tdriverConn385{}
+	_ driver.NamedValueChecker  = &tdriverConn385{}
+	_ driver.SessionResetter    = &tdriverConn385{}
+	_ driver.Conn               = &tdriverConn386{}
+	_ driver.ConnBeginTx        = &tdriverConn386{}
+	_ driver.ConnPrepareContext = &tdriverConn386{}
+	_ driver.Execer             = &tdriverConn386{}
+	_ driver.ExecerContext      = &tdriverConn386{}
+	_ driver.Pinger             = &tdriverConn386{}
+	_ driver.Queryer            = &tdriverConn386{}
+	_ driver.Conn               = &tdriverConn387{}
+	_ driver.ConnBeginTx        = &tdriverConn387{}
+	_ driver.ConnPrepareContext = &tdriverConn387{}
+	_ driver.Execer             = &tdriverConn387{}
+	_ driver.ExecerContext      = &tdriverConn387{}
+	_ driver.Pinger             = &tdriverConn387{}
+	_ driver.QueryerContext     = &tdriverConn387{}
+	_ driver.Conn               = &tdriverConn388{}
+	_ driver.ConnBeginTx        = &tdriverConn388{}
+	_ driver.ConnPrepareContext = &tdriverConn388{}
+	_ driver.Execer             = &tdriverConn388{}
+	_ driver.ExecerContext      = &tdriverConn388{}
+	_ driver.Pinger             = &tdriverConn388{}
+	_ driver.SessionResetter    = &tdriverConn388{}
+	_ driver.Conn               = &tdriverConn389{}
+	_ driver.ConnBeginTx        = &tdriverConn389{}
+	_ driver.ConnPrepareContext = &tdriverConn389{}
+	_ driver.Execer             = &tdriverConn389{}
+	_ driver.ExecerContext      = &tdriverConn389{}
+	_ driver.Queryer            = &tdriverConn389{}
+	_ driver.QueryerContext     = &tdriverConn389{}
+	_ driver.Conn               = &tdriverConn390{}
+	_ driver.ConnBeginTx        = &tdriverConn390{}
+	_ driver.ConnPrepareContext = &tdriverConn390{}
+	_ driver.Execer             = &tdriverConn390{}
+	_ driver.ExecerContext      = &tdriverConn390{}
+	_ driver.Queryer            = &tdriverConn390{}
+	_ driver.SessionResetter    = &tdriverConn390{}
+	_ driver.Conn               = &tdriverConn391{}
+	_ driver.ConnBeginTx        = &tdriverConn391{}
+	_ driver.ConnPrepareContext = &tdriverConn391{}
+	_ driver.Execer             = &tdriverConn391{}
+	_ driver.ExecerContext      = &tdriverConn391{}
+	_ driver.QueryerContext     = &tdriverConn391{}
+	_ driver.SessionResetter    = &tdriverConn391{}
+	_ driver.Conn               = &tdriverConn392{}
+	_ driver.ConnBeginTx        = &tdriverConn392{}
+	_ driver.ConnPrepareContext = &tdriverConn392{}
+	_ driver.Execer             = &tdriverConn392{}
+	_ driver.NamedValueChecker  = &tdriverConn392{}
+	_ driver.Pinger             = &tdriverConn392{}
+	_ driver.Queryer            = &tdriverConn392{}
+	_ driver.Conn               = &tdriverConn393{}
+	_ driver.ConnBeginTx        = &tdriverConn393{}
+	_ driver.ConnPrepareContext = &tdriverConn393{}
+	_ driver.Execer             = &tdriverConn393{}
+	_ driver.NamedValueChecker  = &tdriverConn393{}
+	_ driver.Pinger             = &tdriverConn393{}
+	_ driver.QueryerContext     = &tdriverConn393{}
+	_ driver.Conn               = &tdriverConn394{}
+	_ driver.ConnBeginTx        = &tdriverConn394{}
+	_ driver.ConnPrepareContext = &tdriverConn394{}
+	_ driver.Execer             = &tdriverConn394{}
+	_ driver.NamedValueChecker  = &tdriverConn394{}
+	_ driver.Pinger             = &tdriverConn394{}
+	_ driver.SessionResetter    = &tdriverConn394{}
+	_ driver.Conn               = &tdriverConn395{}
+	_ driver.ConnBeginTx        = &tdriverConn395{}
+	_ driver.ConnPrepareContext = &tdriverConn395{}
+	_ driver.Execer             = &tdriverConn395{}
+	_ driver.NamedValueChecker  = &tdriverConn395{}
+	_ driver.Queryer            = &tdriverConn395{}
+	_ driver.QueryerContext     = &tdriverConn395{}
+	_ driver.Conn               = &tdriverConn396{}
+	_ driver.ConnBeginTx        = &tdriverConn396{}
+	_ driver.ConnPrepareContext = &tdriverConn396{}
+	_ driver.Execer             = &tdriverConn396{}
+	_ driver.NamedValueChecker  = &tdriverConn396{}
+	_ driver.Queryer            = &tdriverConn396{}
+	_ driver.SessionResetter    = &tdriverConn396{}
+	_ driver.Conn               = &tdriverConn397{}
+	_ driver.ConnBeginTx        = &tdriverConn397{}
+	_ driver.ConnPrepareContext = &tdriverConn397{}
+	_ driver.Execer             = &tdriverConn397{}
+	_ driver.NamedValueChecker  = &tdriverConn397{}
+	_ driver.QueryerContext     = &tdriverConn397{}
+	_ driver.SessionResetter    = &tdriverConn397{}
+	_ driver.Conn               = &tdriverConn398{}
+	_ driver.ConnBeginTx        = &tdriverConn398{}
+	_ driver.ConnPrepareContext = &tdriverConn398{}
+	_ driver.Execer             = &tdriverConn398{}
+	_ driver.Pinger             = &tdriverConn398{}
+	_ driver.Queryer            = &tdriverConn398{}
+	_ driver.QueryerContext     = &tdriverConn398{}
+	_ driver.Conn               = &tdriverConn399{}
+	_ driver.ConnBeginTx        = &tdriverConn399{}
+	_ driver.ConnPrepareContext = &tdriverConn399{}
+	_ driver.Execer             = &tdriverConn399{}
+	_ driver.Pinger             = &tdriverConn399{}
+	_ driver.Queryer            = &tdriverConn399{}
+	_ driver.SessionResetter    = &tdriverConn399{}
+	_ driver.Conn               = &tdriverConn400{}
+	_ driver.ConnBeginTx        = &tdriverConn400{}
+	_ driver.ConnPrepareContext = &tdriverConn400{}
+	_ driver.Execer             = &tdriverConn400{}
+	_ driver.Pinger             = &tdriverConn400{}
+	_ driver.QueryerContext     = &tdriverConn400{}
+	_ driver.SessionResetter    = &tdriverConn400{}
+	_ driver.Conn               = &tdriverConn401{}
+	_ driver.ConnBeginTx        = &tdriverConn401{}
+	_ driver.ConnPrepareContext = &tdriverConn401{}
+	_ driver.Execer             = &tdriverConn401{}
+	_ driver.Queryer            = &tdriverConn401{}
+	_ driver.QueryerContext     = &tdriverConn401{}
+	_ driver.SessionResetter    = &tdriverConn401{}
+	_ driver.Conn               = &tdriverConn402{}
+	_ driver.ConnBeginTx        = &tdriverConn402{}
+	_ driver.ConnPrepareContext = &tdriverConn402{}
+	_ driver.ExecerContext      = &tdriverConn402{}
+	_ driver.NamedValueChecker  = &tdriverConn402{}
+	_ driver.Pinger             = &tdriverConn402{}
+	_ driver.Queryer            = &tdriverConn402{}
+	_ driver.Conn               = &tdriverConn403{}
+	_ driver.ConnBeginTx        = &tdriverConn403{}
+	_ driver.ConnPrepareContext = &tdriverConn403{}
+	_ driver.ExecerContext      = &tdriverConn403{}
+	_ driver.NamedValueChecker  = &tdriverConn403{}
+	_ driver.Pinger             = &tdriverConn403{}
+	_ driver.QueryerContext     = &tdriverConn403{}
+	_ driver.Conn               = &tdriverConn404{}
+	_ driver.ConnBeginTx        = &tdriverConn404{}
+	_ driver.ConnPrepareContext = &tdriverConn404{}
+	_ driver.ExecerContext      = &tdriverConn404{}
+	_ driver.NamedValueChecker  = &tdriverConn404{}
+	_ driver.Pinger             = &tdriverConn404{}
+	_ driver.SessionResetter    = &tdriverConn404{}
+	_ driver.Conn               = &tdriverConn405{}
+	_ driver.ConnBeginTx        = &tdriverConn405{}
+	_ driver.ConnPrepareContext = &tdriverConn405{}
+	_ driver.ExecerContext      = &tdriverConn405{}
+	_ driver.NamedValueChecker  = &tdriverConn405{}
+	_ driver.Queryer            = &tdriverConn405{}
+	_ driver.QueryerContext     = &tdriverConn405{}
+	_ driver.Conn               = &tdriverConn406{}
+	_ driver.ConnBeginTx        = &tdriverConn406{}
+	_ driver.ConnPrepareContext = &tdriverConn406{}
+	_ driver.ExecerContext      = &tdriverConn406{}
+	_ driver.NamedValueChecker  = &tdriverConn406{}
+	_ driver.Queryer            = &tdriverConn406{}
+	_ driver.SessionResetter    = &tdriverConn406{}
+	_ driver.Conn               = &tdriverConn407{}
+	_ driver.ConnBeginTx        = &tdriverConn407{}
+	_ driver.ConnPrepareContext = &tdriverConn407{}
+	_ driver.ExecerContext      = &tdriverConn407{}
+	_ driver.NamedValueChecker  = &tdriverConn407{}
+	_ driver.QueryerContext     = &tdriverConn407{}
+	_ driver.SessionResetter    = &tdriverConn407{}
+	_ driver.Conn               = &tdriverConn408{}
+	_ driver.ConnBeginTx        = &tdriverConn408{}
+	_ driver.ConnPrepareContext = &tdriverConn408{}
+	_ driver.ExecerContext      = &tdriverConn408{}
+	_ driver.Pinger             = &tdriverConn408{}
+	_ driver.Queryer            = &tdriverConn408{}
+	_ driver.QueryerContext     = &tdriverConn408{}
+	_ driver.Conn               = &tdriverConn409{}
+	_ driver.ConnBeginTx        = &tdriverConn409{}
+	_ driver.ConnPrepareContext = &tdriverConn409{}
+	_ driver.ExecerContext      = &tdriverConn409{}
+	_ driver.Pinger             = &tdriverConn409{}
+	_ driver.Queryer            = &tdriverConn409{}
+	_ driver.SessionResetter    = &tdriverConn409{}
+	_ driver.Conn               = &tdriverConn410{}
+	_ driver.ConnBeginTx        = &tdriverConn410{}
+	_ driver.ConnPrepareContext = &tdriverConn410{}
+	_ driver.ExecerContext      = &tdriverConn410{}
+	_ driver.Pinger             = &tdriverConn410{}
+	_ driver.QueryerContext     = &tdriverConn410{}
+	_ driver.SessionResetter    = &tdriverConn410{}
+	_ driver.Conn               = &tdriverConn411{}
+	_ driver.ConnBeginTx        = &tdriverConn411{}
+	_ driver.ConnPrepareContext = &tdriverConn411{}
+	_ driver.ExecerContext      = &tdriverConn411{}
+	_ driver.Queryer            = &tdriverConn411{}
+	_ driver.QueryerContext     = &tdriverConn411{}
+	_ driver.SessionResetter    = &tdriverConn411{}
+	_ driver.Conn               = &tdriverConn412{}
+	_ driver.ConnBeginTx        = &tdriverConn412{}
+	_ driver.ConnPrepareContext = &tdriverConn412{}
+	_ driver.NamedValueChecker  = &tdriverConn412{}
+	_ driver.Pinger             = &tdriverConn412{}
+	_ driver.Queryer            = &tdriverConn412{}
+	_ driver.QueryerContext     = &tdriverConn412{}
+	_ driver.Conn               = &tdriverConn413{}
+	_ driver.ConnBeginTx        = &tdriverConn413{}
+	_ driver.ConnPrepareContext = &tdriverConn413{}
+	_ driver.NamedValueChecker  = &tdriverConn413{}
+	_ driver.Pinger             = &tdriverConn413{}
+	_ driver.Queryer            = &tdriverConn413{}
+	_ driver.SessionResetter    = &tdriverConn413{}
+	_ driver.Conn               = &tdriverConn414{}
+	_ driver.ConnBeginTx        = &tdriverConn414{}
+	_ driver.ConnPrepareContext = &tdriverConn414{}
+	_ driver.NamedValueChecker  = &tdriverConn414{}
+	_ driver.Pinger             = &tdriverConn414{}
+	_ driver.QueryerContext     = &tdriverConn414{}
+	_ driver.SessionResetter    = &tdriverConn414{}
+	_ driver.Conn               = &tdriverConn415{}
+	_ driver.ConnBeginTx        = &tdriverConn415{}
+	_ driver.ConnPrepareContext = &tdriverConn415{}
+	_ driver.NamedValueChecker  = &tdriverConn415{}
+	_ driver.Queryer            = &tdriverConn415{}
+	_ driver.QueryerContext     = &tdriverConn415{}
+	_ driver.SessionResetter    = &tdriverConn415{}
+	_ driver.Conn               = &tdriverConn416{}
+	_ driver.ConnBeginTx        = &tdriverConn416{}
+	_ driver.ConnPrepareContext = &tdriverConn416{}
+	_ driver.Pinger             = &tdriverConn416{}
+	_ driver.Queryer            = &tdriverConn416{}
+	_ driver.QueryerContext     = &tdriverConn416{}
+	_ driver.SessionResetter    = &tdriverConn416{}
+	_ driver.Conn               = &tdriverConn417{}
+	_ driver.ConnBeginTx        = &tdriverConn417{}
+	_ driver.Execer             = &tdriverConn417{}
+	_ driver.ExecerContext      = &tdriverConn417{}
+	_ driver.NamedValueChecker  = &tdriverConn417{}
+	_ driver.Pinger             = &tdriverConn417{}
+	_ driver.Queryer            = &tdriverConn417{}
+	_ driver.Conn               = &tdriverConn418{}
+	_ driver.ConnBeginTx        = &tdriverConn418{}
+	_ driver.Execer             = &tdriverConn418{}
+	_ driver.ExecerContext      = &tdriverConn418{}
+	_ driver.NamedValueChecker  = &tdriverConn418{}
+	_ driver.Pinger             = &tdriverConn418{}
+	_ driver.QueryerContext     = &tdriverConn418{}
+	_ driver.Conn               = &tdriverConn419{}
+	_ driver.ConnBeginTx        = &tdriverConn419{}
+	_ driver.Execer             = &tdriverConn419{}
+	_ driver.ExecerContext      = &tdriverConn419{}
+	_ driver.NamedValueChecker  = &tdriverConn419{}
+	_ driver.Pinger             = &tdriverConn419{}
+	_ driver.SessionResetter    = &tdriverConn419{}
+	_ driver.Conn               = &tdriverConn420{}
+	_ driver.ConnBeginTx        = &tdriverConn420{}
+	_ driver.Execer             = &tdriverConn420{}
+	_ driver.ExecerContext      = &tdriverConn420{}
+	_ driver.NamedValueChecker  = &tdriverConn420{}
+	_ driver.Queryer            = &tdriverConn420{}
+	_ driver.QueryerContext     = &tdriverConn420{}
+	_ driver.Conn               = &tdriverConn421{}
+	_ driver.ConnBeginTx        = &tdriverConn421{}
+	_ driver.Execer             = &tdriverConn421{}
+	_ driver.ExecerContext      = &tdriverConn421{}
+	_ driver.NamedValueChecker  = &tdriverConn421{}
+	_ driver.Queryer            = &tdriverConn421{}
+	_ driver.SessionResetter    = &tdriverConn421{}
+	_ driver.Conn               = &tdriverConn422{}
+	_ driver.ConnBeginTx        = &tdriverConn422{}
+	_ driver.Execer             = &tdriverConn422{}
+	_ driver.ExecerContext      = &tdriverConn422{}
+	_ driver.NamedValueChecker  = &tdriverConn422{}
+	_ driver.QueryerContext     = &tdriverConn422{}
+	_ driver.SessionResetter    = &tdriverConn422{}
+	_ driver.Conn               = &tdriverConn423{}
+	_ driver.ConnBeginTx        = &tdriverConn423{}
+	_ driver.Execer             = &tdriverConn423{}
+	_ driver.ExecerContext      = &tdriverConn423{}
+	_ driver.Pinger             = &tdriverConn423{}
+	_ driver.Queryer            = &tdriverConn423{}
+	_ driver.QueryerContext     = &tdriverConn423{}
+	_ driver.Conn               = &tdriverConn424{}
+	_ driver.ConnBeginTx        = &tdriverConn424{}
+	_ driver.Execer             = &tdriverConn424{}
+	_ driver.ExecerContext      = &tdriverConn424{}
+	_ driver.Pinger             = &tdriverConn424{}
+	_ driver.Queryer            = &tdriverConn424{}
+	_ driver.SessionResetter    = &tdriverConn424{}
+	_ driver.Conn               = &tdriverConn425{}
+	_ driver.ConnBeginTx        = &tdriverConn425{}
+	_ driver.Execer             = &tdriverConn425{}
+	_ driver.ExecerContext      = &tdriverConn425{}
+	_ driver.Pinger             = &tdriverConn425{}
+	_ driver.QueryerContext     = &tdriverConn425{}
+	_ driver.SessionResetter    = &tdriverConn425{}
+	_ driver.Conn               = &tdriverConn426{}
+	_ driver.ConnBeginTx        = &tdriverConn426{}
+	_ driver.Execer             = &tdriverConn426{}
+	_ driver.ExecerContext      = &tdriverConn426{}
+	_ driver.Queryer            = &tdriverConn426{}
+	_ driver.QueryerContext     = &tdriverConn426{}
+	_ driver.SessionResetter    = &tdriverConn426{}
+	_ driver.Conn               = &tdriverConn427{}
+	_ driver.ConnBeginTx        = &tdriverConn427{}
+	_ driver.Execer             = &tdriverConn427{}
+	_ driver.NamedValueChecker  = &tdriverConn427{}
+	_ driver.Pinger             = &tdriverConn427{}
+	_ driver.Queryer            = &tdriverConn427{}
+	_ driver.QueryerContext     = &tdriverConn427{}
+	_ driver.Conn               = &tdriverConn428{}
+	_ driver.ConnBeginTx        = &tdriverConn428{}
+	_ driver.Execer             = &tdriverConn428{}
+	_ driver.NamedValueChecker  = &tdriverConn428{}
+	_ driver.Pinger             = &tdriverConn428{}
+	_ driver.Queryer            = &tdriverConn428{}
+	_ driver.SessionResetter    = &tdriverConn428{}
+	_ driver.Conn               = &tdriverConn429{}
+	_ driver.ConnBeginTx        = &tdriverConn429{}
+	_ driver.Execer             = &tdriverConn429{}
+	_ driver.NamedValueChecker  = &tdriverConn429{}
+	_ driver.Pinger             = &tdriverConn429{}
+	_ driver.QueryerContext     = &tdriverConn429{}
+	_ driver.SessionResetter    = &tdriverConn429{}
+	_ driver.Conn               = &tdriverConn430{}
+	_ driver.ConnBeginTx        = &tdriverConn430{}
+	_ driver.Execer             = &tdriverConn430{}
+	_ driver.NamedValueChecker  = &tdriverConn430{}
+	_ driver.Queryer            = &tdriverConn430{}
+	_ driver.QueryerContext     = &tdriverConn430{}
+	_ driver.SessionResetter    = &tdriverConn430{}
+	_ driver.Conn               = &tdriverConn431{}
+	_ driver.ConnBeginTx        = &tdriverConn431{}
+	_ driver.Execer             = &tdriverConn431{}
+	_ driver.Pinger             = &tdriverConn431{}
+	_ driver.Queryer            = &tdriverConn431{}
+	_ driver.QueryerContext     = &tdriverConn431{}
+	_ driver.SessionResetter    = &tdriverConn431{}
+	_ driver.Conn               = &tdriverConn432{}
+	_ driver.ConnBeginTx        = &tdriverConn432{}
+	_ driver.ExecerContext      = &tdriverConn432{}
+	_ driver.NamedValueChecker  = &tdriverConn432{}
+	_ driver.Pinger             = &tdriverConn432{}
+	_ driver.Queryer            = &tdriverConn432{}
+	_ driver.QueryerContext     = &tdriverConn432{}
+	_ driver.Conn               = &tdriverConn433{}
+	_ driver.ConnBeginTx        = &tdriverConn433{}
+	_ driver.ExecerContext      = &tdriverConn433{}
+	_ driver.NamedValueChecker  = &tdriverConn433{}
+	_ driver.Pinger             = &tdriverConn433{}
+	_ driver.Queryer            = &tdriverConn433{}
+	_ driver.SessionResetter    = &tdriverConn433{}
+	_ driver.Conn               = &tdriverConn434{}
+	_ driver.ConnBeginTx        = &tdriverConn434{}
+	_ driver.ExecerContext      = &tdriverConn434{}
+	_ driver.NamedValueChecker  = &tdriverConn434{}
+	_ driver.Pinger             = &tdriverConn434{}
+	_ driver.QueryerContext     = &tdriverConn434{}
+	_ driver.SessionResetter    = &tdriverConn434{}
+	_ driver.Conn               = &tdriverConn435{}
+	_ driver.ConnBeginTx        = &tdriverConn435{}
+	_ driver.ExecerContext      = &tdriverConn435{}
+	_ driver.NamedValueChecker  = &tdriverConn435{}
+	_ driver.Queryer            = &tdriverConn435{}
+	_ driver.QueryerContext     = &tdriverConn435{}
+	_ driver.SessionResetter    = &tdriverConn435{}
+	_ driver.Conn               = &tdriverConn436{}
+	_ driver.ConnBeginTx        = &tdriverConn436{}
+	_ driver.ExecerContext      = &tdriverConn436{}
+	_ driver.Pinger             = &tdriverConn436{}
+	_ driver.Queryer            = &tdriverConn436{}
+	_ driver.QueryerContext     = &tdriverConn436{}
+	_ driver.SessionResetter    = &tdriverConn436{}
+	_ driver.Conn               = &tdriverConn437{}
+	_ driver.ConnBeginTx        = &tdriverConn437{}
+	_ driver.NamedValueChecker  = &tdriverConn437{}
+	_ driver.Pinger             = &tdriverConn437{}
+	_ driver.Queryer            = &tdriverConn437{}
+	_ driver.QueryerContext     = &tdriverConn437{}
+	_ driver.SessionResetter    = &tdriverConn437{}
+	_ driver.Conn               = &tdriverConn438{}
+	_ driver.ConnPrepareContext = &tdriverConn438{}
+	_ driver.Execer             = &tdriverConn438{}
+	_ driver.ExecerContext      = &tdriverConn438{}
+	_ driver.NamedValueChecker  = &tdriverConn438{}
+	_ driver.Pinger             = &tdriverConn438{}
+	_ driver.Queryer            = &tdriverConn438{}
+	_ driver.Conn               = &tdriverConn439{}
+	_ driver.ConnPrepareContext = &tdriverConn439{}
+	_ driver.Execer             = &tdriverConn439{}
+	_ driver.ExecerContext      = &tdriverConn439{}
+	_ driver.NamedValueChecker  = &tdriverConn439{}
+	_ driver.Pinger             = &tdriverConn439{}
+	_ driver.QueryerContext     = &tdriverConn439{}
+	_ driver.Conn               = &tdriverConn440{}
+	_ driver.ConnPrepareContext = &tdriverConn440{}
+	_ driver.Execer             = &tdriverConn440{}
+	_ driver.ExecerContext      = &tdriverConn440{}
+	_ driver.NamedValueChecker  = &tdriverConn440{}
+	_ driver.Pinger             = &tdriverConn440{}
+	_ driver.SessionResetter    = &tdriverConn440{}
+	_ driver.Conn               = &tdriverConn441{}
+	_ driver.ConnPrepareContext = &tdriverConn441{}
+	_ driver.Execer             = &tdriverConn441{}
+	_ driver.ExecerContext      = &tdriverConn441{}
+	_ driver.NamedValueChecker  = &tdriverConn441{}
+	_ driver.Queryer            = &tdriverConn441{}
+	_ driver.QueryerContext     = &tdriverConn441{}
+	_ driver.Conn               = &tdriverConn442{}
+	_ driver.ConnPrepareContext = &tdriverConn442{}
+	_ driver.Execer             = &tdriverConn442{}
+	_ driver.ExecerContext      = &tdriverConn442{}
+	_ driver.NamedValueChecker  = &tdriverConn442{}
+	_ driver.Queryer            = &tdriverConn442{}
+	_ driver.SessionResetter    = &tdriverConn442{}
+	_ driver.Conn               = &tdriverConn443{}
+	_ driver.ConnPrepareContext = &tdriverConn443{}
+	_ driver.Execer             = &tdriverConn443{}
+	_ driver.ExecerContext      = &tdriverConn443{}
+	_ driver.NamedValueChecker  = &tdriverConn443{}
+	_ driver.QueryerContext     = &tdriverConn443{}
+	_ driver.SessionResetter    = &tdriverConn443{}
+	_ driver.Conn               = &tdriverConn444{}
+	_ driver.ConnPrepareContext = &tdriverConn444{}
+	_ driver.Execer             = &tdriverConn444{}
+	_ driver.ExecerContext      = &tdriverConn444{}
+	_ driver.Pinger             = &tdriverConn444{}
+	_ driver.Queryer            = &tdriverConn444{}
+	_ driver.QueryerContext     = &tdriverConn444{}
+	_ driver.Conn               = &tdriverConn445{}
+	_ driver.ConnPrepareContext = &tdriverConn445{}
+	_ driver.Execer             = &tdriverConn445{}
+	_ driver.ExecerContext      = &tdriverConn445{}
+	_ driver.Pinger             = &tdriverConn445{}
+	_ driver.Queryer            = &tdriverConn445{}
+	_ driver.SessionResetter    = &tdriverConn445{}
+	_ driver.Conn               = &tdriverConn446{}
+	_ driver.ConnPrepareContext = &tdriverConn446{}
+	_ driver.Execer             = &tdriverConn446{}
+	_ driver.ExecerContext      = &tdriverConn446{}
+	_ driver.Pinger             = &tdriverConn446{}
+	_ driver.QueryerContext     = &tdriverConn446{}
+	_ driver.SessionResetter    = &tdriverConn446{}
+	_ driver.Conn               = &tdriverConn447{}
+	_ driver.ConnPrepareContext = &tdriverConn447{}
+	_ driver.Execer             = &tdriverConn447{}
+	_ driver.ExecerContext      = &tdriverConn447{}
+	_ driver.Queryer            = &tdriverConn447{}
+	_ driver.QueryerContext     = &tdriverConn447{}
+	_ driver.SessionResetter    = &tdriverConn447{}
+	_ driver.Conn               = &tdriverConn448{}
+	_ driver.ConnPrepareContext = &tdriverConn448{}
+	_ driver.Execer             = &tdriverConn448{}
+	_ driver.NamedValueChecker  = &tdriverConn448{}
+	_ driver.Pinger             = &tdriverConn448{}
+	_ driver.Queryer            = &tdriverConn448{}
+	_ driver.QueryerContext     = &tdriverConn448{}
+	_ driver.Conn               = &tdriverConn449{}
+	_ driver.ConnPrepareContext = &tdriverConn449{}
+	_ driver.Execer             = &tdriverConn449{}
+	_ driver.NamedValueChecker  = &tdriverConn449{}
+	_ driver.Pinger             = &tdriverConn449{}
+	_ driver.Queryer            = &tdriverConn449{}
+	_ driver.SessionResetter    = &tdriverConn449{}
+	_ driver.Conn               = &tdriverConn450{}
+	_ driver.ConnPrepareContext = &tdriverConn450{}
+	_ driver.Execer             = &tdriverConn450{}
+	_ driver.NamedValueChecker  = &tdriverConn450{}
+	_ driver.Pinger             = &tdriverConn450{}
+	_ driver.QueryerContext     = &tdriverConn450{}
+	_ driver.SessionResetter    = &tdriverConn450{}
+	_ driver.Conn               = &tdriverConn451{}
+	_ driver.ConnPrepareContext = &tdriverConn451{}
+	_ driver.Execer             = &tdriverConn451{}
+	_ driver.NamedValueChecker  = &tdriverConn451{}
+	_ driver.Queryer            = &tdriverConn451{}
+	_ driver.QueryerContext     = &tdriverConn451{}
+	_ driver.SessionResetter    = &tdriverConn451{}
+	_ driver.Conn               = &tdriverConn452{}
+	_ driver.ConnPrepareContext = &tdriverConn452{}
+	_ driver.Execer             = &tdriverConn452{}
+	_ driver.Pinger             = &tdriverConn452{}
+	_ driver.Queryer            = &tdriverConn452{}
+	_ driver.QueryerContext     = &tdriverConn452{}
+	_ driver.SessionResetter    = &tdriverConn452{}
+	_ driver.Conn               = &tdriverConn453{}
+	_ driver.ConnPrepareContext = &tdriverConn453{}
+	_ driver.ExecerContext      = &tdriverConn453{}
+	_ driver.NamedValueChecker  = &tdriverConn453{}
+	_ driver.Pinger             = &tdriverConn453{}
+	_ driver.Queryer            = &tdriverConn453{}
+	_ driver.QueryerContext     = &tdriverConn453{}
+	_ driver.Conn               = &tdriverConn454{}
+	_ driver.ConnPrepareContext = &tdriverConn454{}
+	_ driver.ExecerContext      = &tdriverConn454{}
+	_ driver.NamedValueChecker  = &tdriverConn454{}
+	_ driver.Pinger             = &tdriverConn454{}
+	_ driver.Queryer            = &tdriverConn454{}
+	_ driver.SessionResetter    = &tdriverConn454{}
+	_ driver.Conn               = &tdriverConn455{}
+	_ driver.ConnPrepareContext = &tdriverConn455{}
+	_ driver.ExecerContext      = &tdriverConn455{}
+	_ driver.NamedValueChecker  = &tdriverConn455{}
+	_ driver.Pinger             = &tdriverConn455{}
+	_ driver.QueryerContext     = &tdriverConn455{}
+	_ driver.SessionResetter    = &tdriverConn455{}
+	_ driver.Conn               = &tdriverConn456{}
+	_ driver.ConnPrepareContext = &tdriverConn456{}
+	_ driver.ExecerContext      = &tdriverConn456{}
+	_ driver.NamedValueChecker  = &tdriverConn456{}
+	_ driver.Queryer            = &tdriverConn456{}
+	_ driver.QueryerContext     = &tdriverConn456{}
+	_ driver.SessionResetter    = &tdriverConn456{}
+	_ driver.Conn               = &tdriverConn457{}
+	_ driver.ConnPrepareContext = &tdriverConn457{}
+	_ driver.ExecerContext      = &tdriverConn457{}
+	_ driver.Pinger             = &tdriverConn457{}
+	_ driver.Queryer            = &tdriverConn457{}
+	_ driver.QueryerContext     = &tdriverConn457{}
+	_ driver.SessionResetter    = &tdriverConn457{}
+	_ driver.Conn               = &tdriverConn458{}
+	_ driver.ConnPrepareContext = &tdriverConn458{}
+	_ driver.NamedValueChecker  = &tdriverConn458{}
+	_ driver.Pinger             = &tdriverConn458{}
+	_ driver.Queryer            = &tdriverConn458{}
+	_ driver.QueryerContext     = &tdriverConn458{}
+	_ driver.SessionResetter    = &tdriverConn458{}
+	_ driver.Conn               = &tdriverConn459{}
+	_ driver.Execer             = &tdriverConn459{}
+	_ driver.ExecerContext      = &tdriverConn459{}
+	_ driver.NamedValueChecker  = &tdriverConn459{}
+	_ driver.Pinger             = &tdriverConn459{}
+	_ driver.Queryer            = &tdriverConn459{}
+	_ driver.QueryerContext     = &tdriverConn459{}
+	_ driver.Conn               = &tdriverConn460{}
+	_ driver.Execer             = &tdriverConn460{}
+	_ driver.ExecerContext      = &tdriverConn460{}
+	_ driver.NamedValueChecker  = &tdriverConn460{}
+	_ driver.Pinger             = &tdriverConn460{}
+	_ driver.Queryer            = &tdriverConn460{}
+	_ driver.SessionResetter    = &tdriverConn460{}
+	_ driver.Conn               = &tdriverConn461{}
+	_ driver.Execer             = &tdriverConn461{}
+	_ driver.ExecerContext      = &tdriverConn461{}
+	_ driver.NamedValueChecker  = &tdriverConn461{}
+	_ driver.Pinger             = &tdriverConn461{}
+	_ driver.QueryerContext     = &tdriverConn461{}
+	_ driver.SessionResetter    = &tdriverConn461{}
+	_ driver.Conn               = &tdriverConn462{}
+	_ driver.Execer             = &tdriverConn462{}
+	_ driver.ExecerContext      = &tdriverConn462{}
+	_ driver.NamedValueChecker  = &tdriverConn462{}
+	_ driver.Queryer            = &tdriverConn462{}
+	_ driver.QueryerContext     = &tdriverConn462{}
+	_ driver.SessionResetter    = &tdriverConn462{}
+	_ driver.Conn               = &tdriverConn463{}
+	_ driver.Execer             = &tdriverConn463{}
+	_ driver.ExecerContext      = &tdriverConn463{}
+	_ driver.Pinger             = &tdriverConn463{}
+	_ driver.Queryer            = &tdriverConn463{}
+	_ driver.QueryerContext     = &tdriverConn463{}
+	_ driver.SessionResetter    = &tdriverConn463{}
+	_ driver.Conn               = &tdriverConn464{}
+	_ driver.Execer             = &tdriverConn464{}
+	_ driver.NamedValueChecker  = &tdriverConn464{}
+	_ driver.Pinger             = &tdriverConn464{}
+	_ driver.Queryer            = &tdriverConn464{}
+	_ driver.QueryerContext     = &tdriverConn464{}
+	_ driver.SessionResetter    = &tdriverConn464{}
+	_ driver.Conn               = &tdriverConn465{}
+	_ driver.ExecerContext      = &tdriverConn465{}
+	_ driver.NamedValueChecker  = &tdriverConn465{}
+	_ driver.Pinger             = &tdriverConn465{}
+	_ driver.Queryer            = &tdriverConn465{}
+	_ driver.QueryerContext     = &tdriverConn465{}
+	_ driver.SessionResetter    = &tdriverConn465{}
+	_ driver.Conn               = &tdriverConn466{}
+	_ driver.ConnBeginTx        = &tdriverConn466{}
+	_ driver.ConnPrepareContext = &tdriverConn466{}
+	_ driver.Execer             = &tdriverConn466{}
+	_ driver.ExecerContext      = &tdriverConn466{}
+	_ driver.NamedValueChecker  = &tdriverConn466{}
+	_ driver.Pinger             = &tdriverConn466{}
+	_ driver.Queryer            = &tdriverConn466{}
+	_ driver.Conn               = &tdriverConn467{}
+	_ driver.ConnBeginTx        = &tdriverConn467{}
+	_ driver.ConnPrepareContext = &tdriverConn467{}
+	_ driver.Execer             = &tdriverConn467{}
+	_ driver.ExecerContext      = &tdriverConn467{}
+	_ driver.NamedValueChecker  = &tdriverConn467{}
+	_ driver.Pinger             = &tdriverConn467{}
+	_ driver.QueryerContext     = &tdriverConn467{}
+	_ driver.Conn               = &tdriverConn468{}
+	_ driver.ConnBeginTx        = &tdriverConn468{}
+	_ driver.ConnPrepareContext = &tdriverConn468{}
+	_ driver.Execer             = &tdriverConn468{}
+	_ driver.ExecerContext      = &tdriverConn468{}
+	_ driver.NamedValueChecker  = &tdriverConn468{}
+	_ driver.Pinger             = &tdriverConn468{}
+	_ driver.SessionResetter    = &tdriverConn468{}
+	_ driver.Conn               = &tdriverConn469{}
+	_ driver.ConnBeginTx        = &tdriverConn469{}
+	_ driver.ConnPrepareContext = &tdriverConn469{}
+	_ driver.Execer             = &tdriverConn469{}
+	_ driver.ExecerContext      = &tdriverConn469{}
+	_ driver.NamedValueChecker  = &tdriverConn469{}
+	_ driver.Queryer            = &tdriverConn469{}
+	_ driver.QueryerContext     = &tdriverConn469{}
+	_ driver.Conn               = &tdriverConn470{}
+	_ driver.ConnBeginTx        = &tdriverConn470{}
+	_ driver.ConnPrepareContext = &tdriverConn470{}
+	_ driver.Execer             = &tdriverConn470{}
+	_ driver.ExecerContext      = &tdriverConn470{}
+	_ driver.NamedValueChecker  = &tdriverConn470{}
+	_ driver.Queryer            = &tdriverConn470{}
+	_ driver.SessionResetter    = &tdriverConn470{}
+	_ driver.Conn               = &tdriverConn471{}
+	_ driver.ConnBeginTx        = &tdriverConn471{}
+	_ driver.ConnPrepareContext = &tdriverConn471{}
+	_ driver.Execer             = &tdriverConn471{}
+	_ driver.ExecerContext      = &tdriverConn471{}
+	_ driver.NamedValueChecker  = &tdriverConn471{}
+	_ driver.QueryerContext     = &tdriverConn471{}
+	_ driver.SessionResetter    = &tdriverConn471{}
+	_ driver.Conn               = &tdriverConn472{}
+	_ driver.ConnBeginTx        = &tdriverConn472{}
+	_ driver.ConnPrepareContext = &tdriverConn472{}
+	_ driver.Execer             = &tdriverConn472{}
+	_ driver.ExecerContext      = &tdriverConn472{}
+	_ driver.Pinger             = &tdriverConn472{}
+	_ driver.Queryer            = &tdriverConn472{}
+	_ driver.QueryerContext     = &tdriverConn472{}
+	_ driver.Conn               = &tdriverConn473{}
+	_ driver.ConnBeginTx        = &tdriverConn473{}
+	_ driver.ConnPrepareContext = &tdriverConn473{}
+	_ driver.Execer             = &tdriverConn473{}
+	_ driver.ExecerContext      = &tdriverConn473{}
+	_ driver.Pinger             = &tdriverConn473{}
+	_ driver.Queryer            = &tdriverConn473{}
+	_ driver.SessionResetter    = &tdriverConn473{}
+	_ driver.Conn               = &tdriverConn474{}
+	_ driver.ConnBeginTx        = &tdriverConn474{}
+	_ driver.ConnPrepareContext = &tdriverConn474{}
+	_ driver.Execer             = &tdriverConn474{}
+	_ driver.ExecerContext      = &tdriverConn474{}
+	_ driver.Pinger             = &tdriverConn474{}
+	_ driver.QueryerContext     = &tdriverConn474{}
+	_ driver.SessionResetter    = &tdriverConn474{}
+	_ driver.Conn               = &tdriverConn475{}
+	_ driver.ConnBeginTx        = &tdriverConn475{}
+	_ driver.ConnPrepareContext = &tdriverConn475{}
+	_ driver.Execer             = &tdriverConn475{}
+	_ driver.ExecerContext      = &tdriverConn475{}
+	_ driver.Queryer            = &tdriverConn475{}
+	_ driver.QueryerContext     = &tdriverConn475{}
+	_ driver.SessionResetter    = &tdriverConn475{}
+	_ driver.Conn               = &tdriverConn476{}
+	_ driver.ConnBeginTx        = &tdriverConn476{}
+	_ driver.ConnPrepareContext = &tdriverConn476{}
+	_ driver.Execer             = &tdriverConn476{}
+	_ driver.NamedValueChecker  = &tdriverConn476{}
+	_ driver.Pinger             = &tdriverConn476{}
+	_ driver.Queryer            = &tdriverConn476{}
+	_ driver.QueryerContext     = &tdriverConn476{}
+	_ driver.Conn               = &tdriverConn477{}
+	_ driver.ConnBeginTx        = &tdriverConn477{}
+	_ driver.ConnPrepareContext = &tdriverConn477{}
+	_ driver.Execer             = &tdriverConn477{}
+	_ driver.NamedValueChecker  = &tdriverConn477{}
+	_ driver.Pinger             = &tdriverConn477{}
+	_ driver.Queryer            = &tdriverConn477{}
+	_ driver.SessionResetter    = &tdriverConn477{}
+	_ driver.Conn               = &tdriverConn478{}
+	_ driver.ConnBeginTx        = &tdriverConn478{}
+	_ driver.ConnPrepareContext = &tdriverConn478{}
+	_ driver.Execer             = &tdriverConn478{}
+	_ driver.NamedValueChecker  = &tdriverConn478{}
+	_ driver.Pinger             = &tdriverConn478{}
+	_ driver.QueryerContext     = &tdriverConn478{}
+	_ driver.SessionResetter    = &tdriverConn478{}
+	_ driver.Conn               = &tdriverConn479{}
+	_ driver.ConnBeginTx        = &tdriverConn479{}
+	_ driver.ConnPrepareContext = &tdriverConn479{}
+	_ driver.Execer             = &tdriverConn479{}
+	_ driver.NamedValueChecker  = &tdriverConn479{}
+	_ driver.Queryer            = &tdriverConn479{}
+	_ driver.QueryerContext     = &tdriverConn479{}
+	_ driver.SessionResetter    = &tdriverConn479{}
+	_ driver.Conn               = &tdriverConn480{}
+	_ driver.ConnBeginTx        = &tdriverConn480{}
+	_ driver.ConnPrepareContext = &tdriverConn480{}
+	_ driver.Execer             = &tdriverConn480{}
+	_ driver.Pinger             = &tdriverConn480{}
+	_ driver.Queryer            = &tdriverConn480{}
+	_ driver.QueryerContext     = &tdriverConn480{}
+	_ driver.SessionResetter    = &tdriverConn480{}
+	_ driver.Conn               = &tdriverConn481{}
+	_ driver.ConnBeginTx        = &tdriverConn481{}
+	_ driver.ConnPrepareContext = &tdriverConn481{}
+	_ driver.ExecerContext      = &tdriverConn481{}
+	_ driver.NamedValueChecker  = &tdriverConn481{}
+	_ driver.Pinger             = &tdriverConn481{}
+	_ driver.Queryer            = &tdriverConn481{}
+	_ driver.QueryerContext     = &tdriverConn481{}
+	_ driver.Conn               = &tdriverConn482{}
+	_ driver.ConnBeginTx        = &tdriverConn482{}
+	_ driver.ConnPrepareContext = &tdriverConn482{}
+	_ driver.ExecerContext      = &tdriverConn482{}
+	_ driver.NamedValueChecker  = &tdriverConn482{}
+	_ driver.Pinger             = &tdriverConn482{}
+	_ driver.Queryer            = &tdriverConn482{}
+	_ driver.SessionResetter    = &tdriverConn482{}
+	_ driver.Conn               = &tdriverConn483{}
+	_ driver.ConnBeginTx        = &tdriverConn483{}
+	_ driver.ConnPrepareContext = &tdriverConn483{}
+	_ driver.ExecerContext      = &tdriverConn483{}
+	_ driver.NamedValueChecker  = &tdriverConn483{}
+	_ driver.Pinger             = &tdriverConn483{}
+	_ driver.QueryerContext     = &tdriverConn483{}
+	_ driver.SessionResetter    = &tdriverConn483{}
+	_ driver.Conn               = &tdriverConn484{}
+	_ driver.ConnBeginTx        = &tdriverConn484{}
+	_ driver.ConnPrepareContext = &tdriverConn484{}
+	_ driver.ExecerContext      = &tdriverConn484{}
+	_ driver.NamedValueChecker  = &tdriverConn484{}
+	_ driver.Queryer            = &tdriverConn484{}
+	_ driver.QueryerContext     = &tdriverConn484{}
+	_ driver.SessionResetter    = &tdriverConn484{}
+	_ driver.Conn               = &tdriverConn485{}
+	_ driver.ConnBeginTx        = &tdriverConn485{}
+	_ driver.ConnPrepareContext = &tdriverConn485{}
+	_ driver.ExecerContext      = &tdriverConn485{}
+	_ driver.Pinger             = &tdriverConn485{}
+	_ driver.Queryer            = &tdriverConn485{}
+	_ driver.QueryerContext     = &tdriverConn485{}
+	_ driver.SessionResetter    = &tdriverConn485{}
+	_ driver.Conn               = &tdriverConn486{}
+	_ driver.ConnBeginTx        = &tdriverConn486{}
+	_ driver.ConnPrepareContext = &tdriverConn486{}
+	_ driver.NamedValueChecker  = &tdriverConn486{}
+	_ driver.Pinger             = &tdriverConn486{}
+	_ driver.Queryer            = &tdriverConn486{}
+	_ driver.QueryerContext     = &tdriverConn486{}
+	_ driver.SessionResetter    = &tdriverConn486{}
+	_ driver.Conn               = &tdriverConn487{}
+	_ driver.ConnBeginTx        = &tdriverConn487{}
+	_ driver.Execer             = &tdriverConn487{}
+	_ driver.ExecerContext      = &tdriverConn487{}
+	_ driver.NamedValueChecker  = &tdriverConn487{}
+	_ driver.Pinger             = &tdriverConn487{}
+	_ driver.Queryer            = &tdriverConn487{}
+	_ driver.QueryerContext     = &tdriverConn487{}
+	_ driver.Conn               = &tdriverConn488{}
+	_ driver.ConnBeginTx        = &tdriverConn488{}
+	_ driver.Execer             = &tdriverConn488{}
+	_ driver.ExecerContext      = &tdriverConn488{}
+	_ driver.NamedValueChecker  = &tdriverConn488{}
+	_ driver.Pinger             = &tdriverConn488{}
+	_ driver.Queryer            = &tdriverConn488{}
+	_ driver.SessionResetter    = &tdriverConn488{}
+	_ driver.Conn               = &tdriverConn489{}
+	_ driver.ConnBeginTx        = &tdriverConn489{}
+	_ driver.Execer             = &tdriverConn489{}
+	_ driver.ExecerContext      = &tdriverConn489{}
+	_ driver.NamedValueChecker  = &tdriverConn489{}
+	_ driver.Pinger             = &tdriverConn489{}
+	_ driver.QueryerContext     = &tdriverConn489{}
+	_ driver.SessionResetter    = &tdriverConn489{}
+	_ driver.Conn               = &tdriverConn490{}
+	_ driver.ConnBeginTx        = &tdriverConn490{}
+	_ driver.Execer             = &tdriverConn490{}
+	_ driver.ExecerContext      = &tdriverConn490{}
+	_ driver.NamedValueChecker  = &tdriverConn490{}
+	_ driver.Queryer            = &tdriverConn490{}
+	_ driver.QueryerContext     = &tdriverConn490{}
+	_ driver.SessionResetter    = &tdriverConn490{}
+	_ driver.Conn               = &tdriverConn491{}
+	_ driver.ConnBeginTx        = &tdriverConn491{}
+	_ driver.Execer             = &tdriverConn491{}
+	_ driver.ExecerContext      = &tdriverConn491{}
+	_ driver.Pinger             = &tdriverConn491{}
+	_ driver.Queryer            = &tdriverConn491{}
+	_ driver.QueryerContext     = &tdriverConn491{}
+	_ driver.SessionResetter    = &tdriverConn491{}
+	_ driver.Conn               = &tdriverConn492{}
+	_ driver.ConnBeginTx        = &tdriverConn492{}
+	_ driver.Execer             = &tdriverConn492{}
+	_ driver.NamedValueChecker  = &tdriverConn492{}
+	_ driver.Pinger             = &tdriverConn492{}
+	_ driver.Queryer            = &tdriverConn492{}
+	_ driver.QueryerContext     = &tdriverConn492{}
+	_ driver.SessionResetter    = &tdriverConn492{}
+	_ driver.Conn               = &tdriverConn493{}
+	_ driver.ConnBeginTx        = &tdriverConn493{}
+	_ driver.ExecerContext      = &tdriverConn493{}
+	_ driver.NamedValueChecker  = &tdriverConn493{}
+	_ driver.Pinger             = &tdriverConn493{}
+	_ driver.Queryer            = &tdriverConn493{}
+	_ driver.QueryerContext     = &tdriverConn493{}
+	_ driver.SessionResetter    = &tdriverConn493{}
+	_ driver.Conn               = &tdriverConn494{}
+	_ driver.ConnPrepareContext = &tdriverConn494{}
+	_ driver.Execer             = &tdriverConn494{}
+	_ driver.ExecerContext      = &tdriverConn494{}
+	_ driver.NamedValueChecker  = &tdriverConn494{}
+	_ driver.Pinger             = &tdriverConn494{}
+	_ driver.Queryer            = &tdriverConn494{}
+	_ driver.QueryerContext     = &tdriverConn494{}
+	_ driver.Conn               = &tdriverConn495{}
+	_ driver.ConnPrepareContext = &tdriverConn495{}
+	_ driver.Execer             = &tdriverConn495{}
+	_ driver.ExecerContext      = &tdriverConn495{}
+	_ driver.NamedValueChecker  = &tdriverConn495{}
+	_ driver.Pinger             = &tdriverConn495{}
+	_ driver.Queryer            = &tdriverConn495{}
+	_ driver.SessionResetter    = &tdriverConn495{}
+	_ driver.Conn               = &tdriverConn496{}
+	_ driver.ConnPrepareContext = &tdriverConn496{}
+	_ driver.Execer             = &tdriverConn496{}
+	_ driver.ExecerContext      = &tdriverConn496{}
+	_ driver.NamedValueChecker  = &tdriverConn496{}
+	_ driver.Pinger             = &tdriverConn496{}
+	_ driver.QueryerContext     = &tdriverConn496{}
+	_ driver.SessionResetter    = &tdriverConn496{}
+	_ driver.Conn               = &tdriverConn497{}
+	_ driver.ConnPrepareContext = &tdriverConn497{}
+	_ driver.Execer             = &tdriverConn497{}
+	_ driver.ExecerContext      = &tdriverConn497{}
+	_ driver.NamedValueChecker  = &tdriverConn497{}
+	_ driver.Queryer            = &tdriverConn497{}
+	_ driver.QueryerContext     = &tdriverConn497{}
+	_ driver.SessionResetter    = &tdriverConn497{}
+	_ driver.Conn               = &tdriverConn498{}
+	_ driver.ConnPrepareContext = &tdriverConn498{}
+	_ driver.Execer             = &tdriverConn498{}
+	_ driver.ExecerContext      = &tdriverConn498{}
+	_ driver.Pinger             = &tdriverConn498{}
+	_ driver.Queryer            = &tdriverConn498{}
+	_ driver.QueryerContext     = &tdriverConn498{}
+	_ driver.SessionResetter    = &tdriverConn498{}
+	_ driver.Conn               = &tdriverConn499{}
+	_ driver.ConnPrepareContext = &tdriverConn499{}
+	_ driver.Execer             = &tdriverConn499{}
+	_ driver.NamedValueChecker  = &tdriverConn499{}
+	_ driver.Pinger             = &tdriverConn499{}
+	_ driver.Queryer            = &tdriverConn499{}
+	_ driver.QueryerContext     = &tdriverConn499{}
+	_ driver.SessionResetter    = &tdriverConn499{}
+	_ driver.Conn               = &tdriverConn500{}
+	_ driver.ConnPrepareContext = &tdriverConn500{}
+	_ driver.ExecerContext      = &tdriverConn500{}
+	_ driver.NamedValueChecker  = &tdriverConn500{}
+	_ driver.Pinger             = &tdriverConn500{}
+	_ driver.Queryer            = &tdriverConn500{}
+	_ driver.QueryerContext     = &tdriverConn500{}
+	_ driver.SessionResetter    = &tdriverConn500{}
+	_ driver.Conn               = &tdriverConn501{}
+	_ driver.Execer             = &tdriverConn501{}
+	_ driver.ExecerContext      = &tdriverConn501{}
+	_ driver.NamedValueChecker  = &tdriverConn501{}
+	_ driver.Pinger             = &tdriverConn501{}
+	_ driver.Queryer            = &tdriverConn501{}
+	_ driver.QueryerContext     = &tdriverConn501{}
+	_ driver.SessionResetter    = &tdriverConn501{}
+	_ driver.Conn               = &tdriverConn502{}
+	_ driver.ConnBeginTx        = &tdriverConn502{}
+	_ driver.ConnPrepareContext = &tdriverConn502{}
+	_ driver.Execer             = &tdriverConn502{}
+	_ driver.ExecerContext      = &tdriverConn502{}
+	_ driver.NamedValueChecker  = &tdriverConn502{}
+	_ driver.Pinger             = &tdriverConn502{}
+	_ driver.Queryer            = &tdriverConn502{}
+	_ driver.QueryerContext     = &tdriverConn502{}
+	_ driver.Conn               = &tdriverConn503{}
+	_ driver.ConnBeginTx        = &tdriverConn503{}
+	_ driver.ConnPrepareContext = &tdriverConn503{}
+	_ driver.Execer             = &tdriverConn503{}
+	_ driver.ExecerContext      = &tdriverConn503{}
+	_ driver.NamedValueChecker  = &tdriverConn503{}
+	_ driver.Pinger             = &tdriverConn503{}
+	_ driver.Queryer            = &tdriverConn503{}
+	_ driver.SessionResetter    = &tdriverConn503{}
+	_ driver.Conn               = &tdriverConn504{}
+	_ driver.ConnBeginTx        = &tdriverConn504{}
+	_ driver.ConnPrepareContext = &tdriverConn504{}
+	_ driver.Execer             = &tdriverConn504{}
+	_ driver.ExecerContext      = &tdriverConn504{}
+	_ driver.NamedValueChecker  = &tdriverConn504{}
+	_ driver.Pinger             = &tdriverConn504{}
+	_ driver.QueryerContext     = &tdriverConn504{}
+	_ driver.SessionResetter    = &tdriverConn504{}
+	_ driver.Conn               = &tdriverConn505{}
+	_ driver.ConnBeginTx        = &tdriverConn505{}
+	_ driver.ConnPrepareContext = &tdriverConn505{}
+	_ driver.Execer             = &tdriverConn505{}
+	_ driver.ExecerContext      = &tdriverConn505{}
+	_ driver.NamedValueChecker  = &tdriverConn505{}
+	_ driver.Queryer            = &tdriverConn505{}
+	_ driver.QueryerContext     = &tdriverConn505{}
+	_ driver.SessionResetter    = &tdriverConn505{}
+	_ driver.Conn               = &tdriverConn506{}
+	_ driver.ConnBeginTx        = &tdriverConn506{}
+	_ driver.ConnPrepareContext = &tdriverConn506{}
+	_ driver.Execer             = &tdriverConn506{}
+	_ driver.ExecerContext      = &tdriverConn506{}
+	_ driver.Pinger             = &tdriverConn506{}
+	_ driver.Queryer            = &tdriverConn506{}
+	_ driver.QueryerContext     = &tdriverConn506{}
+	_ driver.SessionResetter    = &tdriverConn506{}
+	_ driver.Conn               = &tdriverConn507{}
+	_ driver.ConnBeginTx        = &tdriverConn507{}
+	_ driver.ConnPrepareContext = &tdriverConn507{}
+	_ driver.Execer             = &tdriverConn507{}
+	_ driver.NamedValueChecker  = &tdriverConn507{}
+	_ driver.Pinger             = &tdriverConn507{}
+	_ driver.Queryer            = &tdriverConn507{}
+	_ driver.QueryerContext     = &tdriverConn507{}
+	_ driver.SessionResetter    = &tdriverConn507{}
+	_ driver.Conn               = &tdriverConn508{}
+	_ driver.ConnBeginTx        = &tdriverConn508{}
+	_ driver.ConnPrepareContext = &tdriverConn508{}
+	_ driver.ExecerContext      = &tdriverConn508{}
+	_ driver.NamedValueChecker  = &tdriverConn508{}
+	_ driver.Pinger             = &tdriverConn508{}
+	_ driver.Queryer            = &tdriverConn508{}
+	_ driver.QueryerContext     = &tdriverConn508{}
+	_ driver.SessionResetter    = &tdriverConn508{}
+	_ driver.Conn               = &tdriverConn509{}
+	_ driver.ConnBeginTx        = &tdriverConn509{}
+	_ driver.Execer             = &tdriverConn509{}
+	_ driver.ExecerContext      = &tdriverConn509{}
+	_ driver.NamedValueChecker  = &tdriverConn509{}
+	_ driver.Pinger             = &tdriverConn509{}
+	_ driver.Queryer            = &tdriverConn509{}
+	_ driver.QueryerContext     = &tdriverConn509{}
+	_ driver.SessionResetter    = &tdriverConn509{}
+	_ driver.Conn               = &tdriverConn510{}
+	_ driver.ConnPrepareContext = &tdriverConn510{}
+	_ driver.Execer             = &tdriverConn510{}
+	_ driver.ExecerContext      = &tdriverConn510{}
+	_ driver.NamedValueChecker  = &tdriverConn510{}
+	_ driver.Pinger             = &tdriverConn510{}
+	_ driver.Queryer            = &tdriverConn510{}
+	_ driver.QueryerContext     = &tdriverConn510{}
+	_ driver.SessionResetter    = &tdriverConn510{}
+	_ driver.Conn               = &tdriverConn511{}
+	_ driver.ConnBeginTx        = &tdriverConn511{}
+	_ driver.ConnPrepareContext = &tdriverConn511{}
+	_ driver.Execer             = &tdriverConn511{}
+	_ driver.ExecerContext      = &tdriverConn511{}
+	_ driver.NamedValueChecker  = &tdriverConn511{}
+	_ driver.Pinger             = &tdriverConn511{}
+	_ driver.Queryer            = &tdriverConn511{}
+	_ driver.QueryerContext     = &tdriverConn511{}
+	_ driver.SessionResetter    = &tdriverConn511{}
+)
+
+func (odriverConn0 *tdriverConn0) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn0.r, odriverConn0.extra)
+}
+func (odriverConn0 *tdriverConn0) Close() error {
+	return realDCClose(odriverConn0.r, odriverConn0.extra)
+}
+func (odriverConn0 *tdriverConn0) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn0.r, odriverConn0.extra, query)
+}
+
+func (odriverConn1 *tdriverConn1) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn1.r, odriverConn1.extra)
+}
+func (odriverConn1 *tdriverConn1) Close() error {
+	return realDCClose(odriverConn1.r, odriverConn1.extra)
+}
+func (odriverConn1 *tdriverConn1) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn1.r, odriverConn1.extra, query)
+}
+func (odriverConn1 *tdriverConn1) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn1.r, odriverConn1.extra, ctx, opts)
+}
+
+func (odriverConn2 *tdriverConn2) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn2.r, odriverConn2.extra)
+}
+func (odriverConn2 *tdriverConn2) Close() error {
+	return realDCClose(odriverConn2.r, odriverConn2.extra)
+}
+func (odriverConn2 *tdriverConn2) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn2.r, odriverConn2.extra, query)
+}
+func (odriverConn2 *tdriverConn2) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn2.r, odriverConn2.extra, ctx, query)
+}
+
+func (odriverConn3 *tdriverConn3) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn3.r, odriverConn3.extra)
+}
+func (odriverConn3 *tdriverConn3) Close() error {
+	return realDCClose(odriverConn3.r, odriverConn3.extra)
+}
+func (odriverConn3 *tdriverConn3) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn3.r, odriverConn3.extra, query)
+}
+func (odriverConn3 *tdriverConn3) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn3.r, odriverConn3.extra, query, args)
+}
+
+func (odriverConn4 *tdriverConn4) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn4.r, odriverConn4.extra)
+}
+func (odriverConn4 *tdriverConn4) Close() error {
+	return realDCClose(odriverConn4.r, odriverConn4.extra)
+}
+func (odriverConn4 *tdriverConn4) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn4.r, odriverConn4.extra, query)
+}
+func (odriverConn4 *tdriverConn4) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn4.r, odriverConn4.extra, ctx, query, args)
+}
+
+func (odriverConn5 *tdriverConn5) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn5.r, odriverConn5.extra)
+}
+func (odriverConn5 *tdriverConn5) Close() error {
+	return realDCClose(odriverConn5.r, odriverConn5.extra)
+}
+func (odriverConn5 *tdriverConn5) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn5.r, odriverConn5.extra, query)
+}
+func (odriverConn5 *tdriverConn5) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn5.r, odriverConn5.extra, param0)
+}
+
+func (odriverConn6 *tdriverConn6) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn6.r, odriverConn6.extra)
+}
+func (odriverConn6 *tdriverConn6) Close() error {
+	return realDCClose(odriverConn6.r, odriverConn6.extra)
+}
+func (odriverConn6 *tdriverConn6) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn6.r, odriverConn6.extra, query)
+}
+func (odriverConn6 *tdriverConn6) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn6.r, odriverConn6.extra, ctx)
+}
+
+func (odriverConn7 *tdriverConn7) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn7.r, odriverConn7.extra)
+}
+func (odriverConn7 *tdriverConn7) Close() error {
+	return realDCClose(odriverConn7.r, odriverConn7.extra)
+}
+func (odriverConn7 *tdriverConn7) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn7.r, odriverConn7.extra, query)
+}
+func (odriverConn7 *tdriverConn7) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn7.r, odriverConn7.extra, query, args)
+}
+
+func (odriverConn8 *tdriverConn8) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn8.r, odriverConn8.extra)
+}
+func (odriverConn8 *tdriverConn8) Close() error {
+	return realDCClose(odriverConn8.r, odriverConn8.extra)
+}
+func (odriverConn8 *tdriverConn8) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn8.r, odriverConn8.extra, query)
+}
+func (odriverConn8 *tdriverConn8) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn8.r, odriverConn8.extra, ctx, query, args)
+}
+
+func (odriverConn9 *tdriverConn9) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn9.r, odriverConn9.extra)
+}
+func (odriverConn9 *tdriverConn9) Close() error {
+	return realDCClose(odriverConn9.r, odriverConn9.extra)
+}
+func (odriverConn9 *tdriverConn9) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn9.r, odriverConn9.extra, query)
+}
+func (odriverConn9 *tdriverConn9) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn9.r, odriverConn9.extra, ctx)
+}
+
+func (odriverConn10 *tdriverConn10) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn10.r, odriverConn10.extra)
+}
+func (odriverConn10 *tdriverConn10) Close() error {
+	return realDCClose(odriverConn10.r, odriverConn10.extra)
+}
+func (odriverConn10 *tdriverConn10) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn10.r, odriverConn10.extra, query)
+}
+func (odriverConn10 *tdriverConn10) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn10.r, odriverConn10.extra, ctx, opts)
+}
+func (odriverConn10 *tdriverConn10) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn10.r, odriverConn10.extra, ctx, query)
+}
+
+func (odriverConn11 *tdriverConn11) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn11.r, odriverConn11.extra)
+}
+func (odriverConn11 *tdriverConn11) Close() error {
+	return realDCClose(odriverConn11.r, odriverConn11.extra)
+}
+func (odriverConn11 *tdriverConn11) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn11.r, odriverConn11.extra, query)
+}
+func (odriverConn11 *tdriverConn11) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn11.r, odriverConn11.extra, ctx, opts)
+}
+func (odriverConn11 *tdriverConn11) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn11.r, odriverConn11.extra, query, args)
+}
+
+func (odriverConn12 *tdriverConn12) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn12.r, odriverConn12.extra)
+}
+func (odriverConn12 *tdriverConn12) Close() error {
+	return realDCClose(odriverConn12.r, odriverConn12.extra)
+}
+func (odriverConn12 *tdriverConn12) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn12.r, odriverConn12.extra, query)
+}
+func (odriverConn12 *tdriverConn12) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn12.r, odriverConn12.extra, ctx, opts)
+}
+func (odriverConn12 *tdriverConn12) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn12.r, odriverConn12.extra, ctx, query, args)
+}
+
+func (odriverConn13 *tdriverConn13) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn13.r, odriverConn13.extra)
+}
+func (odriverConn13 *tdriverConn13) Close() error {
+	return realDCClose(odriverConn13.r, odriverConn13.extra)
+}
+func (odriverConn13 *tdriverConn13) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn13.r, odriverConn13.extra, query)
+}
+func (odriverConn13 *tdriverConn13) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn13.r, odriverConn13.extra, ctx, opts)
+}
+func (odriverConn13 *tdriverConn13) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn13.r, odriverConn13.extra, param0)
+}
+
+func (odriverConn14 *tdriverConn14) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn14.r, odriverConn14.extra)
+}
+func (odriverConn14 *tdriverConn14) Close() error {
+	return realDCClose(odriverConn14.r, odriverConn14.extra)
+}
+func (odriverConn14 *tdriverConn14) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn14.r, odriverConn14.extra, query)
+}
+func (odriverConn14 *tdriverConn14) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn14.r, odriverConn14.extra, ctx, opts)
+}
+func (odriverConn14 *tdriverConn14) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn14.r, odriverConn14.extra, ctx)
+}
+
+func (odriverConn15 *tdriverConn15) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn15.r, odriverConn15.extra)
+}
+func (odriverConn15 *tdriverConn15) Close() error {
+	return realDCClose(odriverConn15.r, odriverConn15.extra)
+}
+func (odriverConn15 *tdriverConn15) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn15.r, odriverConn15.extra, query)
+}
+func (odriverConn15 *tdriverConn15) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn15.r, odriverConn15.extra, ctx, opts)
+}
+func (odriverConn15 *tdriverConn15) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn15.r, odriverConn15.extra, query, args)
+}
+
+func (odriverConn16 *tdriverConn16) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn16.r, odriverConn16.extra)
+}
+func (odriverConn16 *tdriverConn16) Close() error {
+	return realDCClose(odriverConn16.r, odriverConn16.extra)
+}
+func (odriverConn16 *tdriverConn16) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn16.r, odriverConn16.extra, query)
+}
+func (odriverConn16 *tdriverConn16) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn16.r, odriverConn16.extra, ctx, opts)
+}
+func (odriverConn16 *tdriverConn16) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn16.r, odriverConn16.extra, ctx, query, args)
+}
+
+func (odriverConn17 *tdriverConn17) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn17.r, odriverConn17.extra)
+}
+func (odriverConn17 *tdriverConn17) Close() error {
+	return realDCClose(odriverConn17.r, odriverConn17.extra)
+}
+func (odriverConn17 *tdriverConn17) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn17.r, odriverConn17.extra, query)
+}
+func (odriverConn17 *tdriverConn17) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn17.r, odriverConn17.extra, ctx, opts)
+}
+func (odriverConn17 *tdriverConn17) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn17.r, odriverConn17.extra, ctx)
+}
+
+func (odriverConn18 *tdriverConn18) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn18.r, odriverConn18.extra)
+}
+func (odriverConn18 *tdriverConn18) Close() error {
+	return realDCClose(odriverConn18.r, odriverConn18.extra)
+}
+func (odriverConn18 *tdriverConn18) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn18.r, odriverConn18.extra, query)
+}
+func (odriverConn18 *tdriverConn18) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn18.r, odriverConn18.extra, ctx, query)
+}
+func (odriverConn18 *tdriverConn18) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn18.r, odriverConn18.extra, query, args)
+}
+
+func (odriverConn19 *tdriverConn19) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn19.r, odriverConn19.extra)
+}
+func (odriverConn19 *tdriverConn19) Close() error {
+	return realDCClose(odriverConn19.r, odriverConn19.extra)
+}
+func (odriverConn19 *tdriverConn19) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn19.r, odriverConn19.extra, query)
+}
+func (odriverConn19 *tdriverConn19) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn19.r, odriverConn19.extra, ctx, query)
+}
+func (odriverConn19 *tdriverConn19) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn19.r, odriverConn19.extra, ctx, query, args)
+}
+
+func (odriverConn20 *tdriverConn20) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn20.r, odriverConn20.extra)
+}
+func (odriverConn20 *tdriverConn20) Close() error {
+	return realDCClose(odriverConn20.r, odriverConn20.extra)
+}
+func (odriverConn20 *tdriverConn20) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn20.r, odriverConn20.extra, query)
+}
+func (odriverConn20 *tdriverConn20) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn20.r, odriverConn20.extra, ctx, query)
+}
+func (odriverConn20 *tdriverConn20) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn20.r, odriverConn20.extra, param0)
+}
+
+func (odriverConn21 *tdriverConn21) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn21.r, odriverConn21.extra)
+}
+func (odriverConn21 *tdriverConn21) Close() error {
+	return realDCClose(odriverConn21.r, odriverConn21.extra)
+}
+func (odriverConn21 *tdriverConn21) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn21.r, odriverConn21.extra, query)
+}
+func (odriverConn21 *tdriverConn21) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn21.r, odriverConn21.extra, ctx, query)
+}
+func (odriverConn21 *tdriverConn21) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn21.r, odriverConn21.extra, ctx)
+}
+
+func (odriverConn22 *tdriverConn22) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn22.r, odriverConn22.extra)
+}
+func (odriverConn22 *tdriverConn22) Close() error {
+	return realDCClose(odriverConn22.r, odriverConn22.extra)
+}
+func (odriverConn22 *tdriverConn22) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn22.r, odriverConn22.extra, query)
+}
+func (odriverConn22 *tdriverConn22) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn22.r, odriverConn22.extra, ctx, query)
+}
+func (odriverConn22 *tdriverConn22) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn22.r, odriverConn22.extra, query, args)
+}
+
+func (odriverConn23 *tdriverConn23) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn23.r, odriverConn23.extra)
+}
+func (odriverConn23 *tdriverConn23) Close() error {
+	return realDCClose(odriverConn23.r, odriverConn23.extra)
+}
+func (odriverConn23 *tdriverConn23) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn23.r, odriverConn23.extra, query)
+}
+func (odriverConn23 *tdriverConn23) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn23.r, odriverConn23.extra, ctx, query)
+}
+func (odriverConn23 *tdriverConn23) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn23.r, odriverConn23.extra, ctx, query, args)
+}
+
+func (odriverConn24 *tdriverConn24) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn24.r, odriverConn24.extra)
+}
+func (odriverConn24 *tdriverConn24) Close() error {
+	return realDCClose(odriverConn24.r, odriverConn24.extra)
+}
+func (odriverConn24 *tdriverConn24) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn24.r, odriverConn24.extra, query)
+}
+func (odriverConn24 *tdriverConn24) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn24.r, odriverConn24.extra, ctx, query)
+}
+func (odriverConn24 *tdriverConn24) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn24.r, odriverConn24.extra, ctx)
+}
+
+func (odriverConn25 *tdriverConn25) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn25.r, odriverConn25.extra)
+}
+func (odriverConn25 *tdriverConn25) Close() error {
+	return realDCClose(odriverConn25.r, odriverConn25.extra)
+}
+func (odriverConn25 *tdriverConn25) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn25.r, odriverConn25.extra, query)
+}
+func (odriverConn25 *tdriverConn25) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn25.r, odriverConn25.extra, query, args)
+}
+func (odriverConn25 *tdriverConn25) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn25.r, odriverConn25.extra, ctx, query, args)
+}
+
+func (odriverConn26 *tdriverConn26) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn26.r, odriverConn26.extra)
+}
+func (odriverConn26 *tdriverConn26) Close() error {
+	return realDCClose(odriverConn26.r, odriverConn26.extra)
+}
+func (odriverConn26 *tdriverConn26) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn26.r, odriverConn26.extra, query)
+}
+func (odriverConn26 *tdriverConn26) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn26.r, odriverConn26.extra, query, args)
+}
+func (odriverConn26 *tdriverConn26) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn26.r, odriverConn26.extra, param0)
+}
+
+func (odriverConn27 *tdriverConn27) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn27.r, odriverConn27.extra)
+}
+func (odriverConn27 *tdriverConn27) Close() error {
+	return realDCClose(odriverConn27.r, odriverConn27.extra)
+}
+func (odriverConn27 *tdriverConn27) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn27.r, odriverConn27.extra, query)
+}
+func (odriverConn27 *tdriverConn27) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn27.r, odriverConn27.extra, query, args)
+}
+func (odriverConn27 *tdriverConn27) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn27.r, odriverConn27.extra, ctx)
+}
+
+func (odriverConn28 *tdriverConn28) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn28.r, odriverConn28.extra)
+}
+func (odriverConn28 *tdriverConn28) Close() error {
+	return realDCClose(odriverConn28.r, odriverConn28.extra)
+}
+func (odriverConn28 *tdriverConn28) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn28.r, odriverConn28.extra, query)
+}
+func (odriverConn28 *tdriverConn28) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn28.r, odriverConn28.extra, query, args)
+}
+func (odriverConn28 *tdriverConn28) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn28.r, odriverConn28.extra, query, args)
+}
+
+func (odriverConn29 *tdriverConn29) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn29.r, odriverConn29.extra)
+}
+func (odriverConn29 *tdriverConn29) Close() error {
+	return realDCClose(odriverConn29.r, odriverConn29.extra)
+}
+func (odriverConn29 *tdriverConn29) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn29.r, odriverConn29.extra, query)
+}
+func (odriverConn29 *tdriverConn29) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn29.r, odriverConn29.extra, query, args)
+}
+func (odriverConn29 *tdriverConn29) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn29.r, odriverConn29.extra, ctx, query, args)
+}
+
+func (odriverConn30 *tdriverConn30) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn30.r, odriverConn30.extra)
+}
+func (odriverConn30 *tdriverConn30) Close() error {
+	return realDCClose(odriverConn30.r, odriverConn30.extra)
+}
+func (odriverConn30 *tdriverConn30) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn30.r, odriverConn30.extra, query)
+}
+func (odriverConn30 *tdriverConn30) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn30.r, odriverConn30.extra, query, args)
+}
+func (odriverConn30 *tdriverConn30) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn30.r, odriverConn30.extra, ctx)
+}
+
+func (odriverConn31 *tdriverConn31) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn31.r, odriverConn31.extra)
+}
+func (odriverConn31 *tdriverConn31) Close() error {
+	return realDCClose(odriverConn31.r, odriverConn31.extra)
+}
+func (odriverConn31 *tdriverConn31) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn31.r, odriverConn31.extra, query)
+}
+func (odriverConn31 *tdriverConn31) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn31.r, odriverConn31.extra, ctx, query, args)
+}
+func (odriverConn31 *tdriverConn31) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn31.r, odriverConn31.extra, param0)
+}
+
+func (odriverConn32 *tdriverConn32) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn32.r, odriverConn32.extra)
+}
+func (odriverConn32 *tdriverConn32) Close() error {
+	return realDCClose(odriverConn32.r, odriverConn32.extra)
+}
+func (odriverConn32 *tdriverConn32) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn32.r, odriverConn32.extra, query)
+}
+func (odriverConn32 *tdriverConn32) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn32.r, odriverConn32.extra, ctx, query, args)
+}
+func (odriverConn32 *tdriverConn32) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn32.r, odriverConn32.extra, ctx)
+}
+
+func (odriverConn33 *tdriverConn33) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn33.r, odriverConn33.extra)
+}
+func (odriverConn33 *tdriverConn33) Close() error {
+	return realDCClose(odriverConn33.r, odriverConn33.extra)
+}
+func (odriverConn33 *tdriverConn33) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn33.r, odriverConn33.extra, query)
+}
+func (odriverConn33 *tdriverConn33) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn33.r, odriverConn33.extra, ctx, query, args)
+}
+func (odriverConn33 *tdriverConn33) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn33.r, odriverConn33.extra, query, args)
+}
+
+func (odriverConn34 *tdriverConn34) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn34.r, odriverConn34.extra)
+}
+func (odriverConn34 *tdriverConn34) Close() error {
+	return realDCClose(odriverConn34.r, odriverConn34.extra)
+}
+func (odriverConn34 *tdriverConn34) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn34.r, odriverConn34.extra, query)
+}
+func (odriverConn34 *tdriverConn34) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn34.r, odriverConn34.extra, ctx, query, args)
+}
+func (odriverConn34 *tdriverConn34) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn34.r, odriverConn34.extra, ctx, query, args)
+}
+
+func (odriverConn35 *tdriverConn35) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn35.r, odriverConn35.extra)
+}
+func (odriverConn35 *tdriverConn35) Close() error {
+	return realDCClose(odriverConn35.r, odriverConn35.extra)
+}
+func (odriverConn35 *tdriverConn35) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn35.r, odriverConn35.extra, query)
+}
+func (odriverConn35 *tdriverConn35) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn35.r, odriverConn35.extra, ctx, query, args)
+}
+func (odriverConn35 *tdriverConn35) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn35.r, odriverConn35.extra, ctx)
+}
+
+func (odriverConn36 *tdriverConn36) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn36.r, odriverConn36.extra)
+}
+func (odriverConn36 *tdriverConn36) Close() error {
+	return realDCClose(odriverConn36.r, odriverConn36.extra)
+}
+func (odriverConn36 *tdriverConn36) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn36.r, odriverConn36.extra, query)
+}
+func (odriverConn36 *tdriverConn36) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn36.r, odriverConn36.extra, param0)
+}
+func (odriverConn36 *tdriverConn36) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn36.r, odriverConn36.extra, ctx)
+}
+
+func (odriverConn37 *tdriverConn37) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn37.r, odriverConn37.extra)
+}
+func (odriverConn37 *tdriverConn37) Close() error {
+	return realDCClose(odriverConn37.r, odriverConn37.extra)
+}
+func (odriverConn37 *tdriverConn37) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn37.r, odriverConn37.extra, query)
+}
+func (odriverConn37 *tdriverConn37) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn37.r, odriverConn37.extra, param0)
+}
+func (odriverConn37 *tdriverConn37) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn37.r, odriverConn37.extra, query, args)
+}
+
+func (odriverConn38 *tdriverConn38) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn38.r, odriverConn38.extra)
+}
+func (odriverConn38 *tdriverConn38) Close() error {
+	return realDCClose(odriverConn38.r, odriverConn38.extra)
+}
+func (odriverConn38 *tdriverConn38) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn38.r, odriverConn38.extra, query)
+}
+func (odriverConn38 *tdriverConn38) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn38.r, odriverConn38.extra, param0)
+}
+func (odriverConn38 *tdriverConn38) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn38.r, odriverConn38.extra, ctx, query, args)
+}
+
+func (odriverConn39 *tdriverConn39) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn39.r, odriverConn39.extra)
+}
+func (odriverConn39 *tdriverConn39) Close() error {
+	return realDCClose(odriverConn39.r, odriverConn39.extra)
+}
+func (odriverConn39 *tdriverConn39) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn39.r, odriverConn39.extra, query)
+}
+func (odriverConn39 *tdriverConn39) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn39.r, odriverConn39.extra, param0)
+}
+func (odriverConn39 *tdriverConn39) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn39.r, odriverConn39.extra, ctx)
+}
+
+func (odriverConn40 *tdriverConn40) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn40.r, odriverConn40.extra)
+}
+func (odriverConn40 *tdriverConn40) Close() error {
+	return realDCClose(odriverConn40.r, odriverConn40.extra)
+}
+func (odriverConn40 *tdriverConn40) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn40.r, odriverConn40.extra, query)
+}
+func (odriverConn40 *tdriverConn40) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn40.r, odriverConn40.extra, ctx)
+}
+func (odriverConn40 *tdriverConn40) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn40.r, odriverConn40.extra, query, args)
+}
+
+func (odriverConn41 *tdriverConn41) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn41.r, odriverConn41.extra)
+}
+func (odriverConn41 *tdriverConn41) Close() error {
+	return realDCClose(odriverConn41.r, odriverConn41.extra)
+}
+func (odriverConn41 *tdriverConn41) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn41.r, odriverConn41.extra, query)
+}
+func (odriverConn41 *tdriverConn41) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn41.r, odriverConn41.extra, ctx)
+}
+func (odriverConn41 *tdriverConn41) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn41.r, odriverConn41.extra, ctx, query, args)
+}
+
+func (odriverConn42 *tdriverConn42) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn42.r, odriverConn42.extra)
+}
+func (odriverConn42 *tdriverConn42) Close() error {
+	return realDCClose(odriverConn42.r, odriverConn42.extra)
+}
+func (odriverConn42 *tdriverConn42) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn42.r, odriverConn42.extra, query)
+}
+func (odriverConn42 *tdriverConn42) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn42.r, odriverConn42.extra, ctx)
+}
+func (odriverConn42 *tdriverConn42) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn42.r, odriverConn42.extra, ctx)
+}
+
+func (odriverConn43 *tdriverConn43) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn43.r, odriverConn43.extra)
+}
+func (odriverConn43 *tdriverConn43) Close() error {
+	return realDCClose(odriverConn43.r, odriverConn43.extra)
+}
+func (odriverConn43 *tdriverConn43) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn43.r, odriverConn43.extra, query)
+}
+func (odriverConn43 *tdriverConn43) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn43.r, odriverConn43.extra, query, args)
+}
+func (odriverConn43 *tdriverConn43) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn43.r, odriverConn43.extra, ctx, query, args)
+}
+
+func (odriverConn44 *tdriverConn44) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn44.r, odriverConn44.extra)
+}
+func (odriverConn44 *tdriverConn44) Close() error {
+	return realDCClose(odriverConn44.r, odriverConn44.extra)
+}
+func (odriverConn44 *tdriverConn44) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn44.r, odriverConn44.extra, query)
+}
+func (odriverConn44 *tdriverConn44) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn44.r, odriverConn44.extra, query, args)
+}
+func (odriverConn44 *tdriverConn44) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn44.r, odriverConn44.extra, ctx)
+}
+
+func (odriverConn45 *tdriverConn45) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn45.r, odriverConn45.extra)
+}
+func (odriverConn45 *tdriverConn45) Close() error {
+	return realDCClose(odriverConn45.r, odriverConn45.extra)
+}
+func (odriverConn45 *tdriverConn45) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn45.r, odriverConn45.extra, query)
+}
+func (odriverConn45 *tdriverConn45) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn45.r, odriverConn45.extra, ctx, query, args)
+}
+func (odriverConn45 *tdriverConn45) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn45.r, odriverConn45.extra, ctx)
+}
+
+func (odriverConn46 *tdriverConn46) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn46.r, odriverConn46.extra)
+}
+func (odriverConn46 *tdriverConn46) Close() error {
+	return realDCClose(odriverConn46.r, odriverConn46.extra)
+}
+func (odriverConn46 *tdriverConn46) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn46.r, odriverConn46.extra, query)
+}
+func (odriverConn46 *tdriverConn46) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn46.r, odriverConn46.extra, ctx, opts)
+}
+func (odriverConn46 *tdriverConn46) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn46.r, odriverConn46.extra, ctx, query)
+}
+func (odriverConn46 *tdriverConn46) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn46.r, odriverConn46.extra, query, args)
+}
+
+func (odriverConn47 *tdriverConn47) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn47.r, odriverConn47.extra)
+}
+func (odriverConn47 *tdriverConn47) Close() error {
+	return realDCClose(odriverConn47.r, odriverConn47.extra)
+}
+func (odriverConn47 *tdriverConn47) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn47.r, odriverConn47.extra, query)
+}
+func (odriverConn47 *tdriverConn47) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn47.r, odriverConn47.extra, ctx, opts)
+}
+func (odriverConn47 *tdriverConn47) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn47.r, odriverConn47.extra, ctx, query)
+}
+func (odriverConn47 *tdriverConn47) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn47.r, odriverConn47.extra, ctx, query, args)
+}
+
+func (odriverConn48 *tdriverConn48) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn48.r, odriverConn48.extra)
+}
+func (odriverConn48 *tdriverConn48) Close() error {
+	return realDCClose(odriverConn48.r, odriverConn48.extra)
+}
+func (odriverConn48 *tdriverConn48) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn48.r, odriverConn48.extra, query)
+}
+func (odriverConn48 *tdriverConn48) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn48.r, odriverConn48.extra, ctx, opts)
+}
+func (odriverConn48 *tdriverConn48) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn48.r, odriverConn48.extra, ctx, query)
+}
+func (odriverConn48 *tdriverConn48) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn48.r, odriverConn48.extra, param0)
+}
+
+func (odriverConn49 *tdriverConn49) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn49.r, odriverConn49.extra)
+}
+func (odriverConn49 *tdriverConn49) Close() error {
+	return realDCClose(odriverConn49.r, odriverConn49.extra)
+}
+func (odriverConn49 *tdriverConn49) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn49.r, odriverConn49.extra, query)
+}
+func (odriverConn49 *tdriverConn49) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn49.r, odriverConn49.extra, ctx, opts)
+}
+func (odriverConn49 *tdriverConn49) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn49.r, odriverConn49.extra, ctx, query)
+}
+func (odriverConn49 *tdriverConn49) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn49.r, odriverConn49.extra, ctx)
+}
+
+func (odriverConn50 *tdriverConn50) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn50.r, odriverConn50.extra)
+}
+func (odriverConn50 *tdriverConn50) Close() error {
+	return realDCClose(odriverConn50.r, odriverConn50.extra)
+}
+func (odriverConn50 *tdriverConn50) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn50.r, odriverConn50.extra, query)
+}
+func (odriverConn50 *tdriverConn50) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn50.r, odriverConn50.extra, ctx, opts)
+}
+func (odriverConn50 *tdriverConn50) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn50.r, odriverConn50.extra, ctx, query)
+}
+func (odriverConn50 *tdriverConn50) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn50.r, odriverConn50.extra, query, args)
+}
+
+func (odriverConn51 *tdriverConn51) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn51.r, odriverConn51.extra)
+}
+func (odriverConn51 *tdriverConn51) Close() error {
+	return realDCClose(odriverConn51.r, odriverConn51.extra)
+}
+func (odriverConn51 *tdriverConn51) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn51.r, odriverConn51.extra, query)
+}
+func (odriverConn51 *tdriverConn51) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn51.r, odriverConn51.extra, ctx, opts)
+}
+func (odriverConn51 *tdriverConn51) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn51.r, odriverConn51.extra, ctx, query)
+}
+func (odriverConn51 *tdriverConn51) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn51.r, odriverConn51.extra, ctx, query, args)
+}
+
+func (odriverConn52 *tdriverConn52) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn52.r, odriverConn52.extra)
+}
+func (odriverConn52 *tdriverConn52) Close() error {
+	return realDCClose(odriverConn52.r, odriverConn52.extra)
+}
+func (odriverConn52 *tdriverConn52) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn52.r, odriverConn52.extra, query)
+}
+func (odriverConn52 *tdriverConn52) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn52.r, odriverConn52.extra, ctx, opts)
+}
+func (odriverConn52 *tdriverConn52) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn52.r, odriverConn52.extra, ctx, query)
+}
+func (odriverConn52 *tdriverConn52) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn52.r, odriverConn52.extra, ctx)
+}
+
+func (odriverConn53 *tdriverConn53) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn53.r, odriverConn53.extra)
+}
+func (odriverConn53 *tdriverConn53) Close() error {
+	return realDCClose(odriverConn53.r, odriverConn53.extra)
+}
+func (odriverConn53 *tdriverConn53) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn53.r, odriverConn53.extra, query)
+}
+func (odriverConn53 *tdriverConn53) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn53.r, odriverConn53.extra, ctx, opts)
+}
+func (odriverConn53 *tdriverConn53) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn53.r, odriverConn53.extra, query, args)
+}
+func (odriverConn53 *tdriverConn53) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn53.r, odriverConn53.extra, ctx, query, args)
+}
+
+func (odriverConn54 *tdriverConn54) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn54.r, odriverConn54.extra)
+}
+func (odriverConn54 *tdriverConn54) Close() error {
+	return realDCClose(odriverConn54.r, odriverConn54.extra)
+}
+func (odriverConn54 *tdriverConn54) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn54.r, odriverConn54.extra, query)
+}
+func (odriverConn54 *tdriverConn54) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn54.r, odriverConn54.extra, ctx, opts)
+}
+func (odriverConn54 *tdriverConn54) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn54.r, odriverConn54.extra, query, args)
+}
+func (odriverConn54 *tdriverConn54) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn54.r, odriverConn54.extra, param0)
+}
+
+func (odriverConn55 *tdriverConn55) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn55.r, odriverConn55.extra)
+}
+func (odriverConn55 *tdriverConn55) Close() error {
+	return realDCClose(odriverConn55.r, odriverConn55.extra)
+}
+func (odriverConn55 *tdriverConn55) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn55.r, odriverConn55.extra, query)
+}
+func (odriverConn55 *tdriverConn55) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn55.r, odriverConn55.extra, ctx, opts)
+}
+func (odriverConn55 *tdriverConn55) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn55.r, odriverConn55.extra, query, args)
+}
+func (odriverConn55 *tdriverConn55) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn55.r, odriverConn55.extra, ctx)
+}
+
+func (odriverConn56 *tdriverConn56) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn56.r, odriverConn56.extra)
+}
+func (odriverConn56 *tdriverConn56) Close() error {
+	return realDCClose(odriverConn56.r, odriverConn56.extra)
+}
+func (odriverConn56 *tdriverConn56) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn56.r, odriverConn56.extra, query)
+}
+func (odriverConn56 *tdriverConn56) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn56.r, odriverConn56.extra, ctx, opts)
+}
+func (odriverConn56 *tdriverConn56) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn56.r, odriverConn56.extra, query, args)
+}
+func (odriverConn56 *tdriverConn56) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn56.r, odriverConn56.extra, query, args)
+}
+
+func (odriverConn57 *tdriverConn57) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn57.r, odriverConn57.extra)
+}
+func (odriverConn57 *tdriverConn57) Close() error {
+	return realDCClose(odriverConn57.r, odriverConn57.extra)
+}
+func (odriverConn57 *tdriverConn57) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn57.r, odriverConn57.extra, query)
+}
+func (odriverConn57 *tdriverConn57) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn57.r, odriverConn57.extra, ctx, opts)
+}
+func (odriverConn57 *tdriverConn57) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn57.r, odriverConn57.extra, query, args)
+}
+func (odriverConn57 *tdriverConn57) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn57.r, odriverConn57.extra, ctx, query, args)
+}
+
+func (odriverConn58 *tdriverConn58) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn58.r, odriverConn58.extra)
+}
+func (odriverConn58 *tdriverConn58) Close() error {
+	return realDCClose(odriverConn58.r, odriverConn58.extra)
+}
+func (odriverConn58 *tdriverConn58) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn58.r, odriverConn58.extra, query)
+}
+func (odriverConn58 *tdriverConn58) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn58.r, odriverConn58.extra, ctx, opts)
+}
+func (odriverConn58 *tdriverConn58) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn58.r, odriverConn58.extra, query, args)
+}
+func (odriverConn58 *tdriverConn58) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn58.r, odriverConn58.extra, ctx)
+}
+
+func (odriverConn59 *tdriverConn59) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn59.r, odriverConn59.extra)
+}
+func (odriverConn59 *tdriverConn59) Close() error {
+	return realDCClose(odriverConn59.r, odriverConn59.extra)
+}
+func (odriverConn59 *tdriverConn59) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn59.r, odriverConn59.extra, query)
+}
+func (odriverConn59 *tdriverConn59) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn59.r, odriverConn59.extra, ctx, opts)
+}
+func (odriverConn59 *tdriverConn59) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn59.r, odriverConn59.extra, ctx, query, args)
+}
+func (odriverConn59 *tdriverConn59) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn59.r, odriverConn59.extra, param0)
+}
+
+func (odriverConn60 *tdriverConn60) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn60.r, odriverConn60.extra)
+}
+func (odriverConn60 *tdriverConn60) Close() error {
+	return realDCClose(odriverConn60.r, odriverConn60.extra)
+}
+func (odriverConn60 *tdriverConn60) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn60.r, odriverConn60.extra, query)
+}
+func (odriverConn60 *tdriverConn60) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn60.r, odriverConn60.extra, ctx, opts)
+}
+func (odriverConn60 *tdriverConn60) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn60.r, odriverConn60.extra, ctx, query, args)
+}
+func (odriverConn60 *tdriverConn60) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn60.r, odriverConn60.extra, ctx)
+}
+
+func (odriverConn61 *tdriverConn61) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn61.r, odriverConn61.extra)
+}
+func (odriverConn61 *tdriverConn61) Close() error {
+	return realDCClose(odriverConn61.r, odriverConn61.extra)
+}
+func (odriverConn61 *tdriverConn61) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn61.r, odriverConn61.extra, query)
+}
+func (odriverConn61 *tdriverConn61) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn61.r, odriverConn61.extra, ctx, opts)
+}
+func (odriverConn61 *tdriverConn61) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn61.r, odriverConn61.extra, ctx, query, args)
+}
+func (odriverConn61 *tdriverConn61) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn61.r, odriverConn61.extra, query, args)
+}
+
+func (odriverConn62 *tdriverConn62) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn62.r, odriverConn62.extra)
+}
+func (odriverConn62 *tdriverConn62) Close() error {
+	return realDCClose(odriverConn62.r, odriverConn62.extra)
+}
+func (odriverConn62 *tdriverConn62) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn62.r, odriverConn62.extra, query)
+}
+func (odriverConn62 *tdriverConn62) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn62.r, odriverConn62.extra, ctx, opts)
+}
+func (odriverConn62 *tdriverConn62) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn62.r, odriverConn62.extra, ctx, query, args)
+}
+func (odriverConn62 *tdriverConn62) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn62.r, odriverConn62.extra, ctx, query, args)
+}
+
+func (odriverConn63 *tdriverConn63) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn63.r, odriverConn63.extra)
+}
+func (odriverConn63 *tdriverConn63) Close() error {
+	return realDCClose(odriverConn63.r, odriverConn63.extra)
+}
+func (odriverConn63 *tdriverConn63) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn63.r, odriverConn63.extra, query)
+}
+func (odriverConn63 *tdriverConn63) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn63.r, odriverConn63.extra, ctx, opts)
+}
+func (odriverConn63 *tdriverConn63) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn63.r, odriverConn63.extra, ctx, query, args)
+}
+func (odriverConn63 *tdriverConn63) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn63.r, odriverConn63.extra, ctx)
+}
+
+func (odriverConn64 *tdriverConn64) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn64.r, odriverConn64.extra)
+}
+func (odriverConn64 *tdriverConn64) Close() error {
+	return realDCClose(odriverConn64.r, odriverConn64.extra)
+}
+func (odriverConn64 *tdriverConn64) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn64.r, odriverConn64.extra, query)
+}
+func (odriverConn64 *tdriverConn64) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn64.r, odriverConn64.extra, ctx, opts)
+}
+func (odriverConn64 *tdriverConn64) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn64.r, odriverConn64.extra, param0)
+}
+func (odriverConn64 *tdriverConn64) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn64.r, odriverConn64.extra, ctx)
+}
+
+func (odriverConn65 *tdriverConn65) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn65.r, odriverConn65.extra)
+}
+func (odriverConn65 *tdriverConn65) Close() error {
+	return realDCClose(odriverConn65.r, odriverConn65.extra)
+}
+func (odriverConn65 *tdriverConn65) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn65.r, odriverConn65.extra, query)
+}
+func (odriverConn65 *tdriverConn65) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn65.r, odriverConn65.extra, ctx, opts)
+}
+func (odriverConn65 *tdriverConn65) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn65.r, odriverConn65.extra, param0)
+}
+func (odriverConn65 *tdriverConn65) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn65.r, odriverConn65.extra, query, args)
+}
+
+func (odriverConn66 *tdriverConn66) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn66.r, odriverConn66.extra)
+}
+func (odriverConn66 *tdriverConn66) Close() error {
+	return realDCClose(odriverConn66.r, odriverConn66.extra)
+}
+func (odriverConn66 *tdriverConn66) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn66.r, odriverConn66.extra, query)
+}
+func (odriverConn66 *tdriverConn66) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn66.r, odriverConn66.extra, ctx, opts)
+}
+func (odriverConn66 *tdriverConn66) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn66.r, odriverConn66.extra, param0)
+}
+func (odriverConn66 *tdriverConn66) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn66.r, odriverConn66.extra, ctx, query, args)
+}
+
+func (odriverConn67 *tdriverConn67) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn67.r, odriverConn67.extra)
+}
+func (odriverConn67 *tdriverConn67) Close() error {
+	return realDCClose(odriverConn67.r, odriverConn67.extra)
+}
+func (odriverConn67 *tdriverConn67) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn67.r, odriverConn67.extra, query)
+}
+func (odriverConn67 *tdriverConn67) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn67.r, odriverConn67.extra, ctx, opts)
+}
+func (odriverConn67 *tdriverConn67) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn67.r, odriverConn67.extra, param0)
+}
+func (odriverConn67 *tdriverConn67) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn67.r, odriverConn67.extra, ctx)
+}
+
+func (odriverConn68 *tdriverConn68) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn68.r, odriverConn68.extra)
+}
+func (odriverConn68 *tdriverConn68) Close() error {
+	return realDCClose(odriverConn68.r, odriverConn68.extra)
+}
+func (odriverConn68 *tdriverConn68) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn68.r, odriverConn68.extra, query)
+}
+func (odriverConn68 *tdriverConn68) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn68.r, odriverConn68.extra, ctx, opts)
+}
+func (odriverConn68 *tdriverConn68) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn68.r, odriverConn68.extra, ctx)
+}
+func (odriverConn68 *tdriverConn68) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn68.r, odriverConn68.extra, query, args)
+}
+
+func (odriverConn69 *tdriverConn69) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn69.r, odriverConn69.extra)
+}
+func (odriverConn69 *tdriverConn69) Close() error {
+	return realDCClose(odriverConn69.r, odriverConn69.extra)
+}
+func (odriverConn69 *tdriverConn69) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn69.r, odriverConn69.extra, query)
+}
+func (odriverConn69 *tdriverConn69) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn69.r, odriverConn69.extra, ctx, opts)
+}
+func (odriverConn69 *tdriverConn69) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn69.r, odriverConn69.extra, ctx)
+}
+func (odriverConn69 *tdriverConn69) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn69.r, odriverConn69.extra, ctx, query, args)
+}
+
+func (odriverConn70 *tdriverConn70) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn70.r, odriverConn70.extra)
+}
+func (odriverConn70 *tdriverConn70) Close() error {
+	return realDCClose(odriverConn70.r, odriverConn70.extra)
+}
+func (odriverConn70 *tdriverConn70) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn70.r, odriverConn70.extra, query)
+}
+func (odriverConn70 *tdriverConn70) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn70.r, odriverConn70.extra, ctx, opts)
+}
+func (odriverConn70 *tdriverConn70) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn70.r, odriverConn70.extra, ctx)
+}
+func (odriverConn70 *tdriverConn70) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn70.r, odriverConn70.extra, ctx)
+}
+
+func (odriverConn71 *tdriverConn71) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn71.r, odriverConn71.extra)
+}
+func (odriverConn71 *tdriverConn71) Close() error {
+	return realDCClose(odriverConn71.r, odriverConn71.extra)
+}
+func (odriverConn71 *tdriverConn71) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn71.r, odriverConn71.extra, query)
+}
+func (odriverConn71 *tdriverConn71) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn71.r, odriverConn71.extra, ctx, opts)
+}
+func (odriverConn71 *tdriverConn71) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn71.r, odriverConn71.extra, query, args)
+}
+func (odriverConn71 *tdriverConn71) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn71.r, odriverConn71.extra, ctx, query, args)
+}
+
+func (odriverConn72 *tdriverConn72) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn72.r, odriverConn72.extra)
+}
+func (odriverConn72 *tdriverConn72) Close() error {
+	return realDCClose(odriverConn72.r, odriverConn72.extra)
+}
+func (odriverConn72 *tdriverConn72) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn72.r, odriverConn72.extra, query)
+}
+func (odriverConn72 *tdriverConn72) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn72.r, odriverConn72.extra, ctx, opts)
+}
+func (odriverConn72 *tdriverConn72) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn72.r, odriverConn72.extra, query, args)
+}
+func (odriverConn72 *tdriverConn72) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn72.r, odriverConn72.extra, ctx)
+}
+
+func (odriverConn73 *tdriverConn73) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn73.r, odriverConn73.extra)
+}
+func (odriverConn73 *tdriverConn73) Close() error {
+	return realDCClose(odriverConn73.r, odriverConn73.extra)
+}
+func (odriverConn73 *tdriverConn73) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn73.r, odriverConn73.extra, query)
+}
+func (odriverConn73 *tdriverConn73) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn73.r, odriverConn73.extra, ctx, opts)
+}
+func (odriverConn73 *tdriverConn73) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn73.r, odriverConn73.extra, ctx, query, args)
+}
+func (odriverConn73 *tdriverConn73) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn73.r, odriverConn73.extra, ctx)
+}
+
+func (odriverConn74 *tdriverConn74) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn74.r, odriverConn74.extra)
+}
+func (odriverConn74 *tdriverConn74) Close() error {
+	return realDCClose(odriverConn74.r, odriverConn74.extra)
+}
+func (odriverConn74 *tdriverConn74) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn74.r, odriverConn74.extra, query)
+}
+func (odriverConn74 *tdriverConn74) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn74.r, odriverConn74.extra, ctx, query)
+}
+func (odriverConn74 *tdriverConn74) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn74.r, odriverConn74.extra, query, args)
+}
+func (odriverConn74 *tdriverConn74) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn74.r, odriverConn74.extra, ctx, query, args)
+}
+
+func (odriverConn75 *tdriverConn75) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn75.r, odriverConn75.extra)
+}
+func (odriverConn75 *tdriverConn75) Close() error {
+	return realDCClose(odriverConn75.r, odriverConn75.extra)
+}
+func (odriverConn75 *tdriverConn75) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn75.r, odriverConn75.extra, query)
+}
+func (odriverConn75 *tdriverConn75) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn75.r, odriverConn75.extra, ctx, query)
+}
+func (odriverConn75 *tdriverConn75) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn75.r, odriverConn75.extra, query, args)
+}
+func (odriverConn75 *tdriverConn75) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn75.r, odriverConn75.extra, param0)
+}
+
+func (odriverConn76 *tdriverConn76) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn76.r, odriverConn76.extra)
+}
+func (odriverConn76 *tdriverConn76) Close() error {
+	return realDCClose(odriverConn76.r, odriverConn76.extra)
+}
+func (odriverConn76 *tdriverConn76) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn76.r, odriverConn76.extra, query)
+}
+func (odriverConn76 *tdriverConn76) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn76.r, odriverConn76.extra, ctx, query)
+}
+func (odriverConn76 *tdriverConn76) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn76.r, odriverConn76.extra, query, args)
+}
+func (odriverConn76 *tdriverConn76) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn76.r, odriverConn76.extra, ctx)
+}
+
+func (odriverConn77 *tdriverConn77) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn77.r, odriverConn77.extra)
+}
+func (odriverConn77 *tdriverConn77) Close() error {
+	return realDCClose(odriverConn77.r, odriverConn77.extra)
+}
+func (odriverConn77 *tdriverConn77) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn77.r, odriverConn77.extra, query)
+}
+func (odriverConn77 *tdriverConn77) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn77.r, odriverConn77.extra, ctx, query)
+}
+func (odriverConn77 *tdriverConn77) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn77.r, odriverConn77.extra, query, args)
+}
+func (odriverConn77 *tdriverConn77) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn77.r, odriverConn77.extra, query, args)
+}
+
+func (odriverConn78 *tdriverConn78) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn78.r, odriverConn78.extra)
+}
+func (odriverConn78 *tdriverConn78) Close() error {
+	return realDCClose(odriverConn78.r, odriverConn78.extra)
+}
+func (odriverConn78 *tdriverConn78) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn78.r, odriverConn78.extra, query)
+}
+func (odriverConn78 *tdriverConn78) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn78.r, odriverConn78.extra, ctx, query)
+}
+func (odriverConn78 *tdriverConn78) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn78.r, odriverConn78.extra, query, args)
+}
+func (odriverConn78 *tdriverConn78) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn78.r, odriverConn78.extra, ctx, query, args)
+}
+
+func (odriverConn79 *tdriverConn79) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn79.r, odriverConn79.extra)
+}
+func (odriverConn79 *tdriverConn79) Close() error {
+	return realDCClose(odriverConn79.r, odriverConn79.extra)
+}
+func (odriverConn79 *tdriverConn79) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn79.r, odriverConn79.extra, query)
+}
+func (odriverConn79 *tdriverConn79) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn79.r, odriverConn79.extra, ctx, query)
+}
+func (odriverConn79 *tdriverConn79) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn79.r, odriverConn79.extra, query, args)
+}
+func (odriverConn79 *tdriverConn79) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn79.r, odriverConn79.extra, ctx)
+}
+
+func (odriverConn80 *tdriverConn80) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn80.r, odriverConn80.extra)
+}
+func (odriverConn80 *tdriverConn80) Close() error {
+	return realDCClose(odriverConn80.r, odriverConn80.extra)
+}
+func (odriverConn80 *tdriverConn80) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn80.r, odriverConn80.extra, query)
+}
+func (odriverConn80 *tdriverConn80) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn80.r, odriverConn80.extra, ctx, query)
+}
+func (odriverConn80 *tdriverConn80) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn80.r, odriverConn80.extra, ctx, query, args)
+}
+func (odriverConn80 *tdriverConn80) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn80.r, odriverConn80.extra, param0)
+}
+
+func (odriverConn81 *tdriverConn81) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn81.r, odriverConn81.extra)
+}
+func (odriverConn81 *tdriverConn81) Close() error {
+	return realDCClose(odriverConn81.r, odriverConn81.extra)
+}
+func (odriverConn81 *tdriverConn81) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn81.r, odriverConn81.extra, query)
+}
+func (odriverConn81 *tdriverConn81) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn81.r, odriverConn81.extra, ctx, query)
+}
+func (odriverConn81 *tdriverConn81) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn81.r, odriverConn81.extra, ctx, query, args)
+}
+func (odriverConn81 *tdriverConn81) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn81.r, odriverConn81.extra, ctx)
+}
+
+func (odriverConn82 *tdriverConn82) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn82.r, odriverConn82.extra)
+}
+func (odriverConn82 *tdriverConn82) Close() error {
+	return realDCClose(odriverConn82.r, odriverConn82.extra)
+}
+func (odriverConn82 *tdriverConn82) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn82.r, odriverConn82.extra, query)
+}
+func (odriverConn82 *tdriverConn82) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn82.r, odriverConn82.extra, ctx, query)
+}
+func (odriverConn82 *tdriverConn82) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn82.r, odriverConn82.extra, ctx, query, args)
+}
+func (odriverConn82 *tdriverConn82) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn82.r, odriverConn82.extra, query, args)
+}
+
+func (odriverConn83 *tdriverConn83) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn83.r, odriverConn83.extra)
+}
+func (odriverConn83 *tdriverConn83) Close() error {
+	return realDCClose(odriverConn83.r, odriverConn83.extra)
+}
+func (odriverConn83 *tdriverConn83) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn83.r, odriverConn83.extra, query)
+}
+func (odriverConn83 *tdriverConn83) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn83.r, odriverConn83.extra, ctx, query)
+}
+func (odriverConn83 *tdriverConn83) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn83.r, odriverConn83.extra, ctx, query, args)
+}
+func (odriverConn83 *tdriverConn83) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn83.r, odriverConn83.extra, ctx, query, args)
+}
+
+func (odriverConn84 *tdriverConn84) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn84.r, odriverConn84.extra)
+}
+func (odriverConn84 *tdriverConn84) Close() error {
+	return realDCClose(odriverConn84.r, odriverConn84.extra)
+}
+func (odriverConn84 *tdriverConn84) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn84.r, odriverConn84.extra, query)
+}
+func (odriverConn84 *tdriverConn84) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn84.r, odriverConn84.extra, ctx, query)
+}
+func (odriverConn84 *tdriverConn84) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn84.r, odriverConn84.extra, ctx, query, args)
+}
+func (odriverConn84 *tdriverConn84) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn84.r, odriverConn84.extra, ctx)
+}
+
+func (odriverConn85 *tdriverConn85) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn85.r, odriverConn85.extra)
+}
+func (odriverConn85 *tdriverConn85) Close() error {
+	return realDCClose(odriverConn85.r, odriverConn85.extra)
+}
+func (odriverConn85 *tdriverConn85) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn85.r, odriverConn85.extra, query)
+}
+func (odriverConn85 *tdriverConn85) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn85.r, odriverConn85.extra, ctx, query)
+}
+func (odriverConn85 *tdriverConn85) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn85.r, odriverConn85.extra, param0)
+}
+func (odriverConn85 *tdriverConn85) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn85.r, odriverConn85.extra, ctx)
+}
+
+func (odriverConn86 *tdriverConn86) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn86.r, odriverConn86.extra)
+}
+func (odriverConn86 *tdriverConn86) Close() error {
+	return realDCClose(odriverConn86.r, odriverConn86.extra)
+}
+func (odriverConn86 *tdriverConn86) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn86.r, odriverConn86.extra, query)
+}
+func (odriverConn86 *tdriverConn86) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn86.r, odriverConn86.extra, ctx, query)
+}
+func (odriverConn86 *tdriverConn86) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn86.r, odriverConn86.extra, param0)
+}
+func (odriverConn86 *tdriverConn86) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn86.r, odriverConn86.extra, query, args)
+}
+
+func (odriverConn87 *tdriverConn87) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn87.r, odriverConn87.extra)
+}
+func (odriverConn87 *tdriverConn87) Close() error {
+	return realDCClose(odriverConn87.r, odriverConn87.extra)
+}
+func (odriverConn87 *tdriverConn87) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn87.r, odriverConn87.extra, query)
+}
+func (odriverConn87 *tdriverConn87) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn87.r, odriverConn87.extra, ctx, query)
+}
+func (odriverConn87 *tdriverConn87) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn87.r, odriverConn87.extra, param0)
+}
+func (odriverConn87 *tdriverConn87) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn87.r, odriverConn87.extra, ctx, query, args)
+}
+
+func (odriverConn88 *tdriverConn88) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn88.r, odriverConn88.extra)
+}
+func (odriverConn88 *tdriverConn88) Close() error {
+	return realDCClose(odriverConn88.r, odriverConn88.extra)
+}
+func (odriverConn88 *tdriverConn88) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn88.r, odriverConn88.extra, query)
+}
+func (odriverConn88 *tdriverConn88) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn88.r, odriverConn88.extra, ctx, query)
+}
+func (odriverConn88 *tdriverConn88) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn88.r, odriverConn88.extra, param0)
+}
+func (odriverConn88 *tdriverConn88) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn88.r, odriverConn88.extra, ctx)
+}
+
+func (odriverConn89 *tdriverConn89) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn89.r, odriverConn89.extra)
+}
+func (odriverConn89 *tdriverConn89) Close() error {
+	return realDCClose(odriverConn89.r, odriverConn89.extra)
+}
+func (odriverConn89 *tdriverConn89) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn89.r, odriverConn89.extra, query)
+}
+func (odriverConn89 *tdriverConn89) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn89.r, odriverConn89.extra, ctx, query)
+}
+func (odriverConn89 *tdriverConn89) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn89.r, odriverConn89.extra, ctx)
+}
+func (odriverConn89 *tdriverConn89) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn89.r, odriverConn89.extra, query, args)
+}
+
+func (odriverConn90 *tdriverConn90) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn90.r, odriverConn90.extra)
+}
+func (odriverConn90 *tdriverConn90) Close() error {
+	return realDCClose(odriverConn90.r, odriverConn90.extra)
+}
+func (odriverConn90 *tdriverConn90) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn90.r, odriverConn90.extra, query)
+}
+func (odriverConn90 *tdriverConn90) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn90.r, odriverConn90.extra, ctx, query)
+}
+func (odriverConn90 *tdriverConn90) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn90.r, odriverConn90.extra, ctx)
+}
+func (odriverConn90 *tdriverConn90) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn90.r, odriverConn90.extra, ctx, query, args)
+}
+
+func (odriverConn91 *tdriverConn91) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn91.r, odriverConn91.extra)
+}
+func (odriverConn91 *tdriverConn91) Close() error {
+	return realDCClose(odriverConn91.r, odriverConn91.extra)
+}
+func (odriverConn91 *tdriverConn91) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn91.r, odriverConn91.extra, query)
+}
+func (odriverConn91 *tdriverConn91) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn91.r, odriverConn91.extra, ctx, query)
+}
+func (odriverConn91 *tdriverConn91) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn91.r, odriverConn91.extra, ctx)
+}
+func (odriverConn91 *tdriverConn91) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn91.r, odriverConn91.extra, ctx)
+}
+
+func (odriverConn92 *tdriverConn92) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn92.r, odriverConn92.extra)
+}
+func (odriverConn92 *tdriverConn92) Close() error {
+	return realDCClose(odriverConn92.r, odriverConn92.extra)
+}
+func (odriverConn92 *tdriverConn92) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn92.r, odriverConn92.extra, query)
+}
+func (odriverConn92 *tdriverConn92) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn92.r, odriverConn92.extra, ctx, query)
+}
+func (odriverConn92 *tdriverConn92) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn92.r, odriverConn92.extra, query, args)
+}
+func (odriverConn92 *tdriverConn92) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn92.r, odriverConn92.extra, ctx, query, args)
+}
+
+func (odriverConn93 *tdriverConn93) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn93.r, odriverConn93.extra)
+}
+func (odriverConn93 *tdriverConn93) Close() error {
+	return realDCClose(odriverConn93.r, odriverConn93.extra)
+}
+func (odriverConn93 *tdriverConn93) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn93.r, odriverConn93.extra, query)
+}
+func (odriverConn93 *tdriverConn93) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn93.r, odriverConn93.extra, ctx, query)
+}
+func (odriverConn93 *tdriverConn93) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn93.r, odriverConn93.extra, query, args)
+}
+func (odriverConn93 *tdriverConn93) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn93.r, odriverConn93.extra, ctx)
+}
+
+func (odriverConn94 *tdriverConn94) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn94.r, odriverConn94.extra)
+}
+func (odriverConn94 *tdriverConn94) Close() error {
+	return realDCClose(odriverConn94.r, odriverConn94.extra)
+}
+func (odriverConn94 *tdriverConn94) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn94.r, odriverConn94.extra, query)
+}
+func (odriverConn94 *tdriverConn94) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn94.r, odriverConn94.extra, ctx, query)
+}
+func (odriverConn94 *tdriverConn94) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn94.r, odriverConn94.extra, ctx, query, args)
+}
+func (odriverConn94 *tdriverConn94) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn94.r, odriverConn94.extra, ctx)
+}
+
+func (odriverConn95 *tdriverConn95) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn95.r, odriverConn95.extra)
+}
+func (odriverConn95 *tdriverConn95) Close() error {
+	return realDCClose(odriverConn95.r, odriverConn95.extra)
+}
+func (odriverConn95 *tdriverConn95) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn95.r, odriverConn95.extra, query)
+}
+func (odriverConn95 *tdriverConn95) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn95.r, odriverConn95.extra, query, args)
+}
+func (odriverConn95 *tdriverConn95) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn95.r, odriverConn95.extra, ctx, query, args)
+}
+func (odriverConn95 *tdriverConn95) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn95.r, odriverConn95.extra, param0)
+}
+
+func (odriverConn96 *tdriverConn96) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn96.r, odriverConn96.extra)
+}
+func (odriverConn96 *tdriverConn96) Close() error {
+	return realDCClose(odriverConn96.r, odriverConn96.extra)
+}
+func (odriverConn96 *tdriverConn96) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn96.r, odriverConn96.extra, query)
+}
+func (odriverConn96 *tdriverConn96) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn96.r, odriverConn96.extra, query, args)
+}
+func (odriverConn96 *tdriverConn96) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn96.r, odriverConn96.extra, ctx, query, args)
+}
+func (odriverConn96 *tdriverConn96) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn96.r, odriverConn96.extra, ctx)
+}
+
+func (odriverConn97 *tdriverConn97) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn97.r, odriverConn97.extra)
+}
+func (odriverConn97 *tdriverConn97) Close() error {
+	return realDCClose(odriverConn97.r, odriverConn97.extra)
+}
+func (odriverConn97 *tdriverConn97) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn97.r, odriverConn97.extra, query)
+}
+func (odriverConn97 *tdriverConn97) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn97.r, odriverConn97.extra, query, args)
+}
+func (odriverConn97 *tdriverConn97) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn97.r, odriverConn97.extra, ctx, query, args)
+}
+func (odriverConn97 *tdriverConn97) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn97.r, odriverConn97.extra, query, args)
+}
+
+func (odriverConn98 *tdriverConn98) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn98.r, odriverConn98.extra)
+}
+func (odriverConn98 *tdriverConn98) Close() error {
+	return realDCClose(odriverConn98.r, odriverConn98.extra)
+}
+func (odriverConn98 *tdriverConn98) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn98.r, odriverConn98.extra, query)
+}
+func (odriverConn98 *tdriverConn98) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn98.r, odriverConn98.extra, query, args)
+}
+func (odriverConn98 *tdriverConn98) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn98.r, odriverConn98.extra, ctx, query, args)
+}
+func (odriverConn98 *tdriverConn98) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn98.r, odriverConn98.extra, ctx, query, args)
+}
+
+func (odriverConn99 *tdriverConn99) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn99.r, odriverConn99.extra)
+}
+func (odriverConn99 *tdriverConn99) Close() error {
+	return realDCClose(odriverConn99.r, odriverConn99.extra)
+}
+func (odriverConn99 *tdriverConn99) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn99.r, odriverConn99.extra, query)
+}
+func (odriverConn99 *tdriverConn99) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn99.r, odriverConn99.extra, query, args)
+}
+func (odriverConn99 *tdriverConn99) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn99.r, odriverConn99.extra, ctx, query, args)
+}
+func (odriverConn99 *tdriverConn99) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn99.r, odriverConn99.extra, ctx)
+}
+
+func (odriverConn100 *tdriverConn100) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn100.r, odriverConn100.extra)
+}
+func (odriverConn100 *tdriverConn100) Close() error {
+	return realDCClose(odriverConn100.r, odriverConn100.extra)
+}
+func (odriverConn100 *tdriverConn100) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn100.r, odriverConn100.extra, query)
+}
+func (odriverConn100 *tdriverConn100) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn100.r, odriverConn100.extra, query, args)
+}
+func (odriverConn100 *tdriverConn100) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn100.r, odriverConn100.extra, param0)
+}
+func (odriverConn100 *tdriverConn100) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn100.r, odriverConn100.extra, ctx)
+}
+
+func (odriverConn101 *tdriverConn101) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn101.r, odriverConn101.extra)
+}
+func (odriverConn101 *tdriverConn101) Close() error {
+	return realDCClose(odriverConn101.r, odriverConn101.extra)
+}
+func (odriverConn101 *tdriverConn101) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn101.r, odriverConn101.extra, query)
+}
+func (odriverConn101 *tdriverConn101) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn101.r, odriverConn101.extra, query, args)
+}
+func (odriverConn101 *tdriverConn101) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn101.r, odriverConn101.extra, param0)
+}
+func (odriverConn101 *tdriverConn101) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn101.r, odriverConn101.extra, query, args)
+}
+
+func (odriverConn102 *tdriverConn102) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn102.r, odriverConn102.extra)
+}
+func (odriverConn102 *tdriverConn102) Close() error {
+	return realDCClose(odriverConn102.r, odriverConn102.extra)
+}
+func (odriverConn102 *tdriverConn102) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn102.r, odriverConn102.extra, query)
+}
+func (odriverConn102 *tdriverConn102) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn102.r, odriverConn102.extra, query, args)
+}
+func (odriverConn102 *tdriverConn102) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn102.r, odriverConn102.extra, param0)
+}
+func (odriverConn102 *tdriverConn102) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn102.r, odriverConn102.extra, ctx, query, args)
+}
+
+func (odriverConn103 *tdriverConn103) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn103.r, odriverConn103.extra)
+}
+func (odriverConn103 *tdriverConn103) Close() error {
+	return realDCClose(odriverConn103.r, odriverConn103.extra)
+}
+func (odriverConn103 *tdriverConn103) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn103.r, odriverConn103.extra, query)
+}
+func (odriverConn103 *tdriverConn103) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn103.r, odriverConn103.extra, query, args)
+}
+func (odriverConn103 *tdriverConn103) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn103.r, odriverConn103.extra, param0)
+}
+func (odriverConn103 *tdriverConn103) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn103.r, odriverConn103.extra, ctx)
+}
+
+func (odriverConn104 *tdriverConn104) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn104.r, odriverConn104.extra)
+}
+func (odriverConn104 *tdriverConn104) Close() error {
+	return realDCClose(odriverConn104.r, odriverConn104.extra)
+}
+func (odriverConn104 *tdriverConn104) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn104.r, odriverConn104.extra, query)
+}
+func (odriverConn104 *tdriverConn104) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn104.r, odriverConn104.extra, query, args)
+}
+func (odriverConn104 *tdriverConn104) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn104.r, odriverConn104.extra, ctx)
+}
+func (odriverConn104 *tdriverConn104) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn104.r, odriverConn104.extra, query, args)
+}
+
+func (odriverConn105 *tdriverConn105) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn105.r, odriverConn105.extra)
+}
+func (odriverConn105 *tdriverConn105) Close() error {
+	return realDCClose(odriverConn105.r, odriverConn105.extra)
+}
+func (odriverConn105 *tdriverConn105) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn105.r, odriverConn105.extra, query)
+}
+func (odriverConn105 *tdriverConn105) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn105.r, odriverConn105.extra, query, args)
+}
+func (odriverConn105 *tdriverConn105) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn105.r, odriverConn105.extra, ctx)
+}
+func (odriverConn105 *tdriverConn105) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn105.r, odriverConn105.extra, ctx, query, args)
+}
+
+func (odriverConn106 *tdriverConn106) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn106.r, odriverConn106.extra)
+}
+func (odriverConn106 *tdriverConn106) Close() error {
+	return realDCClose(odriverConn106.r, odriverConn106.extra)
+}
+func (odriverConn106 *tdriverConn106) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn106.r, odriverConn106.extra, query)
+}
+func (odriverConn106 *tdriverConn106) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn106.r, odriverConn106.extra, query, args)
+}
+func (odriverConn106 *tdriverConn106) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn106.r, odriverConn106.extra, ctx)
+}
+func (odriverConn106 *tdriverConn106) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn106.r, odriverConn106.extra, ctx)
+}
+
+func (odriverConn107 *tdriverConn107) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn107.r, odriverConn107.extra)
+}
+func (odriverConn107 *tdriverConn107) Close() error {
+	return realDCClose(odriverConn107.r, odriverConn107.extra)
+}
+func (odriverConn107 *tdriverConn107) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn107.r, odriverConn107.extra, query)
+}
+func (odriverConn107 *tdriverConn107) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn107.r, odriverConn107.extra, query, args)
+}
+func (odriverConn107 *tdriverConn107) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn107.r, odriverConn107.extra, query, args)
+}
+func (odriverConn107 *tdriverConn107) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn107.r, odriverConn107.extra, ctx, query, args)
+}
+
+func (odriverConn108 *tdriverConn108) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn108.r, odriverConn108.extra)
+}
+func (odriverConn108 *tdriverConn108) Close() error {
+	return realDCClose(odriverConn108.r, odriverConn108.extra)
+}
+func (odriverConn108 *tdriverConn108) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn108.r, odriverConn108.extra, query)
+}
+func (odriverConn108 *tdriverConn108) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn108.r, odriverConn108.extra, query, args)
+}
+func (odriverConn108 *tdriverConn108) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn108.r, odriverConn108.extra, query, args)
+}
+func (odriverConn108 *tdriverConn108) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn108.r, odriverConn108.extra, ctx)
+}
+
+func (odriverConn109 *tdriverConn109) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn109.r, odriverConn109.extra)
+}
+func (odriverConn109 *tdriverConn109) Close() error {
+	return realDCClose(odriverConn109.r, odriverConn109.extra)
+}
+func (odriverConn109 *tdriverConn109) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn109.r, odriverConn109.extra, query)
+}
+func (odriverConn109 *tdriverConn109) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn109.r, odriverConn109.extra, query, args)
+}
+func (odriverConn109 *tdriverConn109) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn109.r, odriverConn109.extra, ctx, query, args)
+}
+func (odriverConn109 *tdriverConn109) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn109.r, odriverConn109.extra, ctx)
+}
+
+func (odriverConn110 *tdriverConn110) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn110.r, odriverConn110.extra)
+}
+func (odriverConn110 *tdriverConn110) Close() error {
+	return realDCClose(odriverConn110.r, odriverConn110.extra)
+}
+func (odriverConn110 *tdriverConn110) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn110.r, odriverConn110.extra, query)
+}
+func (odriverConn110 *tdriverConn110) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn110.r, odriverConn110.extra, ctx, query, args)
+}
+func (odriverConn110 *tdriverConn110) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn110.r, odriverConn110.extra, param0)
+}
+func (odriverConn110 *tdriverConn110) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn110.r, odriverConn110.extra, ctx)
+}
+
+func (odriverConn111 *tdriverConn111) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn111.r, odriverConn111.extra)
+}
+func (odriverConn111 *tdriverConn111) Close() error {
+	return realDCClose(odriverConn111.r, odriverConn111.extra)
+}
+func (odriverConn111 *tdriverConn111) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn111.r, odriverConn111.extra, query)
+}
+func (odriverConn111 *tdriverConn111) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn111.r, odriverConn111.extra, ctx, query, args)
+}
+func (odriverConn111 *tdriverConn111) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn111.r, odriverConn111.extra, param0)
+}
+func (odriverConn111 *tdriverConn111) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn111.r, odriverConn111.extra, query, args)
+}
+
+func (odriverConn112 *tdriverConn112) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn112.r, odriverConn112.extra)
+}
+func (odriverConn112 *tdriverConn112) Close() error {
+	return realDCClose(odriverConn112.r, odriverConn112.extra)
+}
+func (odriverConn112 *tdriverConn112) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn112.r, odriverConn112.extra, query)
+}
+func (odriverConn112 *tdriverConn112) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn112.r, odriverConn112.extra, ctx, query, args)
+}
+func (odriverConn112 *tdriverConn112) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn112.r, odriverConn112.extra, param0)
+}
+func (odriverConn112 *tdriverConn112) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn112.r, odriverConn112.extra, ctx, query, args)
+}
+
+func (odriverConn113 *tdriverConn113) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn113.r, odriverConn113.extra)
+}
+func (odriverConn113 *tdriverConn113) Close() error {
+	return realDCClose(odriverConn113.r, odriverConn113.extra)
+}
+func (odriverConn113 *tdriverConn113) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn113.r, odriverConn113.extra, query)
+}
+func (odriverConn113 *tdriverConn113) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn113.r, odriverConn113.extra, ctx, query, args)
+}
+func (odriverConn113 *tdriverConn113) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn113.r, odriverConn113.extra, param0)
+}
+func (odriverConn113 *tdriverConn113) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn113.r, odriverConn113.extra, ctx)
+}
+
+func (odriverConn114 *tdriverConn114) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn114.r, odriverConn114.extra)
+}
+func (odriverConn114 *tdriverConn114) Close() error {
+	return realDCClose(odriverConn114.r, odriverConn114.extra)
+}
+func (odriverConn114 *tdriverConn114) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn114.r, odriverConn114.extra, query)
+}
+func (odriverConn114 *tdriverConn114) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn114.r, odriverConn114.extra, ctx, query, args)
+}
+func (odriverConn114 *tdriverConn114) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn114.r, odriverConn114.extra, ctx)
+}
+func (odriverConn114 *tdriverConn114) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn114.r, odriverConn114.extra, query, args)
+}
+
+func (odriverConn115 *tdriverConn115) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn115.r, odriverConn115.extra)
+}
+func (odriverConn115 *tdriverConn115) Close() error {
+	return realDCClose(odriverConn115.r, odriverConn115.extra)
+}
+func (odriverConn115 *tdriverConn115) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn115.r, odriverConn115.extra, query)
+}
+func (odriverConn115 *tdriverConn115) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn115.r, odriverConn115.extra, ctx, query, args)
+}
+func (odriverConn115 *tdriverConn115) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn115.r, odriverConn115.extra, ctx)
+}
+func (odriverConn115 *tdriverConn115) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn115.r, odriverConn115.extra, ctx, query, args)
+}
+
+func (odriverConn116 *tdriverConn116) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn116.r, odriverConn116.extra)
+}
+func (odriverConn116 *tdriverConn116) Close() error {
+	return realDCClose(odriverConn116.r, odriverConn116.extra)
+}
+func (odriverConn116 *tdriverConn116) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn116.r, odriverConn116.extra, query)
+}
+func (odriverConn116 *tdriverConn116) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn116.r, odriverConn116.extra, ctx, query, args)
+}
+func (odriverConn116 *tdriverConn116) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn116.r, odriverConn116.extra, ctx)
+}
+func (odriverConn116 *tdriverConn116) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn116.r, odriverConn116.extra, ctx)
+}
+
+func (odriverConn117 *tdriverConn117) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn117.r, odriverConn117.extra)
+}
+func (odriverConn117 *tdriverConn117) Close() error {
+	return realDCClose(odriverConn117.r, odriverConn117.extra)
+}
+func (odriverConn117 *tdriverConn117) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn117.r, odriverConn117.extra, query)
+}
+func (odriverConn117 *tdriverConn117) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn117.r, odriverConn117.extra, ctx, query, args)
+}
+func (odriverConn117 *tdriverConn117) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn117.r, odriverConn117.extra, query, args)
+}
+func (odriverConn117 *tdriverConn117) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn117.r, odriverConn117.extra, ctx, query, args)
+}
+
+func (odriverConn118 *tdriverConn118) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn118.r, odriverConn118.extra)
+}
+func (odriverConn118 *tdriverConn118) Close() error {
+	return realDCClose(odriverConn118.r, odriverConn118.extra)
+}
+func (odriverConn118 *tdriverConn118) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn118.r, odriverConn118.extra, query)
+}
+func (odriverConn118 *tdriverConn118) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn118.r, odriverConn118.extra, ctx, query, args)
+}
+func (odriverConn118 *tdriverConn118) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn118.r, odriverConn118.extra, query, args)
+}
+func (odriverConn118 *tdriverConn118) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn118.r, odriverConn118.extra, ctx)
+}
+
+func (odriverConn119 *tdriverConn119) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn119.r, odriverConn119.extra)
+}
+func (odriverConn119 *tdriverConn119) Close() error {
+	return realDCClose(odriverConn119.r, odriverConn119.extra)
+}
+func (odriverConn119 *tdriverConn119) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn119.r, odriverConn119.extra, query)
+}
+func (odriverConn119 *tdriverConn119) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn119.r, odriverConn119.extra, ctx, query, args)
+}
+func (odriverConn119 *tdriverConn119) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn119.r, odriverConn119.extra, ctx, query, args)
+}
+func (odriverConn119 *tdriverConn119) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn119.r, odriverConn119.extra, ctx)
+}
+
+func (odriverConn120 *tdriverConn120) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn120.r, odriverConn120.extra)
+}
+func (odriverConn120 *tdriverConn120) Close() error {
+	return realDCClose(odriverConn120.r, odriverConn120.extra)
+}
+func (odriverConn120 *tdriverConn120) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn120.r, odriverConn120.extra, query)
+}
+func (odriverConn120 *tdriverConn120) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn120.r, odriverConn120.extra, param0)
+}
+func (odriverConn120 *tdriverConn120) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn120.r, odriverConn120.extra, ctx)
+}
+func (odriverConn120 *tdriverConn120) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn120.r, odriverConn120.extra, query, args)
+}
+
+func (odriverConn121 *tdriverConn121) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn121.r, odriverConn121.extra)
+}
+func (odriverConn121 *tdriverConn121) Close() error {
+	return realDCClose(odriverConn121.r, odriverConn121.extra)
+}
+func (odriverConn121 *tdriverConn121) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn121.r, odriverConn121.extra, query)
+}
+func (odriverConn121 *tdriverConn121) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn121.r, odriverConn121.extra, param0)
+}
+func (odriverConn121 *tdriverConn121) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn121.r, odriverConn121.extra, ctx)
+}
+func (odriverConn121 *tdriverConn121) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn121.r, odriverConn121.extra, ctx, query, args)
+}
+
+func (odriverConn122 *tdriverConn122) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn122.r, odriverConn122.extra)
+}
+func (odriverConn122 *tdriverConn122) Close() error {
+	return realDCClose(odriverConn122.r, odriverConn122.extra)
+}
+func (odriverConn122 *tdriverConn122) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn122.r, odriverConn122.extra, query)
+}
+func (odriverConn122 *tdriverConn122) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn122.r, odriverConn122.extra, param0)
+}
+func (odriverConn122 *tdriverConn122) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn122.r, odriverConn122.extra, ctx)
+}
+func (odriverConn122 *tdriverConn122) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn122.r, odriverConn122.extra, ctx)
+}
+
+func (odriverConn123 *tdriverConn123) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn123.r, odriverConn123.extra)
+}
+func (odriverConn123 *tdriverConn123) Close() error {
+	return realDCClose(odriverConn123.r, odriverConn123.extra)
+}
+func (odriverConn123 *tdriverConn123) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn123.r, odriverConn123.extra, query)
+}
+func (odriverConn123 *tdriverConn123) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn123.r, odriverConn123.extra, param0)
+}
+func (odriverConn123 *tdriverConn123) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn123.r, odriverConn123.extra, query, args)
+}
+func (odriverConn123 *tdriverConn123) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn123.r, odriverConn123.extra, ctx, query, args)
+}
+
+func (odriverConn124 *tdriverConn124) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn124.r, odriverConn124.extra)
+}
+func (odriverConn124 *tdriverConn124) Close() error {
+	return realDCClose(odriverConn124.r, odriverConn124.extra)
+}
+func (odriverConn124 *tdriverConn124) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn124.r, odriverConn124.extra, query)
+}
+func (odriverConn124 *tdriverConn124) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn124.r, odriverConn124.extra, param0)
+}
+func (odriverConn124 *tdriverConn124) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn124.r, odriverConn124.extra, query, args)
+}
+func (odriverConn124 *tdriverConn124) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn124.r, odriverConn124.extra, ctx)
+}
+
+func (odriverConn125 *tdriverConn125) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn125.r, odriverConn125.extra)
+}
+func (odriverConn125 *tdriverConn125) Close() error {
+	return realDCClose(odriverConn125.r, odriverConn125.extra)
+}
+func (odriverConn125 *tdriverConn125) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn125.r, odriverConn125.extra, query)
+}
+func (odriverConn125 *tdriverConn125) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn125.r, odriverConn125.extra, param0)
+}
+func (odriverConn125 *tdriverConn125) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn125.r, odriverConn125.extra, ctx, query, args)
+}
+func (odriverConn125 *tdriverConn125) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn125.r, odriverConn125.extra, ctx)
+}
+
+func (odriverConn126 *tdriverConn126) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn126.r, odriverConn126.extra)
+}
+func (odriverConn126 *tdriverConn126) Close() error {
+	return realDCClose(odriverConn126.r, odriverConn126.extra)
+}
+func (odriverConn126 *tdriverConn126) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn126.r, odriverConn126.extra, query)
+}
+func (odriverConn126 *tdriverConn126) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn126.r, odriverConn126.extra, ctx)
+}
+func (odriverConn126 *tdriverConn126) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn126.r, odriverConn126.extra, query, args)
+}
+func (odriverConn126 *tdriverConn126) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn126.r, odriverConn126.extra, ctx, query, args)
+}
+
+func (odriverConn127 *tdriverConn127) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn127.r, odriverConn127.extra)
+}
+func (odriverConn127 *tdriverConn127) Close() error {
+	return realDCClose(odriverConn127.r, odriverConn127.extra)
+}
+func (odriverConn127 *tdriverConn127) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn127.r, odriverConn127.extra, query)
+}
+func (odriverConn127 *tdriverConn127) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn127.r, odriverConn127.extra, ctx)
+}
+func (odriverConn127 *tdriverConn127) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn127.r, odriverConn127.extra, query, args)
+}
+func (odriverConn127 *tdriverConn127) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn127.r, odriverConn127.extra, ctx)
+}
+
+func (odriverConn128 *tdriverConn128) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn128.r, odriverConn128.extra)
+}
+func (odriverConn128 *tdriverConn128) Close() error {
+	return realDCClose(odriverConn128.r, odriverConn128.extra)
+}
+func (odriverConn128 *tdriverConn128) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn128.r, odriverConn128.extra, query)
+}
+func (odriverConn128 *tdriverConn128) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn128.r, odriverConn128.extra, ctx)
+}
+func (odriverConn128 *tdriverConn128) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn128.r, odriverConn128.extra, ctx, query, args)
+}
+func (odriverConn128 *tdriverConn128) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn128.r, odriverConn128.extra, ctx)
+}
+
+func (odriverConn129 *tdriverConn129) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn129.r, odriverConn129.extra)
+}
+func (odriverConn129 *tdriverConn129) Close() error {
+	return realDCClose(odriverConn129.r, odriverConn129.extra)
+}
+func (odriverConn129 *tdriverConn129) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn129.r, odriverConn129.extra, query)
+}
+func (odriverConn129 *tdriverConn129) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn129.r, odriverConn129.extra, query, args)
+}
+func (odriverConn129 *tdriverConn129) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn129.r, odriverConn129.extra, ctx, query, args)
+}
+func (odriverConn129 *tdriverConn129) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn129.r, odriverConn129.extra, ctx)
+}
+
+func (odriverConn130 *tdriverConn130) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn130.r, odriverConn130.extra)
+}
+func (odriverConn130 *tdriverConn130) Close() error {
+	return realDCClose(odriverConn130.r, odriverConn130.extra)
+}
+func (odriverConn130 *tdriverConn130) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn130.r, odriverConn130.extra, query)
+}
+func (odriverConn130 *tdriverConn130) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn130.r, odriverConn130.extra, ctx, opts)
+}
+func (odriverConn130 *tdriverConn130) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn130.r, odriverConn130.extra, ctx, query)
+}
+func (odriverConn130 *tdriverConn130) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn130.r, odriverConn130.extra, query, args)
+}
+func (odriverConn130 *tdriverConn130) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn130.r, odriverConn130.extra, ctx, query, args)
+}
+
+func (odriverConn131 *tdriverConn131) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn131.r, odriverConn131.extra)
+}
+func (odriverConn131 *tdriverConn131) Close() error {
+	return realDCClose(odriverConn131.r, odriverConn131.extra)
+}
+func (odriverConn131 *tdriverConn131) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn131.r, odriverConn131.extra, query)
+}
+func (odriverConn131 *tdriverConn131) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn131.r, odriverConn131.extra, ctx, opts)
+}
+func (odriverConn131 *tdriverConn131) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn131.r, odriverConn131.extra, ctx, query)
+}
+func (odriverConn131 *tdriverConn131) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn131.r, odriverConn131.extra, query, args)
+}
+func (odriverConn131 *tdriverConn131) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn131.r, odriverConn131.extra, param0)
+}
+
+func (odriverConn132 *tdriverConn132) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn132.r, odriverConn132.extra)
+}
+func (odriverConn132 *tdriverConn132) Close() error {
+	return realDCClose(odriverConn132.r, odriverConn132.extra)
+}
+func (odriverConn132 *tdriverConn132) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn132.r, odriverConn132.extra, query)
+}
+func (odriverConn132 *tdriverConn132) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn132.r, odriverConn132.extra, ctx, opts)
+}
+func (odriverConn132 *tdriverConn132) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn132.r, odriverConn132.extra, ctx, query)
+}
+func (odriverConn132 *tdriverConn132) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn132.r, odriverConn132.extra, query, args)
+}
+func (odriverConn132 *tdriverConn132) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn132.r, odriverConn132.extra, ctx)
+}
+
+func (odriverConn133 *tdriverConn133) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn133.r, odriverConn133.extra)
+}
+func (odriverConn133 *tdriverConn133) Close() error {
+	return realDCClose(odriverConn133.r, odriverConn133.extra)
+}
+func (odriverConn133 *tdriverConn133) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn133.r, odriverConn133.extra, query)
+}
+func (odriverConn133 *tdriverConn133) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn133.r, odriverConn133.extra, ctx, opts)
+}
+func (odriverConn133 *tdriverConn133) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn133.r, odriverConn133.extra, ctx, query)
+}
+func (odriverConn133 *tdriverConn133) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn133.r, odriverConn133.extra, query, args)
+}
+func (odriverConn133 *tdriverConn133) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn133.r, odriverConn133.extra, query, args)
+}
+
+func (odriverConn134 *tdriverConn134) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn134.r, odriverConn134.extra)
+}
+func (odriverConn134 *tdriverConn134) Close() error {
+	return realDCClose(odriverConn134.r, odriverConn134.extra)
+}
+func (odriverConn134 *tdriverConn134) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn134.r, odriverConn134.extra, query)
+}
+func (odriverConn134 *tdriverConn134) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn134.r, odriverConn134.extra, ctx, opts)
+}
+func (odriverConn134 *tdriverConn134) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn134.r, odriverConn134.extra, ctx, query)
+}
+func (odriverConn134 *tdriverConn134) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn134.r, odriverConn134.extra, query, args)
+}
+func (odriverConn134 *tdriverConn134) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn134.r, odriverConn134.extra, ctx, query, args)
+}
+
+func (odriverConn135 *tdriverConn135) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn135.r, odriverConn135.extra)
+}
+func (odriverConn135 *tdriverConn135) Close() error {
+	return realDCClose(odriverConn135.r, odriverConn135.extra)
+}
+func (odriverConn135 *tdriverConn135) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn135.r, odriverConn135.extra, query)
+}
+func (odriverConn135 *tdriverConn135) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn135.r, odriverConn135.extra, ctx, opts)
+}
+func (odriverConn135 *tdriverConn135) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn135.r, odriverConn135.extra, ctx, query)
+}
+func (odriverConn135 *tdriverConn135) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn135.r, odriverConn135.extra, query, args)
+}
+func (odriverConn135 *tdriverConn135) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn135.r, odriverConn135.extra, ctx)
+}
+
+func (odriverConn136 *tdriverConn136) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn136.r, odriverConn136.extra)
+}
+func (odriverConn136 *tdriverConn136) Close() error {
+	return realDCClose(odriverConn136.r, odriverConn136.extra)
+}
+func (odriverConn136 *tdriverConn136) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn136.r, odriverConn136.extra, query)
+}
+func (odriverConn136 *tdriverConn136) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn136.r, odriverConn136.extra, ctx, opts)
+}
+func (odriverConn136 *tdriverConn136) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn136.r, odriverConn136.extra, ctx, query)
+}
+func (odriverConn136 *tdriverConn136) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn136.r, odriverConn136.extra, ctx, query, args)
+}
+func (odriverConn136 *tdriverConn136) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn136.r, odriverConn136.extra, param0)
+}
+
+func (odriverConn137 *tdriverConn137) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn137.r, odriverConn137.extra)
+}
+func (odriverConn137 *tdriverConn137) Close() error {
+	return realDCClose(odriverConn137.r, odriverConn137.extra)
+}
+func (odriverConn137 *tdriverConn137) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn137.r, odriverConn137.extra, query)
+}
+func (odriverConn137 *tdriverConn137) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn137.r, odriverConn137.extra, ctx, opts)
+}
+func (odriverConn137 *tdriverConn137) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn137.r, odriverConn137.extra, ctx, query)
+}
+func (odriverConn137 *tdriverConn137) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn137.r, odriverConn137.extra, ctx, query, args)
+}
+func (odriverConn137 *tdriverConn137) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn137.r, odriverConn137.extra, ctx)
+}
+
+func (odriverConn138 *tdriverConn138) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn138.r, odriverConn138.extra)
+}
+func (odriverConn138 *tdriverConn138) Close() error {
+	return realDCClose(odriverConn138.r, odriverConn138.extra)
+}
+func (odriverConn138 *tdriverConn138) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn138.r, odriverConn138.extra, query)
+}
+func (odriverConn138 *tdriverConn138) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn138.r, odriverConn138.extra, ctx, opts)
+}
+func (odriverConn138 *tdriverConn138) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn138.r, odriverConn138.extra, ctx, query)
+}
+func (odriverConn138 *tdriverConn138) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn138.r, odriverConn138.extra, ctx, query, args)
+}
+func (odriverConn138 *tdriverConn138) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn138.r, odriverConn138.extra, query, args)
+}
+
+func (odriverConn139 *tdriverConn139) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn139.r, odriverConn139.extra)
+}
+func (odriverConn139 *tdriverConn139) Close() error {
+	return realDCClose(odriverConn139.r, odriverConn139.extra)
+}
+func (odriverConn139 *tdriverConn139) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn139.r, odriverConn139.extra, query)
+}
+func (odriverConn139 *tdriverConn139) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn139.r, odriverConn139.extra, ctx, opts)
+}
+func (odriverConn139 *tdriverConn139) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn139.r, odriverConn139.extra, ctx, query)
+}
+func (odriverConn139 *tdriverConn139) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn139.r, odriverConn139.extra, ctx, query, args)
+}
+func (odriverConn139 *tdriverConn139) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn139.r, odriverConn139.extra, ctx, query, args)
+}
+
+func (odriverConn140 *tdriverConn140) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn140.r, odriverConn140.extra)
+}
+func (odriverConn140 *tdriverConn140) Close() error {
+	return realDCClose(odriverConn140.r, odriverConn140.extra)
+}
+func (odriverConn140 *tdriverConn140) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn140.r, odriverConn140.extra, query)
+}
+func (odriverConn140 *tdriverConn140) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn140.r, odriverConn140.extra, ctx, opts)
+}
+func (odriverConn140 *tdriverConn140) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn140.r, odriverConn140.extra, ctx, query)
+}
+func (odriverConn140 *tdriverConn140) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn140.r, odriverConn140.extra, ctx, query, args)
+}
+func (odriverConn140 *tdriverConn140) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn140.r, odriverConn140.extra, ctx)
+}
+
+func (odriverConn141 *tdriverConn141) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn141.r, odriverConn141.extra)
+}
+func (odriverConn141 *tdriverConn141) Close() error {
+	return realDCClose(odriverConn141.r, odriverConn141.extra)
+}
+func (odriverConn141 *tdriverConn141) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn141.r, odriverConn141.extra, query)
+}
+func (odriverConn141 *tdriverConn141) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn141.r, odriverConn141.extra, ctx, opts)
+}
+func (odriverConn141 *tdriverConn141) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn141.r, odriverConn141.extra, ctx, query)
+}
+func (odriverConn141 *tdriverConn141) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn141.r, odriverConn141.extra, param0)
+}
+func (odriverConn141 *tdriverConn141) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn141.r, odriverConn141.extra, ctx)
+}
+
+func (odriverConn142 *tdriverConn142) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn142.r, odriverConn142.extra)
+}
+func (odriverConn142 *tdriverConn142) Close() error {
+	return realDCClose(odriverConn142.r, odriverConn142.extra)
+}
+func (odriverConn142 *tdriverConn142) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn142.r, odriverConn142.extra, query)
+}
+func (odriverConn142 *tdriverConn142) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn142.r, odriverConn142.extra, ctx, opts)
+}
+func (odriverConn142 *tdriverConn142) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn142.r, odriverConn142.extra, ctx, query)
+}
+func (odriverConn142 *tdriverConn142) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn142.r, odriverConn142.extra, param0)
+}
+func (odriverConn142 *tdriverConn142) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn142.r, odriverConn142.extra, query, args)
+}
+
+func (odriverConn143 *tdriverConn143) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn143.r, odriverConn143.extra)
+}
+func (odriverConn143 *tdriverConn143) Close() error {
+	return realDCClose(odriverConn143.r, odriverConn143.extra)
+}
+func (odriverConn143 *tdriverConn143) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn143.r, odriverConn143.extra, query)
+}
+func (odriverConn143 *tdriverConn143) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn143.r, odriverConn143.extra, ctx, opts)
+}
+func (odriverConn143 *tdriverConn143) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn143.r, odriverConn143.extra, ctx, query)
+}
+func (odriverConn143 *tdriverConn143) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn143.r, odriverConn143.extra, param0)
+}
+func (odriverConn143 *tdriverConn143) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn143.r, odriverConn143.extra, ctx, query, args)
+}
+
+func (odriverConn144 *tdriverConn144) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn144.r, odriverConn144.extra)
+}
+func (odriverConn144 *tdriverConn144) Close() error {
+	return realDCClose(odriverConn144.r, odriverConn144.extra)
+}
+func (odriverConn144 *tdriverConn144) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn144.r, odriverConn144.extra, query)
+}
+func (odriverConn144 *tdriverConn144) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn144.r, odriverConn144.extra, ctx, opts)
+}
+func (odriverConn144 *tdriverConn144) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn144.r, odriverConn144.extra, ctx, query)
+}
+func (odriverConn144 *tdriverConn144) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn144.r, odriverConn144.extra, param0)
+}
+func (odriverConn144 *tdriverConn144) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn144.r, odriverConn144.extra, ctx)
+}
+
+func (odriverConn145 *tdriverConn145) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn145.r, odriverConn145.extra)
+}
+func (odriverConn145 *tdriverConn145) Close() error {
+	return realDCClose(odriverConn145.r, odriverConn145.extra)
+}
+func (odriverConn145 *tdriverConn145) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn145.r, odriverConn145.extra, query)
+}
+func (odriverConn145 *tdriverConn145) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn145.r, odriverConn145.extra, ctx, opts)
+}
+func (odriverConn145 *tdriverConn145) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn145.r, odriverConn145.extra, ctx, query)
+}
+func (odriverConn145 *tdriverConn145) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn145.r, odriverConn145.extra, ctx)
+}
+func (odriverConn145 *tdriverConn145) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn145.r, odriverConn145.extra, query, args)
+}
+
+func (odriverConn146 *tdriverConn146) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn146.r, odriverConn146.extra)
+}
+func (odriverConn146 *tdriverConn146) Close() error {
+	return realDCClose(odriverConn146.r, odriverConn146.extra)
+}
+func (odriverConn146 *tdriverConn146) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn146.r, odriverConn146.extra, query)
+}
+func (odriverConn146 *tdriverConn146) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn146.r, odriverConn146.extra, ctx, opts)
+}
+func (odriverConn146 *tdriverConn146) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn146.r, odriverConn146.extra, ctx, query)
+}
+func (odriverConn146 *tdriverConn146) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn146.r, odriverConn146.extra, ctx)
+}
+func (odriverConn146 *tdriverConn146) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn146.r, odriverConn146.extra, ctx, query, args)
+}
+
+func (odriverConn147 *tdriverConn147) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn147.r, odriverConn147.extra)
+}
+func (odriverConn147 *tdriverConn147) Close() error {
+	return realDCClose(odriverConn147.r, odriverConn147.extra)
+}
+func (odriverConn147 *tdriverConn147) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn147.r, odriverConn147.extra, query)
+}
+func (odriverConn147 *tdriverConn147) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn147.r, odriverConn147.extra, ctx, opts)
+}
+func (odriverConn147 *tdriverConn147) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn147.r, odriverConn147.extra, ctx, query)
+}
+func (odriverConn147 *tdriverConn147) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn147.r, odriverConn147.extra, ctx)
+}
+func (odriverConn147 *tdriverConn147) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn147.r, odriverConn147.extra, ctx)
+}
+
+func (odriverConn148 *tdriverConn148) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn148.r, odriverConn148.extra)
+}
+func (odriverConn148 *tdriverConn148) Close() error {
+	return realDCClose(odriverConn148.r, odriverConn148.extra)
+}
+func (odriverConn148 *tdriverConn148) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn148.r, odriverConn148.extra, query)
+}
+func (odriverConn148 *tdriverConn148) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn148.r, odriverConn148.extra, ctx, opts)
+}
+func (odriverConn148 *tdriverConn148) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn148.r, odriverConn148.extra, ctx, query)
+}
+func (odriverConn148 *tdriverConn148) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn148.r, odriverConn148.extra, query, args)
+}
+func (odriverConn148 *tdriverConn148) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn148.r, odriverConn148.extra, ctx, query, args)
+}
+
+func (odriverConn149 *tdriverConn149) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn149.r, odriverConn149.extra)
+}
+func (odriverConn149 *tdriverConn149) Close() error {
+	return realDCClose(odriverConn149.r, odriverConn149.extra)
+}
+func (odriverConn149 *tdriverConn149) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn149.r, odriverConn149.extra, query)
+}
+func (odriverConn149 *tdriverConn149) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn149.r, odriverConn149.extra, ctx, opts)
+}
+func (odriverConn149 *tdriverConn149) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn149.r, odriverConn149.extra, ctx, query)
+}
+func (odriverConn149 *tdriverConn149) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn149.r, odriverConn149.extra, query, args)
+}
+func (odriverConn149 *tdriverConn149) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn149.r, odriverConn149.extra, ctx)
+}
+
+func (odriverConn150 *tdriverConn150) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn150.r, odriverConn150.extra)
+}
+func (odriverConn150 *tdriverConn150) Close() error {
+	return realDCClose(odriverConn150.r, odriverConn150.extra)
+}
+func (odriverConn150 *tdriverConn150) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn150.r, odriverConn150.extra, query)
+}
+func (odriverConn150 *tdriverConn150) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn150.r, odriverConn150.extra, ctx, opts)
+}
+func (odriverConn150 *tdriverConn150) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn150.r, odriverConn150.extra, ctx, query)
+}
+func (odriverConn150 *tdriverConn150) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn150.r, odriverConn150.extra, ctx, query, args)
+}
+func (odriverConn150 *tdriverConn150) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn150.r, odriverConn150.extra, ctx)
+}
+
+func (odriverConn151 *tdriverConn151) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn151.r, odriverConn151.extra)
+}
+func (odriverConn151 *tdriverConn151) Close() error {
+	return realDCClose(odriverConn151.r, odriverConn151.extra)
+}
+func (odriverConn151 *tdriverConn151) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn151.r, odriverConn151.extra, query)
+}
+func (odriverConn151 *tdriverConn151) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn151.r, odriverConn151.extra, ctx, opts)
+}
+func (odriverConn151 *tdriverConn151) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn151.r, odriverConn151.extra, query, args)
+}
+func (odriverConn151 *tdriverConn151) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn151.r, odriverConn151.extra, ctx, query, args)
+}
+func (odriverConn151 *tdriverConn151) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn151.r, odriverConn151.extra, param0)
+}
+
+func (odriverConn152 *tdriverConn152) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn152.r, odriverConn152.extra)
+}
+func (odriverConn152 *tdriverConn152) Close() error {
+	return realDCClose(odriverConn152.r, odriverConn152.extra)
+}
+func (odriverConn152 *tdriverConn152) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn152.r, odriverConn152.extra, query)
+}
+func (odriverConn152 *tdriverConn152) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn152.r, odriverConn152.extra, ctx, opts)
+}
+func (odriverConn152 *tdriverConn152) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn152.r, odriverConn152.extra, query, args)
+}
+func (odriverConn152 *tdriverConn152) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn152.r, odriverConn152.extra, ctx, query, args)
+}
+func (odriverConn152 *tdriverConn152) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn152.r, odriverConn152.extra, ctx)
+}
+
+func (odriverConn153 *tdriverConn153) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn153.r, odriverConn153.extra)
+}
+func (odriverConn153 *tdriverConn153) Close() error {
+	return realDCClose(odriverConn153.r, odriverConn153.extra)
+}
+func (odriverConn153 *tdriverConn153) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn153.r, odriverConn153.extra, query)
+}
+func (odriverConn153 *tdriverConn153) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn153.r, odriverConn153.extra, ctx, opts)
+}
+func (odriverConn153 *tdriverConn153) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn153.r, odriverConn153.extra, query, args)
+}
+func (odriverConn153 *tdriverConn153) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn153.r, odriverConn153.extra, ctx, query, args)
+}
+func (odriverConn153 *tdriverConn153) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn153.r, odriverConn153.extra, query, args)
+}
+
+func (odriverConn154 *tdriverConn154) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn154.r, odriverConn154.extra)
+}
+func (odriverConn154 *tdriverConn154) Close() error {
+	return realDCClose(odriverConn154.r, odriverConn154.extra)
+}
+func (odriverConn154 *tdriverConn154) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn154.r, odriverConn154.extra, query)
+}
+func (odriverConn154 *tdriverConn154) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn154.r, odriverConn154.extra, ctx, opts)
+}
+func (odriverConn154 *tdriverConn154) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn154.r, odriverConn154.extra, query, args)
+}
+func (odriverConn154 *tdriverConn154) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn154.r, odriverConn154.extra, ctx, query, args)
+}
+func (odriverConn154 *tdriverConn154) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn154.r, odriverConn154.extra, ctx, query, args)
+}
+
+func (odriverConn155 *tdriverConn155) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn155.r, odriverConn155.extra)
+}
+func (odriverConn155 *tdriverConn155) Close() error {
+	return realDCClose(odriverConn155.r, odriverConn155.extra)
+}
+func (odriverConn155 *tdriverConn155) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn155.r, odriverConn155.extra, query)
+}
+func (odriverConn155 *tdriverConn155) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn155.r, odriverConn155.extra, ctx, opts)
+}
+func (odriverConn155 *tdriverConn155) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn155.r, odriverConn155.extra, query, args)
+}
+func (odriverConn155 *tdriverConn155) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn155.r, odriverConn155.extra, ctx, query, args)
+}
+func (odriverConn155 *tdriverConn155) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn155.r, odriverConn155.extra, ctx)
+}
+
+func (odriverConn156 *tdriverConn156) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn156.r, odriverConn156.extra)
+}
+func (odriverConn156 *tdriverConn156) Close() error {
+	return realDCClose(odriverConn156.r, odriverConn156.extra)
+}
+func (odriverConn156 *tdriverConn156) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn156.r, odriverConn156.extra, query)
+}
+func (odriverConn156 *tdriverConn156) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn156.r, odriverConn156.extra, ctx, opts)
+}
+func (odriverConn156 *tdriverConn156) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn156.r, odriverConn156.extra, query, args)
+}
+func (odriverConn156 *tdriverConn156) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn156.r, odriverConn156.extra, param0)
+}
+func (odriverConn156 *tdriverConn156) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn156.r, odriverConn156.extra, ctx)
+}
+
+func (odriverConn157 *tdriverConn157) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn157.r, odriverConn157.extra)
+}
+func (odriverConn157 *tdriverConn157) Close() error {
+	return realDCClose(odriverConn157.r, odriverConn157.extra)
+}
+func (odriverConn157 *tdriverConn157) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn157.r, odriverConn157.extra, query)
+}
+func (odriverConn157 *tdriverConn157) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn157.r, odriverConn157.extra, ctx, opts)
+}
+func (odriverConn157 *tdriverConn157) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn157.r, odriverConn157.extra, query, args)
+}
+func (odriverConn157 *tdriverConn157) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn157.r, odriverConn157.extra, param0)
+}
+func (odriverConn157 *tdriverConn157) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn157.r, odriverConn157.extra, query, args)
+}
+
+func (odriverConn158 *tdriverConn158) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn158.r, odriverConn158.extra)
+}
+func (odriverConn158 *tdriverConn158) Close() error {
+	return realDCClose(odriverConn158.r, odriverConn158.extra)
+}
+func (odriverConn158 *tdriverConn158) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn158.r, odriverConn158.extra, query)
+}
+func (odriverConn158 *tdriverConn158) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn158.r, odriverConn158.extra, ctx, opts)
+}
+func (odriverConn158 *tdriverConn158) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn158.r, odriverConn158.extra, query, args)
+}
+func (odriverConn158 *tdriverConn158) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn158.r, odriverConn158.extra, param0)
+}
+func (odriverConn158 *tdriverConn158) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn158.r, odriverConn158.extra, ctx, query, args)
+}
+
+func (odriverConn159 *tdriverConn159) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn159.r, odriverConn159.extra)
+}
+func (odriverConn159 *tdriverConn159) Close() error {
+	return realDCClose(odriverConn159.r, odriverConn159.extra)
+}
+func (odriverConn159 *tdriverConn159) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn159.r, odriverConn159.extra, query)
+}
+func (odriverConn159 *tdriverConn159) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn159.r, odriverConn159.extra, ctx, opts)
+}
+func (odriverConn159 *tdriverConn159) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn159.r, odriverConn159.extra, query, args)
+}
+func (odriverConn159 *tdriverConn159) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn159.r, odriverConn159.extra, param0)
+}
+func (odriverConn159 *tdriverConn159) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn159.r, odriverConn159.extra, ctx)
+}
+
+func (odriverConn160 *tdriverConn160) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn160.r, odriverConn160.extra)
+}
+func (odriverConn160 *tdriverConn160) Close() error {
+	return realDCClose(odriverConn160.r, odriverConn160.extra)
+}
+func (odriverConn160 *tdriverConn160) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn160.r, odriverConn160.extra, query)
+}
+func (odriverConn160 *tdriverConn160) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn160.r, odriverConn160.extra, ctx, opts)
+}
+func (odriverConn160 *tdriverConn160) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn160.r, odriverConn160.extra, query, args)
+}
+func (odriverConn160 *tdriverConn160) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn160.r, odriverConn160.extra, ctx)
+}
+func (odriverConn160 *tdriverConn160) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn160.r, odriverConn160.extra, query, args)
+}
+
+func (odriverConn161 *tdriverConn161) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn161.r, odriverConn161.extra)
+}
+func (odriverConn161 *tdriverConn161) Close() error {
+	return realDCClose(odriverConn161.r, odriverConn161.extra)
+}
+func (odriverConn161 *tdriverConn161) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn161.r, odriverConn161.extra, query)
+}
+func (odriverConn161 *tdriverConn161) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn161.r, odriverConn161.extra, ctx, opts)
+}
+func (odriverConn161 *tdriverConn161) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn161.r, odriverConn161.extra, query, args)
+}
+func (odriverConn161 *tdriverConn161) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn161.r, odriverConn161.extra, ctx)
+}
+func (odriverConn161 *tdriverConn161) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn161.r, odriverConn161.extra, ctx, query, args)
+}
+
+func (odriverConn162 *tdriverConn162) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn162.r, odriverConn162.extra)
+}
+func (odriverConn162 *tdriverConn162) Close() error {
+	return realDCClose(odriverConn162.r, odriverConn162.extra)
+}
+func (odriverConn162 *tdriverConn162) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn162.r, odriverConn162.extra, query)
+}
+func (odriverConn162 *tdriverConn162) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn162.r, odriverConn162.extra, ctx, opts)
+}
+func (odriverConn162 *tdriverConn162) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn162.r, odriverConn162.extra, query, args)
+}
+func (odriverConn162 *tdriverConn162) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn162.r, odriverConn162.extra, ctx)
+}
+func (odriverConn162 *tdriverConn162) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn162.r, odriverConn162.extra, ctx)
+}
+
+func (odriverConn163 *tdriverConn163) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn163.r, odriverConn163.extra)
+}
+func (odriverConn163 *tdriverConn163) Close() error {
+	return realDCClose(odriverConn163.r, odriverConn163.extra)
+}
+func (odriverConn163 *tdriverConn163) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn163.r, odriverConn163.extra, query)
+}
+func (odriverConn163 *tdriverConn163) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn163.r, odriverConn163.extra, ctx, opts)
+}
+func (odriverConn163 *tdriverConn163) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn163.r, odriverConn163.extra, query, args)
+}
+func (odriverConn163 *tdriverConn163) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn163.r, odriverConn163.extra, query, args)
+}
+func (odriverConn163 *tdriverConn163) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn163.r, odriverConn163.extra, ctx, query, args)
+}
+
+func (odriverConn164 *tdriverConn164) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn164.r, odriverConn164.extra)
+}
+func (odriverConn164 *tdriverConn164) Close() error {
+	return realDCClose(odriverConn164.r, odriverConn164.extra)
+}
+func (odriverConn164 *tdriverConn164) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn164.r, odriverConn164.extra, query)
+}
+func (odriverConn164 *tdriverConn164) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn164.r, odriverConn164.extra, ctx, opts)
+}
+func (odriverConn164 *tdriverConn164) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn164.r, odriverConn164.extra, query, args)
+}
+func (odriverConn164 *tdriverConn164) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn164.r, odriverConn164.extra, query, args)
+}
+func (odriverConn164 *tdriverConn164) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn164.r, odriverConn164.extra, ctx)
+}
+
+func (odriverConn165 *tdriverConn165) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn165.r, odriverConn165.extra)
+}
+func (odriverConn165 *tdriverConn165) Close() error {
+	return realDCClose(odriverConn165.r, odriverConn165.extra)
+}
+func (odriverConn165 *tdriverConn165) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn165.r, odriverConn165.extra, query)
+}
+func (odriverConn165 *tdriverConn165) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn165.r, odriverConn165.extra, ctx, opts)
+}
+func (odriverConn165 *tdriverConn165) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn165.r, odriverConn165.extra, query, args)
+}
+func (odriverConn165 *tdriverConn165) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn165.r, odriverConn165.extra, ctx, query, args)
+}
+func (odriverConn165 *tdriverConn165) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn165.r, odriverConn165.extra, ctx)
+}
+
+func (odriverConn166 *tdriverConn166) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn166.r, odriverConn166.extra)
+}
+func (odriverConn166 *tdriverConn166) Close() error {
+	return realDCClose(odriverConn166.r, odriverConn166.extra)
+}
+func (odriverConn166 *tdriverConn166) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn166.r, odriverConn166.extra, query)
+}
+func (odriverConn166 *tdriverConn166) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn166.r, odriverConn166.extra, ctx, opts)
+}
+func (odriverConn166 *tdriverConn166) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn166.r, odriverConn166.extra, ctx, query, args)
+}
+func (odriverConn166 *tdriverConn166) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn166.r, odriverConn166.extra, param0)
+}
+func (odriverConn166 *tdriverConn166) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn166.r, odriverConn166.extra, ctx)
+}
+
+func (odriverConn167 *tdriverConn167) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn167.r, odriverConn167.extra)
+}
+func (odriverConn167 *tdriverConn167) Close() error {
+	return realDCClose(odriverConn167.r, odriverConn167.extra)
+}
+func (odriverConn167 *tdriverConn167) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn167.r, odriverConn167.extra, query)
+}
+func (odriverConn167 *tdriverConn167) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn167.r, odriverConn167.extra, ctx, opts)
+}
+func (odriverConn167 *tdriverConn167) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn167.r, odriverConn167.extra, ctx, query, args)
+}
+func (odriverConn167 *tdriverConn167) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn167.r, odriverConn167.extra, param0)
+}
+func (odriverConn167 *tdriverConn167) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn167.r, odriverConn167.extra, query, args)
+}
+
+func (odriverConn168 *tdriverConn168) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn168.r, odriverConn168.extra)
+}
+func (odriverConn168 *tdriverConn168) Close() error {
+	return realDCClose(odriverConn168.r, odriverConn168.extra)
+}
+func (odriverConn168 *tdriverConn168) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn168.r, odriverConn168.extra, query)
+}
+func (odriverConn168 *tdriverConn168) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn168.r, odriverConn168.extra, ctx, opts)
+}
+func (odriverConn168 *tdriverConn168) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn168.r, odriverConn168.extra, ctx, query, args)
+}
+func (odriverConn168 *tdriverConn168) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn168.r, odriverConn168.extra, param0)
+}
+func (odriverConn168 *tdriverConn168) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn168.r, odriverConn168.extra, ctx, query, args)
+}
+
+func (odriverConn169 *tdriverConn169) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn169.r, odriverConn169.extra)
+}
+func (odriverConn169 *tdriverConn169) Close() error {
+	return realDCClose(odriverConn169.r, odriverConn169.extra)
+}
+func (odriverConn169 *tdriverConn169) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn169.r, odriverConn169.extra, query)
+}
+func (odriverConn169 *tdriverConn169) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn169.r, odriverConn169.extra, ctx, opts)
+}
+func (odriverConn169 *tdriverConn169) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn169.r, odriverConn169.extra, ctx, query, args)
+}
+func (odriverConn169 *tdriverConn169) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn169.r, odriverConn169.extra, param0)
+}
+func (odriverConn169 *tdriverConn169) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn169.r, odriverConn169.extra, ctx)
+}
+
+func (odriverConn170 *tdriverConn170) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn170.r, odriverConn170.extra)
+}
+func (odriverConn170 *tdriverConn170) Close() error {
+	return realDCClose(odriverConn170.r, odriverConn170.extra)
+}
+func (odriverConn170 *tdriverConn170) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn170.r, odriverConn170.extra, query)
+}
+func (odriverConn170 *tdriverConn170) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn170.r, odriverConn170.extra, ctx, opts)
+}
+func (odriverConn170 *tdriverConn170) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn170.r, odriverConn170.extra, ctx, query, args)
+}
+func (odriverConn170 *tdriverConn170) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn170.r, odriverConn170.extra, ctx)
+}
+func (odriverConn170 *tdriverConn170) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn170.r, odriverConn170.extra, query, args)
+}
+
+func (odriverConn171 *tdriverConn171) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn171.r, odriverConn171.extra)
+}
+func (odriverConn171 *tdriverConn171) Close() error {
+	return realDCClose(odriverConn171.r, odriverConn171.extra)
+}
+func (odriverConn171 *tdriverConn171) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn171.r, odriverConn171.extra, query)
+}
+func (odriverConn171 *tdriverConn171) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn171.r, odriverConn171.extra, ctx, opts)
+}
+func (odriverConn171 *tdriverConn171) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn171.r, odriverConn171.extra, ctx, query, args)
+}
+func (odriverConn171 *tdriverConn171) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn171.r, odriverConn171.extra, ctx)
+}
+func (odriverConn171 *tdriverConn171) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn171.r, odriverConn171.extra, ctx, query, args)
+}
+
+func (odriverConn172 *tdriverConn172) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn172.r, odriverConn172.extra)
+}
+func (odriverConn172 *tdriverConn172) Close() error {
+	return realDCClose(odriverConn172.r, odriverConn172.extra)
+}
+func (odriverConn172 *tdriverConn172) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn172.r, odriverConn172.extra, query)
+}
+func (odriverConn172 *tdriverConn172) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn172.r, odriverConn172.extra, ctx, opts)
+}
+func (odriverConn172 *tdriverConn172) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn172.r, odriverConn172.extra, ctx, query, args)
+}
+func (odriverConn172 *tdriverConn172) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn172.r, odriverConn172.extra, ctx)
+}
+func (odriverConn172 *tdriverConn172) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn172.r, odriverConn172.extra, ctx)
+}
+
+func (odriverConn173 *tdriverConn173) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn173.r, odriverConn173.extra)
+}
+func (odriverConn173 *tdriverConn173) Close() error {
+	return realDCClose(odriverConn173.r, odriverConn173.extra)
+}
+func (odriverConn173 *tdriverConn173) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn173.r, odriverConn173.extra, query)
+}
+func (odriverConn173 *tdriverConn173) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn173.r, odriverConn173.extra, ctx, opts)
+}
+func (odriverConn173 *tdriverConn173) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn173.r, odriverConn173.extra, ctx, query, args)
+}
+func (odriverConn173 *tdriverConn173) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn173.r, odriverConn173.extra, query, args)
+}
+func (odriverConn173 *tdriverConn173) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn173.r, odriverConn173.extra, ctx, query, args)
+}
+
+func (odriverConn174 *tdriverConn174) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn174.r, odriverConn174.extra)
+}
+func (odriverConn174 *tdriverConn174) Close() error {
+	return realDCClose(odriverConn174.r, odriverConn174.extra)
+}
+func (odriverConn174 *tdriverConn174) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn174.r, odriverConn174.extra, query)
+}
+func (odriverConn174 *tdriverConn174) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn174.r, odriverConn174.extra, ctx, opts)
+}
+func (odriverConn174 *tdriverConn174) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn174.r, odriverConn174.extra, ctx, query, args)
+}
+func (odriverConn174 *tdriverConn174) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn174.r, odriverConn174.extra, query, args)
+}
+func (odriverConn174 *tdriverConn174) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn174.r, odriverConn174.extra, ctx)
+}
+
+func (odriverConn175 *tdriverConn175) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn175.r, odriverConn175.extra)
+}
+func (odriverConn175 *tdriverConn175) Close() error {
+	return realDCClose(odriverConn175.r, odriverConn175.extra)
+}
+func (odriverConn175 *tdriverConn175) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn175.r, odriverConn175.extra, query)
+}
+func (odriverConn175 *tdriverConn175) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn175.r, odriverConn175.extra, ctx, opts)
+}
+func (odriverConn175 *tdriverConn175) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn175.r, odriverConn175.extra, ctx, query, args)
+}
+func (odriverConn175 *tdriverConn175) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn175.r, odriverConn175.extra, ctx, query, args)
+}
+func (odriverConn175 *tdriverConn175) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn175.r, odriverConn175.extra, ctx)
+}
+
+func (odriverConn176 *tdriverConn176) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn176.r, odriverConn176.extra)
+}
+func (odriverConn176 *tdriverConn176) Close() error {
+	return realDCClose(odriverConn176.r, odriverConn176.extra)
+}
+func (odriverConn176 *tdriverConn176) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn176.r, odriverConn176.extra, query)
+}
+func (odriverConn176 *tdriverConn176) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn176.r, odriverConn176.extra, ctx, opts)
+}
+func (odriverConn176 *tdriverConn176) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn176.r, odriverConn176.extra, param0)
+}
+func (odriverConn176 *tdriverConn176) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn176.r, odriverConn176.extra, ctx)
+}
+func (odriverConn176 *tdriverConn176) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn176.r, odriverConn176.extra, query, args)
+}
+
+func (odriverConn177 *tdriverConn177) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn177.r, odriverConn177.extra)
+}
+func (odriverConn177 *tdriverConn177) Close() error {
+	return realDCClose(odriverConn177.r, odriverConn177.extra)
+}
+func (odriverConn177 *tdriverConn177) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn177.r, odriverConn177.extra, query)
+}
+func (odriverConn177 *tdriverConn177) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn177.r, odriverConn177.extra, ctx, opts)
+}
+func (odriverConn177 *tdriverConn177) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn177.r, odriverConn177.extra, param0)
+}
+func (odriverConn177 *tdriverConn177) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn177.r, odriverConn177.extra, ctx)
+}
+func (odriverConn177 *tdriverConn177) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn177.r, odriverConn177.extra, ctx, query, args)
+}
+
+func (odriverConn178 *tdriverConn178) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn178.r, odriverConn178.extra)
+}
+func (odriverConn178 *tdriverConn178) Close() error {
+	return realDCClose(odriverConn178.r, odriverConn178.extra)
+}
+func (odriverConn178 *tdriverConn178) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn178.r, odriverConn178.extra, query)
+}
+func (odriverConn178 *tdriverConn178) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn178.r, odriverConn178.extra, ctx, opts)
+}
+func (odriverConn178 *tdriverConn178) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn178.r, odriverConn178.extra, param0)
+}
+func (odriverConn178 *tdriverConn178) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn178.r, odriverConn178.extra, ctx)
+}
+func (odriverConn178 *tdriverConn178) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn178.r, odriverConn178.extra, ctx)
+}
+
+func (odriverConn179 *tdriverConn179) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn179.r, odriverConn179.extra)
+}
+func (odriverConn179 *tdriverConn179) Close() error {
+	return realDCClose(odriverConn179.r, odriverConn179.extra)
+}
+func (odriverConn179 *tdriverConn179) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn179.r, odriverConn179.extra, query)
+}
+func (odriverConn179 *tdriverConn179) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn179.r, odriverConn179.extra, ctx, opts)
+}
+func (odriverConn179 *tdriverConn179) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn179.r, odriverConn179.extra, param0)
+}
+func (odriverConn179 *tdriverConn179) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn179.r, odriverConn179.extra, query, args)
+}
+func (odriverConn179 *tdriverConn179) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn179.r, odriverConn179.extra, ctx, query, args)
+}
+
+func (odriverConn180 *tdriverConn180) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn180.r, odriverConn180.extra)
+}
+func (odriverConn180 *tdriverConn180) Close() error {
+	return realDCClose(odriverConn180.r, odriverConn180.extra)
+}
+func (odriverConn180 *tdriverConn180) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn180.r, odriverConn180.extra, query)
+}
+func (odriverConn180 *tdriverConn180) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn180.r, odriverConn180.extra, ctx, opts)
+}
+func (odriverConn180 *tdriverConn180) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn180.r, odriverConn180.extra, param0)
+}
+func (odriverConn180 *tdriverConn180) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn180.r, odriverConn180.extra, query, args)
+}
+func (odriverConn180 *tdriverConn180) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn180.r, odriverConn180.extra, ctx)
+}
+
+func (odriverConn181 *tdriverConn181) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn181.r, odriverConn181.extra)
+}
+func (odriverConn181 *tdriverConn181) Close() error {
+	return realDCClose(odriverConn181.r, odriverConn181.extra)
+}
+func (odriverConn181 *tdriverConn181) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn181.r, odriverConn181.extra, query)
+}
+func (odriverConn181 *tdriverConn181) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn181.r, odriverConn181.extra, ctx, opts)
+}
+func (odriverConn181 *tdriverConn181) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn181.r, odriverConn181.extra, param0)
+}
+func (odriverConn181 *tdriverConn181) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn181.r, odriverConn181.extra, ctx, query, args)
+}
+func (odriverConn181 *tdriverConn181) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn181.r, odriverConn181.extra, ctx)
+}
+
+func (odriverConn182 *tdriverConn182) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn182.r, odriverConn182.extra)
+}
+func (odriverConn182 *tdriverConn182) Close() error {
+	return realDCClose(odriverConn182.r, odriverConn182.extra)
+}
+func (odriverConn182 *tdriverConn182) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn182.r, odriverConn182.extra, query)
+}
+func (odriverConn182 *tdriverConn182) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn182.r, odriverConn182.extra, ctx, opts)
+}
+func (odriverConn182 *tdriverConn182) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn182.r, odriverConn182.extra, ctx)
+}
+func (odriverConn182 *tdriverConn182) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn182.r, odriverConn182.extra, query, args)
+}
+func (odriverConn182 *tdriverConn182) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn182.r, odriverConn182.extra, ctx, query, args)
+}
+
+func (odriverConn183 *tdriverConn183) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn183.r, odriverConn183.extra)
+}
+func (odriverConn183 *tdriverConn183) Close() error {
+	return realDCClose(odriverConn183.r, odriverConn183.extra)
+}
+func (odriverConn183 *tdriverConn183) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn183.r, odriverConn183.extra, query)
+}
+func (odriverConn183 *tdriverConn183) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn183.r, odriverConn183.extra, ctx, opts)
+}
+func (odriverConn183 *tdriverConn183) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn183.r, odriverConn183.extra, ctx)
+}
+func (odriverConn183 *tdriverConn183) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn183.r, odriverConn183.extra, query, args)
+}
+func (odriverConn183 *tdriverConn183) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn183.r, odriverConn183.extra, ctx)
+}
+
+func (odriverConn184 *tdriverConn184) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn184.r, odriverConn184.extra)
+}
+func (odriverConn184 *tdriverConn184) Close() error {
+	return realDCClose(odriverConn184.r, odriverConn184.extra)
+}
+func (odriverConn184 *tdriverConn184) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn184.r, odriverConn184.extra, query)
+}
+func (odriverConn184 *tdriverConn184) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn184.r, odriverConn184.extra, ctx, opts)
+}
+func (odriverConn184 *tdriverConn184) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn184.r, odriverConn184.extra, ctx)
+}
+func (odriverConn184 *tdriverConn184) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn184.r, odriverConn184.extra, ctx, query, args)
+}
+func (odriverConn184 *tdriverConn184) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn184.r, odriverConn184.extra, ctx)
+}
+
+func (odriverConn185 *tdriverConn185) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn185.r, odriverConn185.extra)
+}
+func (odriverConn185 *tdriverConn185) Close() error {
+	return realDCClose(odriverConn185.r, odriverConn185.extra)
+}
+func (odriverConn185 *tdriverConn185) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn185.r, odriverConn185.extra, query)
+}
+func (odriverConn185 *tdriverConn185) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn185.r, odriverConn185.extra, ctx, opts)
+}
+func (odriverConn185 *tdriverConn185) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn185.r, odriverConn185.extra, query, args)
+}
+func (odriverConn185 *tdriverConn185) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn185.r, odriverConn185.extra, ctx, query, args)
+}
+func (odriverConn185 *tdriverConn185) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn185.r, odriverConn185.extra, ctx)
+}
+
+func (odriverConn186 *tdriverConn186) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn186.r, odriverConn186.extra)
+}
+func (odriverConn186 *tdriverConn186) Close() error {
+	return realDCClose(odriverConn186.r, odriverConn186.extra)
+}
+func (odriverConn186 *tdriverConn186) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn186.r, odriverConn186.extra, query)
+}
+func (odriverConn186 *tdriverConn186) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn186.r, odriverConn186.extra, ctx, query)
+}
+func (odriverConn186 *tdriverConn186) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn186.r, odriverConn186.extra, query, args)
+}
+func (odriverConn186 *tdriverConn186) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn186.r, odriverConn186.extra, ctx, query, args)
+}
+func (odriverConn186 *tdriverConn186) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn186.r, odriverConn186.extra, param0)
+}
+
+func (odriverConn187 *tdriverConn187) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn187.r, odriverConn187.extra)
+}
+func (odriverConn187 *tdriverConn187) Close() error {
+	return realDCClose(odriverConn187.r, odriverConn187.extra)
+}
+func (odriverConn187 *tdriverConn187) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn187.r, odriverConn187.extra, query)
+}
+func (odriverConn187 *tdriverConn187) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn187.r, odriverConn187.extra, ctx, query)
+}
+func (odriverConn187 *tdriverConn187) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn187.r, odriverConn187.extra, query, args)
+}
+func (odriverConn187 *tdriverConn187) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn187.r, odriverConn187.extra, ctx, query, args)
+}
+func (odriverConn187 *tdriverConn187) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn187.r, odriverConn187.extra, ctx)
+}
+
+func (odriverConn188 *tdriverConn188) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn188.r, odriverConn188.extra)
+}
+func (odriverConn188 *tdriverConn188) Close() error {
+	return realDCClose(odriverConn188.r, odriverConn188.extra)
+}
+func (odriverConn188 *tdriverConn188) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn188.r, odriverConn188.extra, query)
+}
+func (odriverConn188 *tdriverConn188) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn188.r, odriverConn188.extra, ctx, query)
+}
+func (odriverConn188 *tdriverConn188) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn188.r, odriverConn188.extra, query, args)
+}
+func (odriverConn188 *tdriverConn188) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn188.r, odriverConn188.extra, ctx, query, args)
+}
+func (odriverConn188 *tdriverConn188) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn188.r, odriverConn188.extra, query, args)
+}
+
+func (odriverConn189 *tdriverConn189) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn189.r, odriverConn189.extra)
+}
+func (odriverConn189 *tdriverConn189) Close() error {
+	return realDCClose(odriverConn189.r, odriverConn189.extra)
+}
+func (odriverConn189 *tdriverConn189) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn189.r, odriverConn189.extra, query)
+}
+func (odriverConn189 *tdriverConn189) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn189.r, odriverConn189.extra, ctx, query)
+}
+func (odriverConn189 *tdriverConn189) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn189.r, odriverConn189.extra, query, args)
+}
+func (odriverConn189 *tdriverConn189) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn189.r, odriverConn189.extra, ctx, query, args)
+}
+func (odriverConn189 *tdriverConn189) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn189.r, odriverConn189.extra, ctx, query, args)
+}
+
+func (odriverConn190 *tdriverConn190) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn190.r, odriverConn190.extra)
+}
+func (odriverConn190 *tdriverConn190) Close() error {
+	return realDCClose(odriverConn190.r, odriverConn190.extra)
+}
+func (odriverConn190 *tdriverConn190) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn190.r, odriverConn190.extra, query)
+}
+func (odriverConn190 *tdriverConn190) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn190.r, odriverConn190.extra, ctx, query)
+}
+func (odriverConn190 *tdriverConn190) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn190.r, odriverConn190.extra, query, args)
+}
+func (odriverConn190 *tdriverConn190) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn190.r, odriverConn190.extra, ctx, query, args)
+}
+func (odriverConn190 *tdriverConn190) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn190.r, odriverConn190.extra, ctx)
+}
+
+func (odriverConn191 *tdriverConn191) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn191.r, odriverConn191.extra)
+}
+func (odriverConn191 *tdriverConn191) Close() error {
+	return realDCClose(odriverConn191.r, odriverConn191.extra)
+}
+func (odriverConn191 *tdriverConn191) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn191.r, odriverConn191.extra, query)
+}
+func (odriverConn191 *tdriverConn191) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn191.r, odriverConn191.extra, ctx, query)
+}
+func (odriverConn191 *tdriverConn191) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn191.r, odriverConn191.extra, query, args)
+}
+func (odriverConn191 *tdriverConn191) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn191.r, odriverConn191.extra, param0)
+}
+func (odriverConn191 *tdriverConn191) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn191.r, odriverConn191.extra, ctx)
+}
+
+func (odriverConn192 *tdriverConn192) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn192.r, odriverConn192.extra)
+}
+func (odriverConn192 *tdriverConn192) Close() error {
+	return realDCClose(odriverConn192.r, odriverConn192.extra)
+}
+func (odriverConn192 *tdriverConn192) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn192.r, odriverConn192.extra, query)
+}
+func (odriverConn192 *tdriverConn192) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn192.r, odriverConn192.extra, ctx, query)
+}
+func (odriverConn192 *tdriverConn192) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn192.r, odriverConn192.extra, query, args)
+}
+func (odriverConn192 *tdriverConn192) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn192.r, odriverConn192.extra, param0)
+}
+func (odriverConn192 *tdriverConn192) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn192.r, odriverConn192.extra, query, args)
+}
+
+func (odriverConn193 *tdriverConn193) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn193.r, odriverConn193.extra)
+}
+func (odriverConn193 *tdriverConn193) Close() error {
+	return realDCClose(odriverConn193.r, odriverConn193.extra)
+}
+func (odriverConn193 *tdriverConn193) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn193.r, odriverConn193.extra, query)
+}
+func (odriverConn193 *tdriverConn193) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn193.r, odriverConn193.extra, ctx, query)
+}
+func (odriverConn193 *tdriverConn193) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn193.r, odriverConn193.extra, query, args)
+}
+func (odriverConn193 *tdriverConn193) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn193.r, odriverConn193.extra, param0)
+}
+func (odriverConn193 *tdriverConn193) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn193.r, odriverConn193.extra, ctx, query, args)
+}
+
+func (odriverConn194 *tdriverConn194) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn194.r, odriverConn194.extra)
+}
+func (odriverConn194 *tdriverConn194) Close() error {
+	return realDCClose(odriverConn194.r, odriverConn194.extra)
+}
+func (odriverConn194 *tdriverConn194) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn194.r, odriverConn194.extra, query)
+}
+func (odriverConn194 *tdriverConn194) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn194.r, odriverConn194.extra, ctx, query)
+}
+func (odriverConn194 *tdriverConn194) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn194.r, odriverConn194.extra, query, args)
+}
+func (odriverConn194 *tdriverConn194) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn194.r, odriverConn194.extra, param0)
+}
+func (odriverConn194 *tdriverConn194) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn194.r, odriverConn194.extra, ctx)
+}
+
+func (odriverConn195 *tdriverConn195) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn195.r, odriverConn195.extra)
+}
+func (odriverConn195 *tdriverConn195) Close() error {
+	return realDCClose(odriverConn195.r, odriverConn195.extra)
+}
+func (odriverConn195 *tdriverConn195) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn195.r, odriverConn195.extra, query)
+}
+func (odriverConn195 *tdriverConn195) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn195.r, odriverConn195.extra, ctx, query)
+}
+func (odriverConn195 *tdriverConn195) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn195.r, odriverConn195.extra, query, args)
+}
+func (odriverConn195 *tdriverConn195) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn195.r, odriverConn195.extra, ctx)
+}
+func (odriverConn195 *tdriverConn195) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn195.r, odriverConn195.extra, query, args)
+}
+
+func (odriverConn196 *tdriverConn196) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn196.r, odriverConn196.extra)
+}
+func (odriverConn196 *tdriverConn196) Close() error {
+	return realDCClose(odriverConn196.r, odriverConn196.extra)
+}
+func (odriverConn196 *tdriverConn196) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn196.r, odriverConn196.extra, query)
+}
+func (odriverConn196 *tdriverConn196) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn196.r, odriverConn196.extra, ctx, query)
+}
+func (odriverConn196 *tdriverConn196) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn196.r, odriverConn196.extra, query, args)
+}
+func (odriverConn196 *tdriverConn196) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn196.r, odriverConn196.extra, ctx)
+}
+func (odriverConn196 *tdriverConn196) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn196.r, odriverConn196.extra, ctx, query, args)
+}
+
+func (odriverConn197 *tdriverConn197) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn197.r, odriverConn197.extra)
+}
+func (odriverConn197 *tdriverConn197) Close() error {
+	return realDCClose(odriverConn197.r, odriverConn197.extra)
+}
+func (odriverConn197 *tdriverConn197) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn197.r, odriverConn197.extra, query)
+}
+func (odriverConn197 *tdriverConn197) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn197.r, odriverConn197.extra, ctx, query)
+}
+func (odriverConn197 *tdriverConn197) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn197.r, odriverConn197.extra, query, args)
+}
+func (odriverConn197 *tdriverConn197) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn197.r, odriverConn197.extra, ctx)
+}
+func (odriverConn197 *tdriverConn197) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn197.r, odriverConn197.extra, ctx)
+}
+
+func (odriverConn198 *tdriverConn198) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn198.r, odriverConn198.extra)
+}
+func (odriverConn198 *tdriverConn198) Close() error {
+	return realDCClose(odriverConn198.r, odriverConn198.extra)
+}
+func (odriverConn198 *tdriverConn198) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn198.r, odriverConn198.extra, query)
+}
+func (odriverConn198 *tdriverConn198) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn198.r, odriverConn198.extra, ctx, query)
+}
+func (odriverConn198 *tdriverConn198) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn198.r, odriverConn198.extra, query, args)
+}
+func (odriverConn198 *tdriverConn198) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn198.r, odriverConn198.extra, query, args)
+}
+func (odriverConn198 *tdriverConn198) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn198.r, odriverConn198.extra, ctx, query, args)
+}
+
+func (odriverConn199 *tdriverConn199) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn199.r, odriverConn199.extra)
+}
+func (odriverConn199 *tdriverConn199) Close() error {
+	return realDCClose(odriverConn199.r, odriverConn199.extra)
+}
+func (odriverConn199 *tdriverConn199) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn199.r, odriverConn199.extra, query)
+}
+func (odriverConn199 *tdriverConn199) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn199.r, odriverConn199.extra, ctx, query)
+}
+func (odriverConn199 *tdriverConn199) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn199.r, odriverConn199.extra, query, args)
+}
+func (odriverConn199 *tdriverConn199) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn199.r, odriverConn199.extra, query, args)
+}
+func (odriverConn199 *tdriverConn199) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn199.r, odriverConn199.extra, ctx)
+}
+
+func (odriverConn200 *tdriverConn200) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn200.r, odriverConn200.extra)
+}
+func (odriverConn200 *tdriverConn200) Close() error {
+	return realDCClose(odriverConn200.r, odriverConn200.extra)
+}
+func (odriverConn200 *tdriverConn200) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn200.r, odriverConn200.extra, query)
+}
+func (odriverConn200 *tdriverConn200) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn200.r, odriverConn200.extra, ctx, query)
+}
+func (odriverConn200 *tdriverConn200) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn200.r, odriverConn200.extra, query, args)
+}
+func (odriverConn200 *tdriverConn200) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn200.r, odriverConn200.extra, ctx, query, args)
+}
+func (odriverConn200 *tdriverConn200) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn200.r, odriverConn200.extra, ctx)
+}
+
+func (odriverConn201 *tdriverConn201) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn201.r, odriverConn201.extra)
+}
+func (odriverConn201 *tdriverConn201) Close() error {
+	return realDCClose(odriverConn201.r, odriverConn201.extra)
+}
+func (odriverConn201 *tdriverConn201) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn201.r, odriverConn201.extra, query)
+}
+func (odriverConn201 *tdriverConn201) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn201.r, odriverConn201.extra, ctx, query)
+}
+func (odriverConn201 *tdriverConn201) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn201.r, odriverConn201.extra, ctx, query, args)
+}
+func (odriverConn201 *tdriverConn201) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn201.r, odriverConn201.extra, param0)
+}
+func (odriverConn201 *tdriverConn201) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn201.r, odriverConn201.extra, ctx)
+}
+
+func (odriverConn202 *tdriverConn202) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn202.r, odriverConn202.extra)
+}
+func (odriverConn202 *tdriverConn202) Close() error {
+	return realDCClose(odriverConn202.r, odriverConn202.extra)
+}
+func (odriverConn202 *tdriverConn202) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn202.r, odriverConn202.extra, query)
+}
+func (odriverConn202 *tdriverConn202) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn202.r, odriverConn202.extra, ctx, query)
+}
+func (odriverConn202 *tdriverConn202) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn202.r, odriverConn202.extra, ctx, query, args)
+}
+func (odriverConn202 *tdriverConn202) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn202.r, odriverConn202.extra, param0)
+}
+func (odriverConn202 *tdriverConn202) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn202.r, odriverConn202.extra, query, args)
+}
+
+func (odriverConn203 *tdriverConn203) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn203.r, odriverConn203.extra)
+}
+func (odriverConn203 *tdriverConn203) Close() error {
+	return realDCClose(odriverConn203.r, odriverConn203.extra)
+}
+func (odriverConn203 *tdriverConn203) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn203.r, odriverConn203.extra, query)
+}
+func (odriverConn203 *tdriverConn203) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn203.r, odriverConn203.extra, ctx, query)
+}
+func (odriverConn203 *tdriverConn203) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn203.r, odriverConn203.extra, ctx, query, args)
+}
+func (odriverConn203 *tdriverConn203) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn203.r, odriverConn203.extra, param0)
+}
+func (odriverConn203 *tdriverConn203) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn203.r, odriverConn203.extra, ctx, query, args)
+}
+
+func (odriverConn204 *tdriverConn204) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn204.r, odriverConn204.extra)
+}
+func (odriverConn204 *tdriverConn204) Close() error {
+	return realDCClose(odriverConn204.r, odriverConn204.extra)
+}
+func (odriverConn204 *tdriverConn204) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn204.r, odriverConn204.extra, query)
+}
+func (odriverConn204 *tdriverConn204) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn204.r, odriverConn204.extra, ctx, query)
+}
+func (odriverConn204 *tdriverConn204) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn204.r, odriverConn204.extra, ctx, query, args)
+}
+func (odriverConn204 *tdriverConn204) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn204.r, odriverConn204.extra, param0)
+}
+func (odriverConn204 *tdriverConn204) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn204.r, odriverConn204.extra, ctx)
+}
+
+func (odriverConn205 *tdriverConn205) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn205.r, odriverConn205.extra)
+}
+func (odriverConn205 *tdriverConn205) Close() error {
+	return realDCClose(odriverConn205.r, odriverConn205.extra)
+}
+func (odriverConn205 *tdriverConn205) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn205.r, odriverConn205.extra, query)
+}
+func (odriverConn205 *tdriverConn205) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn205.r, odriverConn205.extra, ctx, query)
+}
+func (odriverConn205 *tdriverConn205) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn205.r, odriverConn205.extra, ctx, query, args)
+}
+func (odriverConn205 *tdriverConn205) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn205.r, odriverConn205.extra, ctx)
+}
+func (odriverConn205 *tdriverConn205) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn205.r, odriverConn205.extra, query, args)
+}
+
+func (odriverConn206 *tdriverConn206) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn206.r, odriverConn206.extra)
+}
+func (odriverConn206 *tdriverConn206) Close() error {
+	return realDCClose(odriverConn206.r, odriverConn206.extra)
+}
+func (odriverConn206 *tdriverConn206) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn206.r, odriverConn206.extra, query)
+}
+func (odriverConn206 *tdriverConn206) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn206.r, odriverConn206.extra, ctx, query)
+}
+func (odriverConn206 *tdriverConn206) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn206.r, odriverConn206.extra, ctx, query, args)
+}
+func (odriverConn206 *tdriverConn206) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn206.r, odriverConn206.extra, ctx)
+}
+func (odriverConn206 *tdriverConn206) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn206.r, odriverConn206.extra, ctx, query, args)
+}
+
+func (odriverConn207 *tdriverConn207) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn207.r, odriverConn207.extra)
+}
+func (odriverConn207 *tdriverConn207) Close() error {
+	return realDCClose(odriverConn207.r, odriverConn207.extra)
+}
+func (odriverConn207 *tdriverConn207) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn207.r, odriverConn207.extra, query)
+}
+func (odriverConn207 *tdriverConn207) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn207.r, odriverConn207.extra, ctx, query)
+}
+func (odriverConn207 *tdriverConn207) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn207.r, odriverConn207.extra, ctx, query, args)
+}
+func (odriverConn207 *tdriverConn207) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn207.r, odriverConn207.extra, ctx)
+}
+func (odriverConn207 *tdriverConn207) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn207.r, odriverConn207.extra, ctx)
+}
+
+func (odriverConn208 *tdriverConn208) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn208.r, odriverConn208.extra)
+}
+func (odriverConn208 *tdriverConn208) Close() error {
+	return realDCClose(odriverConn208.r, odriverConn208.extra)
+}
+func (odriverConn208 *tdriverConn208) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn208.r, odriverConn208.extra, query)
+}
+func (odriverConn208 *tdriverConn208) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn208.r, odriverConn208.extra, ctx, query)
+}
+func (odriverConn208 *tdriverConn208) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn208.r, odriverConn208.extra, ctx, query, args)
+}
+func (odriverConn208 *tdriverConn208) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn208.r, odriverConn208.extra, query, args)
+}
+func (odriverConn208 *tdriverConn208) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn208.r, odriverConn208.extra, ctx, query, args)
+}
+
+func (odriverConn209 *tdriverConn209) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn209.r, odriverConn209.extra)
+}
+func (odriverConn209 *tdriverConn209) Close() error {
+	return realDCClose(odriverConn209.r, odriverConn209.extra)
+}
+func (odriverConn209 *tdriverConn209) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn209.r, odriverConn209.extra, query)
+}
+func (odriverConn209 *tdriverConn209) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn209.r, odriverConn209.extra, ctx, query)
+}
+func (odriverConn209 *tdriverConn209) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn209.r, odriverConn209.extra, ctx, query, args)
+}
+func (odriverConn209 *tdriverConn209) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn209.r, odriverConn209.extra, query, args)
+}
+func (odriverConn209 *tdriverConn209) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn209.r, odriverConn209.extra, ctx)
+}
+
+func (odriverConn210 *tdriverConn210) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn210.r, odriverConn210.extra)
+}
+func (odriverConn210 *tdriverConn210) Close() error {
+	return realDCClose(odriverConn210.r, odriverConn210.extra)
+}
+func (odriverConn210 *tdriverConn210) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn210.r, odriverConn210.extra, query)
+}
+func (odriverConn210 *tdriverConn210) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn210.r, odriverConn210.extra, ctx, query)
+}
+func (odriverConn210 *tdriverConn210) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn210.r, odriverConn210.extra, ctx, query, args)
+}
+func (odriverConn210 *tdriverConn210) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn210.r, odriverConn210.extra, ctx, query, args)
+}
+func (odriverConn210 *tdriverConn210) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn210.r, odriverConn210.extra, ctx)
+}
+
+func (odriverConn211 *tdriverConn211) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn211.r, odriverConn211.extra)
+}
+func (odriverConn211 *tdriverConn211) Close() error {
+	return realDCClose(odriverConn211.r, odriverConn211.extra)
+}
+func (odriverConn211 *tdriverConn211) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn211.r, odriverConn211.extra, query)
+}
+func (odriverConn211 *tdriverConn211) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn211.r, odriverConn211.extra, ctx, query)
+}
+func (odriverConn211 *tdriverConn211) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn211.r, odriverConn211.extra, param0)
+}
+func (odriverConn211 *tdriverConn211) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn211.r, odriverConn211.extra, ctx)
+}
+func (odriverConn211 *tdriverConn211) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn211.r, odriverConn211.extra, query, args)
+}
+
+func (odriverConn212 *tdriverConn212) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn212.r, odriverConn212.extra)
+}
+func (odriverConn212 *tdriverConn212) Close() error {
+	return realDCClose(odriverConn212.r, odriverConn212.extra)
+}
+func (odriverConn212 *tdriverConn212) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn212.r, odriverConn212.extra, query)
+}
+func (odriverConn212 *tdriverConn212) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn212.r, odriverConn212.extra, ctx, query)
+}
+func (odriverConn212 *tdriverConn212) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn212.r, odriverConn212.extra, param0)
+}
+func (odriverConn212 *tdriverConn212) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn212.r, odriverConn212.extra, ctx)
+}
+func (odriverConn212 *tdriverConn212) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn212.r, odriverConn212.extra, ctx, query, args)
+}
+
+func (odriverConn213 *tdriverConn213) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn213.r, odriverConn213.extra)
+}
+func (odriverConn213 *tdriverConn213) Close() error {
+	return realDCClose(odriverConn213.r, odriverConn213.extra)
+}
+func (odriverConn213 *tdriverConn213) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn213.r, odriverConn213.extra, query)
+}
+func (odriverConn213 *tdriverConn213) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn213.r, odriverConn213.extra, ctx, query)
+}
+func (odriverConn213 *tdriverConn213) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn213.r, odriverConn213.extra, param0)
+}
+func (odriverConn213 *tdriverConn213) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn213.r, odriverConn213.extra, ctx)
+}
+func (odriverConn213 *tdriverConn213) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn213.r, odriverConn213.extra, ctx)
+}
+
+func (odriverConn214 *tdriverConn214) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn214.r, odriverConn214.extra)
+}
+func (odriverConn214 *tdriverConn214) Close() error {
+	return realDCClose(odriverConn214.r, odriverConn214.extra)
+}
+func (odriverConn214 *tdriverConn214) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn214.r, odriverConn214.extra, query)
+}
+func (odriverConn214 *tdriverConn214) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn214.r, odriverConn214.extra, ctx, query)
+}
+func (odriverConn214 *tdriverConn214) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn214.r, odriverConn214.extra, param0)
+}
+func (odriverConn214 *tdriverConn214) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn214.r, odriverConn214.extra, query, args)
+}
+func (odriverConn214 *tdriverConn214) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn214.r, odriverConn214.extra, ctx, query, args)
+}
+
+func (odriverConn215 *tdriverConn215) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn215.r, odriverConn215.extra)
+}
+func (odriverConn215 *tdriverConn215) Close() error {
+	return realDCClose(odriverConn215.r, odriverConn215.extra)
+}
+func (odriverConn215 *tdriverConn215) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn215.r, odriverConn215.extra, query)
+}
+func (odriverConn215 *tdriverConn215) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn215.r, odriverConn215.extra, ctx, query)
+}
+func (odriverConn215 *tdriverConn215) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn215.r, odriverConn215.extra, param0)
+}
+func (odriverConn215 *tdriverConn215) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn215.r, odriverConn215.extra, query, args)
+}
+func (odriverConn215 *tdriverConn215) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn215.r, odriverConn215.extra, ctx)
+}
+
+func (odriverConn216 *tdriverConn216) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn216.r, odriverConn216.extra)
+}
+func (odriverConn216 *tdriverConn216) Close() error {
+	return realDCClose(odriverConn216.r, odriverConn216.extra)
+}
+func (odriverConn216 *tdriverConn216) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn216.r, odriverConn216.extra, query)
+}
+func (odriverConn216 *tdriverConn216) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn216.r, odriverConn216.extra, ctx, query)
+}
+func (odriverConn216 *tdriverConn216) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn216.r, odriverConn216.extra, param0)
+}
+func (odriverConn216 *tdriverConn216) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn216.r, odriverConn216.extra, ctx, query, args)
+}
+func (odriverConn216 *tdriverConn216) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn216.r, odriverConn216.extra, ctx)
+}
+
+func (odriverConn217 *tdriverConn217) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn217.r, odriverConn217.extra)
+}
+func (odriverConn217 *tdriverConn217) Close() error {
+	return realDCClose(odriverConn217.r, odriverConn217.extra)
+}
+func (odriverConn217 *tdriverConn217) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn217.r, odriverConn217.extra, query)
+}
+func (odriverConn217 *tdriverConn217) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn217.r, odriverConn217.extra, ctx, query)
+}
+func (odriverConn217 *tdriverConn217) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn217.r, odriverConn217.extra, ctx)
+}
+func (odriverConn217 *tdriverConn217) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn217.r, odriverConn217.extra, query, args)
+}
+func (odriverConn217 *tdriverConn217) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn217.r, odriverConn217.extra, ctx, query, args)
+}
+
+func (odriverConn218 *tdriverConn218) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn218.r, odriverConn218.extra)
+}
+func (odriverConn218 *tdriverConn218) Close() error {
+	return realDCClose(odriverConn218.r, odriverConn218.extra)
+}
+func (odriverConn218 *tdriverConn218) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn218.r, odriverConn218.extra, query)
+}
+func (odriverConn218 *tdriverConn218) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn218.r, odriverConn218.extra, ctx, query)
+}
+func (odriverConn218 *tdriverConn218) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn218.r, odriverConn218.extra, ctx)
+}
+func (odriverConn218 *tdriverConn218) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn218.r, odriverConn218.extra, query, args)
+}
+func (odriverConn218 *tdriverConn218) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn218.r, odriverConn218.extra, ctx)
+}
+
+func (odriverConn219 *tdriverConn219) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn219.r, odriverConn219.extra)
+}
+func (odriverConn219 *tdriverConn219) Close() error {
+	return realDCClose(odriverConn219.r, odriverConn219.extra)
+}
+func (odriverConn219 *tdriverConn219) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn219.r, odriverConn219.extra, query)
+}
+func (odriverConn219 *tdriverConn219) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn219.r, odriverConn219.extra, ctx, query)
+}
+func (odriverConn219 *tdriverConn219) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn219.r, odriverConn219.extra, ctx)
+}
+func (odriverConn219 *tdriverConn219) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn219.r, odriverConn219.extra, ctx, query, args)
+}
+func (odriverConn219 *tdriverConn219) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn219.r, odriverConn219.extra, ctx)
+}
+
+func (odriverConn220 *tdriverConn220) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn220.r, odriverConn220.extra)
+}
+func (odriverConn220 *tdriverConn220) Close() error {
+	return realDCClose(odriverConn220.r, odriverConn220.extra)
+}
+func (odriverConn220 *tdriverConn220) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn220.r, odriverConn220.extra, query)
+}
+func (odriverConn220 *tdriverConn220) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn220.r, odriverConn220.extra, ctx, query)
+}
+func (odriverConn220 *tdriverConn220) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn220.r, odriverConn220.extra, query, args)
+}
+func (odriverConn220 *tdriverConn220) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn220.r, odriverConn220.extra, ctx, query, args)
+}
+func (odriverConn220 *tdriverConn220) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn220.r, odriverConn220.extra, ctx)
+}
+
+func (odriverConn221 *tdriverConn221) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn221.r, odriverConn221.extra)
+}
+func (odriverConn221 *tdriverConn221) Close() error {
+	return realDCClose(odriverConn221.r, odriverConn221.extra)
+}
+func (odriverConn221 *tdriverConn221) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn221.r, odriverConn221.extra, query)
+}
+func (odriverConn221 *tdriverConn221) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn221.r, odriverConn221.extra, query, args)
+}
+func (odriverConn221 *tdriverConn221) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn221.r, odriverConn221.extra, ctx, query, args)
+}
+func (odriverConn221 *tdriverConn221) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn221.r, odriverConn221.extra, param0)
+}
+func (odriverConn221 *tdriverConn221) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn221.r, odriverConn221.extra, ctx)
+}
+
+func (odriverConn222 *tdriverConn222) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn222.r, odriverConn222.extra)
+}
+func (odriverConn222 *tdriverConn222) Close() error {
+	return realDCClose(odriverConn222.r, odriverConn222.extra)
+}
+func (odriverConn222 *tdriverConn222) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn222.r, odriverConn222.extra, query)
+}
+func (odriverConn222 *tdriverConn222) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn222.r, odriverConn222.extra, query, args)
+}
+func (odriverConn222 *tdriverConn222) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn222.r, odriverConn222.extra, ctx, query, args)
+}
+func (odriverConn222 *tdriverConn222) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn222.r, odriverConn222.extra, param0)
+}
+func (odriverConn222 *tdriverConn222) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn222.r, odriverConn222.extra, query, args)
+}
+
+func (odriverConn223 *tdriverConn223) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn223.r, odriverConn223.extra)
+}
+func (odriverConn223 *tdriverConn223) Close() error {
+	return realDCClose(odriverConn223.r, odriverConn223.extra)
+}
+func (odriverConn223 *tdriverConn223) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn223.r, odriverConn223.extra, query)
+}
+func (odriverConn223 *tdriverConn223) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn223.r, odriverConn223.extra, query, args)
+}
+func (odriverConn223 *tdriverConn223) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn223.r, odriverConn223.extra, ctx, query, args)
+}
+func (odriverConn223 *tdriverConn223) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn223.r, odriverConn223.extra, param0)
+}
+func (odriverConn223 *tdriverConn223) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn223.r, odriverConn223.extra, ctx, query, args)
+}
+
+func (odriverConn224 *tdriverConn224) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn224.r, odriverConn224.extra)
+}
+func (odriverConn224 *tdriverConn224) Close() error {
+	return realDCClose(odriverConn224.r, odriverConn224.extra)
+}
+func (odriverConn224 *tdriverConn224) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn224.r, odriverConn224.extra, query)
+}
+func (odriverConn224 *tdriverConn224) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn224.r, odriverConn224.extra, query, args)
+}
+func (odriverConn224 *tdriverConn224) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn224.r, odriverConn224.extra, ctx, query, args)
+}
+func (odriverConn224 *tdriverConn224) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn224.r, odriverConn224.extra, param0)
+}
+func (odriverConn224 *tdriverConn224) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn224.r, odriverConn224.extra, ctx)
+}
+
+func (odriverConn225 *tdriverConn225) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn225.r, odriverConn225.extra)
+}
+func (odriverConn225 *tdriverConn225) Close() error {
+	return realDCClose(odriverConn225.r, odriverConn225.extra)
+}
+func (odriverConn225 *tdriverConn225) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn225.r, odriverConn225.extra, query)
+}
+func (odriverConn225 *tdriverConn225) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn225.r, odriverConn225.extra, query, args)
+}
+func (odriverConn225 *tdriverConn225) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn225.r, odriverConn225.extra, ctx, query, args)
+}
+func (odriverConn225 *tdriverConn225) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn225.r, odriverConn225.extra, ctx)
+}
+func (odriverConn225 *tdriverConn225) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn225.r, odriverConn225.extra, query, args)
+}
+
+func (odriverConn226 *tdriverConn226) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn226.r, odriverConn226.extra)
+}
+func (odriverConn226 *tdriverConn226) Close() error {
+	return realDCClose(odriverConn226.r, odriverConn226.extra)
+}
+func (odriverConn226 *tdriverConn226) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn226.r, odriverConn226.extra, query)
+}
+func (odriverConn226 *tdriverConn226) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn226.r, odriverConn226.extra, query, args)
+}
+func (odriverConn226 *tdriverConn226) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn226.r, odriverConn226.extra, ctx, query, args)
+}
+func (odriverConn226 *tdriverConn226) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn226.r, odriverConn226.extra, ctx)
+}
+func (odriverConn226 *tdriverConn226) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn226.r, odriverConn226.extra, ctx, query, args)
+}
+
+func (odriverConn227 *tdriverConn227) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn227.r, odriverConn227.extra)
+}
+func (odriverConn227 *tdriverConn227) Close() error {
+	return realDCClose(odriverConn227.r, odriverConn227.extra)
+}
+func (odriverConn227 *tdriverConn227) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn227.r, odriverConn227.extra, query)
+}
+func (odriverConn227 *tdriverConn227) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn227.r, odriverConn227.extra, query, args)
+}
+func (odriverConn227 *tdriverConn227) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn227.r, odriverConn227.extra, ctx, query, args)
+}
+func (odriverConn227 *tdriverConn227) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn227.r, odriverConn227.extra, ctx)
+}
+func (odriverConn227 *tdriverConn227) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn227.r, odriverConn227.extra, ctx)
+}
+
+func (odriverConn228 *tdriverConn228) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn228.r, odriverConn228.extra)
+}
+func (odriverConn228 *tdriverConn228) Close() error {
+	return realDCClose(odriverConn228.r, odriverConn228.extra)
+}
+func (odriverConn228 *tdriverConn228) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn228.r, odriverConn228.extra, query)
+}
+func (odriverConn228 *tdriverConn228) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn228.r, odriverConn228.extra, query, args)
+}
+func (odriverConn228 *tdriverConn228) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn228.r, odriverConn228.extra, ctx, query, args)
+}
+func (odriverConn228 *tdriverConn228) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn228.r, odriverConn228.extra, query, args)
+}
+func (odriverConn228 *tdriverConn228) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn228.r, odriverConn228.extra, ctx, query, args)
+}
+
+func (odriverConn229 *tdriverConn229) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn229.r, odriverConn229.extra)
+}
+func (odriverConn229 *tdriverConn229) Close() error {
+	return realDCClose(odriverConn229.r, odriverConn229.extra)
+}
+func (odriverConn229 *tdriverConn229) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn229.r, odriverConn229.extra, query)
+}
+func (odriverConn229 *tdriverConn229) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn229.r, odriverConn229.extra, query, args)
+}
+func (odriverConn229 *tdriverConn229) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn229.r, odriverConn229.extra, ctx, query, args)
+}
+func (odriverConn229 *tdriverConn229) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn229.r, odriverConn229.extra, query, args)
+}
+func (odriverConn229 *tdriverConn229) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn229.r, odriverConn229.extra, ctx)
+}
+
+func (odriverConn230 *tdriverConn230) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn230.r, odriverConn230.extra)
+}
+func (odriverConn230 *tdriverConn230) Close() error {
+	return realDCClose(odriverConn230.r, odriverConn230.extra)
+}
+func (odriverConn230 *tdriverConn230) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn230.r, odriverConn230.extra, query)
+}
+func (odriverConn230 *tdriverConn230) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn230.r, odriverConn230.extra, query, args)
+}
+func (odriverConn230 *tdriverConn230) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn230.r, odriverConn230.extra, ctx, query, args)
+}
+func (odriverConn230 *tdriverConn230) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn230.r, odriverConn230.extra, ctx, query, args)
+}
+func (odriverConn230 *tdriverConn230) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn230.r, odriverConn230.extra, ctx)
+}
+
+func (odriverConn231 *tdriverConn231) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn231.r, odriverConn231.extra)
+}
+func (odriverConn231 *tdriverConn231) Close() error {
+	return realDCClose(odriverConn231.r, odriverConn231.extra)
+}
+func (odriverConn231 *tdriverConn231) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn231.r, odriverConn231.extra, query)
+}
+func (odriverConn231 *tdriverConn231) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn231.r, odriverConn231.extra, query, args)
+}
+func (odriverConn231 *tdriverConn231) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn231.r, odriverConn231.extra, param0)
+}
+func (odriverConn231 *tdriverConn231) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn231.r, odriverConn231.extra, ctx)
+}
+func (odriverConn231 *tdriverConn231) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn231.r, odriverConn231.extra, query, args)
+}
+
+func (odriverConn232 *tdriverConn232) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn232.r, odriverConn232.extra)
+}
+func (odriverConn232 *tdriverConn232) Close() error {
+	return realDCClose(odriverConn232.r, odriverConn232.extra)
+}
+func (odriverConn232 *tdriverConn232) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn232.r, odriverConn232.extra, query)
+}
+func (odriverConn232 *tdriverConn232) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn232.r, odriverConn232.extra, query, args)
+}
+func (odriverConn232 *tdriverConn232) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn232.r, odriverConn232.extra, param0)
+}
+func (odriverConn232 *tdriverConn232) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn232.r, odriverConn232.extra, ctx)
+}
+func (odriverConn232 *tdriverConn232) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn232.r, odriverConn232.extra, ctx, query, args)
+}
+
+func (odriverConn233 *tdriverConn233) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn233.r, odriverConn233.extra)
+}
+func (odriverConn233 *tdriverConn233) Close() error {
+	return realDCClose(odriverConn233.r, odriverConn233.extra)
+}
+func (odriverConn233 *tdriverConn233) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn233.r, odriverConn233.extra, query)
+}
+func (odriverConn233 *tdriverConn233) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn233.r, odriverConn233.extra, query, args)
+}
+func (odriverConn233 *tdriverConn233) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn233.r, odriverConn233.extra, param0)
+}
+func (odriverConn233 *tdriverConn233) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn233.r, odriverConn233.extra, ctx)
+}
+func (odriverConn233 *tdriverConn233) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn233.r, odriverConn233.extra, ctx)
+}
+
+func (odriverConn234 *tdriverConn234) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn234.r, odriverConn234.extra)
+}
+func (odriverConn234 *tdriverConn234) Close() error {
+	return realDCClose(odriverConn234.r, odriverConn234.extra)
+}
+func (odriverConn234 *tdriverConn234) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn234.r, odriverConn234.extra, query)
+}
+func (odriverConn234 *tdriverConn234) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn234.r, odriverConn234.extra, query, args)
+}
+func (odriverConn234 *tdriverConn234) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn234.r, odriverConn234.extra, param0)
+}
+func (odriverConn234 *tdriverConn234) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn234.r, odriverConn234.extra, query, args)
+}
+func (odriverConn234 *tdriverConn234) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn234.r, odriverConn234.extra, ctx, query, args)
+}
+
+func (odriverConn235 *tdriverConn235) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn235.r, odriverConn235.extra)
+}
+func (odriverConn235 *tdriverConn235) Close() error {
+	return realDCClose(odriverConn235.r, odriverConn235.extra)
+}
+func (odriverConn235 *tdriverConn235) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn235.r, odriverConn235.extra, query)
+}
+func (odriverConn235 *tdriverConn235) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn235.r, odriverConn235.extra, query, args)
+}
+func (odriverConn235 *tdriverConn235) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn235.r, odriverConn235.extra, param0)
+}
+func (odriverConn235 *tdriverConn235) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn235.r, odriverConn235.extra, query, args)
+}
+func (odriverConn235 *tdriverConn235) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn235.r, odriverConn235.extra, ctx)
+}
+
+func (odriverConn236 *tdriverConn236) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn236.r, odriverConn236.extra)
+}
+func (odriverConn236 *tdriverConn236) Close() error {
+	return realDCClose(odriverConn236.r, odriverConn236.extra)
+}
+func (odriverConn236 *tdriverConn236) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn236.r, odriverConn236.extra, query)
+}
+func (odriverConn236 *tdriverConn236) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn236.r, odriverConn236.extra, query, args)
+}
+func (odriverConn236 *tdriverConn236) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn236.r, odriverConn236.extra, param0)
+}
+func (odriverConn236 *tdriverConn236) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn236.r, odriverConn236.extra, ctx, query, args)
+}
+func (odriverConn236 *tdriverConn236) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn236.r, odriverConn236.extra, ctx)
+}
+
+func (odriverConn237 *tdriverConn237) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn237.r, odriverConn237.extra)
+}
+func (odriverConn237 *tdriverConn237) Close() error {
+	return realDCClose(odriverConn237.r, odriverConn237.extra)
+}
+func (odriverConn237 *tdriverConn237) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn237.r, odriverConn237.extra, query)
+}
+func (odriverConn237 *tdriverConn237) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn237.r, odriverConn237.extra, query, args)
+}
+func (odriverConn237 *tdriverConn237) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn237.r, odriverConn237.extra, ctx)
+}
+func (odriverConn237 *tdriverConn237) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn237.r, odriverConn237.extra, query, args)
+}
+func (odriverConn237 *tdriverConn237) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn237.r, odriverConn237.extra, ctx, query, args)
+}
+
+func (odriverConn238 *tdriverConn238) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn238.r, odriverConn238.extra)
+}
+func (odriverConn238 *tdriverConn238) Close() error {
+	return realDCClose(odriverConn238.r, odriverConn238.extra)
+}
+func (odriverConn238 *tdriverConn238) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn238.r, odriverConn238.extra, query)
+}
+func (odriverConn238 *tdriverConn238) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn238.r, odriverConn238.extra, query, args)
+}
+func (odriverConn238 *tdriverConn238) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn238.r, odriverConn238.extra, ctx)
+}
+func (odriverConn238 *tdriverConn238) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn238.r, odriverConn238.extra, query, args)
+}
+func (odriverConn238 *tdriverConn238) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn238.r, odriverConn238.extra, ctx)
+}
+
+func (odriverConn239 *tdriverConn239) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn239.r, odriverConn239.extra)
+}
+func (odriverConn239 *tdriverConn239) Close() error {
+	return realDCClose(odriverConn239.r, odriverConn239.extra)
+}
+func (odriverConn239 *tdriverConn239) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn239.r, odriverConn239.extra, query)
+}
+func (odriverConn239 *tdriverConn239) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn239.r, odriverConn239.extra, query, args)
+}
+func (odriverConn239 *tdriverConn239) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn239.r, odriverConn239.extra, ctx)
+}
+func (odriverConn239 *tdriverConn239) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn239.r, odriverConn239.extra, ctx, query, args)
+}
+func (odriverConn239 *tdriverConn239) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn239.r, odriverConn239.extra, ctx)
+}
+
+func (odriverConn240 *tdriverConn240) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn240.r, odriverConn240.extra)
+}
+func (odriverConn240 *tdriverConn240) Close() error {
+	return realDCClose(odriverConn240.r, odriverConn240.extra)
+}
+func (odriverConn240 *tdriverConn240) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn240.r, odriverConn240.extra, query)
+}
+func (odriverConn240 *tdriverConn240) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn240.r, odriverConn240.extra, query, args)
+}
+func (odriverConn240 *tdriverConn240) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn240.r, odriverConn240.extra, query, args)
+}
+func (odriverConn240 *tdriverConn240) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn240.r, odriverConn240.extra, ctx, query, args)
+}
+func (odriverConn240 *tdriverConn240) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn240.r, odriverConn240.extra, ctx)
+}
+
+func (odriverConn241 *tdriverConn241) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn241.r, odriverConn241.extra)
+}
+func (odriverConn241 *tdriverConn241) Close() error {
+	return realDCClose(odriverConn241.r, odriverConn241.extra)
+}
+func (odriverConn241 *tdriverConn241) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn241.r, odriverConn241.extra, query)
+}
+func (odriverConn241 *tdriverConn241) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn241.r, odriverConn241.extra, ctx, query, args)
+}
+func (odriverConn241 *tdriverConn241) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn241.r, odriverConn241.extra, param0)
+}
+func (odriverConn241 *tdriverConn241) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn241.r, odriverConn241.extra, ctx)
+}
+func (odriverConn241 *tdriverConn241) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn241.r, odriverConn241.extra, query, args)
+}
+
+func (odriverConn242 *tdriverConn242) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn242.r, odriverConn242.extra)
+}
+func (odriverConn242 *tdriverConn242) Close() error {
+	return realDCClose(odriverConn242.r, odriverConn242.extra)
+}
+func (odriverConn242 *tdriverConn242) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn242.r, odriverConn242.extra, query)
+}
+func (odriverConn242 *tdriverConn242) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn242.r, odriverConn242.extra, ctx, query, args)
+}
+func (odriverConn242 *tdriverConn242) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn242.r, odriverConn242.extra, param0)
+}
+func (odriverConn242 *tdriverConn242) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn242.r, odriverConn242.extra, ctx)
+}
+func (odriverConn242 *tdriverConn242) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn242.r, odriverConn242.extra, ctx, query, args)
+}
+
+func (odriverConn243 *tdriverConn243) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn243.r, odriverConn243.extra)
+}
+func (odriverConn243 *tdriverConn243) Close() error {
+	return realDCClose(odriverConn243.r, odriverConn243.extra)
+}
+func (odriverConn243 *tdriverConn243) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn243.r, odriverConn243.extra, query)
+}
+func (odriverConn243 *tdriverConn243) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn243.r, odriverConn243.extra, ctx, query, args)
+}
+func (odriverConn243 *tdriverConn243) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn243.r, odriverConn243.extra, param0)
+}
+func (odriverConn243 *tdriverConn243) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn243.r, odriverConn243.extra, ctx)
+}
+func (odriverConn243 *tdriverConn243) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn243.r, odriverConn243.extra, ctx)
+}
+
+func (odriverConn244 *tdriverConn244) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn244.r, odriverConn244.extra)
+}
+func (odriverConn244 *tdriverConn244) Close() error {
+	return realDCClose(odriverConn244.r, odriverConn244.extra)
+}
+func (odriverConn244 *tdriverConn244) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn244.r, odriverConn244.extra, query)
+}
+func (odriverConn244 *tdriverConn244) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn244.r, odriverConn244.extra, ctx, query, args)
+}
+func (odriverConn244 *tdriverConn244) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn244.r, odriverConn244.extra, param0)
+}
+func (odriverConn244 *tdriverConn244) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn244.r, odriverConn244.extra, query, args)
+}
+func (odriverConn244 *tdriverConn244) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn244.r, odriverConn244.extra, ctx, query, args)
+}
+
+func (odriverConn245 *tdriverConn245) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn245.r, odriverConn245.extra)
+}
+func (odriverConn245 *tdriverConn245) Close() error {
+	return realDCClose(odriverConn245.r, odriverConn245.extra)
+}
+func (odriverConn245 *tdriverConn245) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn245.r, odriverConn245.extra, query)
+}
+func (odriverConn245 *tdriverConn245) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn245.r, odriverConn245.extra, ctx, query, args)
+}
+func (odriverConn245 *tdriverConn245) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn245.r, odriverConn245.extra, param0)
+}
+func (odriverConn245 *tdriverConn245) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn245.r, odriverConn245.extra, query, args)
+}
+func (odriverConn245 *tdriverConn245) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn245.r, odriverConn245.extra, ctx)
+}
+
+func (odriverConn246 *tdriverConn246) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn246.r, odriverConn246.extra)
+}
+func (odriverConn246 *tdriverConn246) Close() error {
+	return realDCClose(odriverConn246.r, odriverConn246.extra)
+}
+func (odriverConn246 *tdriverConn246) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn246.r, odriverConn246.extra, query)
+}
+func (odriverConn246 *tdriverConn246) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn246.r, odriverConn246.extra, ctx, query, args)
+}
+func (odriverConn246 *tdriverConn246) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn246.r, odriverConn246.extra, param0)
+}
+func (odriverConn246 *tdriverConn246) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn246.r, odriverConn246.extra, ctx, query, args)
+}
+func (odriverConn246 *tdriverConn246) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn246.r, odriverConn246.extra, ctx)
+}
+
+func (odriverConn247 *tdriverConn247) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn247.r, odriverConn247.extra)
+}
+func (odriverConn247 *tdriverConn247) Close() error {
+	return realDCClose(odriverConn247.r, odriverConn247.extra)
+}
+func (odriverConn247 *tdriverConn247) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn247.r, odriverConn247.extra, query)
+}
+func (odriverConn247 *tdriverConn247) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn247.r, odriverConn247.extra, ctx, query, args)
+}
+func (odriverConn247 *tdriverConn247) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn247.r, odriverConn247.extra, ctx)
+}
+func (odriverConn247 *tdriverConn247) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn247.r, odriverConn247.extra, query, args)
+}
+func (odriverConn247 *tdriverConn247) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn247.r, odriverConn247.extra, ctx, query, args)
+}
+
+func (odriverConn248 *tdriverConn248) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn248.r, odriverConn248.extra)
+}
+func (odriverConn248 *tdriverConn248) Close() error {
+	return realDCClose(odriverConn248.r, odriverConn248.extra)
+}
+func (odriverConn248 *tdriverConn248) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn248.r, odriverConn248.extra, query)
+}
+func (odriverConn248 *tdriverConn248) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn248.r, odriverConn248.extra, ctx, query, args)
+}
+func (odriverConn248 *tdriverConn248) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn248.r, odriverConn248.extra, ctx)
+}
+func (odriverConn248 *tdriverConn248) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn248.r, odriverConn248.extra, query, args)
+}
+func (odriverConn248 *tdriverConn248) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn248.r, odriverConn248.extra, ctx)
+}
+
+func (odriverConn249 *tdriverConn249) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn249.r, odriverConn249.extra)
+}
+func (odriverConn249 *tdriverConn249) Close() error {
+	return realDCClose(odriverConn249.r, odriverConn249.extra)
+}
+func (odriverConn249 *tdriverConn249) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn249.r, odriverConn249.extra, query)
+}
+func (odriverConn249 *tdriverConn249) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn249.r, odriverConn249.extra, ctx, query, args)
+}
+func (odriverConn249 *tdriverConn249) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn249.r, odriverConn249.extra, ctx)
+}
+func (odriverConn249 *tdriverConn249) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn249.r, odriverConn249.extra, ctx, query, args)
+}
+func (odriverConn249 *tdriverConn249) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn249.r, odriverConn249.extra, ctx)
+}
+
+func (odriverConn250 *tdriverConn250) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn250.r, odriverConn250.extra)
+}
+func (odriverConn250 *tdriverConn250) Close() error {
+	return realDCClose(odriverConn250.r, odriverConn250.extra)
+}
+func (odriverConn250 *tdriverConn250) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn250.r, odriverConn250.extra, query)
+}
+func (odriverConn250 *tdriverConn250) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn250.r, odriverConn250.extra, ctx, query, args)
+}
+func (odriverConn250 *tdriverConn250) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn250.r, odriverConn250.extra, query, args)
+}
+func (odriverConn250 *tdriverConn250) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn250.r, odriverConn250.extra, ctx, query, args)
+}
+func (odriverConn250 *tdriverConn250) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn250.r, odriverConn250.extra, ctx)
+}
+
+func (odriverConn251 *tdriverConn251) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn251.r, odriverConn251.extra)
+}
+func (odriverConn251 *tdriverConn251) Close() error {
+	return realDCClose(odriverConn251.r, odriverConn251.extra)
+}
+func (odriverConn251 *tdriverConn251) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn251.r, odriverConn251.extra, query)
+}
+func (odriverConn251 *tdriverConn251) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn251.r, odriverConn251.extra, param0)
+}
+func (odriverConn251 *tdriverConn251) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn251.r, odriverConn251.extra, ctx)
+}
+func (odriverConn251 *tdriverConn251) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn251.r, odriverConn251.extra, query, args)
+}
+func (odriverConn251 *tdriverConn251) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn251.r, odriverConn251.extra, ctx, query, args)
+}
+
+func (odriverConn252 *tdriverConn252) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn252.r, odriverConn252.extra)
+}
+func (odriverConn252 *tdriverConn252) Close() error {
+	return realDCClose(odriverConn252.r, odriverConn252.extra)
+}
+func (odriverConn252 *tdriverConn252) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn252.r, odriverConn252.extra, query)
+}
+func (odriverConn252 *tdriverConn252) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn252.r, odriverConn252.extra, param0)
+}
+func (odriverConn252 *tdriverConn252) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn252.r, odriverConn252.extra, ctx)
+}
+func (odriverConn252 *tdriverConn252) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn252.r, odriverConn252.extra, query, args)
+}
+func (odriverConn252 *tdriverConn252) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn252.r, odriverConn252.extra, ctx)
+}
+
+func (odriverConn253 *tdriverConn253) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn253.r, odriverConn253.extra)
+}
+func (odriverConn253 *tdriverConn253) Close() error {
+	return realDCClose(odriverConn253.r, odriverConn253.extra)
+}
+func (odriverConn253 *tdriverConn253) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn253.r, odriverConn253.extra, query)
+}
+func (odriverConn253 *tdriverConn253) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn253.r, odriverConn253.extra, param0)
+}
+func (odriverConn253 *tdriverConn253) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn253.r, odriverConn253.extra, ctx)
+}
+func (odriverConn253 *tdriverConn253) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn253.r, odriverConn253.extra, ctx, query, args)
+}
+func (odriverConn253 *tdriverConn253) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn253.r, odriverConn253.extra, ctx)
+}
+
+func (odriverConn254 *tdriverConn254) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn254.r, odriverConn254.extra)
+}
+func (odriverConn254 *tdriverConn254) Close() error {
+	return realDCClose(odriverConn254.r, odriverConn254.extra)
+}
+func (odriverConn254 *tdriverConn254) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn254.r, odriverConn254.extra, query)
+}
+func (odriverConn254 *tdriverConn254) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn254.r, odriverConn254.extra, param0)
+}
+func (odriverConn254 *tdriverConn254) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn254.r, odriverConn254.extra, query, args)
+}
+func (odriverConn254 *tdriverConn254) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn254.r, odriverConn254.extra, ctx, query, args)
+}
+func (odriverConn254 *tdriverConn254) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn254.r, odriverConn254.extra, ctx)
+}
+
+func (odriverConn255 *tdriverConn255) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn255.r, odriverConn255.extra)
+}
+func (odriverConn255 *tdriverConn255) Close() error {
+	return realDCClose(odriverConn255.r, odriverConn255.extra)
+}
+func (odriverConn255 *tdriverConn255) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn255.r, odriverConn255.extra, query)
+}
+func (odriverConn255 *tdriverConn255) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn255.r, odriverConn255.extra, ctx)
+}
+func (odriverConn255 *tdriverConn255) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn255.r, odriverConn255.extra, query, args)
+}
+func (odriverConn255 *tdriverConn255) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn255.r, odriverConn255.extra, ctx, query, args)
+}
+func (odriverConn255 *tdriverConn255) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn255.r, odriverConn255.extra, ctx)
+}
+
+func (odriverConn256 *tdriverConn256) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn256.r, odriverConn256.extra)
+}
+func (odriverConn256 *tdriverConn256) Close() error {
+	return realDCClose(odriverConn256.r, odriverConn256.extra)
+}
+func (odriverConn256 *tdriverConn256) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn256.r, odriverConn256.extra, query)
+}
+func (odriverConn256 *tdriverConn256) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn256.r, odriverConn256.extra, ctx, opts)
+}
+func (odriverConn256 *tdriverConn256) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn256.r, odriverConn256.extra, ctx, query)
+}
+func (odriverConn256 *tdriverConn256) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn256.r, odriverConn256.extra, query, args)
+}
+func (odriverConn256 *tdriverConn256) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn256.r, odriverConn256.extra, ctx, query, args)
+}
+func (odriverConn256 *tdriverConn256) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn256.r, odriverConn256.extra, param0)
+}
+
+func (odriverConn257 *tdriverConn257) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn257.r, odriverConn257.extra)
+}
+func (odriverConn257 *tdriverConn257) Close() error {
+	return realDCClose(odriverConn257.r, odriverConn257.extra)
+}
+func (odriverConn257 *tdriverConn257) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn257.r, odriverConn257.extra, query)
+}
+func (odriverConn257 *tdriverConn257) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn257.r, odriverConn257.extra, ctx, opts)
+}
+func (odriverConn257 *tdriverConn257) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn257.r, odriverConn257.extra, ctx, query)
+}
+func (odriverConn257 *tdriverConn257) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn257.r, odriverConn257.extra, query, args)
+}
+func (odriverConn257 *tdriverConn257) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn257.r, odriverConn257.extra, ctx, query, args)
+}
+func (odriverConn257 *tdriverConn257) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn257.r, odriverConn257.extra, ctx)
+}
+
+func (odriverConn258 *tdriverConn258) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn258.r, odriverConn258.extra)
+}
+func (odriverConn258 *tdriverConn258) Close() error {
+	return realDCClose(odriverConn258.r, odriverConn258.extra)
+}
+func (odriverConn258 *tdriverConn258) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn258.r, odriverConn258.extra, query)
+}
+func (odriverConn258 *tdriverConn258) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn258.r, odriverConn258.extra, ctx, opts)
+}
+func (odriverConn258 *tdriverConn258) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn258.r, odriverConn258.extra, ctx, query)
+}
+func (odriverConn258 *tdriverConn258) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn258.r, odriverConn258.extra, query, args)
+}
+func (odriverConn258 *tdriverConn258) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn258.r, odriverConn258.extra, ctx, query, args)
+}
+func (odriverConn258 *tdriverConn258) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn258.r, odriverConn258.extra, query, args)
+}
+
+func (odriverConn259 *tdriverConn259) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn259.r, odriverConn259.extra)
+}
+func (odriverConn259 *tdriverConn259) Close() error {
+	return realDCClose(odriverConn259.r, odriverConn259.extra)
+}
+func (odriverConn259 *tdriverConn259) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn259.r, odriverConn259.extra, query)
+}
+func (odriverConn259 *tdriverConn259) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn259.r, odriverConn259.extra, ctx, opts)
+}
+func (odriverConn259 *tdriverConn259) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn259.r, odriverConn259.extra, ctx, query)
+}
+func (odriverConn259 *tdriverConn259) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn259.r, odriverConn259.extra, query, args)
+}
+func (odriverConn259 *tdriverConn259) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn259.r, odriverConn259.extra, ctx, query, args)
+}
+func (odriverConn259 *tdriverConn259) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn259.r, odriverConn259.extra, ctx, query, args)
+}
+
+func (odriverConn260 *tdriverConn260) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn260.r, odriverConn260.extra)
+}
+func (odriverConn260 *tdriverConn260) Close() error {
+	return realDCClose(odriverConn260.r, odriverConn260.extra)
+}
+func (odriverConn260 *tdriverConn260) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn260.r, odriverConn260.extra, query)
+}
+func (odriverConn260 *tdriverConn260) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn260.r, odriverConn260.extra, ctx, opts)
+}
+func (odriverConn260 *tdriverConn260) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn260.r, odriverConn260.extra, ctx, query)
+}
+func (odriverConn260 *tdriverConn260) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn260.r, odriverConn260.extra, query, args)
+}
+func (odriverConn260 *tdriverConn260) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn260.r, odriverConn260.extra, ctx, query, args)
+}
+func (odriverConn260 *tdriverConn260) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn260.r, odriverConn260.extra, ctx)
+}
+
+func (odriverConn261 *tdriverConn261) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn261.r, odriverConn261.extra)
+}
+func (odriverConn261 *tdriverConn261) Close() error {
+	return realDCClose(odriverConn261.r, odriverConn261.extra)
+}
+func (odriverConn261 *tdriverConn261) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn261.r, odriverConn261.extra, query)
+}
+func (odriverConn261 *tdriverConn261) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn261.r, odriverConn261.extra, ctx, opts)
+}
+func (odriverConn261 *tdriverConn261) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn261.r, odriverConn261.extra, ctx, query)
+}
+func (odriverConn261 *tdriverConn261) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn261.r, odriverConn261.extra, query, args)
+}
+func (odriverConn261 *tdriverConn261) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn261.r, odriverConn261.extra, param0)
+}
+func (odriverConn261 *tdriverConn261) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn261.r, odriverConn261.extra, ctx)
+}
+
+func (odriverConn262 *tdriverConn262) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn262.r, odriverConn262.extra)
+}
+func (odriverConn262 *tdriverConn262) Close() error {
+	return realDCClose(odriverConn262.r, odriverConn262.extra)
+}
+func (odriverConn262 *tdriverConn262) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn262.r, odriverConn262.extra, query)
+}
+func (odriverConn262 *tdriverConn262) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn262.r, odriverConn262.extra, ctx, opts)
+}
+func (odriverConn262 *tdriverConn262) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn262.r, odriverConn262.extra, ctx, query)
+}
+func (odriverConn262 *tdriverConn262) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn262.r, odriverConn262.extra, query, args)
+}
+func (odriverConn262 *tdriverConn262) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn262.r, odriverConn262.extra, param0)
+}
+func (odriverConn262 *tdriverConn262) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn262.r, odriverConn262.extra, query, args)
+}
+
+func (odriverConn263 *tdriverConn263) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn263.r, odriverConn263.extra)
+}
+func (odriverConn263 *tdriverConn263) Close() error {
+	return realDCClose(odriverConn263.r, odriverConn263.extra)
+}
+func (odriverConn263 *tdriverConn263) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn263.r, odriverConn263.extra, query)
+}
+func (odriverConn263 *tdriverConn263) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn263.r, odriverConn263.extra, ctx, opts)
+}
+func (odriverConn263 *tdriverConn263) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn263.r, odriverConn263.extra, ctx, query)
+}
+func (odriverConn263 *tdriverConn263) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn263.r, odriverConn263.extra, query, args)
+}
+func (odriverConn263 *tdriverConn263) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn263.r, odriverConn263.extra, param0)
+}
+func (odriverConn263 *tdriverConn263) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn263.r, odriverConn263.extra, ctx, query, args)
+}
+
+func (odriverConn264 *tdriverConn264) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn264.r, odriverConn264.extra)
+}
+func (odriverConn264 *tdriverConn264) Close() error {
+	return realDCClose(odriverConn264.r, odriverConn264.extra)
+}
+func (odriverConn264 *tdriverConn264) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn264.r, odriverConn264.extra, query)
+}
+func (odriverConn264 *tdriverConn264) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn264.r, odriverConn264.extra, ctx, opts)
+}
+func (odriverConn264 *tdriverConn264) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn264.r, odriverConn264.extra, ctx, query)
+}
+func (odriverConn264 *tdriverConn264) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn264.r, odriverConn264.extra, query, args)
+}
+func (odriverConn264 *tdriverConn264) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn264.r, odriverConn264.extra, param0)
+}
+func (odriverConn264 *tdriverConn264) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn264.r, odriverConn264.extra, ctx)
+}
+
+func (odriverConn265 *tdriverConn265) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn265.r, odriverConn265.extra)
+}
+func (odriverConn265 *tdriverConn265) Close() error {
+	return realDCClose(odriverConn265.r, odriverConn265.extra)
+}
+func (odriverConn265 *tdriverConn265) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn265.r, odriverConn265.extra, query)
+}
+func (odriverConn265 *tdriverConn265) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn265.r, odriverConn265.extra, ctx, opts)
+}
+func (odriverConn265 *tdriverConn265) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn265.r, odriverConn265.extra, ctx, query)
+}
+func (odriverConn265 *tdriverConn265) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn265.r, odriverConn265.extra, query, args)
+}
+func (odriverConn265 *tdriverConn265) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn265.r, odriverConn265.extra, ctx)
+}
+func (odriverConn265 *tdriverConn265) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn265.r, odriverConn265.extra, query, args)
+}
+
+func (odriverConn266 *tdriverConn266) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn266.r, odriverConn266.extra)
+}
+func (odriverConn266 *tdriverConn266) Close() error {
+	return realDCClose(odriverConn266.r, odriverConn266.extra)
+}
+func (odriverConn266 *tdriverConn266) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn266.r, odriverConn266.extra, query)
+}
+func (odriverConn266 *tdriverConn266) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn266.r, odriverConn266.extra, ctx, opts)
+}
+func (odriverConn266 *tdriverConn266) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn266.r, odriverConn266.extra, ctx, query)
+}
+func (odriverConn266 *tdriverConn266) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn266.r, odriverConn266.extra, query, args)
+}
+func (odriverConn266 *tdriverConn266) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn266.r, odriverConn266.extra, ctx)
+}
+func (odriverConn266 *tdriverConn266) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn266.r, odriverConn266.extra, ctx, query, args)
+}
+
+func (odriverConn267 *tdriverConn267) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn267.r, odriverConn267.extra)
+}
+func (odriverConn267 *tdriverConn267) Close() error {
+	return realDCClose(odriverConn267.r, odriverConn267.extra)
+}
+func (odriverConn267 *tdriverConn267) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn267.r, odriverConn267.extra, query)
+}
+func (odriverConn267 *tdriverConn267) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn267.r, odriverConn267.extra, ctx, opts)
+}
+func (odriverConn267 *tdriverConn267) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn267.r, odriverConn267.extra, ctx, query)
+}
+func (odriverConn267 *tdriverConn267) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn267.r, odriverConn267.extra, query, args)
+}
+func (odriverConn267 *tdriverConn267) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn267.r, odriverConn267.extra, ctx)
+}
+func (odriverConn267 *tdriverConn267) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn267.r, odriverConn267.extra, ctx)
+}
+
+func (odriverConn268 *tdriverConn268) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn268.r, odriverConn268.extra)
+}
+func (odriverConn268 *tdriverConn268) Close() error {
+	return realDCClose(odriverConn268.r, odriverConn268.extra)
+}
+func (odriverConn268 *tdriverConn268) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn268.r, odriverConn268.extra, query)
+}
+func (odriverConn268 *tdriverConn268) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn268.r, odriverConn268.extra, ctx, opts)
+}
+func (odriverConn268 *tdriverConn268) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn268.r, odriverConn268.extra, ctx, query)
+}
+func (odriverConn268 *tdriverConn268) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn268.r, odriverConn268.extra, query, args)
+}
+func (odriverConn268 *tdriverConn268) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn268.r, odriverConn268.extra, query, args)
+}
+func (odriverConn268 *tdriverConn268) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn268.r, odriverConn268.extra, ctx, query, args)
+}
+
+func (odriverConn269 *tdriverConn269) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn269.r, odriverConn269.extra)
+}
+func (odriverConn269 *tdriverConn269) Close() error {
+	return realDCClose(odriverConn269.r, odriverConn269.extra)
+}
+func (odriverConn269 *tdriverConn269) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn269.r, odriverConn269.extra, query)
+}
+func (odriverConn269 *tdriverConn269) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn269.r, odriverConn269.extra, ctx, opts)
+}
+func (odriverConn269 *tdriverConn269) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn269.r, odriverConn269.extra, ctx, query)
+}
+func (odriverConn269 *tdriverConn269) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn269.r, odriverConn269.extra, query, args)
+}
+func (odriverConn269 *tdriverConn269) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn269.r, odriverConn269.extra, query, args)
+}
+func (odriverConn269 *tdriverConn269) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn269.r, odriverConn269.extra, ctx)
+}
+
+func (odriverConn270 *tdriverConn270) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn270.r, odriverConn270.extra)
+}
+func (odriverConn270 *tdriverConn270) Close() error {
+	return realDCClose(odriverConn270.r, odriverConn270.extra)
+}
+func (odriverConn270 *tdriverConn270) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn270.r, odriverConn270.extra, query)
+}
+func (odriverConn270 *tdriverConn270) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn270.r, odriverConn270.extra, ctx, opts)
+}
+func (odriverConn270 *tdriverConn270) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn270.r, odriverConn270.extra, ctx, query)
+}
+func (odriverConn270 *tdriverConn270) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn270.r, odriverConn270.extra, query, args)
+}
+func (odriverConn270 *tdriverConn270) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn270.r, odriverConn270.extra, ctx, query, args)
+}
+func (odriverConn270 *tdriverConn270) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn270.r, odriverConn270.extra, ctx)
+}
+
+func (odriverConn271 *tdriverConn271) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn271.r, odriverConn271.extra)
+}
+func (odriverConn271 *tdriverConn271) Close() error {
+	return realDCClose(odriverConn271.r, odriverConn271.extra)
+}
+func (odriverConn271 *tdriverConn271) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn271.r, odriverConn271.extra, query)
+}
+func (odriverConn271 *tdriverConn271) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn271.r, odriverConn271.extra, ctx, opts)
+}
+func (odriverConn271 *tdriverConn271) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn271.r, odriverConn271.extra, ctx, query)
+}
+func (odriverConn271 *tdriverConn271) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn271.r, odriverConn271.extra, ctx, query, args)
+}
+func (odriverConn271 *tdriverConn271) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn271.r, odriverConn271.extra, param0)
+}
+func (odriverConn271 *tdriverConn271) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn271.r, odriverConn271.extra, ctx)
+}
+
+func (odriverConn272 *tdriverConn272) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn272.r, odriverConn272.extra)
+}
+func (odriverConn272 *tdriverConn272) Close() error {
+	return realDCClose(odriverConn272.r, odriverConn272.extra)
+}
+func (odriverConn272 *tdriverConn272) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn272.r, odriverConn272.extra, query)
+}
+func (odriverConn272 *tdriverConn272) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn272.r, odriverConn272.extra, ctx, opts)
+}
+func (odriverConn272 *tdriverConn272) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn272.r, odriverConn272.extra, ctx, query)
+}
+func (odriverConn272 *tdriverConn272) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn272.r, odriverConn272.extra, ctx, query, args)
+}
+func (odriverConn272 *tdriverConn272) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn272.r, odriverConn272.extra, param0)
+}
+func (odriverConn272 *tdriverConn272) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn272.r, odriverConn272.extra, query, args)
+}
+
+func (odriverConn273 *tdriverConn273) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn273.r, odriverConn273.extra)
+}
+func (odriverConn273 *tdriverConn273) Close() error {
+	return realDCClose(odriverConn273.r, odriverConn273.extra)
+}
+func (odriverConn273 *tdriverConn273) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn273.r, odriverConn273.extra, query)
+}
+func (odriverConn273 *tdriverConn273) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn273.r, odriverConn273.extra, ctx, opts)
+}
+func (odriverConn273 *tdriverConn273) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn273.r, odriverConn273.extra, ctx, query)
+}
+func (odriverConn273 *tdriverConn273) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn273.r, odriverConn273.extra, ctx, query, args)
+}
+func (odriverConn273 *tdriverConn273) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn273.r, odriverConn273.extra, param0)
+}
+func (odriverConn273 *tdriverConn273) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn273.r, odriverConn273.extra, ctx, query, args)
+}
+
+func (odriverConn274 *tdriverConn274) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn274.r, odriverConn274.extra)
+}
+func (odriverConn274 *tdriverConn274) Close() error {
+	return realDCClose(odriverConn274.r, odriverConn274.extra)
+}
+func (odriverConn274 *tdriverConn274) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn274.r, odriverConn274.extra, query)
+}
+func (odriverConn274 *tdriverConn274) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn274.r, odriverConn274.extra, ctx, opts)
+}
+func (odriverConn274 *tdriverConn274) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn274.r, odriverConn274.extra, ctx, query)
+}
+func (odriverConn274 *tdriverConn274) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn274.r, odriverConn274.extra, ctx, query, args)
+}
+func (odriverConn274 *tdriverConn274) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn274.r, odriverConn274.extra, param0)
+}
+func (odriverConn274 *tdriverConn274) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn274.r, odriverConn274.extra, ctx)
+}
+
+func (odriverConn275 *tdriverConn275) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn275.r, odriverConn275.extra)
+}
+func (odriverConn275 *tdriverConn275) Close() error {
+	return realDCClose(odriverConn275.r, odriverConn275.extra)
+}
+func (odriverConn275 *tdriverConn275) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn275.r, odriverConn275.extra, query)
+}
+func (odriverConn275 *tdriverConn275) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn275.r, odriverConn275.extra, ctx, opts)
+}
+func (odriverConn275 *tdriverConn275) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn275.r, odriverConn275.extra, ctx, query)
+}
+func (odriverConn275 *tdriverConn275) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn275.r, odriverConn275.extra, ctx, query, args)
+}
+func (odriverConn275 *tdriverConn275) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn275.r, odriverConn275.extra, ctx)
+}
+func (odriverConn275 *tdriverConn275) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn275.r, odriverConn275.extra, query, args)
+}
+
+func (odriverConn276 *tdriverConn276) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn276.r, odriverConn276.extra)
+}
+func (odriverConn276 *tdriverConn276) Close() error {
+	return realDCClose(odriverConn276.r, odriverConn276.extra)
+}
+func (odriverConn276 *tdriverConn276) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn276.r, odriverConn276.extra, query)
+}
+func (odriverConn276 *tdriverConn276) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn276.r, odriverConn276.extra, ctx, opts)
+}
+func (odriverConn276 *tdriverConn276) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn276.r, odriverConn276.extra, ctx, query)
+}
+func (odriverConn276 *tdriverConn276) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn276.r, odriverConn276.extra, ctx, query, args)
+}
+func (odriverConn276 *tdriverConn276) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn276.r, odriverConn276.extra, ctx)
+}
+func (odriverConn276 *tdriverConn276) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn276.r, odriverConn276.extra, ctx, query, args)
+}
+
+func (odriverConn277 *tdriverConn277) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn277.r, odriverConn277.extra)
+}
+func (odriverConn277 *tdriverConn277) Close() error {
+	return realDCClose(odriverConn277.r, odriverConn277.extra)
+}
+func (odriverConn277 *tdriverConn277) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn277.r, odriverConn277.extra, query)
+}
+func (odriverConn277 *tdriverConn277) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn277.r, odriverConn277.extra, ctx, opts)
+}
+func (odriverConn277 *tdriverConn277) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn277.r, odriverConn277.extra, ctx, query)
+}
+func (odriverConn277 *tdriverConn277) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn277.r, odriverConn277.extra, ctx, query, args)
+}
+func (odriverConn277 *tdriverConn277) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn277.r, odriverConn277.extra, ctx)
+}
+func (odriverConn277 *tdriverConn277) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn277.r, odriverConn277.extra, ctx)
+}
+
+func (odriverConn278 *tdriverConn278) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn278.r, odriverConn278.extra)
+}
+func (odriverConn278 *tdriverConn278) Close() error {
+	return realDCClose(odriverConn278.r, odriverConn278.extra)
+}
+func (odriverConn278 *tdriverConn278) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn278.r, odriverConn278.extra, query)
+}
+func (odriverConn278 *tdriverConn278) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn278.r, odriverConn278.extra, ctx, opts)
+}
+func (odriverConn278 *tdriverConn278) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn278.r, odriverConn278.extra, ctx, query)
+}
+func (odriverConn278 *tdriverConn278) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn278.r, odriverConn278.extra, ctx, query, args)
+}
+func (odriverConn278 *tdriverConn278) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn278.r, odriverConn278.extra, query, args)
+}
+func (odriverConn278 *tdriverConn278) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn278.r, odriverConn278.extra, ctx, query, args)
+}
+
+func (odriverConn279 *tdriverConn279) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn279.r, odriverConn279.extra)
+}
+func (odriverConn279 *tdriverConn279) Close() error {
+	return realDCClose(odriverConn279.r, odriverConn279.extra)
+}
+func (odriverConn279 *tdriverConn279) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn279.r, odriverConn279.extra, query)
+}
+func (odriverConn279 *tdriverConn279) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn279.r, odriverConn279.extra, ctx, opts)
+}
+func (odriverConn279 *tdriverConn279) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn279.r, odriverConn279.extra, ctx, query)
+}
+func (odriverConn279 *tdriverConn279) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn279.r, odriverConn279.extra, ctx, query, args)
+}
+func (odriverConn279 *tdriverConn279) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn279.r, odriverConn279.extra, query, args)
+}
+func (odriverConn279 *tdriverConn279) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn279.r, odriverConn279.extra, ctx)
+}
+
+func (odriverConn280 *tdriverConn280) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn280.r, odriverConn280.extra)
+}
+func (odriverConn280 *tdriverConn280) Close() error {
+	return realDCClose(odriverConn280.r, odriverConn280.extra)
+}
+func (odriverConn280 *tdriverConn280) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn280.r, odriverConn280.extra, query)
+}
+func (odriverConn280 *tdriverConn280) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn280.r, odriverConn280.extra, ctx, opts)
+}
+func (odriverConn280 *tdriverConn280) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn280.r, odriverConn280.extra, ctx, query)
+}
+func (odriverConn280 *tdriverConn280) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn280.r, odriverConn280.extra, ctx, query, args)
+}
+func (odriverConn280 *tdriverConn280) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn280.r, odriverConn280.extra, ctx, query, args)
+}
+func (odriverConn280 *tdriverConn280) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn280.r, odriverConn280.extra, ctx)
+}
+
+func (odriverConn281 *tdriverConn281) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn281.r, odriverConn281.extra)
+}
+func (odriverConn281 *tdriverConn281) Close() error {
+	return realDCClose(odriverConn281.r, odriverConn281.extra)
+}
+func (odriverConn281 *tdriverConn281) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn281.r, odriverConn281.extra, query)
+}
+func (odriverConn281 *tdriverConn281) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn281.r, odriverConn281.extra, ctx, opts)
+}
+func (odriverConn281 *tdriverConn281) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn281.r, odriverConn281.extra, ctx, query)
+}
+func (odriverConn281 *tdriverConn281) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn281.r, odriverConn281.extra, param0)
+}
+func (odriverConn281 *tdriverConn281) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn281.r, odriverConn281.extra, ctx)
+}
+func (odriverConn281 *tdriverConn281) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn281.r, odriverConn281.extra, query, args)
+}
+
+func (odriverConn282 *tdriverConn282) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn282.r, odriverConn282.extra)
+}
+func (odriverConn282 *tdriverConn282) Close() error {
+	return realDCClose(odriverConn282.r, odriverConn282.extra)
+}
+func (odriverConn282 *tdriverConn282) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn282.r, odriverConn282.extra, query)
+}
+func (odriverConn282 *tdriverConn282) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn282.r, odriverConn282.extra, ctx, opts)
+}
+func (odriverConn282 *tdriverConn282) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn282.r, odriverConn282.extra, ctx, query)
+}
+func (odriverConn282 *tdriverConn282) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn282.r, odriverConn282.extra, param0)
+}
+func (odriverConn282 *tdriverConn282) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn282.r, odriverConn282.extra, ctx)
+}
+func (odriverConn282 *tdriverConn282) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn282.r, odriverConn282.extra, ctx, query, args)
+}
+
+func (odriverConn283 *tdriverConn283) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn283.r, odriverConn283.extra)
+}
+func (odriverConn283 *tdriverConn283) Close() error {
+	return realDCClose(odriverConn283.r, odriverConn283.extra)
+}
+func (odriverConn283 *tdriverConn283) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn283.r, odriverConn283.extra, query)
+}
+func (odriverConn283 *tdriverConn283) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn283.r, odriverConn283.extra, ctx, opts)
+}
+func (odriverConn283 *tdriverConn283) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn283.r, odriverConn283.extra, ctx, query)
+}
+func (odriverConn283 *tdriverConn283) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn283.r, odriverConn283.extra, param0)
+}
+func (odriverConn283 *tdriverConn283) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn283.r, odriverConn283.extra, ctx)
+}
+func (odriverConn283 *tdriverConn283) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn283.r, odriverConn283.extra, ctx)
+}
+
+func (odriverConn284 *tdriverConn284) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn284.r, odriverConn284.extra)
+}
+func (odriverConn284 *tdriverConn284) Close() error {
+	return realDCClose(odriverConn284.r, odriverConn284.extra)
+}
+func (odriverConn284 *tdriverConn284) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn284.r, odriverConn284.extra, query)
+}
+func (odriverConn284 *tdriverConn284) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn284.r, odriverConn284.extra, ctx, opts)
+}
+func (odriverConn284 *tdriverConn284) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn284.r, odriverConn284.extra, ctx, query)
+}
+func (odriverConn284 *tdriverConn284) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn284.r, odriverConn284.extra, param0)
+}
+func (odriverConn284 *tdriverConn284) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn284.r, odriverConn284.extra, query, args)
+}
+func (odriverConn284 *tdriverConn284) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn284.r, odriverConn284.extra, ctx, query, args)
+}
+
+func (odriverConn285 *tdriverConn285) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn285.r, odriverConn285.extra)
+}
+func (odriverConn285 *tdriverConn285) Close() error {
+	return realDCClose(odriverConn285.r, odriverConn285.extra)
+}
+func (odriverConn285 *tdriverConn285) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn285.r, odriverConn285.extra, query)
+}
+func (odriverConn285 *tdriverConn285) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn285.r, odriverConn285.extra, ctx, opts)
+}
+func (odriverConn285 *tdriverConn285) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn285.r, odriverConn285.extra, ctx, query)
+}
+func (odriverConn285 *tdriverConn285) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn285.r, odriverConn285.extra, param0)
+}
+func (odriverConn285 *tdriverConn285) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn285.r, odriverConn285.extra, query, args)
+}
+func (odriverConn285 *tdriverConn285) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn285.r, odriverConn285.extra, ctx)
+}
+
+func (odriverConn286 *tdriverConn286) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn286.r, odriverConn286.extra)
+}
+func (odriverConn286 *tdriverConn286) Close() error {
+	return realDCClose(odriverConn286.r, odriverConn286.extra)
+}
+func (odriverConn286 *tdriverConn286) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn286.r, odriverConn286.extra, query)
+}
+func (odriverConn286 *tdriverConn286) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn286.r, odriverConn286.extra, ctx, opts)
+}
+func (odriverConn286 *tdriverConn286) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn286.r, odriverConn286.extra, ctx, query)
+}
+func (odriverConn286 *tdriverConn286) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn286.r, odriverConn286.extra, param0)
+}
+func (odriverConn286 *tdriverConn286) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn286.r, odriverConn286.extra, ctx, query, args)
+}
+func (odriverConn286 *tdriverConn286) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn286.r, odriverConn286.extra, ctx)
+}
+
+func (odriverConn287 *tdriverConn287) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn287.r, odriverConn287.extra)
+}
+func (odriverConn287 *tdriverConn287) Close() error {
+	return realDCClose(odriverConn287.r, odriverConn287.extra)
+}
+func (odriverConn287 *tdriverConn287) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn287.r, odriverConn287.extra, query)
+}
+func (odriverConn287 *tdriverConn287) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn287.r, odriverConn287.extra, ctx, opts)
+}
+func (odriverConn287 *tdriverConn287) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn287.r, odriverConn287.extra, ctx, query)
+}
+func (odriverConn287 *tdriverConn287) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn287.r, odriverConn287.extra, ctx)
+}
+func (odriverConn287 *tdriverConn287) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn287.r, odriverConn287.extra, query, args)
+}
+func (odriverConn287 *tdriverConn287) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn287.r, odriverConn287.extra, ctx, query, args)
+}
+
+func (odriverConn288 *tdriverConn288) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn288.r, odriverConn288.extra)
+}
+func (odriverConn288 *tdriverConn288) Close() error {
+	return realDCClose(odriverConn288.r, odriverConn288.extra)
+}
+func (odriverConn288 *tdriverConn288) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn288.r, odriverConn288.extra, query)
+}
+func (odriverConn288 *tdriverConn288) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn288.r, odriverConn288.extra, ctx, opts)
+}
+func (odriverConn288 *tdriverConn288) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn288.r, odriverConn288.extra, ctx, query)
+}
+func (odriverConn288 *tdriverConn288) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn288.r, odriverConn288.extra, ctx)
+}
+func (odriverConn288 *tdriverConn288) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn288.r, odriverConn288.extra, query, args)
+}
+func (odriverConn288 *tdriverConn288) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn288.r, odriverConn288.extra, ctx)
+}
+
+func (odriverConn289 *tdriverConn289) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn289.r, odriverConn289.extra)
+}
+func (odriverConn289 *tdriverConn289) Close() error {
+	return realDCClose(odriverConn289.r, odriverConn289.extra)
+}
+func (odriverConn289 *tdriverConn289) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn289.r, odriverConn289.extra, query)
+}
+func (odriverConn289 *tdriverConn289) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn289.r, odriverConn289.extra, ctx, opts)
+}
+func (odriverConn289 *tdriverConn289) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn289.r, odriverConn289.extra, ctx, query)
+}
+func (odriverConn289 *tdriverConn289) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn289.r, odriverConn289.extra, ctx)
+}
+func (odriverConn289 *tdriverConn289) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn289.r, odriverConn289.extra, ctx, query, args)
+}
+func (odriverConn289 *tdriverConn289) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn289.r, odriverConn289.extra, ctx)
+}
+
+func (odriverConn290 *tdriverConn290) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn290.r, odriverConn290.extra)
+}
+func (odriverConn290 *tdriverConn290) Close() error {
+	return realDCClose(odriverConn290.r, odriverConn290.extra)
+}
+func (odriverConn290 *tdriverConn290) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn290.r, odriverConn290.extra, query)
+}
+func (odriverConn290 *tdriverConn290) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn290.r, odriverConn290.extra, ctx, opts)
+}
+func (odriverConn290 *tdriverConn290) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn290.r, odriverConn290.extra, ctx, query)
+}
+func (odriverConn290 *tdriverConn290) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn290.r, odriverConn290.extra, query, args)
+}
+func (odriverConn290 *tdriverConn290) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn290.r, odriverConn290.extra, ctx, query, args)
+}
+func (odriverConn290 *tdriverConn290) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn290.r, odriverConn290.extra, ctx)
+}
+
+func (odriverConn291 *tdriverConn291) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn291.r, odriverConn291.extra)
+}
+func (odriverConn291 *tdriverConn291) Close() error {
+	return realDCClose(odriverConn291.r, odriverConn291.extra)
+}
+func (odriverConn291 *tdriverConn291) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn291.r, odriverConn291.extra, query)
+}
+func (odriverConn291 *tdriverConn291) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn291.r, odriverConn291.extra, ctx, opts)
+}
+func (odriverConn291 *tdriverConn291) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn291.r, odriverConn291.extra, query, args)
+}
+func (odriverConn291 *tdriverConn291) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn291.r, odriverConn291.extra, ctx, query, args)
+}
+func (odriverConn291 *tdriverConn291) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn291.r, odriverConn291.extra, param0)
+}
+func (odriverConn291 *tdriverConn291) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn291.r, odriverConn291.extra, ctx)
+}
+
+func (odriverConn292 *tdriverConn292) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn292.r, odriverConn292.extra)
+}
+func (odriverConn292 *tdriverConn292) Close() error {
+	return realDCClose(odriverConn292.r, odriverConn292.extra)
+}
+func (odriverConn292 *tdriverConn292) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn292.r, odriverConn292.extra, query)
+}
+func (odriverConn292 *tdriverConn292) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn292.r, odriverConn292.extra, ctx, opts)
+}
+func (odriverConn292 *tdriverConn292) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn292.r, odriverConn292.extra, query, args)
+}
+func (odriverConn292 *tdriverConn292) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn292.r, odriverConn292.extra, ctx, query, args)
+}
+func (odriverConn292 *tdriverConn292) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn292.r, odriverConn292.extra, param0)
+}
+func (odriverConn292 *tdriverConn292) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn292.r, odriverConn292.extra, query, args)
+}
+
+func (odriverConn293 *tdriverConn293) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn293.r, odriverConn293.extra)
+}
+func (odriverConn293 *tdriverConn293) Close() error {
+	return realDCClose(odriverConn293.r, odriverConn293.extra)
+}
+func (odriverConn293 *tdriverConn293) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn293.r, odriverConn293.extra, query)
+}
+func (odriverConn293 *tdriverConn293) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn293.r, odriverConn293.extra, ctx, opts)
+}
+func (odriverConn293 *tdriverConn293) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn293.r, odriverConn293.extra, query, args)
+}
+func (odriverConn293 *tdriverConn293) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn293.r, odriverConn293.extra, ctx, query, args)
+}
+func (odriverConn293 *tdriverConn293) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn293.r, odriverConn293.extra, param0)
+}
+func (odriverConn293 *tdriverConn293) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn293.r, odriverConn293.extra, ctx, query, args)
+}
+
+func (odriverConn294 *tdriverConn294) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn294.r, odriverConn294.extra)
+}
+func (odriverConn294 *tdriverConn294) Close() error {
+	return realDCClose(odriverConn294.r, odriverConn294.extra)
+}
+func (odriverConn294 *tdriverConn294) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn294.r, odriverConn294.extra, query)
+}
+func (odriverConn294 *tdriverConn294) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn294.r, odriverConn294.extra, ctx, opts)
+}
+func (odriverConn294 *tdriverConn294) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn294.r, odriverConn294.extra, query, args)
+}
+func (odriverConn294 *tdriverConn294) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn294.r, odriverConn294.extra, ctx, query, args)
+}
+func (odriverConn294 *tdriverConn294) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn294.r, odriverConn294.extra, param0)
+}
+func (odriverConn294 *tdriverConn294) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn294.r, odriverConn294.extra, ctx)
+}
+
+func (odriverConn295 *tdriverConn295) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn295.r, odriverConn295.extra)
+}
+func (odriverConn295 *tdriverConn295) Close() error {
+	return realDCClose(odriverConn295.r, odriverConn295.extra)
+}
+func (odriverConn295 *tdriverConn295) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn295.r, odriverConn295.extra, query)
+}
+func (odriverConn295 *tdriverConn295) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn295.r, odriverConn295.extra, ctx, opts)
+}
+func (odriverConn295 *tdriverConn295) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn295.r, odriverConn295.extra, query, args)
+}
+func (odriverConn295 *tdriverConn295) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn295.r, odriverConn295.extra, ctx, query, args)
+}
+func (odriverConn295 *tdriverConn295) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn295.r, odriverConn295.extra, ctx)
+}
+func (odriverConn295 *tdriverConn295) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn295.r, odriverConn295.extra, query, args)
+}
+
+func (odriverConn296 *tdriverConn296) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn296.r, odriverConn296.extra)
+}
+func (odriverConn296 *tdriverConn296) Close() error {
+	return realDCClose(odriverConn296.r, odriverConn296.extra)
+}
+func (odriverConn296 *tdriverConn296) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn296.r, odriverConn296.extra, query)
+}
+func (odriverConn296 *tdriverConn296) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn296.r, odriverConn296.extra, ctx, opts)
+}
+func (odriverConn296 *tdriverConn296) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn296.r, odriverConn296.extra, query, args)
+}
+func (odriverConn296 *tdriverConn296) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn296.r, odriverConn296.extra, ctx, query, args)
+}
+func (odriverConn296 *tdriverConn296) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn296.r, odriverConn296.extra, ctx)
+}
+func (odriverConn296 *tdriverConn296) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn296.r, odriverConn296.extra, ctx, query, args)
+}
+
+func (odriverConn297 *tdriverConn297) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn297.r, odriverConn297.extra)
+}
+func (odriverConn297 *tdriverConn297) Close() error {
+	return realDCClose(odriverConn297.r, odriverConn297.extra)
+}
+func (odriverConn297 *tdriverConn297) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn297.r, odriverConn297.extra, query)
+}
+func (odriverConn297 *tdriverConn297) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn297.r, odriverConn297.extra, ctx, opts)
+}
+func (odriverConn297 *tdriverConn297) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn297.r, odriverConn297.extra, query, args)
+}
+func (odriverConn297 *tdriverConn297) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn297.r, odriverConn297.extra, ctx, query, args)
+}
+func (odriverConn297 *tdriverConn297) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn297.r, odriverConn297.extra, ctx)
+}
+func (odriverConn297 *tdriverConn297) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn297.r, odriverConn297.extra, ctx)
+}
+
+func (odriverConn298 *tdriverConn298) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn298.r, odriverConn298.extra)
+}
+func (odriverConn298 *tdriverConn298) Close() error {
+	return realDCClose(odriverConn298.r, odriverConn298.extra)
+}
+func (odriverConn298 *tdriverConn298) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn298.r, odriverConn298.extra, query)
+}
+func (odriverConn298 *tdriverConn298) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn298.r, odriverConn298.extra, ctx, opts)
+}
+func (odriverConn298 *tdriverConn298) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn298.r, odriverConn298.extra, query, args)
+}
+func (odriverConn298 *tdriverConn298) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn298.r, odriverConn298.extra, ctx, query, args)
+}
+func (odriverConn298 *tdriverConn298) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn298.r, odriverConn298.extra, query, args)
+}
+func (odriverConn298 *tdriverConn298) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn298.r, odriverConn298.extra, ctx, query, args)
+}
+
+func (odriverConn299 *tdriverConn299) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn299.r, odriverConn299.extra)
+}
+func (odriverConn299 *tdriverConn299) Close() error {
+	return realDCClose(odriverConn299.r, odriverConn299.extra)
+}
+func (odriverConn299 *tdriverConn299) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn299.r, odriverConn299.extra, query)
+}
+func (odriverConn299 *tdriverConn299) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn299.r, odriverConn299.extra, ctx, opts)
+}
+func (odriverConn299 *tdriverConn299) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn299.r, odriverConn299.extra, query, args)
+}
+func (odriverConn299 *tdriverConn299) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn299.r, odriverConn299.extra, ctx, query, args)
+}
+func (odriverConn299 *tdriverConn299) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn299.r, odriverConn299.extra, query, args)
+}
+func (odriverConn299 *tdriverConn299) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn299.r, odriverConn299.extra, ctx)
+}
+
+func (odriverConn300 *tdriverConn300) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn300.r, odriverConn300.extra)
+}
+func (odriverConn300 *tdriverConn300) Close() error {
+	return realDCClose(odriverConn300.r, odriverConn300.extra)
+}
+func (odriverConn300 *tdriverConn300) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn300.r, odriverConn300.extra, query)
+}
+func (odriverConn300 *tdriverConn300) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn300.r, odriverConn300.extra, ctx, opts)
+}
+func (odriverConn300 *tdriverConn300) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn300.r, odriverConn300.extra, query, args)
+}
+func (odriverConn300 *tdriverConn300) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn300.r, odriverConn300.extra, ctx, query, args)
+}
+func (odriverConn300 *tdriverConn300) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn300.r, odriverConn300.extra, ctx, query, args)
+}
+func (odriverConn300 *tdriverConn300) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn300.r, odriverConn300.extra, ctx)
+}
+
+func (odriverConn301 *tdriverConn301) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn301.r, odriverConn301.extra)
+}
+func (odriverConn301 *tdriverConn301) Close() error {
+	return realDCClose(odriverConn301.r, odriverConn301.extra)
+}
+func (odriverConn301 *tdriverConn301) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn301.r, odriverConn301.extra, query)
+}
+func (odriverConn301 *tdriverConn301) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn301.r, odriverConn301.extra, ctx, opts)
+}
+func (odriverConn301 *tdriverConn301) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn301.r, odriverConn301.extra, query, args)
+}
+func (odriverConn301 *tdriverConn301) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn301.r, odriverConn301.extra, param0)
+}
+func (odriverConn301 *tdriverConn301) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn301.r, odriverConn301.extra, ctx)
+}
+func (odriverConn301 *tdriverConn301) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn301.r, odriverConn301.extra, query, args)
+}
+
+func (odriverConn302 *tdriverConn302) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn302.r, odriverConn302.extra)
+}
+func (odriverConn302 *tdriverConn302) Close() error {
+	return realDCClose(odriverConn302.r, odriverConn302.extra)
+}
+func (odriverConn302 *tdriverConn302) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn302.r, odriverConn302.extra, query)
+}
+func (odriverConn302 *tdriverConn302) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn302.r, odriverConn302.extra, ctx, opts)
+}
+func (odriverConn302 *tdriverConn302) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn302.r, odriverConn302.extra, query, args)
+}
+func (odriverConn302 *tdriverConn302) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn302.r, odriverConn302.extra, param0)
+}
+func (odriverConn302 *tdriverConn302) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn302.r, odriverConn302.extra, ctx)
+}
+func (odriverConn302 *tdriverConn302) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn302.r, odriverConn302.extra, ctx, query, args)
+}
+
+func (odriverConn303 *tdriverConn303) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn303.r, odriverConn303.extra)
+}
+func (odriverConn303 *tdriverConn303) Close() error {
+	return realDCClose(odriverConn303.r, odriverConn303.extra)
+}
+func (odriverConn303 *tdriverConn303) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn303.r, odriverConn303.extra, query)
+}
+func (odriverConn303 *tdriverConn303) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn303.r, odriverConn303.extra, ctx, opts)
+}
+func (odriverConn303 *tdriverConn303) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn303.r, odriverConn303.extra, query, args)
+}
+func (odriverConn303 *tdriverConn303) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn303.r, odriverConn303.extra, param0)
+}
+func (odriverConn303 *tdriverConn303) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn303.r, odriverConn303.extra, ctx)
+}
+func (odriverConn303 *tdriverConn303) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn303.r, odriverConn303.extra, ctx)
+}
+
+func (odriverConn304 *tdriverConn304) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn304.r, odriverConn304.extra)
+}
+func (odriverConn304 *tdriverConn304) Close() error {
+	return realDCClose(odriverConn304.r, odriverConn304.extra)
+}
+func (odriverConn304 *tdriverConn304) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn304.r, odriverConn304.extra, query)
+}
+func (odriverConn304 *tdriverConn304) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn304.r, odriverConn304.extra, ctx, opts)
+}
+func (odriverConn304 *tdriverConn304) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn304.r, odriverConn304.extra, query, args)
+}
+func (odriverConn304 *tdriverConn304) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn304.r, odriverConn304.extra, param0)
+}
+func (odriverConn304 *tdriverConn304) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn304.r, odriverConn304.extra, query, args)
+}
+func (odriverConn304 *tdriverConn304) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn304.r, odriverConn304.extra, ctx, query, args)
+}
+
+func (odriverConn305 *tdriverConn305) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn305.r, odriverConn305.extra)
+}
+func (odriverConn305 *tdriverConn305) Close() error {
+	return realDCClose(odriverConn305.r, odriverConn305.extra)
+}
+func (odriverConn305 *tdriverConn305) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn305.r, odriverConn305.extra, query)
+}
+func (odriverConn305 *tdriverConn305) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn305.r, odriverConn305.extra, ctx, opts)
+}
+func (odriverConn305 *tdriverConn305) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn305.r, odriverConn305.extra, query, args)
+}
+func (odriverConn305 *tdriverConn305) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn305.r, odriverConn305.extra, param0)
+}
+func (odriverConn305 *tdriverConn305) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn305.r, odriverConn305.extra, query, args)
+}
+func (odriverConn305 *tdriverConn305) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn305.r, odriverConn305.extra, ctx)
+}
+
+func (odriverConn306 *tdriverConn306) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn306.r, odriverConn306.extra)
+}
+func (odriverConn306 *tdriverConn306) Close() error {
+	return realDCClose(odriverConn306.r, odriverConn306.extra)
+}
+func (odriverConn306 *tdriverConn306) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn306.r, odriverConn306.extra, query)
+}
+func (odriverConn306 *tdriverConn306) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn306.r, odriverConn306.extra, ctx, opts)
+}
+func (odriverConn306 *tdriverConn306) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn306.r, odriverConn306.extra, query, args)
+}
+func (odriverConn306 *tdriverConn306) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn306.r, odriverConn306.extra, param0)
+}
+func (odriverConn306 *tdriverConn306) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn306.r, odriverConn306.extra, ctx, query, args)
+}
+func (odriverConn306 *tdriverConn306) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn306.r, odriverConn306.extra, ctx)
+}
+
+func (odriverConn307 *tdriverConn307) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn307.r, odriverConn307.extra)
+}
+func (odriverConn307 *tdriverConn307) Close() error {
+	return realDCClose(odriverConn307.r, odriverConn307.extra)
+}
+func (odriverConn307 *tdriverConn307) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn307.r, odriverConn307.extra, query)
+}
+func (odriverConn307 *tdriverConn307) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn307.r, odriverConn307.extra, ctx, opts)
+}
+func (odriverConn307 *tdriverConn307) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn307.r, odriverConn307.extra, query, args)
+}
+func (odriverConn307 *tdriverConn307) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn307.r, odriverConn307.extra, ctx)
+}
+func (odriverConn307 *tdriverConn307) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn307.r, odriverConn307.extra, query, args)
+}
+func (odriverConn307 *tdriverConn307) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn307.r, odriverConn307.extra, ctx, query, args)
+}
+
+func (odriverConn308 *tdriverConn308) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn308.r, odriverConn308.extra)
+}
+func (odriverConn308 *tdriverConn308) Close() error {
+	return realDCClose(odriverConn308.r, odriverConn308.extra)
+}
+func (odriverConn308 *tdriverConn308) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn308.r, odriverConn308.extra, query)
+}
+func (odriverConn308 *tdriverConn308) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn308.r, odriverConn308.extra, ctx, opts)
+}
+func (odriverConn308 *tdriverConn308) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn308.r, odriverConn308.extra, query, args)
+}
+func (odriverConn308 *tdriverConn308) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn308.r, odriverConn308.extra, ctx)
+}
+func (odriverConn308 *tdriverConn308) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn308.r, odriverConn308.extra, query, args)
+}
+func (odriverConn308 *tdriverConn308) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn308.r, odriverConn308.extra, ctx)
+}
+
+func (odriverConn309 *tdriverConn309) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn309.r, odriverConn309.extra)
+}
+func (odriverConn309 *tdriverConn309) Close() error {
+	return realDCClose(odriverConn309.r, odriverConn309.extra)
+}
+func (odriverConn309 *tdriverConn309) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn309.r, odriverConn309.extra, query)
+}
+func (odriverConn309 *tdriverConn309) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn309.r, odriverConn309.extra, ctx, opts)
+}
+func (odriverConn309 *tdriverConn309) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn309.r, odriverConn309.extra, query, args)
+}
+func (odriverConn309 *tdriverConn309) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn309.r, odriverConn309.extra, ctx)
+}
+func (odriverConn309 *tdriverConn309) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn309.r, odriverConn309.extra, ctx, query, args)
+}
+func (odriverConn309 *tdriverConn309) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn309.r, odriverConn309.extra, ctx)
+}
+
+func (odriverConn310 *tdriverConn310) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn310.r, odriverConn310.extra)
+}
+func (odriverConn310 *tdriverConn310) Close() error {
+	return realDCClose(odriverConn310.r, odriverConn310.extra)
+}
+func (odriverConn310 *tdriverConn310) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn310.r, odriverConn310.extra, query)
+}
+func (odriverConn310 *tdriverConn310) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn310.r, odriverConn310.extra, ctx, opts)
+}
+func (odriverConn310 *tdriverConn310) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn310.r, odriverConn310.extra, query, args)
+}
+func (odriverConn310 *tdriverConn310) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn310.r, odriverConn310.extra, query, args)
+}
+func (odriverConn310 *tdriverConn310) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn310.r, odriverConn310.extra, ctx, query, args)
+}
+func (odriverConn310 *tdriverConn310) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn310.r, odriverConn310.extra, ctx)
+}
+
+func (odriverConn311 *tdriverConn311) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn311.r, odriverConn311.extra)
+}
+func (odriverConn311 *tdriverConn311) Close() error {
+	return realDCClose(odriverConn311.r, odriverConn311.extra)
+}
+func (odriverConn311 *tdriverConn311) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn311.r, odriverConn311.extra, query)
+}
+func (odriverConn311 *tdriverConn311) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn311.r, odriverConn311.extra, ctx, opts)
+}
+func (odriverConn311 *tdriverConn311) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn311.r, odriverConn311.extra, ctx, query, args)
+}
+func (odriverConn311 *tdriverConn311) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn311.r, odriverConn311.extra, param0)
+}
+func (odriverConn311 *tdriverConn311) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn311.r, odriverConn311.extra, ctx)
+}
+func (odriverConn311 *tdriverConn311) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn311.r, odriverConn311.extra, query, args)
+}
+
+func (odriverConn312 *tdriverConn312) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn312.r, odriverConn312.extra)
+}
+func (odriverConn312 *tdriverConn312) Close() error {
+	return realDCClose(odriverConn312.r, odriverConn312.extra)
+}
+func (odriverConn312 *tdriverConn312) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn312.r, odriverConn312.extra, query)
+}
+func (odriverConn312 *tdriverConn312) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn312.r, odriverConn312.extra, ctx, opts)
+}
+func (odriverConn312 *tdriverConn312) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn312.r, odriverConn312.extra, ctx, query, args)
+}
+func (odriverConn312 *tdriverConn312) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn312.r, odriverConn312.extra, param0)
+}
+func (odriverConn312 *tdriverConn312) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn312.r, odriverConn312.extra, ctx)
+}
+func (odriverConn312 *tdriverConn312) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn312.r, odriverConn312.extra, ctx, query, args)
+}
+
+func (odriverConn313 *tdriverConn313) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn313.r, odriverConn313.extra)
+}
+func (odriverConn313 *tdriverConn313) Close() error {
+	return realDCClose(odriverConn313.r, odriverConn313.extra)
+}
+func (odriverConn313 *tdriverConn313) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn313.r, odriverConn313.extra, query)
+}
+func (odriverConn313 *tdriverConn313) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn313.r, odriverConn313.extra, ctx, opts)
+}
+func (odriverConn313 *tdriverConn313) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn313.r, odriverConn313.extra, ctx, query, args)
+}
+func (odriverConn313 *tdriverConn313) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn313.r, odriverConn313.extra, param0)
+}
+func (odriverConn313 *tdriverConn313) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn313.r, odriverConn313.extra, ctx)
+}
+func (odriverConn313 *tdriverConn313) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn313.r, odriverConn313.extra, ctx)
+}
+
+func (odriverConn314 *tdriverConn314) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn314.r, odriverConn314.extra)
+}
+func (odriverConn314 *tdriverConn314) Close() error {
+	return realDCClose(odriverConn314.r, odriverConn314.extra)
+}
+func (odriverConn314 *tdriverConn314) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn314.r, odriverConn314.extra, query)
+}
+func (odriverConn314 *tdriverConn314) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn314.r, odriverConn314.extra, ctx, opts)
+}
+func (odriverConn314 *tdriverConn314) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn314.r, odriverConn314.extra, ctx, query, args)
+}
+func (odriverConn314 *tdriverConn314) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn314.r, odriverConn314.extra, param0)
+}
+func (odriverConn314 *tdriverConn314) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn314.r, odriverConn314.extra, query, args)
+}
+func (odriverConn314 *tdriverConn314) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn314.r, odriverConn314.extra, ctx, query, args)
+}
+
+func (odriverConn315 *tdriverConn315) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn315.r, odriverConn315.extra)
+}
+func (odriverConn315 *tdriverConn315) Close() error {
+	return realDCClose(odriverConn315.r, odriverConn315.extra)
+}
+func (odriverConn315 *tdriverConn315) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn315.r, odriverConn315.extra, query)
+}
+func (odriverConn315 *tdriverConn315) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn315.r, odriverConn315.extra, ctx, opts)
+}
+func (odriverConn315 *tdriverConn315) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn315.r, odriverConn315.extra, ctx, query, args)
+}
+func (odriverConn315 *tdriverConn315) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn315.r, odriverConn315.extra, param0)
+}
+func (odriverConn315 *tdriverConn315) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn315.r, odriverConn315.extra, query, args)
+}
+func (odriverConn315 *tdriverConn315) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn315.r, odriverConn315.extra, ctx)
+}
+
+func (odriverConn316 *tdriverConn316) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn316.r, odriverConn316.extra)
+}
+func (odriverConn316 *tdriverConn316) Close() error {
+	return realDCClose(odriverConn316.r, odriverConn316.extra)
+}
+func (odriverConn316 *tdriverConn316) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn316.r, odriverConn316.extra, query)
+}
+func (odriverConn316 *tdriverConn316) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn316.r, odriverConn316.extra, ctx, opts)
+}
+func (odriverConn316 *tdriverConn316) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn316.r, odriverConn316.extra, ctx, query, args)
+}
+func (odriverConn316 *tdriverConn316) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn316.r, odriverConn316.extra, param0)
+}
+func (odriverConn316 *tdriverConn316) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn316.r, odriverConn316.extra, ctx, query, args)
+}
+func (odriverConn316 *tdriverConn316) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn316.r, odriverConn316.extra, ctx)
+}
+
+func (odriverConn317 *tdriverConn317) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn317.r, odriverConn317.extra)
+}
+func (odriverConn317 *tdriverConn317) Close() error {
+	return realDCClose(odriverConn317.r, odriverConn317.extra)
+}
+func (odriverConn317 *tdriverConn317) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn317.r, odriverConn317.extra, query)
+}
+func (odriverConn317 *tdriverConn317) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn317.r, odriverConn317.extra, ctx, opts)
+}
+func (odriverConn317 *tdriverConn317) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn317.r, odriverConn317.extra, ctx, query, args)
+}
+func (odriverConn317 *tdriverConn317) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn317.r, odriverConn317.extra, ctx)
+}
+func (odriverConn317 *tdriverConn317) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn317.r, odriverConn317.extra, query, args)
+}
+func (odriverConn317 *tdriverConn317) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn317.r, odriverConn317.extra, ctx, query, args)
+}
+
+func (odriverConn318 *tdriverConn318) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn318.r, odriverConn318.extra)
+}
+func (odriverConn318 *tdriverConn318) Close() error {
+	return realDCClose(odriverConn318.r, odriverConn318.extra)
+}
+func (odriverConn318 *tdriverConn318) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn318.r, odriverConn318.extra, query)
+}
+func (odriverConn318 *tdriverConn318) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn318.r, odriverConn318.extra, ctx, opts)
+}
+func (odriverConn318 *tdriverConn318) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn318.r, odriverConn318.extra, ctx, query, args)
+}
+func (odriverConn318 *tdriverConn318) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn318.r, odriverConn318.extra, ctx)
+}
+func (odriverConn318 *tdriverConn318) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn318.r, odriverConn318.extra, query, args)
+}
+func (odriverConn318 *tdriverConn318) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn318.r, odriverConn318.extra, ctx)
+}
+
+func (odriverConn319 *tdriverConn319) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn319.r, odriverConn319.extra)
+}
+func (odriverConn319 *tdriverConn319) Close() error {
+	return realDCClose(odriverConn319.r, odriverConn319.extra)
+}
+func (odriverConn319 *tdriverConn319) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn319.r, odriverConn319.extra, query)
+}
+func (odriverConn319 *tdriverConn319) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn319.r, odriverConn319.extra, ctx, opts)
+}
+func (odriverConn319 *tdriverConn319) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn319.r, odriverConn319.extra, ctx, query, args)
+}
+func (odriverConn319 *tdriverConn319) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn319.r, odriverConn319.extra, ctx)
+}
+func (odriverConn319 *tdriverConn319) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn319.r, odriverConn319.extra, ctx, query, args)
+}
+func (odriverConn319 *tdriverConn319) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn319.r, odriverConn319.extra, ctx)
+}
+
+func (odriverConn320 *tdriverConn320) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn320.r, odriverConn320.extra)
+}
+func (odriverConn320 *tdriverConn320) Close() error {
+	return realDCClose(odriverConn320.r, odriverConn320.extra)
+}
+func (odriverConn320 *tdriverConn320) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn320.r, odriverConn320.extra, query)
+}
+func (odriverConn320 *tdriverConn320) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn320.r, odriverConn320.extra, ctx, opts)
+}
+func (odriverConn320 *tdriverConn320) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn320.r, odriverConn320.extra, ctx, query, args)
+}
+func (odriverConn320 *tdriverConn320) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn320.r, odriverConn320.extra, query, args)
+}
+func (odriverConn320 *tdriverConn320) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn320.r, odriverConn320.extra, ctx, query, args)
+}
+func (odriverConn320 *tdriverConn320) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn320.r, odriverConn320.extra, ctx)
+}
+
+func (odriverConn321 *tdriverConn321) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn321.r, odriverConn321.extra)
+}
+func (odriverConn321 *tdriverConn321) Close() error {
+	return realDCClose(odriverConn321.r, odriverConn321.extra)
+}
+func (odriverConn321 *tdriverConn321) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn321.r, odriverConn321.extra, query)
+}
+func (odriverConn321 *tdriverConn321) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn321.r, odriverConn321.extra, ctx, opts)
+}
+func (odriverConn321 *tdriverConn321) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn321.r, odriverConn321.extra, param0)
+}
+func (odriverConn321 *tdriverConn321) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn321.r, odriverConn321.extra, ctx)
+}
+func (odriverConn321 *tdriverConn321) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn321.r, odriverConn321.extra, query, args)
+}
+func (odriverConn321 *tdriverConn321) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn321.r, odriverConn321.extra, ctx, query, args)
+}
+
+func (odriverConn322 *tdriverConn322) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn322.r, odriverConn322.extra)
+}
+func (odriverConn322 *tdriverConn322) Close() error {
+	return realDCClose(odriverConn322.r, odriverConn322.extra)
+}
+func (odriverConn322 *tdriverConn322) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn322.r, odriverConn322.extra, query)
+}
+func (odriverConn322 *tdriverConn322) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn322.r, odriverConn322.extra, ctx, opts)
+}
+func (odriverConn322 *tdriverConn322) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn322.r, odriverConn322.extra, param0)
+}
+func (odriverConn322 *tdriverConn322) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn322.r, odriverConn322.extra, ctx)
+}
+func (odriverConn322 *tdriverConn322) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn322.r, odriverConn322.extra, query, args)
+}
+func (odriverConn322 *tdriverConn322) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn322.r, odriverConn322.extra, ctx)
+}
+
+func (odriverConn323 *tdriverConn323) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn323.r, odriverConn323.extra)
+}
+func (odriverConn323 *tdriverConn323) Close() error {
+	return realDCClose(odriverConn323.r, odriverConn323.extra)
+}
+func (odriverConn323 *tdriverConn323) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn323.r, odriverConn323.extra, query)
+}
+func (odriverConn323 *tdriverConn323) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn323.r, odriverConn323.extra, ctx, opts)
+}
+func (odriverConn323 *tdriverConn323) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn323.r, odriverConn323.extra, param0)
+}
+func (odriverConn323 *tdriverConn323) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn323.r, odriverConn323.extra, ctx)
+}
+func (odriverConn323 *tdriverConn323) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn323.r, odriverConn323.extra, ctx, query, args)
+}
+func (odriverConn323 *tdriverConn323) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn323.r, odriverConn323.extra, ctx)
+}
+
+func (odriverConn324 *tdriverConn324) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn324.r, odriverConn324.extra)
+}
+func (odriverConn324 *tdriverConn324) Close() error {
+	return realDCClose(odriverConn324.r, odriverConn324.extra)
+}
+func (odriverConn324 *tdriverConn324) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn324.r, odriverConn324.extra, query)
+}
+func (odriverConn324 *tdriverConn324) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn324.r, odriverConn324.extra, ctx, opts)
+}
+func (odriverConn324 *tdriverConn324) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn324.r, odriverConn324.extra, param0)
+}
+func (odriverConn324 *tdriverConn324) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn324.r, odriverConn324.extra, query, args)
+}
+func (odriverConn324 *tdriverConn324) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn324.r, odriverConn324.extra, ctx, query, args)
+}
+func (odriverConn324 *tdriverConn324) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn324.r, odriverConn324.extra, ctx)
+}
+
+func (odriverConn325 *tdriverConn325) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn325.r, odriverConn325.extra)
+}
+func (odriverConn325 *tdriverConn325) Close() error {
+	return realDCClose(odriverConn325.r, odriverConn325.extra)
+}
+func (odriverConn325 *tdriverConn325) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn325.r, odriverConn325.extra, query)
+}
+func (odriverConn325 *tdriverConn325) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn325.r, odriverConn325.extra, ctx, opts)
+}
+func (odriverConn325 *tdriverConn325) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn325.r, odriverConn325.extra, ctx)
+}
+func (odriverConn325 *tdriverConn325) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn325.r, odriverConn325.extra, query, args)
+}
+func (odriverConn325 *tdriverConn325) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn325.r, odriverConn325.extra, ctx, query, args)
+}
+func (odriverConn325 *tdriverConn325) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn325.r, odriverConn325.extra, ctx)
+}
+
+func (odriverConn326 *tdriverConn326) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn326.r, odriverConn326.extra)
+}
+func (odriverConn326 *tdriverConn326) Close() error {
+	return realDCClose(odriverConn326.r, odriverConn326.extra)
+}
+func (odriverConn326 *tdriverConn326) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn326.r, odriverConn326.extra, query)
+}
+func (odriverConn326 *tdriverConn326) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn326.r, odriverConn326.extra, ctx, query)
+}
+func (odriverConn326 *tdriverConn326) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn326.r, odriverConn326.extra, query, args)
+}
+func (odriverConn326 *tdriverConn326) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn326.r, odriverConn326.extra, ctx, query, args)
+}
+func (odriverConn326 *tdriverConn326) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn326.r, odriverConn326.extra, param0)
+}
+func (odriverConn326 *tdriverConn326) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn326.r, odriverConn326.extra, ctx)
+}
+
+func (odriverConn327 *tdriverConn327) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn327.r, odriverConn327.extra)
+}
+func (odriverConn327 *tdriverConn327) Close() error {
+	return realDCClose(odriverConn327.r, odriverConn327.extra)
+}
+func (odriverConn327 *tdriverConn327) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn327.r, odriverConn327.extra, query)
+}
+func (odriverConn327 *tdriverConn327) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn327.r, odriverConn327.extra, ctx, query)
+}
+func (odriverConn327 *tdriverConn327) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn327.r, odriverConn327.extra, query, args)
+}
+func (odriverConn327 *tdriverConn327) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn327.r, odriverConn327.extra, ctx, query, args)
+}
+func (odriverConn327 *tdriverConn327) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn327.r, odriverConn327.extra, param0)
+}
+func (odriverConn327 *tdriverConn327) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn327.r, odriverConn327.extra, query, args)
+}
+
+func (odriverConn328 *tdriverConn328) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn328.r, odriverConn328.extra)
+}
+func (odriverConn328 *tdriverConn328) Close() error {
+	return realDCClose(odriverConn328.r, odriverConn328.extra)
+}
+func (odriverConn328 *tdriverConn328) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn328.r, odriverConn328.extra, query)
+}
+func (odriverConn328 *tdriverConn328) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn328.r, odriverConn328.extra, ctx, query)
+}
+func (odriverConn328 *tdriverConn328) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn328.r, odriverConn328.extra, query, args)
+}
+func (odriverConn328 *tdriverConn328) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn328.r, odriverConn328.extra, ctx, query, args)
+}
+func (odriverConn328 *tdriverConn328) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn328.r, odriverConn328.extra, param0)
+}
+func (odriverConn328 *tdriverConn328) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn328.r, odriverConn328.extra, ctx, query, args)
+}
+
+func (odriverConn329 *tdriverConn329) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn329.r, odriverConn329.extra)
+}
+func (odriverConn329 *tdriverConn329) Close() error {
+	return realDCClose(odriverConn329.r, odriverConn329.extra)
+}
+func (odriverConn329 *tdriverConn329) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn329.r, odriverConn329.extra, query)
+}
+func (odriverConn329 *tdriverConn329) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn329.r, odriverConn329.extra, ctx, query)
+}
+func (odriverConn329 *tdriverConn329) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn329.r, odriverConn329.extra, query, args)
+}
+func (odriverConn329 *tdriverConn329) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn329.r, odriverConn329.extra, ctx, query, args)
+}
+func (odriverConn329 *tdriverConn329) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn329.r, odriverConn329.extra, param0)
+}
+func (odriverConn329 *tdriverConn329) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn329.r, odriverConn329.extra, ctx)
+}
+
+func (odriverConn330 *tdriverConn330) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn330.r, odriverConn330.extra)
+}
+func (odriverConn330 *tdriverConn330) Close() error {
+	return realDCClose(odriverConn330.r, odriverConn330.extra)
+}
+func (odriverConn330 *tdriverConn330) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn330.r, odriverConn330.extra, query)
+}
+func (odriverConn330 *tdriverConn330) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn330.r, odriverConn330.extra, ctx, query)
+}
+func (odriverConn330 *tdriverConn330) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn330.r, odriverConn330.extra, query, args)
+}
+func (odriverConn330 *tdriverConn330) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn330.r, odriverConn330.extra, ctx, query, args)
+}
+func (odriverConn330 *tdriverConn330) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn330.r, odriverConn330.extra, ctx)
+}
+func (odriverConn330 *tdriverConn330) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn330.r, odriverConn330.extra, query, args)
+}
+
+func (odriverConn331 *tdriverConn331) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn331.r, odriverConn331.extra)
+}
+func (odriverConn331 *tdriverConn331) Close() error {
+	return realDCClose(odriverConn331.r, odriverConn331.extra)
+}
+func (odriverConn331 *tdriverConn331) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn331.r, odriverConn331.extra, query)
+}
+func (odriverConn331 *tdriverConn331) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn331.r, odriverConn331.extra, ctx, query)
+}
+func (odriverConn331 *tdriverConn331) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn331.r, odriverConn331.extra, query, args)
+}
+func (odriverConn331 *tdriverConn331) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn331.r, odriverConn331.extra, ctx, query, args)
+}
+func (odriverConn331 *tdriverConn331) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn331.r, odriverConn331.extra, ctx)
+}
+func (odriverConn331 *tdriverConn331) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn331.r, odriverConn331.extra, ctx, query, args)
+}
+
+func (odriverConn332 *tdriverConn332) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn332.r, odriverConn332.extra)
+}
+func (odriverConn332 *tdriverConn332) Close() error {
+	return realDCClose(odriverConn332.r, odriverConn332.extra)
+}
+func (odriverConn332 *tdriverConn332) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn332.r, odriverConn332.extra, query)
+}
+func (odriverConn332 *tdriverConn332) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn332.r, odriverConn332.extra, ctx, query)
+}
+func (odriverConn332 *tdriverConn332) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn332.r, odriverConn332.extra, query, args)
+}
+func (odriverConn332 *tdriverConn332) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn332.r, odriverConn332.extra, ctx, query, args)
+}
+func (odriverConn332 *tdriverConn332) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn332.r, odriverConn332.extra, ctx)
+}
+func (odriverConn332 *tdriverConn332) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn332.r, odriverConn332.extra, ctx)
+}
+
+func (odriverConn333 *tdriverConn333) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn333.r, odriverConn333.extra)
+}
+func (odriverConn333 *tdriverConn333) Close() error {
+	return realDCClose(odriverConn333.r, odriverConn333.extra)
+}
+func (odriverConn333 *tdriverConn333) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn333.r, odriverConn333.extra, query)
+}
+func (odriverConn333 *tdriverConn333) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn333.r, odriverConn333.extra, ctx, query)
+}
+func (odriverConn333 *tdriverConn333) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn333.r, odriverConn333.extra, query, args)
+}
+func (odriverConn333 *tdriverConn333) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn333.r, odriverConn333.extra, ctx, query, args)
+}
+func (odriverConn333 *tdriverConn333) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn333.r, odriverConn333.extra, query, args)
+}
+func (odriverConn333 *tdriverConn333) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn333.r, odriverConn333.extra, ctx, query, args)
+}
+
+func (odriverConn334 *tdriverConn334) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn334.r, odriverConn334.extra)
+}
+func (odriverConn334 *tdriverConn334) Close() error {
+	return realDCClose(odriverConn334.r, odriverConn334.extra)
+}
+func (odriverConn334 *tdriverConn334) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn334.r, odriverConn334.extra, query)
+}
+func (odriverConn334 *tdriverConn334) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn334.r, odriverConn334.extra, ctx, query)
+}
+func (odriverConn334 *tdriverConn334) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn334.r, odriverConn334.extra, query, args)
+}
+func (odriverConn334 *tdriverConn334) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn334.r, odriverConn334.extra, ctx, query, args)
+}
+func (odriverConn334 *tdriverConn334) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn334.r, odriverConn334.extra, query, args)
+}
+func (odriverConn334 *tdriverConn334) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn334.r, odriverConn334.extra, ctx)
+}
+
+func (odriverConn335 *tdriverConn335) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn335.r, odriverConn335.extra)
+}
+func (odriverConn335 *tdriverConn335) Close() error {
+	return realDCClose(odriverConn335.r, odriverConn335.extra)
+}
+func (odriverConn335 *tdriverConn335) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn335.r, odriverConn335.extra, query)
+}
+func (odriverConn335 *tdriverConn335) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn335.r, odriverConn335.extra, ctx, query)
+}
+func (odriverConn335 *tdriverConn335) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn335.r, odriverConn335.extra, query, args)
+}
+func (odriverConn335 *tdriverConn335) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn335.r, odriverConn335.extra, ctx, query, args)
+}
+func (odriverConn335 *tdriverConn335) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn335.r, odriverConn335.extra, ctx, query, args)
+}
+func (odriverConn335 *tdriverConn335) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn335.r, odriverConn335.extra, ctx)
+}
+
+func (odriverConn336 *tdriverConn336) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn336.r, odriverConn336.extra)
+}
+func (odriverConn336 *tdriverConn336) Close() error {
+	return realDCClose(odriverConn336.r, odriverConn336.extra)
+}
+func (odriverConn336 *tdriverConn336) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn336.r, odriverConn336.extra, query)
+}
+func (odriverConn336 *tdriverConn336) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn336.r, odriverConn336.extra, ctx, query)
+}
+func (odriverConn336 *tdriverConn336) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn336.r, odriverConn336.extra, query, args)
+}
+func (odriverConn336 *tdriverConn336) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn336.r, odriverConn336.extra, param0)
+}
+func (odriverConn336 *tdriverConn336) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn336.r, odriverConn336.extra, ctx)
+}
+func (odriverConn336 *tdriverConn336) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn336.r, odriverConn336.extra, query, args)
+}
+
+func (odriverConn337 *tdriverConn337) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn337.r, odriverConn337.extra)
+}
+func (odriverConn337 *tdriverConn337) Close() error {
+	return realDCClose(odriverConn337.r, odriverConn337.extra)
+}
+func (odriverConn337 *tdriverConn337) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn337.r, odriverConn337.extra, query)
+}
+func (odriverConn337 *tdriverConn337) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn337.r, odriverConn337.extra, ctx, query)
+}
+func (odriverConn337 *tdriverConn337) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn337.r, odriverConn337.extra, query, args)
+}
+func (odriverConn337 *tdriverConn337) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn337.r, odriverConn337.extra, param0)
+}
+func (odriverConn337 *tdriverConn337) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn337.r, odriverConn337.extra, ctx)
+}
+func (odriverConn337 *tdriverConn337) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn337.r, odriverConn337.extra, ctx, query, args)
+}
+
+func (odriverConn338 *tdriverConn338) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn338.r, odriverConn338.extra)
+}
+func (odriverConn338 *tdriverConn338) Close() error {
+	return realDCClose(odriverConn338.r, odriverConn338.extra)
+}
+func (odriverConn338 *tdriverConn338) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn338.r, odriverConn338.extra, query)
+}
+func (odriverConn338 *tdriverConn338) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn338.r, odriverConn338.extra, ctx, query)
+}
+func (odriverConn338 *tdriverConn338) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn338.r, odriverConn338.extra, query, args)
+}
+func (odriverConn338 *tdriverConn338) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn338.r, odriverConn338.extra, param0)
+}
+func (odriverConn338 *tdriverConn338) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn338.r, odriverConn338.extra, ctx)
+}
+func (odriverConn338 *tdriverConn338) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn338.r, odriverConn338.extra, ctx)
+}
+
+func (odriverConn339 *tdriverConn339) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn339.r, odriverConn339.extra)
+}
+func (odriverConn339 *tdriverConn339) Close() error {
+	return realDCClose(odriverConn339.r, odriverConn339.extra)
+}
+func (odriverConn339 *tdriverConn339) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn339.r, odriverConn339.extra, query)
+}
+func (odriverConn339 *tdriverConn339) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn339.r, odriverConn339.extra, ctx, query)
+}
+func (odriverConn339 *tdriverConn339) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn339.r, odriverConn339.extra, query, args)
+}
+func (odriverConn339 *tdriverConn339) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn339.r, odriverConn339.extra, param0)
+}
+func (odriverConn339 *tdriverConn339) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn339.r, odriverConn339.extra, query, args)
+}
+func (odriverConn339 *tdriverConn339) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn339.r, odriverConn339.extra, ctx, query, args)
+}
+
+func (odriverConn340 *tdriverConn340) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn340.r, odriverConn340.extra)
+}
+func (odriverConn340 *tdriverConn340) Close() error {
+	return realDCClose(odriverConn340.r, odriverConn340.extra)
+}
+func (odriverConn340 *tdriverConn340) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn340.r, odriverConn340.extra, query)
+}
+func (odriverConn340 *tdriverConn340) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn340.r, odriverConn340.extra, ctx, query)
+}
+func (odriverConn340 *tdriverConn340) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn340.r, odriverConn340.extra, query, args)
+}
+func (odriverConn340 *tdriverConn340) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn340.r, odriverConn340.extra, param0)
+}
+func (odriverConn340 *tdriverConn340) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn340.r, odriverConn340.extra, query, args)
+}
+func (odriverConn340 *tdriverConn340) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn340.r, odriverConn340.extra, ctx)
+}
+
+func (odriverConn341 *tdriverConn341) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn341.r, odriverConn341.extra)
+}
+func (odriverConn341 *tdriverConn341) Close() error {
+	return realDCClose(odriverConn341.r, odriverConn341.extra)
+}
+func (odriverConn341 *tdriverConn341) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn341.r, odriverConn341.extra, query)
+}
+func (odriverConn341 *tdriverConn341) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn341.r, odriverConn341.extra, ctx, query)
+}
+func (odriverConn341 *tdriverConn341) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn341.r, odriverConn341.extra, query, args)
+}
+func (odriverConn341 *tdriverConn341) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn341.r, odriverConn341.extra, param0)
+}
+func (odriverConn341 *tdriverConn341) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn341.r, odriverConn341.extra, ctx, query, args)
+}
+func (odriverConn341 *tdriverConn341) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn341.r, odriverConn341.extra, ctx)
+}
+
+func (odriverConn342 *tdriverConn342) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn342.r, odriverConn342.extra)
+}
+func (odriverConn342 *tdriverConn342) Close() error {
+	return realDCClose(odriverConn342.r, odriverConn342.extra)
+}
+func (odriverConn342 *tdriverConn342) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn342.r, odriverConn342.extra, query)
+}
+func (odriverConn342 *tdriverConn342) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn342.r, odriverConn342.extra, ctx, query)
+}
+func (odriverConn342 *tdriverConn342) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn342.r, odriverConn342.extra, query, args)
+}
+func (odriverConn342 *tdriverConn342) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn342.r, odriverConn342.extra, ctx)
+}
+func (odriverConn342 *tdriverConn342) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn342.r, odriverConn342.extra, query, args)
+}
+func (odriverConn342 *tdriverConn342) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn342.r, odriverConn342.extra, ctx, query, args)
+}
+
+func (odriverConn343 *tdriverConn343) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn343.r, odriverConn343.extra)
+}
+func (odriverConn343 *tdriverConn343) Close() error {
+	return realDCClose(odriverConn343.r, odriverConn343.extra)
+}
+func (odriverConn343 *tdriverConn343) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn343.r, odriverConn343.extra, query)
+}
+func (odriverConn343 *tdriverConn343) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn343.r, odriverConn343.extra, ctx, query)
+}
+func (odriverConn343 *tdriverConn343) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn343.r, odriverConn343.extra, query, args)
+}
+func (odriverConn343 *tdriverConn343) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn343.r, odriverConn343.extra, ctx)
+}
+func (odriverConn343 *tdriverConn343) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn343.r, odriverConn343.extra, query, args)
+}
+func (odriverConn343 *tdriverConn343) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn343.r, odriverConn343.extra, ctx)
+}
+
+func (odriverConn344 *tdriverConn344) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn344.r, odriverConn344.extra)
+}
+func (odriverConn344 *tdriverConn344) Close() error {
+	return realDCClose(odriverConn344.r, odriverConn344.extra)
+}
+func (odriverConn344 *tdriverConn344) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn344.r, odriverConn344.extra, query)
+}
+func (odriverConn344 *tdriverConn344) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn344.r, odriverConn344.extra, ctx, query)
+}
+func (odriverConn344 *tdriverConn344) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn344.r, odriverConn344.extra, query, args)
+}
+func (odriverConn344 *tdriverConn344) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn344.r, odriverConn344.extra, ctx)
+}
+func (odriverConn344 *tdriverConn344) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn344.r, odriverConn344.extra, ctx, query, args)
+}
+func (odriverConn344 *tdriverConn344) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn344.r, odriverConn344.extra, ctx)
+}
+
+func (odriverConn345 *tdriverConn345) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn345.r, odriverConn345.extra)
+}
+func (odriverConn345 *tdriverConn345) Close() error {
+	return realDCClose(odriverConn345.r, odriverConn345.extra)
+}
+func (odriverConn345 *tdriverConn345) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn345.r, odriverConn345.extra, query)
+}
+func (odriverConn345 *tdriverConn345) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn345.r, odriverConn345.extra, ctx, query)
+}
+func (odriverConn345 *tdriverConn345) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn345.r, odriverConn345.extra, query, args)
+}
+func (odriverConn345 *tdriverConn345) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn345.r, odriverConn345.extra, query, args)
+}
+func (odriverConn345 *tdriverConn345) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn345.r, odriverConn345.extra, ctx, query, args)
+}
+func (odriverConn345 *tdriverConn345) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn345.r, odriverConn345.extra, ctx)
+}
+
+func (odriverConn346 *tdriverConn346) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn346.r, odriverConn346.extra)
+}
+func (odriverConn346 *tdriverConn346) Close() error {
+	return realDCClose(odriverConn346.r, odriverConn346.extra)
+}
+func (odriverConn346 *tdriverConn346) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn346.r, odriverConn346.extra, query)
+}
+func (odriverConn346 *tdriverConn346) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn346.r, odriverConn346.extra, ctx, query)
+}
+func (odriverConn346 *tdriverConn346) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn346.r, odriverConn346.extra, ctx, query, args)
+}
+func (odriverConn346 *tdriverConn346) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn346.r, odriverConn346.extra, param0)
+}
+func (odriverConn346 *tdriverConn346) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn346.r, odriverConn346.extra, ctx)
+}
+func (odriverConn346 *tdriverConn346) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn346.r, odriverConn346.extra, query, args)
+}
+
+func (odriverConn347 *tdriverConn347) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn347.r, odriverConn347.extra)
+}
+func (odriverConn347 *tdriverConn347) Close() error {
+	return realDCClose(odriverConn347.r, odriverConn347.extra)
+}
+func (odriverConn347 *tdriverConn347) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn347.r, odriverConn347.extra, query)
+}
+func (odriverConn347 *tdriverConn347) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn347.r, odriverConn347.extra, ctx, query)
+}
+func (odriverConn347 *tdriverConn347) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn347.r, odriverConn347.extra, ctx, query, args)
+}
+func (odriverConn347 *tdriverConn347) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn347.r, odriverConn347.extra, param0)
+}
+func (odriverConn347 *tdriverConn347) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn347.r, odriverConn347.extra, ctx)
+}
+func (odriverConn347 *tdriverConn347) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn347.r, odriverConn347.extra, ctx, query, args)
+}
+
+func (odriverConn348 *tdriverConn348) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn348.r, odriverConn348.extra)
+}
+func (odriverConn348 *tdriverConn348) Close() error {
+	return realDCClose(odriverConn348.r, odriverConn348.extra)
+}
+func (odriverConn348 *tdriverConn348) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn348.r, odriverConn348.extra, query)
+}
+func (odriverConn348 *tdriverConn348) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn348.r, odriverConn348.extra, ctx, query)
+}
+func (odriverConn348 *tdriverConn348) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn348.r, odriverConn348.extra, ctx, query, args)
+}
+func (odriverConn348 *tdriverConn348) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn348.r, odriverConn348.extra, param0)
+}
+func (odriverConn348 *tdriverConn348) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn348.r, odriverConn348.extra, ctx)
+}
+func (odriverConn348 *tdriverConn348) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn348.r, odriverConn348.extra, ctx)
+}
+
+func (odriverConn349 *tdriverConn349) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn349.r, odriverConn349.extra)
+}
+func (odriverConn349 *tdriverConn349) Close() error {
+	return realDCClose(odriverConn349.r, odriverConn349.extra)
+}
+func (odriverConn349 *tdriverConn349) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn349.r, odriverConn349.extra, query)
+}
+func (odriverConn349 *tdriverConn349) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn349.r, odriverConn349.extra, ctx, query)
+}
+func (odriverConn349 *tdriverConn349) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn349.r, odriverConn349.extra, ctx, query, args)
+}
+func (odriverConn349 *tdriverConn349) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn349.r, odriverConn349.extra, param0)
+}
+func (odriverConn349 *tdriverConn349) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn349.r, odriverConn349.extra, query, args)
+}
+func (odriverConn349 *tdriverConn349) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn349.r, odriverConn349.extra, ctx, query, args)
+}
+
+func (odriverConn350 *tdriverConn350) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn350.r, odriverConn350.extra)
+}
+func (odriverConn350 *tdriverConn350) Close() error {
+	return realDCClose(odriverConn350.r, odriverConn350.extra)
+}
+func (odriverConn350 *tdriverConn350) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn350.r, odriverConn350.extra, query)
+}
+func (odriverConn350 *tdriverConn350) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn350.r, odriverConn350.extra, ctx, query)
+}
+func (odriverConn350 *tdriverConn350) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn350.r, odriverConn350.extra, ctx, query, args)
+}
+func (odriverConn350 *tdriverConn350) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn350.r, odriverConn350.extra, param0)
+}
+func (odriverConn350 *tdriverConn350) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn350.r, odriverConn350.extra, query, args)
+}
+func (odriverConn350 *tdriverConn350) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn350.r, odriverConn350.extra, ctx)
+}
+
+func (odriverConn351 *tdriverConn351) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn351.r, odriverConn351.extra)
+}
+func (odriverConn351 *tdriverConn351) Close() error {
+	return realDCClose(odriverConn351.r, odriverConn351.extra)
+}
+func (odriverConn351 *tdriverConn351) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn351.r, odriverConn351.extra, query)
+}
+func (odriverConn351 *tdriverConn351) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn351.r, odriverConn351.extra, ctx, query)
+}
+func (odriverConn351 *tdriverConn351) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn351.r, odriverConn351.extra, ctx, query, args)
+}
+func (odriverConn351 *tdriverConn351) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn351.r, odriverConn351.extra, param0)
+}
+func (odriverConn351 *tdriverConn351) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn351.r, odriverConn351.extra, ctx, query, args)
+}
+func (odriverConn351 *tdriverConn351) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn351.r, odriverConn351.extra, ctx)
+}
+
+func (odriverConn352 *tdriverConn352) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn352.r, odriverConn352.extra)
+}
+func (odriverConn352 *tdriverConn352) Close() error {
+	return realDCClose(odriverConn352.r, odriverConn352.extra)
+}
+func (odriverConn352 *tdriverConn352) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn352.r, odriverConn352.extra, query)
+}
+func (odriverConn352 *tdriverConn352) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn352.r, odriverConn352.extra, ctx, query)
+}
+func (odriverConn352 *tdriverConn352) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn352.r, odriverConn352.extra, ctx, query, args)
+}
+func (odriverConn352 *tdriverConn352) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn352.r, odriverConn352.extra, ctx)
+}
+func (odriverConn352 *tdriverConn352) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn352.r, odriverConn352.extra, query, args)
+}
+func (odriverConn352 *tdriverConn352) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn352.r, odriverConn352.extra, ctx, query, args)
+}
+
+func (odriverConn353 *tdriverConn353) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn353.r, odriverConn353.extra)
+}
+func (odriverConn353 *tdriverConn353) Close() error {
+	return realDCClose(odriverConn353.r, odriverConn353.extra)
+}
+func (odriverConn353 *tdriverConn353) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn353.r, odriverConn353.extra, query)
+}
+func (odriverConn353 *tdriverConn353) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn353.r, odriverConn353.extra, ctx, query)
+}
+func (odriverConn353 *tdriverConn353) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn353.r, odriverConn353.extra, ctx, query, args)
+}
+func (odriverConn353 *tdriverConn353) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn353.r, odriverConn353.extra, ctx)
+}
+func (odriverConn353 *tdriverConn353) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn353.r, odriverConn353.extra, query, args)
+}
+func (odriverConn353 *tdriverConn353) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn353.r, odriverConn353.extra, ctx)
+}
+
+func (odriverConn354 *tdriverConn354) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn354.r, odriverConn354.extra)
+}
+func (odriverConn354 *tdriverConn354) Close() error {
+	return realDCClose(odriverConn354.r, odriverConn354.extra)
+}
+func (odriverConn354 *tdriverConn354) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn354.r, odriverConn354.extra, query)
+}
+func (odriverConn354 *tdriverConn354) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn354.r, odriverConn354.extra, ctx, query)
+}
+func (odriverConn354 *tdriverConn354) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn354.r, odriverConn354.extra, ctx, query, args)
+}
+func (odriverConn354 *tdriverConn354) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn354.r, odriverConn354.extra, ctx)
+}
+func (odriverConn354 *tdriverConn354) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn354.r, odriverConn354.extra, ctx, query, args)
+}
+func (odriverConn354 *tdriverConn354) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn354.r, odriverConn354.extra, ctx)
+}
+
+func (odriverConn355 *tdriverConn355) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn355.r, odriverConn355.extra)
+}
+func (odriverConn355 *tdriverConn355) Close() error {
+	return realDCClose(odriverConn355.r, odriverConn355.extra)
+}
+func (odriverConn355 *tdriverConn355) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn355.r, odriverConn355.extra, query)
+}
+func (odriverConn355 *tdriverConn355) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn355.r, odriverConn355.extra, ctx, query)
+}
+func (odriverConn355 *tdriverConn355) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn355.r, odriverConn355.extra, ctx, query, args)
+}
+func (odriverConn355 *tdriverConn355) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn355.r, odriverConn355.extra, query, args)
+}
+func (odriverConn355 *tdriverConn355) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn355.r, odriverConn355.extra, ctx, query, args)
+}
+func (odriverConn355 *tdriverConn355) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn355.r, odriverConn355.extra, ctx)
+}
+
+func (odriverConn356 *tdriverConn356) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn356.r, odriverConn356.extra)
+}
+func (odriverConn356 *tdriverConn356) Close() error {
+	return realDCClose(odriverConn356.r, odriverConn356.extra)
+}
+func (odriverConn356 *tdriverConn356) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn356.r, odriverConn356.extra, query)
+}
+func (odriverConn356 *tdriverConn356) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn356.r, odriverConn356.extra, ctx, query)
+}
+func (odriverConn356 *tdriverConn356) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn356.r, odriverConn356.extra, param0)
+}
+func (odriverConn356 *tdriverConn356) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn356.r, odriverConn356.extra, ctx)
+}
+func (odriverConn356 *tdriverConn356) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn356.r, odriverConn356.extra, query, args)
+}
+func (odriverConn356 *tdriverConn356) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn356.r, odriverConn356.extra, ctx, query, args)
+}
+
+func (odriverConn357 *tdriverConn357) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn357.r, odriverConn357.extra)
+}
+func (odriverConn357 *tdriverConn357) Close() error {
+	return realDCClose(odriverConn357.r, odriverConn357.extra)
+}
+func (odriverConn357 *tdriverConn357) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn357.r, odriverConn357.extra, query)
+}
+func (odriverConn357 *tdriverConn357) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn357.r, odriverConn357.extra, ctx, query)
+}
+func (odriverConn357 *tdriverConn357) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn357.r, odriverConn357.extra, param0)
+}
+func (odriverConn357 *tdriverConn357) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn357.r, odriverConn357.extra, ctx)
+}
+func (odriverConn357 *tdriverConn357) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn357.r, odriverConn357.extra, query, args)
+}
+func (odriverConn357 *tdriverConn357) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn357.r, odriverConn357.extra, ctx)
+}
+
+func (odriverConn358 *tdriverConn358) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn358.r, odriverConn358.extra)
+}
+func (odriverConn358 *tdriverConn358) Close() error {
+	return realDCClose(odriverConn358.r, odriverConn358.extra)
+}
+func (odriverConn358 *tdriverConn358) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn358.r, odriverConn358.extra, query)
+}
+func (odriverConn358 *tdriverConn358) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn358.r, odriverConn358.extra, ctx, query)
+}
+func (odriverConn358 *tdriverConn358) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn358.r, odriverConn358.extra, param0)
+}
+func (odriverConn358 *tdriverConn358) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn358.r, odriverConn358.extra, ctx)
+}
+func (odriverConn358 *tdriverConn358) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn358.r, odriverConn358.extra, ctx, query, args)
+}
+func (odriverConn358 *tdriverConn358) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn358.r, odriverConn358.extra, ctx)
+}
+
+func (odriverConn359 *tdriverConn359) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn359.r, odriverConn359.extra)
+}
+func (odriverConn359 *tdriverConn359) Close() error {
+	return realDCClose(odriverConn359.r, odriverConn359.extra)
+}
+func (odriverConn359 *tdriverConn359) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn359.r, odriverConn359.extra, query)
+}
+func (odriverConn359 *tdriverConn359) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn359.r, odriverConn359.extra, ctx, query)
+}
+func (odriverConn359 *tdriverConn359) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn359.r, odriverConn359.extra, param0)
+}
+func (odriverConn359 *tdriverConn359) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn359.r, odriverConn359.extra, query, args)
+}
+func (odriverConn359 *tdriverConn359) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn359.r, odriverConn359.extra, ctx, query, args)
+}
+func (odriverConn359 *tdriverConn359) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn359.r, odriverConn359.extra, ctx)
+}
+
+func (odriverConn360 *tdriverConn360) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn360.r, odriverConn360.extra)
+}
+func (odriverConn360 *tdriverConn360) Close() error {
+	return realDCClose(odriverConn360.r, odriverConn360.extra)
+}
+func (odriverConn360 *tdriverConn360) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn360.r, odriverConn360.extra, query)
+}
+func (odriverConn360 *tdriverConn360) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn360.r, odriverConn360.extra, ctx, query)
+}
+func (odriverConn360 *tdriverConn360) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn360.r, odriverConn360.extra, ctx)
+}
+func (odriverConn360 *tdriverConn360) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn360.r, odriverConn360.extra, query, args)
+}
+func (odriverConn360 *tdriverConn360) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn360.r, odriverConn360.extra, ctx, query, args)
+}
+func (odriverConn360 *tdriverConn360) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn360.r, odriverConn360.extra, ctx)
+}
+
+func (odriverConn361 *tdriverConn361) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn361.r, odriverConn361.extra)
+}
+func (odriverConn361 *tdriverConn361) Close() error {
+	return realDCClose(odriverConn361.r, odriverConn361.extra)
+}
+func (odriverConn361 *tdriverConn361) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn361.r, odriverConn361.extra, query)
+}
+func (odriverConn361 *tdriverConn361) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn361.r, odriverConn361.extra, query, args)
+}
+func (odriverConn361 *tdriverConn361) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn361.r, odriverConn361.extra, ctx, query, args)
+}
+func (odriverConn361 *tdriverConn361) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn361.r, odriverConn361.extra, param0)
+}
+func (odriverConn361 *tdriverConn361) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn361.r, odriverConn361.extra, ctx)
+}
+func (odriverConn361 *tdriverConn361) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn361.r, odriverConn361.extra, query, args)
+}
+
+func (odriverConn362 *tdriverConn362) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn362.r, odriverConn362.extra)
+}
+func (odriverConn362 *tdriverConn362) Close() error {
+	return realDCClose(odriverConn362.r, odriverConn362.extra)
+}
+func (odriverConn362 *tdriverConn362) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn362.r, odriverConn362.extra, query)
+}
+func (odriverConn362 *tdriverConn362) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn362.r, odriverConn362.extra, query, args)
+}
+func (odriverConn362 *tdriverConn362) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn362.r, odriverConn362.extra, ctx, query, args)
+}
+func (odriverConn362 *tdriverConn362) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn362.r, odriverConn362.extra, param0)
+}
+func (odriverConn362 *tdriverConn362) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn362.r, odriverConn362.extra, ctx)
+}
+func (odriverConn362 *tdriverConn362) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn362.r, odriverConn362.extra, ctx, query, args)
+}
+
+func (odriverConn363 *tdriverConn363) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn363.r, odriverConn363.extra)
+}
+func (odriverConn363 *tdriverConn363) Close() error {
+	return realDCClose(odriverConn363.r, odriverConn363.extra)
+}
+func (odriverConn363 *tdriverConn363) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn363.r, odriverConn363.extra, query)
+}
+func (odriverConn363 *tdriverConn363) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn363.r, odriverConn363.extra, query, args)
+}
+func (odriverConn363 *tdriverConn363) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn363.r, odriverConn363.extra, ctx, query, args)
+}
+func (odriverConn363 *tdriverConn363) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn363.r, odriverConn363.extra, param0)
+}
+func (odriverConn363 *tdriverConn363) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn363.r, odriverConn363.extra, ctx)
+}
+func (odriverConn363 *tdriverConn363) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn363.r, odriverConn363.extra, ctx)
+}
+
+func (odriverConn364 *tdriverConn364) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn364.r, odriverConn364.extra)
+}
+func (odriverConn364 *tdriverConn364) Close() error {
+	return realDCClose(odriverConn364.r, odriverConn364.extra)
+}
+func (odriverConn364 *tdriverConn364) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn364.r, odriverConn364.extra, query)
+}
+func (odriverConn364 *tdriverConn364) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn364.r, odriverConn364.extra, query, args)
+}
+func (odriverConn364 *tdriverConn364) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn364.r, odriverConn364.extra, ctx, query, args)
+}
+func (odriverConn364 *tdriverConn364) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn364.r, odriverConn364.extra, param0)
+}
+func (odriverConn364 *tdriverConn364) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn364.r, odriverConn364.extra, query, args)
+}
+func (odriverConn364 *tdriverConn364) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn364.r, odriverConn364.extra, ctx, query, args)
+}
+
+func (odriverConn365 *tdriverConn365) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn365.r, odriverConn365.extra)
+}
+func (odriverConn365 *tdriverConn365) Close() error {
+	return realDCClose(odriverConn365.r, odriverConn365.extra)
+}
+func (odriverConn365 *tdriverConn365) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn365.r, odriverConn365.extra, query)
+}
+func (odriverConn365 *tdriverConn365) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn365.r, odriverConn365.extra, query, args)
+}
+func (odriverConn365 *tdriverConn365) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn365.r, odriverConn365.extra, ctx, query, args)
+}
+func (odriverConn365 *tdriverConn365) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn365.r, odriverConn365.extra, param0)
+}
+func (odriverConn365 *tdriverConn365) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn365.r, odriverConn365.extra, query, args)
+}
+func (odriverConn365 *tdriverConn365) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn365.r, odriverConn365.extra, ctx)
+}
+
+func (odriverConn366 *tdriverConn366) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn366.r, odriverConn366.extra)
+}
+func (odriverConn366 *tdriverConn366) Close() error {
+	return realDCClose(odriverConn366.r, odriverConn366.extra)
+}
+func (odriverConn366 *tdriverConn366) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn366.r, odriverConn366.extra, query)
+}
+func (odriverConn366 *tdriverConn366) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn366.r, odriverConn366.extra, query, args)
+}
+func (odriverConn366 *tdriverConn366) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn366.r, odriverConn366.extra, ctx, query, args)
+}
+func (odriverConn366 *tdriverConn366) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn366.r, odriverConn366.extra, param0)
+}
+func (odriverConn366 *tdriverConn366) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn366.r, odriverConn366.extra, ctx, query, args)
+}
+func (odriverConn366 *tdriverConn366) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn366.r, odriverConn366.extra, ctx)
+}
+
+func (odriverConn367 *tdriverConn367) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn367.r, odriverConn367.extra)
+}
+func (odriverConn367 *tdriverConn367) Close() error {
+	return realDCClose(odriverConn367.r, odriverConn367.extra)
+}
+func (odriverConn367 *tdriverConn367) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn367.r, odriverConn367.extra, query)
+}
+func (odriverConn367 *tdriverConn367) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn367.r, odriverConn367.extra, query, args)
+}
+func (odriverConn367 *tdriverConn367) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn367.r, odriverConn367.extra, ctx, query, args)
+}
+func (odriverConn367 *tdriverConn367) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn367.r, odriverConn367.extra, ctx)
+}
+func (odriverConn367 *tdriverConn367) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn367.r, odriverConn367.extra, query, args)
+}
+func (odriverConn367 *tdriverConn367) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn367.r, odriverConn367.extra, ctx, query, args)
+}
+
+func (odriverConn368 *tdriverConn368) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn368.r, odriverConn368.extra)
+}
+func (odriverConn368 *tdriverConn368) Close() error {
+	return realDCClose(odriverConn368.r, odriverConn368.extra)
+}
+func (odriverConn368 *tdriverConn368) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn368.r, odriverConn368.extra, query)
+}
+func (odriverConn368 *tdriverConn368) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn368.r, odriverConn368.extra, query, args)
+}
+func (odriverConn368 *tdriverConn368) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn368.r, odriverConn368.extra, ctx, query, args)
+}
+func (odriverConn368 *tdriverConn368) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn368.r, odriverConn368.extra, ctx)
+}
+func (odriverConn368 *tdriverConn368) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn368.r, odriverConn368.extra, query, args)
+}
+func (odriverConn368 *tdriverConn368) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn368.r, odriverConn368.extra, ctx)
+}
+
+func (odriverConn369 *tdriverConn369) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn369.r, odriverConn369.extra)
+}
+func (odriverConn369 *tdriverConn369) Close() error {
+	return realDCClose(odriverConn369.r, odriverConn369.extra)
+}
+func (odriverConn369 *tdriverConn369) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn369.r, odriverConn369.extra, query)
+}
+func (odriverConn369 *tdriverConn369) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn369.r, odriverConn369.extra, query, args)
+}
+func (odriverConn369 *tdriverConn369) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn369.r, odriverConn369.extra, ctx, query, args)
+}
+func (odriverConn369 *tdriverConn369) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn369.r, odriverConn369.extra, ctx)
+}
+func (odriverConn369 *tdriverConn369) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn369.r, odriverConn369.extra, ctx, query, args)
+}
+func (odriverConn369 *tdriverConn369) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn369.r, odriverConn369.extra, ctx)
+}
+
+func (odriverConn370 *tdriverConn370) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn370.r, odriverConn370.extra)
+}
+func (odriverConn370 *tdriverConn370) Close() error {
+	return realDCClose(odriverConn370.r, odriverConn370.extra)
+}
+func (odriverConn370 *tdriverConn370) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn370.r, odriverConn370.extra, query)
+}
+func (odriverConn370 *tdriverConn370) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn370.r, odriverConn370.extra, query, args)
+}
+func (odriverConn370 *tdriverConn370) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn370.r, odriverConn370.extra, ctx, query, args)
+}
+func (odriverConn370 *tdriverConn370) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn370.r, odriverConn370.extra, query, args)
+}
+func (odriverConn370 *tdriverConn370) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn370.r, odriverConn370.extra, ctx, query, args)
+}
+func (odriverConn370 *tdriverConn370) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn370.r, odriverConn370.extra, ctx)
+}
+
+func (odriverConn371 *tdriverConn371) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn371.r, odriverConn371.extra)
+}
+func (odriverConn371 *tdriverConn371) Close() error {
+	return realDCClose(odriverConn371.r, odriverConn371.extra)
+}
+func (odriverConn371 *tdriverConn371) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn371.r, odriverConn371.extra, query)
+}
+func (odriverConn371 *tdriverConn371) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn371.r, odriverConn371.extra, query, args)
+}
+func (odriverConn371 *tdriverConn371) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn371.r, odriverConn371.extra, param0)
+}
+func (odriverConn371 *tdriverConn371) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn371.r, odriverConn371.extra, ctx)
+}
+func (odriverConn371 *tdriverConn371) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn371.r, odriverConn371.extra, query, args)
+}
+func (odriverConn371 *tdriverConn371) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn371.r, odriverConn371.extra, ctx, query, args)
+}
+
+func (odriverConn372 *tdriverConn372) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn372.r, odriverConn372.extra)
+}
+func (odriverConn372 *tdriverConn372) Close() error {
+	return realDCClose(odriverConn372.r, odriverConn372.extra)
+}
+func (odriverConn372 *tdriverConn372) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn372.r, odriverConn372.extra, query)
+}
+func (odriverConn372 *tdriverConn372) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn372.r, odriverConn372.extra, query, args)
+}
+func (odriverConn372 *tdriverConn372) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn372.r, odriverConn372.extra, param0)
+}
+func (odriverConn372 *tdriverConn372) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn372.r, odriverConn372.extra, ctx)
+}
+func (odriverConn372 *tdriverConn372) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn372.r, odriverConn372.extra, query, args)
+}
+func (odriverConn372 *tdriverConn372) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn372.r, odriverConn372.extra, ctx)
+}
+
+func (odriverConn373 *tdriverConn373) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn373.r, odriverConn373.extra)
+}
+func (odriverConn373 *tdriverConn373) Close() error {
+	return realDCClose(odriverConn373.r, odriverConn373.extra)
+}
+func (odriverConn373 *tdriverConn373) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn373.r, odriverConn373.extra, query)
+}
+func (odriverConn373 *tdriverConn373) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn373.r, odriverConn373.extra, query, args)
+}
+func (odriverConn373 *tdriverConn373) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn373.r, odriverConn373.extra, param0)
+}
+func (odriverConn373 *tdriverConn373) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn373.r, odriverConn373.extra, ctx)
+}
+func (odriverConn373 *tdriverConn373) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn373.r, odriverConn373.extra, ctx, query, args)
+}
+func (odriverConn373 *tdriverConn373) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn373.r, odriverConn373.extra, ctx)
+}
+
+func (odriverConn374 *tdriverConn374) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn374.r, odriverConn374.extra)
+}
+func (odriverConn374 *tdriverConn374) Close() error {
+	return realDCClose(odriverConn374.r, odriverConn374.extra)
+}
+func (odriverConn374 *tdriverConn374) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn374.r, odriverConn374.extra, query)
+}
+func (odriverConn374 *tdriverConn374) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn374.r, odriverConn374.extra, query, args)
+}
+func (odriverConn374 *tdriverConn374) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn374.r, odriverConn374.extra, param0)
+}
+func (odriverConn374 *tdriverConn374) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn374.r, odriverConn374.extra, query, args)
+}
+func (odriverConn374 *tdriverConn374) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn374.r, odriverConn374.extra, ctx, query, args)
+}
+func (odriverConn374 *tdriverConn374) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn374.r, odriverConn374.extra, ctx)
+}
+
+func (odriverConn375 *tdriverConn375) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn375.r, odriverConn375.extra)
+}
+func (odriverConn375 *tdriverConn375) Close() error {
+	return realDCClose(odriverConn375.r, odriverConn375.extra)
+}
+func (odriverConn375 *tdriverConn375) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn375.r, odriverConn375.extra, query)
+}
+func (odriverConn375 *tdriverConn375) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn375.r, odriverConn375.extra, query, args)
+}
+func (odriverConn375 *tdriverConn375) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn375.r, odriverConn375.extra, ctx)
+}
+func (odriverConn375 *tdriverConn375) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn375.r, odriverConn375.extra, query, args)
+}
+func (odriverConn375 *tdriverConn375) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn375.r, odriverConn375.extra, ctx, query, args)
+}
+func (odriverConn375 *tdriverConn375) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn375.r, odriverConn375.extra, ctx)
+}
+
+func (odriverConn376 *tdriverConn376) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn376.r, odriverConn376.extra)
+}
+func (odriverConn376 *tdriverConn376) Close() error {
+	return realDCClose(odriverConn376.r, odriverConn376.extra)
+}
+func (odriverConn376 *tdriverConn376) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn376.r, odriverConn376.extra, query)
+}
+func (odriverConn376 *tdriverConn376) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn376.r, odriverConn376.extra, ctx, query, args)
+}
+func (odriverConn376 *tdriverConn376) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn376.r, odriverConn376.extra, param0)
+}
+func (odriverConn376 *tdriverConn376) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn376.r, odriverConn376.extra, ctx)
+}
+func (odriverConn376 *tdriverConn376) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn376.r, odriverConn376.extra, query, args)
+}
+func (odriverConn376 *tdriverConn376) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn376.r, odriverConn376.extra, ctx, query, args)
+}
+
+func (odriverConn377 *tdriverConn377) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn377.r, odriverConn377.extra)
+}
+func (odriverConn377 *tdriverConn377) Close() error {
+	return realDCClose(odriverConn377.r, odriverConn377.extra)
+}
+func (odriverConn377 *tdriverConn377) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn377.r, odriverConn377.extra, query)
+}
+func (odriverConn377 *tdriverConn377) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn377.r, odriverConn377.extra, ctx, query, args)
+}
+func (odriverConn377 *tdriverConn377) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn377.r, odriverConn377.extra, param0)
+}
+func (odriverConn377 *tdriverConn377) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn377.r, odriverConn377.extra, ctx)
+}
+func (odriverConn377 *tdriverConn377) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn377.r, odriverConn377.extra, query, args)
+}
+func (odriverConn377 *tdriverConn377) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn377.r, odriverConn377.extra, ctx)
+}
+
+func (odriverConn378 *tdriverConn378) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn378.r, odriverConn378.extra)
+}
+func (odriverConn378 *tdriverConn378) Close() error {
+	return realDCClose(odriverConn378.r, odriverConn378.extra)
+}
+func (odriverConn378 *tdriverConn378) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn378.r, odriverConn378.extra, query)
+}
+func (odriverConn378 *tdriverConn378) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn378.r, odriverConn378.extra, ctx, query, args)
+}
+func (odriverConn378 *tdriverConn378) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn378.r, odriverConn378.extra, param0)
+}
+func (odriverConn378 *tdriverConn378) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn378.r, odriverConn378.extra, ctx)
+}
+func (odriverConn378 *tdriverConn378) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn378.r, odriverConn378.extra, ctx, query, args)
+}
+func (odriverConn378 *tdriverConn378) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn378.r, odriverConn378.extra, ctx)
+}
+
+func (odriverConn379 *tdriverConn379) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn379.r, odriverConn379.extra)
+}
+func (odriverConn379 *tdriverConn379) Close() error {
+	return realDCClose(odriverConn379.r, odriverConn379.extra)
+}
+func (odriverConn379 *tdriverConn379) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn379.r, odriverConn379.extra, query)
+}
+func (odriverConn379 *tdriverConn379) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn379.r, odriverConn379.extra, ctx, query, args)
+}
+func (odriverConn379 *tdriverConn379) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn379.r, odriverConn379.extra, param0)
+}
+func (odriverConn379 *tdriverConn379) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn379.r, odriverConn379.extra, query, args)
+}
+func (odriverConn379 *tdriverConn379) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn379.r, odriverConn379.extra, ctx, query, args)
+}
+func (odriverConn379 *tdriverConn379) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn379.r, odriverConn379.extra, ctx)
+}
+
+func (odriverConn380 *tdriverConn380) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn380.r, odriverConn380.extra)
+}
+func (odriverConn380 *tdriverConn380) Close() error {
+	return realDCClose(odriverConn380.r, odriverConn380.extra)
+}
+func (odriverConn380 *tdriverConn380) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn380.r, odriverConn380.extra, query)
+}
+func (odriverConn380 *tdriverConn380) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn380.r, odriverConn380.extra, ctx, query, args)
+}
+func (odriverConn380 *tdriverConn380) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn380.r, odriverConn380.extra, ctx)
+}
+func (odriverConn380 *tdriverConn380) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn380.r, odriverConn380.extra, query, args)
+}
+func (odriverConn380 *tdriverConn380) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn380.r, odriverConn380.extra, ctx, query, args)
+}
+func (odriverConn380 *tdriverConn380) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn380.r, odriverConn380.extra, ctx)
+}
+
+func (odriverConn381 *tdriverConn381) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn381.r, odriverConn381.extra)
+}
+func (odriverConn381 *tdriverConn381) Close() error {
+	return realDCClose(odriverConn381.r, odriverConn381.extra)
+}
+func (odriverConn381 *tdriverConn381) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn381.r, odriverConn381.extra, query)
+}
+func (odriverConn381 *tdriverConn381) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn381.r, odriverConn381.extra, param0)
+}
+func (odriverConn381 *tdriverConn381) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn381.r, odriverConn381.extra, ctx)
+}
+func (odriverConn381 *tdriverConn381) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn381.r, odriverConn381.extra, query, args)
+}
+func (odriverConn381 *tdriverConn381) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn381.r, odriverConn381.extra, ctx, query, args)
+}
+func (odriverConn381 *tdriverConn381) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn381.r, odriverConn381.extra, ctx)
+}
+
+func (odriverConn382 *tdriverConn382) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn382.r, odriverConn382.extra)
+}
+func (odriverConn382 *tdriverConn382) Close() error {
+	return realDCClose(odriverConn382.r, odriverConn382.extra)
+}
+func (odriverConn382 *tdriverConn382) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn382.r, odriverConn382.extra, query)
+}
+func (odriverConn382 *tdriverConn382) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn382.r, odriverConn382.extra, ctx, opts)
+}
+func (odriverConn382 *tdriverConn382) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn382.r, odriverConn382.extra, ctx, query)
+}
+func (odriverConn382 *tdriverConn382) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn382.r, odriverConn382.extra, query, args)
+}
+func (odriverConn382 *tdriverConn382) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn382.r, odriverConn382.extra, ctx, query, args)
+}
+func (odriverConn382 *tdriverConn382) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn382.r, odriverConn382.extra, param0)
+}
+func (odriverConn382 *tdriverConn382) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn382.r, odriverConn382.extra, ctx)
+}
+
+func (odriverConn383 *tdriverConn383) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn383.r, odriverConn383.extra)
+}
+func (odriverConn383 *tdriverConn383) Close() error {
+	return realDCClose(odriverConn383.r, odriverConn383.extra)
+}
+func (odriverConn383 *tdriverConn383) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn383.r, odriverConn383.extra, query)
+}
+func (odriverConn383 *tdriverConn383) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn383.r, odriverConn383.extra, ctx, opts)
+}
+func (odriverConn383 *tdriverConn383) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn383.r, odriverConn383.extra, ctx, query)
+}
+func (odriverConn383 *tdriverConn383) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn383.r, odriverConn383.extra, query, args)
+}
+func (odriverConn383 *tdriverConn383) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn383.r, odriverConn383.extra, ctx, query, args)
+}
+func (odriverConn383 *tdriverConn383) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn383.r, odriverConn383.extra, param0)
+}
+func (odriverConn383 *tdriverConn383) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn383.r, odriverConn383.extra, query, args)
+}
+
+func (odriverConn384 *tdriverConn384) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn384.r, odriverConn384.extra)
+}
+func (odriverConn384 *tdriverConn384) Close() error {
+	return realDCClose(odriverConn384.r, odriverConn384.extra)
+}
+func (odriverConn384 *tdriverConn384) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn384.r, odriverConn384.extra, query)
+}
+func (odriverConn384 *tdriverConn384) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn384.r, odriverConn384.extra, ctx, opts)
+}
+func (odriverConn384 *tdriverConn384) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn384.r, odriverConn384.extra, ctx, query)
+}
+func (odriverConn384 *tdriverConn384) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn384.r, odriverConn384.extra, query, args)
+}
+func (odriverConn384 *tdriverConn384) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn384.r, odriverConn384.extra, ctx, query, args)
+}
+func (odriverConn384 *tdriverConn384) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn384.r, odriverConn384.extra, param0)
+}
+func (odriverConn384 *tdriverConn384) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn384.r, odriverConn384.extra, ctx, query, args)
+}
+
+func (odriverConn385 *tdriverConn385) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn385.r, odriverConn385.extra)
+}
+func (odriverConn385 *tdriverConn385) Close() error {
+	return realDCClose(odriverConn385.r, odriverConn385.extra)
+}
+func (odriverConn385 *tdriverConn385) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn385.r, odriverConn385.extra, query)
+}
+func (odriverConn385 *tdriverConn385) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn385.r, odriverConn385.extra, ctx, opts)
+}
+func (odriverConn385 *tdriverConn385) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn385.r, odriverConn385.extra, ctx, query)
+}
+func (odriverConn385 *tdriverConn385) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn385.r, odriverConn385.extra, query, args)
+}
+func (odriverConn385 *tdriverConn385) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn385.r, odriverConn385.extra, ctx, query, args)
+}
+func (odriverConn385 *tdriverConn385) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn385.r, odriverConn385.extra, param0)
+}
+func (odriverConn385 *tdriverConn385) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn385.r, odriverConn385.extra, ctx)
+}
+
+func (odriverConn386 *tdriverConn386) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn386.r, odriverConn386.extra)
+}
+func (odriverConn386 *tdriverConn386) Close() error {
+	return realDCClose(odriverConn386.r, odriverConn386.extra)
+}
+func (odriverConn386 *tdriverConn386) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn386.r, odriverConn386.extra, query)
+}
+func (odriverConn386 *tdriverConn386) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn386.r, odriverConn386.extra, ctx, opts)
+}
+func (odriverConn386 *tdriverConn386) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn386.r, odriverConn386.extra, ctx, query)
+}
+func (odriverConn386 *tdriverConn386) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn386.r, odriverConn386.extra, query, args)
+}
+func (odriverConn386 *tdriverConn386) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn386.r, odriverConn386.extra, ctx, query, args)
+}
+func (odriverConn386 *tdriverConn386) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn386.r, odriverConn386.extra, ctx)
+}
+func (odriverConn386 *tdriverConn386) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn386.r, odriverConn386.extra, query, args)
+}
+
+func (odriverConn387 *tdriverConn387) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn387.r, odriverConn387.extra)
+}
+func (odriverConn387 *tdriverConn387) Close() error {
+	return realDCClose(odriverConn387.r, odriverConn387.extra)
+}
+func (odriverConn387 *tdriverConn387) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn387.r, odriverConn387.extra, query)
+}
+func (odriverConn387 *tdriverConn387) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn387.r, odriverConn387.extra, ctx, opts)
+}
+func (odriverConn387 *tdriverConn387) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn387.r, odriverConn387.extra, ctx, query)
+}
+func (odriverConn387 *tdriverConn387) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn387.r, odriverConn387.extra, query, args)
+}
+func (odriverConn387 *tdriverConn387) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn387.r, odriverConn387.extra, ctx, query, args)
+}
+func (odriverConn387 *tdriverConn387) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn387.r, odriverConn387.extra, ctx)
+}
+func (odriverConn387 *tdriverConn387) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn387.r, odriverConn387.extra, ctx, query, args)
+}
+
+func (odriverConn388 *tdriverConn388) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn388.r, odriverConn388.extra)
+}
+func (odriverConn388 *tdriverConn388) Close() error {
+	return realDCClose(odriverConn388.r, odriverConn388.extra)
+}
+func (odriverConn388 *tdriverConn388) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn388.r, odriverConn388.extra, query)
+}
+func (odriverConn388 *tdriverConn388) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn388.r, odriverConn388.extra, ctx, opts)
+}
+func (odriverConn388 *tdriverConn388) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn388.r, odriverConn388.extra, ctx, query)
+}
+func (odriverConn388 *tdriverConn388) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn388.r, odriverConn388.extra, query, args)
+}
+func (odriverConn388 *tdriverConn388) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn388.r, odriverConn388.extra, ctx, query, args)
+}
+func (odriverConn388 *tdriverConn388) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn388.r, odriverConn388.extra, ctx)
+}
+func (odriverConn388 *tdriverConn388) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn388.r, odriverConn388.extra, ctx)
+}
+
+func (odriverConn389 *tdriverConn389) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn389.r, odriverConn389.extra)
+}
+func (odriverConn389 *tdriverConn389) Close() error {
+	return realDCClose(odriverConn389.r, odriverConn389.extra)
+}
+func (odriverConn389 *tdriverConn389) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn389.r, odriverConn389.extra, query)
+}
+func (odriverConn389 *tdriverConn389) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn389.r, odriverConn389.extra, ctx, opts)
+}
+func (odriverConn389 *tdriverConn389) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn389.r, odriverConn389.extra, ctx, query)
+}
+func (odriverConn389 *tdriverConn389) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn389.r, odriverConn389.extra, query, args)
+}
+func (odriverConn389 *tdriverConn389) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn389.r, odriverConn389.extra, ctx, query, args)
+}
+func (odriverConn389 *tdriverConn389) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn389.r, odriverConn389.extra, query, args)
+}
+func (odriverConn389 *tdriverConn389) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn389.r, odriverConn389.extra, ctx, query, args)
+}
+
+func (odriverConn390 *tdriverConn390) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn390.r, odriverConn390.extra)
+}
+func (odriverConn390 *tdriverConn390) Close() error {
+	return realDCClose(odriverConn390.r, odriverConn390.extra)
+}
+func (odriverConn390 *tdriverConn390) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn390.r, odriverConn390.extra, query)
+}
+func (odriverConn390 *tdriverConn390) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn390.r, odriverConn390.extra, ctx, opts)
+}
+func (odriverConn390 *tdriverConn390) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn390.r, odriverConn390.extra, ctx, query)
+}
+func (odriverConn390 *tdriverConn390) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn390.r, odriverConn390.extra, query, args)
+}
+func (odriverConn390 *tdriverConn390) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn390.r, odriverConn390.extra, ctx, query, args)
+}
+func (odriverConn390 *tdriverConn390) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn390.r, odriverConn390.extra, query, args)
+}
+func (odriverConn390 *tdriverConn390) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn390.r, odriverConn390.extra, ctx)
+}
+
+func (odriverConn391 *tdriverConn391) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn391.r, odriverConn391.extra)
+}
+func (odriverConn391 *tdriverConn391) Close() error {
+	return realDCClose(odriverConn391.r, odriverConn391.extra)
+}
+func (odriverConn391 *tdriverConn391) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn391.r, odriverConn391.extra, query)
+}
+func (odriverConn391 *tdriverConn391) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn391.r, odriverConn391.extra, ctx, opts)
+}
+func (odriverConn391 *tdriverConn391) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn391.r, odriverConn391.extra, ctx, query)
+}
+func (odriverConn391 *tdriverConn391) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn391.r, odriverConn391.extra, query, args)
+}
+func (odriverConn391 *tdriverConn391) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn391.r, odriverConn391.extra, ctx, query, args)
+}
+func (odriverConn391 *tdriverConn391) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn391.r, odriverConn391.extra, ctx, query, args)
+}
+func (odriverConn391 *tdriverConn391) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn391.r, odriverConn391.extra, ctx)
+}
+
+func (odriverConn392 *tdriverConn392) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn392.r, odriverConn392.extra)
+}
+func (odriverConn392 *tdriverConn392) Close() error {
+	return realDCClose(odriverConn392.r, odriverConn392.extra)
+}
+func (odriverConn392 *tdriverConn392) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn392.r, odriverConn392.extra, query)
+}
+func (odriverConn392 *tdriverConn392) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn392.r, odriverConn392.extra, ctx, opts)
+}
+func (odriverConn392 *tdriverConn392) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn392.r, odriverConn392.extra, ctx, query)
+}
+func (odriverConn392 *tdriverConn392) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn392.r, odriverConn392.extra, query, args)
+}
+func (odriverConn392 *tdriverConn392) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn392.r, odriverConn392.extra, param0)
+}
+func (odriverConn392 *tdriverConn392) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn392.r, odriverConn392.extra, ctx)
+}
+func (odriverConn392 *tdriverConn392) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn392.r, odriverConn392.extra, query, args)
+}
+
+func (odriverConn393 *tdriverConn393) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn393.r, odriverConn393.extra)
+}
+func (odriverConn393 *tdriverConn393) Close() error {
+	return realDCClose(odriverConn393.r, odriverConn393.extra)
+}
+func (odriverConn393 *tdriverConn393) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn393.r, odriverConn393.extra, query)
+}
+func (odriverConn393 *tdriverConn393) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn393.r, odriverConn393.extra, ctx, opts)
+}
+func (odriverConn393 *tdriverConn393) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn393.r, odriverConn393.extra, ctx, query)
+}
+func (odriverConn393 *tdriverConn393) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn393.r, odriverConn393.extra, query, args)
+}
+func (odriverConn393 *tdriverConn393) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn393.r, odriverConn393.extra, param0)
+}
+func (odriverConn393 *tdriverConn393) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn393.r, odriverConn393.extra, ctx)
+}
+func (odriverConn393 *tdriverConn393) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn393.r, odriverConn393.extra, ctx, query, args)
+}
+
+func (odriverConn394 *tdriverConn394) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn394.r, odriverConn394.extra)
+}
+func (odriverConn394 *tdriverConn394) Close() error {
+	return realDCClose(odriverConn394.r, odriverConn394.extra)
+}
+func (odriverConn394 *tdriverConn394) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn394.r, odriverConn394.extra, query)
+}
+func (odriverConn394 *tdriverConn394) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn394.r, odriverConn394.extra, ctx, opts)
+}
+func (odriverConn394 *tdriverConn394) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn394.r, odriverConn394.extra, ctx, query)
+}
+func (odriverConn394 *tdriverConn394) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn394.r, odriverConn394.extra, query, args)
+}
+func (odriverConn394 *tdriverConn394) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn394.r, odriverConn394.extra, param0)
+}
+func (odriverConn394 *tdriverConn394) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn394.r, odriverConn394.extra, ctx)
+}
+func (odriverConn394 *tdriverConn394) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn394.r, odriverConn394.extra, ctx)
+}
+
+func (odriverConn395 *tdriverConn395) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn395.r, odriverConn395.extra)
+}
+func (odriverConn395 *tdriverConn395) Close() error {
+	return realDCClose(odriverConn395.r, odriverConn395.extra)
+}
+func (odriverConn395 *tdriverConn395) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn395.r, odriverConn395.extra, query)
+}
+func (odriverConn395 *tdriverConn395) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn395.r, odriverConn395.extra, ctx, opts)
+}
+func (odriverConn395 *tdriverConn395) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn395.r, odriverConn395.extra, ctx, query)
+}
+func (odriverConn395 *tdriverConn395) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn395.r, odriverConn395.extra, query, args)
+}
+func (odriverConn395 *tdriverConn395) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn395.r, odriverConn395.extra, param0)
+}
+func (odriverConn395 *tdriverConn395) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn395.r, odriverConn395.extra, query, args)
+}
+func (odriverConn395 *tdriverConn395) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn395.r, odriverConn395.extra, ctx, query, args)
+}
+
+func (odriverConn396 *tdriverConn396) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn396.r, odriverConn396.extra)
+}
+func (odriverConn396 *tdriverConn396) Close() error {
+	return realDCClose(odriverConn396.r, odriverConn396.extra)
+}
+func (odriverConn396 *tdriverConn396) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn396.r, odriverConn396.extra, query)
+}
+func (odriverConn396 *tdriverConn396) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn396.r, odriverConn396.extra, ctx, opts)
+}
+func (odriverConn396 *tdriverConn396) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn396.r, odriverConn396.extra, ctx, query)
+}
+func (odriverConn396 *tdriverConn396) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn396.r, odriverConn396.extra, query, args)
+}
+func (odriverConn396 *tdriverConn396) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn396.r, odriverConn396.extra, param0)
+}
+func (odriverConn396 *tdriverConn396) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn396.r, odriverConn396.extra, query, args)
+}
+func (odriverConn396 *tdriverConn396) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn396.r, odriverConn396.extra, ctx)
+}
+
+func (odriverConn397 *tdriverConn397) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn397.r, odriverConn397.extra)
+}
+func (odriverConn397 *tdriverConn397) Close() error {
+	return realDCClose(odriverConn397.r, odriverConn397.extra)
+}
+func (odriverConn397 *tdriverConn397) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn397.r, odriverConn397.extra, query)
+}
+func (odriverConn397 *tdriverConn397) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn397.r, odriverConn397.extra, ctx, opts)
+}
+func (odriverConn397 *tdriverConn397) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn397.r, odriverConn397.extra, ctx, query)
+}
+func (odriverConn397 *tdriverConn397) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn397.r, odriverConn397.extra, query, args)
+}
+func (odriverConn397 *tdriverConn397) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn397.r, odriverConn397.extra, param0)
+}
+func (odriverConn397 *tdriverConn397) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn397.r, odriverConn397.extra, ctx, query, args)
+}
+func (odriverConn397 *tdriverConn397) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn397.r, odriverConn397.extra, ctx)
+}
+
+func (odriverConn398 *tdriverConn398) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn398.r, odriverConn398.extra)
+}
+func (odriverConn398 *tdriverConn398) Close() error {
+	return realDCClose(odriverConn398.r, odriverConn398.extra)
+}
+func (odriverConn398 *tdriverConn398) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn398.r, odriverConn398.extra, query)
+}
+func (odriverConn398 *tdriverConn398) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn398.r, odriverConn398.extra, ctx, opts)
+}
+func (odriverConn398 *tdriverConn398) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn398.r, odriverConn398.extra, ctx, query)
+}
+func (odriverConn398 *tdriverConn398) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn398.r, odriverConn398.extra, query, args)
+}
+func (odriverConn398 *tdriverConn398) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn398.r, odriverConn398.extra, ctx)
+}
+func (odriverConn398 *tdriverConn398) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn398.r, odriverConn398.extra, query, args)
+}
+func (odriverConn398 *tdriverConn398) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn398.r, odriverConn398.extra, ctx, query, args)
+}
+
+func (odriverConn399 *tdriverConn399) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn399.r, odriverConn399.extra)
+}
+func (odriverConn399 *tdriverConn399) Close() error {
+	return realDCClose(odriverConn399.r, odriverConn399.extra)
+}
+func (odriverConn399 *tdriverConn399) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn399.r, odriverConn399.extra, query)
+}
+func (odriverConn399 *tdriverConn399) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn399.r, odriverConn399.extra, ctx, opts)
+}
+func (odriverConn399 *tdriverConn399) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn399.r, odriverConn399.extra, ctx, query)
+}
+func (odriverConn399 *tdriverConn399) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn399.r, odriverConn399.extra, query, args)
+}
+func (odriverConn399 *tdriverConn399) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn399.r, odriverConn399.extra, ctx)
+}
+func (odriverConn399 *tdriverConn399) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn399.r, odriverConn399.extra, query, args)
+}
+func (odriverConn399 *tdriverConn399) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn399.r, odriverConn399.extra, ctx)
+}
+
+func (odriverConn400 *tdriverConn400) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn400.r, odriverConn400.extra)
+}
+func (odriverConn400 *tdriverConn400) Close() error {
+	return realDCClose(odriverConn400.r, odriverConn400.extra)
+}
+func (odriverConn400 *tdriverConn400) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn400.r, odriverConn400.extra, query)
+}
+func (odriverConn400 *tdriverConn400) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn400.r, odriverConn400.extra, ctx, opts)
+}
+func (odriverConn400 *tdriverConn400) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn400.r, odriverConn400.extra, ctx, query)
+}
+func (odriverConn400 *tdriverConn400) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn400.r, odriverConn400.extra, query, args)
+}
+func (odriverConn400 *tdriverConn400) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn400.r, odriverConn400.extra, ctx)
+}
+func (odriverConn400 *tdriverConn400) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn400.r, odriverConn400.extra, ctx, query, args)
+}
+func (odriverConn400 *tdriverConn400) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn400.r, odriverConn400.extra, ctx)
+}
+
+func (odriverConn401 *tdriverConn401) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn401.r, odriverConn401.extra)
+}
+func (odriverConn401 *tdriverConn401) Close() error {
+	return realDCClose(odriverConn401.r, odriverConn401.extra)
+}
+func (odriverConn401 *tdriverConn401) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn401.r, odriverConn401.extra, query)
+}
+func (odriverConn401 *tdriverConn401) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn401.r, odriverConn401.extra, ctx, opts)
+}
+func (odriverConn401 *tdriverConn401) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn401.r, odriverConn401.extra, ctx, query)
+}
+func (odriverConn401 *tdriverConn401) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn401.r, odriverConn401.extra, query, args)
+}
+func (odriverConn401 *tdriverConn401) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn401.r, odriverConn401.extra, query, args)
+}
+func (odriverConn401 *tdriverConn401) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn401.r, odriverConn401.extra, ctx, query, args)
+}
+func (odriverConn401 *tdriverConn401) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn401.r, odriverConn401.extra, ctx)
+}
+
+func (odriverConn402 *tdriverConn402) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn402.r, odriverConn402.extra)
+}
+func (odriverConn402 *tdriverConn402) Close() error {
+	return realDCClose(odriverConn402.r, odriverConn402.extra)
+}
+func (odriverConn402 *tdriverConn402) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn402.r, odriverConn402.extra, query)
+}
+func (odriverConn402 *tdriverConn402) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn402.r, odriverConn402.extra, ctx, opts)
+}
+func (odriverConn402 *tdriverConn402) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn402.r, odriverConn402.extra, ctx, query)
+}
+func (odriverConn402 *tdriverConn402) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn402.r, odriverConn402.extra, ctx, query, args)
+}
+func (odriverConn402 *tdriverConn402) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn402.r, odriverConn402.extra, param0)
+}
+func (odriverConn402 *tdriverConn402) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn402.r, odriverConn402.extra, ctx)
+}
+func (odriverConn402 *tdriverConn402) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn402.r, odriverConn402.extra, query, args)
+}
+
+func (odriverConn403 *tdriverConn403) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn403.r, odriverConn403.extra)
+}
+func (odriverConn403 *tdriverConn403) Close() error {
+	return realDCClose(odriverConn403.r, odriverConn403.extra)
+}
+func (odriverConn403 *tdriverConn403) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn403.r, odriverConn403.extra, query)
+}
+func (odriverConn403 *tdriverConn403) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn403.r, odriverConn403.extra, ctx, opts)
+}
+func (odriverConn403 *tdriverConn403) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn403.r, odriverConn403.extra, ctx, query)
+}
+func (odriverConn403 *tdriverConn403) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn403.r, odriverConn403.extra, ctx, query, args)
+}
+func (odriverConn403 *tdriverConn403) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn403.r, odriverConn403.extra, param0)
+}
+func (odriverConn403 *tdriverConn403) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn403.r, odriverConn403.extra, ctx)
+}
+func (odriverConn403 *tdriverConn403) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn403.r, odriverConn403.extra, ctx, query, args)
+}
+
+func (odriverConn404 *tdriverConn404) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn404.r, odriverConn404.extra)
+}
+func (odriverConn404 *tdriverConn404) Close() error {
+	return realDCClose(odriverConn404.r, odriverConn404.extra)
+}
+func (odriverConn404 *tdriverConn404) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn404.r, odriverConn404.extra, query)
+}
+func (odriverConn404 *tdriverConn404) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn404.r, odriverConn404.extra, ctx, opts)
+}
+func (odriverConn404 *tdriverConn404) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn404.r, odriverConn404.extra, ctx, query)
+}
+func (odriverConn404 *tdriverConn404) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn404.r, odriverConn404.extra, ctx, query, args)
+}
+func (odriverConn404 *tdriverConn404) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn404.r, odriverConn404.extra, param0)
+}
+func (odriverConn404 *tdriverConn404) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn404.r, odriverConn404.extra, ctx)
+}
+func (odriverConn404 *tdriverConn404) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn404.r, odriverConn404.extra, ctx)
+}
+
+func (odriverConn405 *tdriverConn405) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn405.r, odriverConn405.extra)
+}
+func (odriverConn405 *tdriverConn405) Close() error {
+	return realDCClose(odriverConn405.r, odriverConn405.extra)
+}
+func (odriverConn405 *tdriverConn405) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn405.r, odriverConn405.extra, query)
+}
+func (odriverConn405 *tdriverConn405) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn405.r, odriverConn405.extra, ctx, opts)
+}
+func (odriverConn405 *tdriverConn405) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn405.r, odriverConn405.extra, ctx, query)
+}
+func (odriverConn405 *tdriverConn405) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn405.r, odriverConn405.extra, ctx, query, args)
+}
+func (odriverConn405 *tdriverConn405) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn405.r, odriverConn405.extra, param0)
+}
+func (odriverConn405 *tdriverConn405) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn405.r, odriverConn405.extra, query, args)
+}
+func (odriverConn405 *tdriverConn405) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn405.r, odriverConn405.extra, ctx, query, args)
+}
+
+func (odriverConn406 *tdriverConn406) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn406.r, odriverConn406.extra)
+}
+func (odriverConn406 *tdriverConn406) Close() error {
+	return realDCClose(odriverConn406.r, odriverConn406.extra)
+}
+func (odriverConn406 *tdriverConn406) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn406.r, odriverConn406.extra, query)
+}
+func (odriverConn406 *tdriverConn406) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn406.r, odriverConn406.extra, ctx, opts)
+}
+func (odriverConn406 *tdriverConn406) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn406.r, odriverConn406.extra, ctx, query)
+}
+func (odriverConn406 *tdriverConn406) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn406.r, odriverConn406.extra, ctx, query, args)
+}
+func (odriverConn406 *tdriverConn406) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn406.r, odriverConn406.extra, param0)
+}
+func (odriverConn406 *tdriverConn406) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn406.r, odriverConn406.extra, query, args)
+}
+func (odriverConn406 *tdriverConn406) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn406.r, odriverConn406.extra, ctx)
+}
+
+func (odriverConn407 *tdriverConn407) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn407.r, odriverConn407.extra)
+}
+func (odriverConn407 *tdriverConn407) Close() error {
+	return realDCClose(odriverConn407.r, odriverConn407.extra)
+}
+func (odriverConn407 *tdriverConn407) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn407.r, odriverConn407.extra, query)
+}
+func (odriverConn407 *tdriverConn407) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn407.r, odriverConn407.extra, ctx, opts)
+}
+func (odriverConn407 *tdriverConn407) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn407.r, odriverConn407.extra, ctx, query)
+}
+func (odriverConn407 *tdriverConn407) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn407.r, odriverConn407.extra, ctx, query, args)
+}
+func (odriverConn407 *tdriverConn407) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn407.r, odriverConn407.extra, param0)
+}
+func (odriverConn407 *tdriverConn407) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn407.r, odriverConn407.extra, ctx, query, args)
+}
+func (odriverConn407 *tdriverConn407) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn407.r, odriverConn407.extra, ctx)
+}
+
+func (odriverConn408 *tdriverConn408) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn408.r, odriverConn408.extra)
+}
+func (odriverConn408 *tdriverConn408) Close() error {
+	return realDCClose(odriverConn408.r, odriverConn408.extra)
+}
+func (odriverConn408 *tdriverConn408) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn408.r, odriverConn408.extra, query)
+}
+func (odriverConn408 *tdriverConn408) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn408.r, odriverConn408.extra, ctx, opts)
+}
+func (odriverConn408 *tdriverConn408) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn408.r, odriverConn408.extra, ctx, query)
+}
+func (odriverConn408 *tdriverConn408) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn408.r, odriverConn408.extra, ctx, query, args)
+}
+func (odriverConn408 *tdriverConn408) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn408.r, odriverConn408.extra, ctx)
+}
+func (odriverConn408 *tdriverConn408) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn408.r, odriverConn408.extra, query, args)
+}
+func (odriverConn408 *tdriverConn408) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn408.r, odriverConn408.extra, ctx, query, args)
+}
+
+func (odriverConn409 *tdriverConn409) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn409.r, odriverConn409.extra)
+}
+func (odriverConn409 *tdriverConn409) Close() error {
+	return realDCClose(odriverConn409.r, odriverConn409.extra)
+}
+func (odriverConn409 *tdriverConn409) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn409.r, odriverConn409.extra, query)
+}
+func (odriverConn409 *tdriverConn409) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn409.r, odriverConn409.extra, ctx, opts)
+}
+func (odriverConn409 *tdriverConn409) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn409.r, odriverConn409.extra, ctx, query)
+}
+func (odriverConn409 *tdriverConn409) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn409.r, odriverConn409.extra, ctx, query, args)
+}
+func (odriverConn409 *tdriverConn409) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn409.r, odriverConn409.extra, ctx)
+}
+func (odriverConn409 *tdriverConn409) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn409.r, odriverConn409.extra, query, args)
+}
+func (odriverConn409 *tdriverConn409) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn409.r, odriverConn409.extra, ctx)
+}
+
+func (odriverConn410 *tdriverConn410) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn410.r, odriverConn410.extra)
+}
+func (odriverConn410 *tdriverConn410) Close() error {
+	return realDCClose(odriverConn410.r, odriverConn410.extra)
+}
+func (odriverConn410 *tdriverConn410) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn410.r, odriverConn410.extra, query)
+}
+func (odriverConn410 *tdriverConn410) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn410.r, odriverConn410.extra, ctx, opts)
+}
+func (odriverConn410 *tdriverConn410) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn410.r, odriverConn410.extra, ctx, query)
+}
+func (odriverConn410 *tdriverConn410) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn410.r, odriverConn410.extra, ctx, query, args)
+}
+func (odriverConn410 *tdriverConn410) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn410.r, odriverConn410.extra, ctx)
+}
+func (odriverConn410 *tdriverConn410) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn410.r, odriverConn410.extra, ctx, query, args)
+}
+func (odriverConn410 *tdriverConn410) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn410.r, odriverConn410.extra, ctx)
+}
+
+func (odriverConn411 *tdriverConn411) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn411.r, odriverConn411.extra)
+}
+func (odriverConn411 *tdriverConn411) Close() error {
+	return realDCClose(odriverConn411.r, odriverConn411.extra)
+}
+func (odriverConn411 *tdriverConn411) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn411.r, odriverConn411.extra, query)
+}
+func (odriverConn411 *tdriverConn411) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn411.r, odriverConn411.extra, ctx, opts)
+}
+func (odriverConn411 *tdriverConn411) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn411.r, odriverConn411.extra, ctx, query)
+}
+func (odriverConn411 *tdriverConn411) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn411.r, odriverConn411.extra, ctx, query, args)
+}
+func (odriverConn411 *tdriverConn411) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn411.r, odriverConn411.extra, query, args)
+}
+func (odriverConn411 *tdriverConn411) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn411.r, odriverConn411.extra, ctx, query, args)
+}
+func (odriverConn411 *tdriverConn411) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn411.r, odriverConn411.extra, ctx)
+}
+
+func (odriverConn412 *tdriverConn412) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn412.r, odriverConn412.extra)
+}
+func (odriverConn412 *tdriverConn412) Close() error {
+	return realDCClose(odriverConn412.r, odriverConn412.extra)
+}
+func (odriverConn412 *tdriverConn412) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn412.r, odriverConn412.extra, query)
+}
+func (odriverConn412 *tdriverConn412) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn412.r, odriverConn412.extra, ctx, opts)
+}
+func (odriverConn412 *tdriverConn412) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn412.r, odriverConn412.extra, ctx, query)
+}
+func (odriverConn412 *tdriverConn412) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn412.r, odriverConn412.extra, param0)
+}
+func (odriverConn412 *tdriverConn412) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn412.r, odriverConn412.extra, ctx)
+}
+func (odriverConn412 *tdriverConn412) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn412.r, odriverConn412.extra, query, args)
+}
+func (odriverConn412 *tdriverConn412) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn412.r, odriverConn412.extra, ctx, query, args)
+}
+
+func (odriverConn413 *tdriverConn413) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn413.r, odriverConn413.extra)
+}
+func (odriverConn413 *tdriverConn413) Close() error {
+	return realDCClose(odriverConn413.r, odriverConn413.extra)
+}
+func (odriverConn413 *tdriverConn413) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn413.r, odriverConn413.extra, query)
+}
+func (odriverConn413 *tdriverConn413) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn413.r, odriverConn413.extra, ctx, opts)
+}
+func (odriverConn413 *tdriverConn413) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn413.r, odriverConn413.extra, ctx, query)
+}
+func (odriverConn413 *tdriverConn413) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn413.r, odriverConn413.extra, param0)
+}
+func (odriverConn413 *tdriverConn413) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn413.r, odriverConn413.extra, ctx)
+}
+func (odriverConn413 *tdriverConn413) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn413.r, odriverConn413.extra, query, args)
+}
+func (odriverConn413 *tdriverConn413) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn413.r, odriverConn413.extra, ctx)
+}
+
+func (odriverConn414 *tdriverConn414) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn414.r, odriverConn414.extra)
+}
+func (odriverConn414 *tdriverConn414) Close() error {
+	return realDCClose(odriverConn414.r, odriverConn414.extra)
+}
+func (odriverConn414 *tdriverConn414) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn414.r, odriverConn414.extra, query)
+}
+func (odriverConn414 *tdriverConn414) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn414.r, odriverConn414.extra, ctx, opts)
+}
+func (odriverConn414 *tdriverConn414) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn414.r, odriverConn414.extra, ctx, query)
+}
+func (odriverConn414 *tdriverConn414) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn414.r, odriverConn414.extra, param0)
+}
+func (odriverConn414 *tdriverConn414) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn414.r, odriverConn414.extra, ctx)
+}
+func (odriverConn414 *tdriverConn414) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn414.r, odriverConn414.extra, ctx, query, args)
+}
+func (odriverConn414 *tdriverConn414) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn414.r, odriverConn414.extra, ctx)
+}
+
+func (odriverConn415 *tdriverConn415) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn415.r, odriverConn415.extra)
+}
+func (odriverConn415 *tdriverConn415) Close() error {
+	return realDCClose(odriverConn415.r, odriverConn415.extra)
+}
+func (odriverConn415 *tdriverConn415) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn415.r, odriverConn415.extra, query)
+}
+func (odriverConn415 *tdriverConn415) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn415.r, odriverConn415.extra, ctx, opts)
+}
+func (odriverConn415 *tdriverConn415) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn415.r, odriverConn415.extra, ctx, query)
+}
+func (odriverConn415 *tdriverConn415) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn415.r, odriverConn415.extra, param0)
+}
+func (odriverConn415 *tdriverConn415) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn415.r, odriverConn415.extra, query, args)
+}
+func (odriverConn415 *tdriverConn415) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn415.r, odriverConn415.extra, ctx, query, args)
+}
+func (odriverConn415 *tdriverConn415) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn415.r, odriverConn415.extra, ctx)
+}
+
+func (odriverConn416 *tdriverConn416) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn416.r, odriverConn416.extra)
+}
+func (odriverConn416 *tdriverConn416) Close() error {
+	return realDCClose(odriverConn416.r, odriverConn416.extra)
+}
+func (odriverConn416 *tdriverConn416) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn416.r, odriverConn416.extra, query)
+}
+func (odriverConn416 *tdriverConn416) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn416.r, odriverConn416.extra, ctx, opts)
+}
+func (odriverConn416 *tdriverConn416) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn416.r, odriverConn416.extra, ctx, query)
+}
+func (odriverConn416 *tdriverConn416) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn416.r, odriverConn416.extra, ctx)
+}
+func (odriverConn416 *tdriverConn416) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn416.r, odriverConn416.extra, query, args)
+}
+func (odriverConn416 *tdriverConn416) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn416.r, odriverConn416.extra, ctx, query, args)
+}
+func (odriverConn416 *tdriverConn416) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn416.r, odriverConn416.extra, ctx)
+}
+
+func (odriverConn417 *tdriverConn417) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn417.r, odriverConn417.extra)
+}
+func (odriverConn417 *tdriverConn417) Close() error {
+	return realDCClose(odriverConn417.r, odriverConn417.extra)
+}
+func (odriverConn417 *tdriverConn417) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn417.r, odriverConn417.extra, query)
+}
+func (odriverConn417 *tdriverConn417) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn417.r, odriverConn417.extra, ctx, opts)
+}
+func (odriverConn417 *tdriverConn417) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn417.r, odriverConn417.extra, query, args)
+}
+func (odriverConn417 *tdriverConn417) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn417.r, odriverConn417.extra, ctx, query, args)
+}
+func (odriverConn417 *tdriverConn417) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn417.r, odriverConn417.extra, param0)
+}
+func (odriverConn417 *tdriverConn417) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn417.r, odriverConn417.extra, ctx)
+}
+func (odriverConn417 *tdriverConn417) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn417.r, odriverConn417.extra, query, args)
+}
+
+func (odriverConn418 *tdriverConn418) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn418.r, odriverConn418.extra)
+}
+func (odriverConn418 *tdriverConn418) Close() error {
+	return realDCClose(odriverConn418.r, odriverConn418.extra)
+}
+func (odriverConn418 *tdriverConn418) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn418.r, odriverConn418.extra, query)
+}
+func (odriverConn418 *tdriverConn418) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn418.r, odriverConn418.extra, ctx, opts)
+}
+func (odriverConn418 *tdriverConn418) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn418.r, odriverConn418.extra, query, args)
+}
+func (odriverConn418 *tdriverConn418) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn418.r, odriverConn418.extra, ctx, query, args)
+}
+func (odriverConn418 *tdriverConn418) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn418.r, odriverConn418.extra, param0)
+}
+func (odriverConn418 *tdriverConn418) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn418.r, odriverConn418.extra, ctx)
+}
+func (odriverConn418 *tdriverConn418) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn418.r, odriverConn418.extra, ctx, query, args)
+}
+
+func (odriverConn419 *tdriverConn419) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn419.r, odriverConn419.extra)
+}
+func (odriverConn419 *tdriverConn419) Close() error {
+	return realDCClose(odriverConn419.r, odriverConn419.extra)
+}
+func (odriverConn419 *tdriverConn419) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn419.r, odriverConn419.extra, query)
+}
+func (odriverConn419 *tdriverConn419) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn419.r, odriverConn419.extra, ctx, opts)
+}
+func (odriverConn419 *tdriverConn419) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn419.r, odriverConn419.extra, query, args)
+}
+func (odriverConn419 *tdriverConn419) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn419.r, odriverConn419.extra, ctx, query, args)
+}
+func (odriverConn419 *tdriverConn419) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn419.r, odriverConn419.extra, param0)
+}
+func (odriverConn419 *tdriverConn419) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn419.r, odriverConn419.extra, ctx)
+}
+func (odriverConn419 *tdriverConn419) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn419.r, odriverConn419.extra, ctx)
+}
+
+func (odriverConn420 *tdriverConn420) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn420.r, odriverConn420.extra)
+}
+func (odriverConn420 *tdriverConn420) Close() error {
+	return realDCClose(odriverConn420.r, odriverConn420.extra)
+}
+func (odriverConn420 *tdriverConn420) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn420.r, odriverConn420.extra, query)
+}
+func (odriverConn420 *tdriverConn420) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn420.r, odriverConn420.extra, ctx, opts)
+}
+func (odriverConn420 *tdriverConn420) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn420.r, odriverConn420.extra, query, args)
+}
+func (odriverConn420 *tdriverConn420) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn420.r, odriverConn420.extra, ctx, query, args)
+}
+func (odriverConn420 *tdriverConn420) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn420.r, odriverConn420.extra, param0)
+}
+func (odriverConn420 *tdriverConn420) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn420.r, odriverConn420.extra, query, args)
+}
+func (odriverConn420 *tdriverConn420) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn420.r, odriverConn420.extra, ctx, query, args)
+}
+
+func (odriverConn421 *tdriverConn421) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn421.r, odriverConn421.extra)
+}
+func (odriverConn421 *tdriverConn421) Close() error {
+	return realDCClose(odriverConn421.r, odriverConn421.extra)
+}
+func (odriverConn421 *tdriverConn421) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn421.r, odriverConn421.extra, query)
+}
+func (odriverConn421 *tdriverConn421) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn421.r, odriverConn421.extra, ctx, opts)
+}
+func (odriverConn421 *tdriverConn421) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn421.r, odriverConn421.extra, query, args)
+}
+func (odriverConn421 *tdriverConn421) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn421.r, odriverConn421.extra, ctx, query, args)
+}
+func (odriverConn421 *tdriverConn421) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn421.r, odriverConn421.extra, param0)
+}
+func (odriverConn421 *tdriverConn421) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn421.r, odriverConn421.extra, query, args)
+}
+func (odriverConn421 *tdriverConn421) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn421.r, odriverConn421.extra, ctx)
+}
+
+func (odriverConn422 *tdriverConn422) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn422.r, odriverConn422.extra)
+}
+func (odriverConn422 *tdriverConn422) Close() error {
+	return realDCClose(odriverConn422.r, odriverConn422.extra)
+}
+func (odriverConn422 *tdriverConn422) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn422.r, odriverConn422.extra, query)
+}
+func (odriverConn422 *tdriverConn422) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn422.r, odriverConn422.extra, ctx, opts)
+}
+func (odriverConn422 *tdriverConn422) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn422.r, odriverConn422.extra, query, args)
+}
+func (odriverConn422 *tdriverConn422) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn422.r, odriverConn422.extra, ctx, query, args)
+}
+func (odriverConn422 *tdriverConn422) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn422.r, odriverConn422.extra, param0)
+}
+func (odriverConn422 *tdriverConn422) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn422.r, odriverConn422.extra, ctx, query, args)
+}
+func (odriverConn422 *tdriverConn422) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn422.r, odriverConn422.extra, ctx)
+}
+
+func (odriverConn423 *tdriverConn423) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn423.r, odriverConn423.extra)
+}
+func (odriverConn423 *tdriverConn423) Close() error {
+	return realDCClose(odriverConn423.r, odriverConn423.extra)
+}
+func (odriverConn423 *tdriverConn423) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn423.r, odriverConn423.extra, query)
+}
+func (odriverConn423 *tdriverConn423) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn423.r, odriverConn423.extra, ctx, opts)
+}
+func (odriverConn423 *tdriverConn423) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn423.r, odriverConn423.extra, query, args)
+}
+func (odriverConn423 *tdriverConn423) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn423.r, odriverConn423.extra, ctx, query, args)
+}
+func (odriverConn423 *tdriverConn423) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn423.r, odriverConn423.extra, ctx)
+}
+func (odriverConn423 *tdriverConn423) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn423.r, odriverConn423.extra, query, args)
+}
+func (odriverConn423 *tdriverConn423) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn423.r, odriverConn423.extra, ctx, query, args)
+}
+
+func (odriverConn424 *tdriverConn424) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn424.r, odriverConn424.extra)
+}
+func (odriverConn424 *tdriverConn424) Close() error {
+	return realDCClose(odriverConn424.r, odriverConn424.extra)
+}
+func (odriverConn424 *tdriverConn424) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn424.r, odriverConn424.extra, query)
+}
+func (odriverConn424 *tdriverConn424) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn424.r, odriverConn424.extra, ctx, opts)
+}
+func (odriverConn424 *tdriverConn424) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn424.r, odriverConn424.extra, query, args)
+}
+func (odriverConn424 *tdriverConn424) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn424.r, odriverConn424.extra, ctx, query, args)
+}
+func (odriverConn424 *tdriverConn424) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn424.r, odriverConn424.extra, ctx)
+}
+func (odriverConn424 *tdriverConn424) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn424.r, odriverConn424.extra, query, args)
+}
+func (odriverConn424 *tdriverConn424) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn424.r, odriverConn424.extra, ctx)
+}
+
+func (odriverConn425 *tdriverConn425) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn425.r, odriverConn425.extra)
+}
+func (odriverConn425 *tdriverConn425) Close() error {
+	return realDCClose(odriverConn425.r, odriverConn425.extra)
+}
+func (odriverConn425 *tdriverConn425) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn425.r, odriverConn425.extra, query)
+}
+func (odriverConn425 *tdriverConn425) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn425.r, odriverConn425.extra, ctx, opts)
+}
+func (odriverConn425 *tdriverConn425) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn425.r, odriverConn425.extra, query, args)
+}
+func (odriverConn425 *tdriverConn425) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn425.r, odriverConn425.extra, ctx, query, args)
+}
+func (odriverConn425 *tdriverConn425) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn425.r, odriverConn425.extra, ctx)
+}
+func (odriverConn425 *tdriverConn425) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn425.r, odriverConn425.extra, ctx, query, args)
+}
+func (odriverConn425 *tdriverConn425) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn425.r, odriverConn425.extra, ctx)
+}
+
+func (odriverConn426 *tdriverConn426) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn426.r, odriverConn426.extra)
+}
+func (odriverConn426 *tdriverConn426) Close() error {
+	return realDCClose(odriverConn426.r, odriverConn426.extra)
+}
+func (odriverConn426 *tdriverConn426) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn426.r, odriverConn426.extra, query)
+}
+func (odriverConn426 *tdriverConn426) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn426.r, odriverConn426.extra, ctx, opts)
+}
+func (odriverConn426 *tdriverConn426) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn426.r, odriverConn426.extra, query, args)
+}
+func (odriverConn426 *tdriverConn426) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn426.r, odriverConn426.extra, ctx, query, args)
+}
+func (odriverConn426 *tdriverConn426) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn426.r, odriverConn426.extra, query, args)
+}
+func (odriverConn426 *tdriverConn426) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn426.r, odriverConn426.extra, ctx, query, args)
+}
+func (odriverConn426 *tdriverConn426) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn426.r, odriverConn426.extra, ctx)
+}
+
+func (odriverConn427 *tdriverConn427) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn427.r, odriverConn427.extra)
+}
+func (odriverConn427 *tdriverConn427) Close() error {
+	return realDCClose(odriverConn427.r, odriverConn427.extra)
+}
+func (odriverConn427 *tdriverConn427) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn427.r, odriverConn427.extra, query)
+}
+func (odriverConn427 *tdriverConn427) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn427.r, odriverConn427.extra, ctx, opts)
+}
+func (odriverConn427 *tdriverConn427) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn427.r, odriverConn427.extra, query, args)
+}
+func (odriverConn427 *tdriverConn427) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn427.r, odriverConn427.extra, param0)
+}
+func (odriverConn427 *tdriverConn427) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn427.r, odriverConn427.extra, ctx)
+}
+func (odriverConn427 *tdriverConn427) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn427.r, odriverConn427.extra, query, args)
+}
+func (odriverConn427 *tdriverConn427) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn427.r, odriverConn427.extra, ctx, query, args)
+}
+
+func (odriverConn428 *tdriverConn428) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn428.r, odriverConn428.extra)
+}
+func (odriverConn428 *tdriverConn428) Close() error {
+	return realDCClose(odriverConn428.r, odriverConn428.extra)
+}
+func (odriverConn428 *tdriverConn428) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn428.r, odriverConn428.extra, query)
+}
+func (odriverConn428 *tdriverConn428) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn428.r, odriverConn428.extra, ctx, opts)
+}
+func (odriverConn428 *tdriverConn428) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn428.r, odriverConn428.extra, query, args)
+}
+func (odriverConn428 *tdriverConn428) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn428.r, odriverConn428.extra, param0)
+}
+func (odriverConn428 *tdriverConn428) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn428.r, odriverConn428.extra, ctx)
+}
+func (odriverConn428 *tdriverConn428) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn428.r, odriverConn428.extra, query, args)
+}
+func (odriverConn428 *tdriverConn428) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn428.r, odriverConn428.extra, ctx)
+}
+
+func (odriverConn429 *tdriverConn429) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn429.r, odriverConn429.extra)
+}
+func (odriverConn429 *tdriverConn429) Close() error {
+	return realDCClose(odriverConn429.r, odriverConn429.extra)
+}
+func (odriverConn429 *tdriverConn429) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn429.r, odriverConn429.extra, query)
+}
+func (odriverConn429 *tdriverConn429) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn429.r, odriverConn429.extra, ctx, opts)
+}
+func (odriverConn429 *tdriverConn429) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn429.r, odriverConn429.extra, query, args)
+}
+func (odriverConn429 *tdriverConn429) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn429.r, odriverConn429.extra, param0)
+}
+func (odriverConn429 *tdriverConn429) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn429.r, odriverConn429.extra, ctx)
+}
+func (odriverConn429 *tdriverConn429) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn429.r, odriverConn429.extra, ctx, query, args)
+}
+func (odriverConn429 *tdriverConn429) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn429.r, odriverConn429.extra, ctx)
+}
+
+func (odriverConn430 *tdriverConn430) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn430.r, odriverConn430.extra)
+}
+func (odriverConn430 *tdriverConn430) Close() error {
+	return realDCClose(odriverConn430.r, odriverConn430.extra)
+}
+func (odriverConn430 *tdriverConn430) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn430.r, odriverConn430.extra, query)
+}
+func (odriverConn430 *tdriverConn430) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn430.r, odriverConn430.extra, ctx, opts)
+}
+func (odriverConn430 *tdriverConn430) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn430.r, odriverConn430.extra, query, args)
+}
+func (odriverConn430 *tdriverConn430) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn430.r, odriverConn430.extra, param0)
+}
+func (odriverConn430 *tdriverConn430) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn430.r, odriverConn430.extra, query, args)
+}
+func (odriverConn430 *tdriverConn430) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn430.r, odriverConn430.extra, ctx, query, args)
+}
+func (odriverConn430 *tdriverConn430) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn430.r, odriverConn430.extra, ctx)
+}
+
+func (odriverConn431 *tdriverConn431) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn431.r, odriverConn431.extra)
+}
+func (odriverConn431 *tdriverConn431) Close() error {
+	return realDCClose(odriverConn431.r, odriverConn431.extra)
+}
+func (odriverConn431 *tdriverConn431) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn431.r, odriverConn431.extra, query)
+}
+func (odriverConn431 *tdriverConn431) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn431.r, odriverConn431.extra, ctx, opts)
+}
+func (odriverConn431 *tdriverConn431) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn431.r, odriverConn431.extra, query, args)
+}
+func (odriverConn431 *tdriverConn431) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn431.r, odriverConn431.extra, ctx)
+}
+func (odriverConn431 *tdriverConn431) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn431.r, odriverConn431.extra, query, args)
+}
+func (odriverConn431 *tdriverConn431) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn431.r, odriverConn431.extra, ctx, query, args)
+}
+func (odriverConn431 *tdriverConn431) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn431.r, odriverConn431.extra, ctx)
+}
+
+func (odriverConn432 *tdriverConn432) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn432.r, odriverConn432.extra)
+}
+func (odriverConn432 *tdriverConn432) Close() error {
+	return realDCClose(odriverConn432.r, odriverConn432.extra)
+}
+func (odriverConn432 *tdriverConn432) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn432.r, odriverConn432.extra, query)
+}
+func (odriverConn432 *tdriverConn432) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn432.r, odriverConn432.extra, ctx, opts)
+}
+func (odriverConn432 *tdriverConn432) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn432.r, odriverConn432.extra, ctx, query, args)
+}
+func (odriverConn432 *tdriverConn432) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn432.r, odriverConn432.extra, param0)
+}
+func (odriverConn432 *tdriverConn432) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn432.r, odriverConn432.extra, ctx)
+}
+func (odriverConn432 *tdriverConn432) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn432.r, odriverConn432.extra, query, args)
+}
+func (odriverConn432 *tdriverConn432) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn432.r, odriverConn432.extra, ctx, query, args)
+}
+
+func (odriverConn433 *tdriverConn433) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn433.r, odriverConn433.extra)
+}
+func (odriverConn433 *tdriverConn433) Close() error {
+	return realDCClose(odriverConn433.r, odriverConn433.extra)
+}
+func (odriverConn433 *tdriverConn433) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn433.r, odriverConn433.extra, query)
+}
+func (odriverConn433 *tdriverConn433) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn433.r, odriverConn433.extra, ctx, opts)
+}
+func (odriverConn433 *tdriverConn433) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn433.r, odriverConn433.extra, ctx, query, args)
+}
+func (odriverConn433 *tdriverConn433) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn433.r, odriverConn433.extra, param0)
+}
+func (odriverConn433 *tdriverConn433) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn433.r, odriverConn433.extra, ctx)
+}
+func (odriverConn433 *tdriverConn433) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn433.r, odriverConn433.extra, query, args)
+}
+func (odriverConn433 *tdriverConn433) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn433.r, odriverConn433.extra, ctx)
+}
+
+func (odriverConn434 *tdriverConn434) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn434.r, odriverConn434.extra)
+}
+func (odriverConn434 *tdriverConn434) Close() error {
+	return realDCClose(odriverConn434.r, odriverConn434.extra)
+}
+func (odriverConn434 *tdriverConn434) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn434.r, odriverConn434.extra, query)
+}
+func (odriverConn434 *tdriverConn434) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn434.r, odriverConn434.extra, ctx, opts)
+}
+func (odriverConn434 *tdriverConn434) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn434.r, odriverConn434.extra, ctx, query, args)
+}
+func (odriverConn434 *tdriverConn434) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn434.r, odriverConn434.extra, param0)
+}
+func (odriverConn434 *tdriverConn434) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn434.r, odriverConn434.extra, ctx)
+}
+func (odriverConn434 *tdriverConn434) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn434.r, odriverConn434.extra, ctx, query, args)
+}
+func (odriverConn434 *tdriverConn434) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn434.r, odriverConn434.extra, ctx)
+}
+
+func (odriverConn435 *tdriverConn435) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn435.r, odriverConn435.extra)
+}
+func (odriverConn435 *tdriverConn435) Close() error {
+	return realDCClose(odriverConn435.r, odriverConn435.extra)
+}
+func (odriverConn435 *tdriverConn435) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn435.r, odriverConn435.extra, query)
+}
+func (odriverConn435 *tdriverConn435) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn435.r, odriverConn435.extra, ctx, opts)
+}
+func (odriverConn435 *tdriverConn435) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn435.r, odriverConn435.extra, ctx, query, args)
+}
+func (odriverConn435 *tdriverConn435) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn435.r, odriverConn435.extra, param0)
+}
+func (odriverConn435 *tdriverConn435) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn435.r, odriverConn435.extra, query, args)
+}
+func (odriverConn435 *tdriverConn435) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn435.r, odriverConn435.extra, ctx, query, args)
+}
+func (odriverConn435 *tdriverConn435) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn435.r, odriverConn435.extra, ctx)
+}
+
+func (odriverConn436 *tdriverConn436) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn436.r, odriverConn436.extra)
+}
+func (odriverConn436 *tdriverConn436) Close() error {
+	return realDCClose(odriverConn436.r, odriverConn436.extra)
+}
+func (odriverConn436 *tdriverConn436) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn436.r, odriverConn436.extra, query)
+}
+func (odriverConn436 *tdriverConn436) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn436.r, odriverConn436.extra, ctx, opts)
+}
+func (odriverConn436 *tdriverConn436) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn436.r, odriverConn436.extra, ctx, query, args)
+}
+func (odriverConn436 *tdriverConn436) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn436.r, odriverConn436.extra, ctx)
+}
+func (odriverConn436 *tdriverConn436) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn436.r, odriverConn436.extra, query, args)
+}
+func (odriverConn436 *tdriverConn436) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn436.r, odriverConn436.extra, ctx, query, args)
+}
+func (odriverConn436 *tdriverConn436) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn436.r, odriverConn436.extra, ctx)
+}
+
+func (odriverConn437 *tdriverConn437) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn437.r, odriverConn437.extra)
+}
+func (odriverConn437 *tdriverConn437) Close() error {
+	return realDCClose(odriverConn437.r, odriverConn437.extra)
+}
+func (odriverConn437 *tdriverConn437) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn437.r, odriverConn437.extra, query)
+}
+func (odriverConn437 *tdriverConn437) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn437.r, odriverConn437.extra, ctx, opts)
+}
+func (odriverConn437 *tdriverConn437) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn437.r, odriverConn437.extra, param0)
+}
+func (odriverConn437 *tdriverConn437) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn437.r, odriverConn437.extra, ctx)
+}
+func (odriverConn437 *tdriverConn437) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn437.r, odriverConn437.extra, query, args)
+}
+func (odriverConn437 *tdriverConn437) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn437.r, odriverConn437.extra, ctx, query, args)
+}
+func (odriverConn437 *tdriverConn437) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn437.r, odriverConn437.extra, ctx)
+}
+
+func (odriverConn438 *tdriverConn438) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn438.r, odriverConn438.extra)
+}
+func (odriverConn438 *tdriverConn438) Close() error {
+	return realDCClose(odriverConn438.r, odriverConn438.extra)
+}
+func (odriverConn438 *tdriverConn438) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn438.r, odriverConn438.extra, query)
+}
+func (odriverConn438 *tdriverConn438) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn438.r, odriverConn438.extra, ctx, query)
+}
+func (odriverConn438 *tdriverConn438) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn438.r, odriverConn438.extra, query, args)
+}
+func (odriverConn438 *tdriverConn438) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn438.r, odriverConn438.extra, ctx, query, args)
+}
+func (odriverConn438 *tdriverConn438) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn438.r, odriverConn438.extra, param0)
+}
+func (odriverConn438 *tdriverConn438) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn438.r, odriverConn438.extra, ctx)
+}
+func (odriverConn438 *tdriverConn438) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn438.r, odriverConn438.extra, query, args)
+}
+
+func (odriverConn439 *tdriverConn439) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn439.r, odriverConn439.extra)
+}
+func (odriverConn439 *tdriverConn439) Close() error {
+	return realDCClose(odriverConn439.r, odriverConn439.extra)
+}
+func (odriverConn439 *tdriverConn439) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn439.r, odriverConn439.extra, query)
+}
+func (odriverConn439 *tdriverConn439) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn439.r, odriverConn439.extra, ctx, query)
+}
+func (odriverConn439 *tdriverConn439) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn439.r, odriverConn439.extra, query, args)
+}
+func (odriverConn439 *tdriverConn439) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn439.r, odriverConn439.extra, ctx, query, args)
+}
+func (odriverConn439 *tdriverConn439) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn439.r, odriverConn439.extra, param0)
+}
+func (odriverConn439 *tdriverConn439) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn439.r, odriverConn439.extra, ctx)
+}
+func (odriverConn439 *tdriverConn439) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn439.r, odriverConn439.extra, ctx, query, args)
+}
+
+func (odriverConn440 *tdriverConn440) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn440.r, odriverConn440.extra)
+}
+func (odriverConn440 *tdriverConn440) Close() error {
+	return realDCClose(odriverConn440.r, odriverConn440.extra)
+}
+func (odriverConn440 *tdriverConn440) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn440.r, odriverConn440.extra, query)
+}
+func (odriverConn440 *tdriverConn440) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn440.r, odriverConn440.extra, ctx, query)
+}
+func (odriverConn440 *tdriverConn440) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn440.r, odriverConn440.extra, query, args)
+}
+func (odriverConn440 *tdriverConn440) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn440.r, odriverConn440.extra, ctx, query, args)
+}
+func (odriverConn440 *tdriverConn440) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn440.r, odriverConn440.extra, param0)
+}
+func (odriverConn440 *tdriverConn440) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn440.r, odriverConn440.extra, ctx)
+}
+func (odriverConn440 *tdriverConn440) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn440.r, odriverConn440.extra, ctx)
+}
+
+func (odriverConn441 *tdriverConn441) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn441.r, odriverConn441.extra)
+}
+func (odriverConn441 *tdriverConn441) Close() error {
+	return realDCClose(odriverConn441.r, odriverConn441.extra)
+}
+func (odriverConn441 *tdriverConn441) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn441.r, odriverConn441.extra, query)
+}
+func (odriverConn441 *tdriverConn441) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn441.r, odriverConn441.extra, ctx, query)
+}
+func (odriverConn441 *tdriverConn441) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn441.r, odriverConn441.extra, query, args)
+}
+func (odriverConn441 *tdriverConn441) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn441.r, odriverConn441.extra, ctx, query, args)
+}
+func (odriverConn441 *tdriverConn441) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn441.r, odriverConn441.extra, param0)
+}
+func (odriverConn441 *tdriverConn441) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn441.r, odriverConn441.extra, query, args)
+}
+func (odriverConn441 *tdriverConn441) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn441.r, odriverConn441.extra, ctx, query, args)
+}
+
+func (odriverConn442 *tdriverConn442) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn442.r, odriverConn442.extra)
+}
+func (odriverConn442 *tdriverConn442) Close() error {
+	return realDCClose(odriverConn442.r, odriverConn442.extra)
+}
+func (odriverConn442 *tdriverConn442) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn442.r, odriverConn442.extra, query)
+}
+func (odriverConn442 *tdriverConn442) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn442.r, odriverConn442.extra, ctx, query)
+}
+func (odriverConn442 *tdriverConn442) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn442.r, odriverConn442.extra, query, args)
+}
+func (odriverConn442 *tdriverConn442) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn442.r, odriverConn442.extra, ctx, query, args)
+}
+func (odriverConn442 *tdriverConn442) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn442.r, odriverConn442.extra, param0)
+}
+func (odriverConn442 *tdriverConn442) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn442.r, odriverConn442.extra, query, args)
+}
+func (odriverConn442 *tdriverConn442) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn442.r, odriverConn442.extra, ctx)
+}
+
+func (odriverConn443 *tdriverConn443) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn443.r, odriverConn443.extra)
+}
+func (odriverConn443 *tdriverConn443) Close() error {
+	return realDCClose(odriverConn443.r, odriverConn443.extra)
+}
+func (odriverConn443 *tdriverConn443) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn443.r, odriverConn443.extra, query)
+}
+func (odriverConn443 *tdriverConn443) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn443.r, odriverConn443.extra, ctx, query)
+}
+func (odriverConn443 *tdriverConn443) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn443.r, odriverConn443.extra, query, args)
+}
+func (odriverConn443 *tdriverConn443) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn443.r, odriverConn443.extra, ctx, query, args)
+}
+func (odriverConn443 *tdriverConn443) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn443.r, odriverConn443.extra, param0)
+}
+func (odriverConn443 *tdriverConn443) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn443.r, odriverConn443.extra, ctx, query, args)
+}
+func (odriverConn443 *tdriverConn443) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn443.r, odriverConn443.extra, ctx)
+}
+
+func (odriverConn444 *tdriverConn444) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn444.r, odriverConn444.extra)
+}
+func (odriverConn444 *tdriverConn444) Close() error {
+	return realDCClose(odriverConn444.r, odriverConn444.extra)
+}
+func (odriverConn444 *tdriverConn444) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn444.r, odriverConn444.extra, query)
+}
+func (odriverConn444 *tdriverConn444) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn444.r, odriverConn444.extra, ctx, query)
+}
+func (odriverConn444 *tdriverConn444) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn444.r, odriverConn444.extra, query, args)
+}
+func (odriverConn444 *tdriverConn444) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn444.r, odriverConn444.extra, ctx, query, args)
+}
+func (odriverConn444 *tdriverConn444) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn444.r, odriverConn444.extra, ctx)
+}
+func (odriverConn444 *tdriverConn444) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn444.r, odriverConn444.extra, query, args)
+}
+func (odriverConn444 *tdriverConn444) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn444.r, odriverConn444.extra, ctx, query, args)
+}
+
+func (odriverConn445 *tdriverConn445) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn445.r, odriverConn445.extra)
+}
+func (odriverConn445 *tdriverConn445) Close() error {
+	return realDCClose(odriverConn445.r, odriverConn445.extra)
+}
+func (odriverConn445 *tdriverConn445) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn445.r, odriverConn445.extra, query)
+}
+func (odriverConn445 *tdriverConn445) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn445.r, odriverConn445.extra, ctx, query)
+}
+func (odriverConn445 *tdriverConn445) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn445.r, odriverConn445.extra, query, args)
+}
+func (odriverConn445 *tdriverConn445) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn445.r, odriverConn445.extra, ctx, query, args)
+}
+func (odriverConn445 *tdriverConn445) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn445.r, odriverConn445.extra, ctx)
+}
+func (odriverConn445 *tdriverConn445) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn445.r, odriverConn445.extra, query, args)
+}
+func (odriverConn445 *tdriverConn445) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn445.r, odriverConn445.extra, ctx)
+}
+
+func (odriverConn446 *tdriverConn446) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn446.r, odriverConn446.extra)
+}
+func (odriverConn446 *tdriverConn446) Close() error {
+	return realDCClose(odriverConn446.r, odriverConn446.extra)
+}
+func (odriverConn446 *tdriverConn446) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn446.r, odriverConn446.extra, query)
+}
+func (odriverConn446 *tdriverConn446) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn446.r, odriverConn446.extra, ctx, query)
+}
+func (odriverConn446 *tdriverConn446) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn446.r, odriverConn446.extra, query, args)
+}
+func (odriverConn446 *tdriverConn446) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn446.r, odriverConn446.extra, ctx, query, args)
+}
+func (odriverConn446 *tdriverConn446) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn446.r, odriverConn446.extra, ctx)
+}
+func (odriverConn446 *tdriverConn446) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn446.r, odriverConn446.extra, ctx, query, args)
+}
+func (odriverConn446 *tdriverConn446) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn446.r, odriverConn446.extra, ctx)
+}
+
+func (odriverConn447 *tdriverConn447) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn447.r, odriverConn447.extra)
+}
+func (odriverConn447 *tdriverConn447) Close() error {
+	return realDCClose(odriverConn447.r, odriverConn447.extra)
+}
+func (odriverConn447 *tdriverConn447) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn447.r, odriverConn447.extra, query)
+}
+func (odriverConn447 *tdriverConn447) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn447.r, odriverConn447.extra, ctx, query)
+}
+func (odriverConn447 *tdriverConn447) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn447.r, odriverConn447.extra, query, args)
+}
+func (odriverConn447 *tdriverConn447) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn447.r, odriverConn447.extra, ctx, query, args)
+}
+func (odriverConn447 *tdriverConn447) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn447.r, odriverConn447.extra, query, args)
+}
+func (odriverConn447 *tdriverConn447) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn447.r, odriverConn447.extra, ctx, query, args)
+}
+func (odriverConn447 *tdriverConn447) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn447.r, odriverConn447.extra, ctx)
+}
+
+func (odriverConn448 *tdriverConn448) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn448.r, odriverConn448.extra)
+}
+func (odriverConn448 *tdriverConn448) Close() error {
+	return realDCClose(odriverConn448.r, odriverConn448.extra)
+}
+func (odriverConn448 *tdriverConn448) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn448.r, odriverConn448.extra, query)
+}
+func (odriverConn448 *tdriverConn448) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn448.r, odriverConn448.extra, ctx, query)
+}
+func (odriverConn448 *tdriverConn448) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn448.r, odriverConn448.extra, query, args)
+}
+func (odriverConn448 *tdriverConn448) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn448.r, odriverConn448.extra, param0)
+}
+func (odriverConn448 *tdriverConn448) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn448.r, odriverConn448.extra, ctx)
+}
+func (odriverConn448 *tdriverConn448) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn448.r, odriverConn448.extra, query, args)
+}
+func (odriverConn448 *tdriverConn448) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn448.r, odriverConn448.extra, ctx, query, args)
+}
+
+func (odriverConn449 *tdriverConn449) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn449.r, odriverConn449.extra)
+}
+func (odriverConn449 *tdriverConn449) Close() error {
+	return realDCClose(odriverConn449.r, odriverConn449.extra)
+}
+func (odriverConn449 *tdriverConn449) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn449.r, odriverConn449.extra, query)
+}
+func (odriverConn449 *tdriverConn449) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn449.r, odriverConn449.extra, ctx, query)
+}
+func (odriverConn449 *tdriverConn449) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn449.r, odriverConn449.extra, query, args)
+}
+func (odriverConn449 *tdriverConn449) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn449.r, odriverConn449.extra, param0)
+}
+func (odriverConn449 *tdriverConn449) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn449.r, odriverConn449.extra, ctx)
+}
+func (odriverConn449 *tdriverConn449) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn449.r, odriverConn449.extra, query, args)
+}
+func (odriverConn449 *tdriverConn449) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn449.r, odriverConn449.extra, ctx)
+}
+
+func (odriverConn450 *tdriverConn450) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn450.r, odriverConn450.extra)
+}
+func (odriverConn450 *tdriverConn450) Close() error {
+	return realDCClose(odriverConn450.r, odriverConn450.extra)
+}
+func (odriverConn450 *tdriverConn450) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn450.r, odriverConn450.extra, query)
+}
+func (odriverConn450 *tdriverConn450) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn450.r, odriverConn450.extra, ctx, query)
+}
+func (odriverConn450 *tdriverConn450) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn450.r, odriverConn450.extra, query, args)
+}
+func (odriverConn450 *tdriverConn450) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn450.r, odriverConn450.extra, param0)
+}
+func (odriverConn450 *tdriverConn450) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn450.r, odriverConn450.extra, ctx)
+}
+func (odriverConn450 *tdriverConn450) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn450.r, odriverConn450.extra, ctx, query, args)
+}
+func (odriverConn450 *tdriverConn450) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn450.r, odriverConn450.extra, ctx)
+}
+
+func (odriverConn451 *tdriverConn451) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn451.r, odriverConn451.extra)
+}
+func (odriverConn451 *tdriverConn451) Close() error {
+	return realDCClose(odriverConn451.r, odriverConn451.extra)
+}
+func (odriverConn451 *tdriverConn451) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn451.r, odriverConn451.extra, query)
+}
+func (odriverConn451 *tdriverConn451) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn451.r, odriverConn451.extra, ctx, query)
+}
+func (odriverConn451 *tdriverConn451) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn451.r, odriverConn451.extra, query, args)
+}
+func (odriverConn451 *tdriverConn451) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn451.r, odriverConn451.extra, param0)
+}
+func (odriverConn451 *tdriverConn451) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn451.r, odriverConn451.extra, query, args)
+}
+func (odriverConn451 *tdriverConn451) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn451.r, odriverConn451.extra, ctx, query, args)
+}
+func (odriverConn451 *tdriverConn451) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn451.r, odriverConn451.extra, ctx)
+}
+
+func (odriverConn452 *tdriverConn452) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn452.r, odriverConn452.extra)
+}
+func (odriverConn452 *tdriverConn452) Close() error {
+	return realDCClose(odriverConn452.r, odriverConn452.extra)
+}
+func (odriverConn452 *tdriverConn452) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn452.r, odriverConn452.extra, query)
+}
+func (odriverConn452 *tdriverConn452) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn452.r, odriverConn452.extra, ctx, query)
+}
+func (odriverConn452 *tdriverConn452) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn452.r, odriverConn452.extra, query, args)
+}
+func (odriverConn452 *tdriverConn452) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn452.r, odriverConn452.extra, ctx)
+}
+func (odriverConn452 *tdriverConn452) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn452.r, odriverConn452.extra, query, args)
+}
+func (odriverConn452 *tdriverConn452) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn452.r, odriverConn452.extra, ctx, query, args)
+}
+func (odriverConn452 *tdriverConn452) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn452.r, odriverConn452.extra, ctx)
+}
+
+func (odriverConn453 *tdriverConn453) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn453.r, odriverConn453.extra)
+}
+func (odriverConn453 *tdriverConn453) Close() error {
+	return realDCClose(odriverConn453.r, odriverConn453.extra)
+}
+func (odriverConn453 *tdriverConn453) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn453.r, odriverConn453.extra, query)
+}
+func (odriverConn453 *tdriverConn453) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn453.r, odriverConn453.extra, ctx, query)
+}
+func (odriverConn453 *tdriverConn453) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn453.r, odriverConn453.extra, ctx, query, args)
+}
+func (odriverConn453 *tdriverConn453) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn453.r, odriverConn453.extra, param0)
+}
+func (odriverConn453 *tdriverConn453) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn453.r, odriverConn453.extra, ctx)
+}
+func (odriverConn453 *tdriverConn453) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn453.r, odriverConn453.extra, query, args)
+}
+func (odriverConn453 *tdriverConn453) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn453.r, odriverConn453.extra, ctx, query, args)
+}
+
+func (odriverConn454 *tdriverConn454) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn454.r, odriverConn454.extra)
+}
+func (odriverConn454 *tdriverConn454) Close() error {
+	return realDCClose(odriverConn454.r, odriverConn454.extra)
+}
+func (odriverConn454 *tdriverConn454) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn454.r, odriverConn454.extra, query)
+}
+func (odriverConn454 *tdriverConn454) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn454.r, odriverConn454.extra, ctx, query)
+}
+func (odriverConn454 *tdriverConn454) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn454.r, odriverConn454.extra, ctx, query, args)
+}
+func (odriverConn454 *tdriverConn454) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn454.r, odriverConn454.extra, param0)
+}
+func (odriverConn454 *tdriverConn454) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn454.r, odriverConn454.extra, ctx)
+}
+func (odriverConn454 *tdriverConn454) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn454.r, odriverConn454.extra, query, args)
+}
+func (odriverConn454 *tdriverConn454) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn454.r, odriverConn454.extra, ctx)
+}
+
+func (odriverConn455 *tdriverConn455) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn455.r, odriverConn455.extra)
+}
+func (odriverConn455 *tdriverConn455) Close() error {
+	return realDCClose(odriverConn455.r, odriverConn455.extra)
+}
+func (odriverConn455 *tdriverConn455) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn455.r, odriverConn455.extra, query)
+}
+func (odriverConn455 *tdriverConn455) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn455.r, odriverConn455.extra, ctx, query)
+}
+func (odriverConn455 *tdriverConn455) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn455.r, odriverConn455.extra, ctx, query, args)
+}
+func (odriverConn455 *tdriverConn455) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn455.r, odriverConn455.extra, param0)
+}
+func (odriverConn455 *tdriverConn455) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn455.r, odriverConn455.extra, ctx)
+}
+func (odriverConn455 *tdriverConn455) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn455.r, odriverConn455.extra, ctx, query, args)
+}
+func (odriverConn455 *tdriverConn455) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn455.r, odriverConn455.extra, ctx)
+}
+
+func (odriverConn456 *tdriverConn456) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn456.r, odriverConn456.extra)
+}
+func (odriverConn456 *tdriverConn456) Close() error {
+	return realDCClose(odriverConn456.r, odriverConn456.extra)
+}
+func (odriverConn456 *tdriverConn456) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn456.r, odriverConn456.extra, query)
+}
+func (odriverConn456 *tdriverConn456) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn456.r, odriverConn456.extra, ctx, query)
+}
+func (odriverConn456 *tdriverConn456) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn456.r, odriverConn456.extra, ctx, query, args)
+}
+func (odriverConn456 *tdriverConn456) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn456.r, odriverConn456.extra, param0)
+}
+func (odriverConn456 *tdriverConn456) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn456.r, odriverConn456.extra, query, args)
+}
+func (odriverConn456 *tdriverConn456) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn456.r, odriverConn456.extra, ctx, query, args)
+}
+func (odriverConn456 *tdriverConn456) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn456.r, odriverConn456.extra, ctx)
+}
+
+func (odriverConn457 *tdriverConn457) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn457.r, odriverConn457.extra)
+}
+func (odriverConn457 *tdriverConn457) Close() error {
+	return realDCClose(odriverConn457.r, odriverConn457.extra)
+}
+func (odriverConn457 *tdriverConn457) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn457.r, odriverConn457.extra, query)
+}
+func (odriverConn457 *tdriverConn457) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn457.r, odriverConn457.extra, ctx, query)
+}
+func (odriverConn457 *tdriverConn457) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn457.r, odriverConn457.extra, ctx, query, args)
+}
+func (odriverConn457 *tdriverConn457) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn457.r, odriverConn457.extra, ctx)
+}
+func (odriverConn457 *tdriverConn457) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn457.r, odriverConn457.extra, query, args)
+}
+func (odriverConn457 *tdriverConn457) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn457.r, odriverConn457.extra, ctx, query, args)
+}
+func (odriverConn457 *tdriverConn457) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn457.r, odriverConn457.extra, ctx)
+}
+
+func (odriverConn458 *tdriverConn458) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn458.r, odriverConn458.extra)
+}
+func (odriverConn458 *tdriverConn458) Close() error {
+	return realDCClose(odriverConn458.r, odriverConn458.extra)
+}
+func (odriverConn458 *tdriverConn458) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn458.r, odriverConn458.extra, query)
+}
+func (odriverConn458 *tdriverConn458) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn458.r, odriverConn458.extra, ctx, query)
+}
+func (odriverConn458 *tdriverConn458) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn458.r, odriverConn458.extra, param0)
+}
+func (odriverConn458 *tdriverConn458) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn458.r, odriverConn458.extra, ctx)
+}
+func (odriverConn458 *tdriverConn458) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn458.r, odriverConn458.extra, query, args)
+}
+func (odriverConn458 *tdriverConn458) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn458.r, odriverConn458.extra, ctx, query, args)
+}
+func (odriverConn458 *tdriverConn458) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn458.r, odriverConn458.extra, ctx)
+}
+
+func (odriverConn459 *tdriverConn459) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn459.r, odriverConn459.extra)
+}
+func (odriverConn459 *tdriverConn459) Close() error {
+	return realDCClose(odriverConn459.r, odriverConn459.extra)
+}
+func (odriverConn459 *tdriverConn459) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn459.r, odriverConn459.extra, query)
+}
+func (odriverConn459 *tdriverConn459) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn459.r, odriverConn459.extra, query, args)
+}
+func (odriverConn459 *tdriverConn459) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn459.r, odriverConn459.extra, ctx, query, args)
+}
+func (odriverConn459 *tdriverConn459) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn459.r, odriverConn459.extra, param0)
+}
+func (odriverConn459 *tdriverConn459) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn459.r, odriverConn459.extra, ctx)
+}
+func (odriverConn459 *tdriverConn459) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn459.r, odriverConn459.extra, query, args)
+}
+func (odriverConn459 *tdriverConn459) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn459.r, odriverConn459.extra, ctx, query, args)
+}
+
+func (odriverConn460 *tdriverConn460) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn460.r, odriverConn460.extra)
+}
+func (odriverConn460 *tdriverConn460) Close() error {
+	return realDCClose(odriverConn460.r, odriverConn460.extra)
+}
+func (odriverConn460 *tdriverConn460) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn460.r, odriverConn460.extra, query)
+}
+func (odriverConn460 *tdriverConn460) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn460.r, odriverConn460.extra, query, args)
+}
+func (odriverConn460 *tdriverConn460) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn460.r, odriverConn460.extra, ctx, query, args)
+}
+func (odriverConn460 *tdriverConn460) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn460.r, odriverConn460.extra, param0)
+}
+func (odriverConn460 *tdriverConn460) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn460.r, odriverConn460.extra, ctx)
+}
+func (odriverConn460 *tdriverConn460) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn460.r, odriverConn460.extra, query, args)
+}
+func (odriverConn460 *tdriverConn460) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn460.r, odriverConn460.extra, ctx)
+}
+
+func (odriverConn461 *tdriverConn461) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn461.r, odriverConn461.extra)
+}
+func (odriverConn461 *tdriverConn461) Close() error {
+	return realDCClose(odriverConn461.r, odriverConn461.extra)
+}
+func (odriverConn461 *tdriverConn461) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn461.r, odriverConn461.extra, query)
+}
+func (odriverConn461 *tdriverConn461) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn461.r, odriverConn461.extra, query, args)
+}
+func (odriverConn461 *tdriverConn461) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn461.r, odriverConn461.extra, ctx, query, args)
+}
+func (odriverConn461 *tdriverConn461) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn461.r, odriverConn461.extra, param0)
+}
+func (odriverConn461 *tdriverConn461) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn461.r, odriverConn461.extra, ctx)
+}
+func (odriverConn461 *tdriverConn461) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn461.r, odriverConn461.extra, ctx, query, args)
+}
+func (odriverConn461 *tdriverConn461) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn461.r, odriverConn461.extra, ctx)
+}
+
+func (odriverConn462 *tdriverConn462) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn462.r, odriverConn462.extra)
+}
+func (odriverConn462 *tdriverConn462) Close() error {
+	return realDCClose(odriverConn462.r, odriverConn462.extra)
+}
+func (odriverConn462 *tdriverConn462) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn462.r, odriverConn462.extra, query)
+}
+func (odriverConn462 *tdriverConn462) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn462.r, odriverConn462.extra, query, args)
+}
+func (odriverConn462 *tdriverConn462) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn462.r, odriverConn462.extra, ctx, query, args)
+}
+func (odriverConn462 *tdriverConn462) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn462.r, odriverConn462.extra, param0)
+}
+func (odriverConn462 *tdriverConn462) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn462.r, odriverConn462.extra, query, args)
+}
+func (odriverConn462 *tdriverConn462) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn462.r, odriverConn462.extra, ctx, query, args)
+}
+func (odriverConn462 *tdriverConn462) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn462.r, odriverConn462.extra, ctx)
+}
+
+func (odriverConn463 *tdriverConn463) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn463.r, odriverConn463.extra)
+}
+func (odriverConn463 *tdriverConn463) Close() error {
+	return realDCClose(odriverConn463.r, odriverConn463.extra)
+}
+func (odriverConn463 *tdriverConn463) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn463.r, odriverConn463.extra, query)
+}
+func (odriverConn463 *tdriverConn463) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn463.r, odriverConn463.extra, query, args)
+}
+func (odriverConn463 *tdriverConn463) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn463.r, odriverConn463.extra, ctx, query, args)
+}
+func (odriverConn463 *tdriverConn463) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn463.r, odriverConn463.extra, ctx)
+}
+func (odriverConn463 *tdriverConn463) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn463.r, odriverConn463.extra, query, args)
+}
+func (odriverConn463 *tdriverConn463) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn463.r, odriverConn463.extra, ctx, query, args)
+}
+func (odriverConn463 *tdriverConn463) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn463.r, odriverConn463.extra, ctx)
+}
+
+func (odriverConn464 *tdriverConn464) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn464.r, odriverConn464.extra)
+}
+func (odriverConn464 *tdriverConn464) Close() error {
+	return realDCClose(odriverConn464.r, odriverConn464.extra)
+}
+func (odriverConn464 *tdriverConn464) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn464.r, odriverConn464.extra, query)
+}
+func (odriverConn464 *tdriverConn464) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn464.r, odriverConn464.extra, query, args)
+}
+func (odriverConn464 *tdriverConn464) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn464.r, odriverConn464.extra, param0)
+}
+func (odriverConn464 *tdriverConn464) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn464.r, odriverConn464.extra, ctx)
+}
+func (odriverConn464 *tdriverConn464) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn464.r, odriverConn464.extra, query, args)
+}
+func (odriverConn464 *tdriverConn464) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn464.r, odriverConn464.extra, ctx, query, args)
+}
+func (odriverConn464 *tdriverConn464) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn464.r, odriverConn464.extra, ctx)
+}
+
+func (odriverConn465 *tdriverConn465) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn465.r, odriverConn465.extra)
+}
+func (odriverConn465 *tdriverConn465) Close() error {
+	return realDCClose(odriverConn465.r, odriverConn465.extra)
+}
+func (odriverConn465 *tdriverConn465) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn465.r, odriverConn465.extra, query)
+}
+func (odriverConn465 *tdriverConn465) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn465.r, odriverConn465.extra, ctx, query, args)
+}
+func (odriverConn465 *tdriverConn465) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn465.r, odriverConn465.extra, param0)
+}
+func (odriverConn465 *tdriverConn465) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn465.r, odriverConn465.extra, ctx)
+}
+func (odriverConn465 *tdriverConn465) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn465.r, odriverConn465.extra, query, args)
+}
+func (odriverConn465 *tdriverConn465) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn465.r, odriverConn465.extra, ctx, query, args)
+}
+func (odriverConn465 *tdriverConn465) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn465.r, odriverConn465.extra, ctx)
+}
+
+func (odriverConn466 *tdriverConn466) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn466.r, odriverConn466.extra)
+}
+func (odriverConn466 *tdriverConn466) Close() error {
+	return realDCClose(odriverConn466.r, odriverConn466.extra)
+}
+func (odriverConn466 *tdriverConn466) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn466.r, odriverConn466.extra, query)
+}
+func (odriverConn466 *tdriverConn466) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn466.r, odriverConn466.extra, ctx, opts)
+}
+func (odriverConn466 *tdriverConn466) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn466.r, odriverConn466.extra, ctx, query)
+}
+func (odriverConn466 *tdriverConn466) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn466.r, odriverConn466.extra, query, args)
+}
+func (odriverConn466 *tdriverConn466) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn466.r, odriverConn466.extra, ctx, query, args)
+}
+func (odriverConn466 *tdriverConn466) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn466.r, odriverConn466.extra, param0)
+}
+func (odriverConn466 *tdriverConn466) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn466.r, odriverConn466.extra, ctx)
+}
+func (odriverConn466 *tdriverConn466) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn466.r, odriverConn466.extra, query, args)
+}
+
+func (odriverConn467 *tdriverConn467) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn467.r, odriverConn467.extra)
+}
+func (odriverConn467 *tdriverConn467) Close() error {
+	return realDCClose(odriverConn467.r, odriverConn467.extra)
+}
+func (odriverConn467 *tdriverConn467) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn467.r, odriverConn467.extra, query)
+}
+func (odriverConn467 *tdriverConn467) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn467.r, odriverConn467.extra, ctx, opts)
+}
+func (odriverConn467 *tdriverConn467) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn467.r, odriverConn467.extra, ctx, query)
+}
+func (odriverConn467 *tdriverConn467) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn467.r, odriverConn467.extra, query, args)
+}
+func (odriverConn467 *tdriverConn467) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn467.r, odriverConn467.extra, ctx, query, args)
+}
+func (odriverConn467 *tdriverConn467) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn467.r, odriverConn467.extra, param0)
+}
+func (odriverConn467 *tdriverConn467) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn467.r, odriverConn467.extra, ctx)
+}
+func (odriverConn467 *tdriverConn467) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn467.r, odriverConn467.extra, ctx, query, args)
+}
+
+func (odriverConn468 *tdriverConn468) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn468.r, odriverConn468.extra)
+}
+func (odriverConn468 *tdriverConn468) Close() error {
+	return realDCClose(odriverConn468.r, odriverConn468.extra)
+}
+func (odriverConn468 *tdriverConn468) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn468.r, odriverConn468.extra, query)
+}
+func (odriverConn468 *tdriverConn468) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn468.r, odriverConn468.extra, ctx, opts)
+}
+func (odriverConn468 *tdriverConn468) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn468.r, odriverConn468.extra, ctx, query)
+}
+func (odriverConn468 *tdriverConn468) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn468.r, odriverConn468.extra, query, args)
+}
+func (odriverConn468 *tdriverConn468) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn468.r, odriverConn468.extra, ctx, query, args)
+}
+func (odriverConn468 *tdriverConn468) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn468.r, odriverConn468.extra, param0)
+}
+func (odriverConn468 *tdriverConn468) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn468.r, odriverConn468.extra, ctx)
+}
+func (odriverConn468 *tdriverConn468) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn468.r, odriverConn468.extra, ctx)
+}
+
+func (odriverConn469 *tdriverConn469) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn469.r, odriverConn469.extra)
+}
+func (odriverConn469 *tdriverConn469) Close() error {
+	return realDCClose(odriverConn469.r, odriverConn469.extra)
+}
+func (odriverConn469 *tdriverConn469) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn469.r, odriverConn469.extra, query)
+}
+func (odriverConn469 *tdriverConn469) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn469.r, odriverConn469.extra, ctx, opts)
+}
+func (odriverConn469 *tdriverConn469) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn469.r, odriverConn469.extra, ctx, query)
+}
+func (odriverConn469 *tdriverConn469) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn469.r, odriverConn469.extra, query, args)
+}
+func (odriverConn469 *tdriverConn469) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn469.r, odriverConn469.extra, ctx, query, args)
+}
+func (odriverConn469 *tdriverConn469) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn469.r, odriverConn469.extra, param0)
+}
+func (odriverConn469 *tdriverConn469) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn469.r, odriverConn469.extra, query, args)
+}
+func (odriverConn469 *tdriverConn469) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn469.r, odriverConn469.extra, ctx, query, args)
+}
+
+func (odriverConn470 *tdriverConn470) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn470.r, odriverConn470.extra)
+}
+func (odriverConn470 *tdriverConn470) Close() error {
+	return realDCClose(odriverConn470.r, odriverConn470.extra)
+}
+func (odriverConn470 *tdriverConn470) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn470.r, odriverConn470.extra, query)
+}
+func (odriverConn470 *tdriverConn470) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn470.r, odriverConn470.extra, ctx, opts)
+}
+func (odriverConn470 *tdriverConn470) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn470.r, odriverConn470.extra, ctx, query)
+}
+func (odriverConn470 *tdriverConn470) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn470.r, odriverConn470.extra, query, args)
+}
+func (odriverConn470 *tdriverConn470) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn470.r, odriverConn470.extra, ctx, query, args)
+}
+func (odriverConn470 *tdriverConn470) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn470.r, odriverConn470.extra, param0)
+}
+func (odriverConn470 *tdriverConn470) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn470.r, odriverConn470.extra, query, args)
+}
+func (odriverConn470 *tdriverConn470) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn470.r, odriverConn470.extra, ctx)
+}
+
+func (odriverConn471 *tdriverConn471) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn471.r, odriverConn471.extra)
+}
+func (odriverConn471 *tdriverConn471) Close() error {
+	return realDCClose(odriverConn471.r, odriverConn471.extra)
+}
+func (odriverConn471 *tdriverConn471) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn471.r, odriverConn471.extra, query)
+}
+func (odriverConn471 *tdriverConn471) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn471.r, odriverConn471.extra, ctx, opts)
+}
+func (odriverConn471 *tdriverConn471) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn471.r, odriverConn471.extra, ctx, query)
+}
+func (odriverConn471 *tdriverConn471) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn471.r, odriverConn471.extra, query, args)
+}
+func (odriverConn471 *tdriverConn471) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn471.r, odriverConn471.extra, ctx, query, args)
+}
+func (odriverConn471 *tdriverConn471) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn471.r, odriverConn471.extra, param0)
+}
+func (odriverConn471 *tdriverConn471) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn471.r, odriverConn471.extra, ctx, query, args)
+}
+func (odriverConn471 *tdriverConn471) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn471.r, odriverConn471.extra, ctx)
+}
+
+func (odriverConn472 *tdriverConn472) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn472.r, odriverConn472.extra)
+}
+func (odriverConn472 *tdriverConn472) Close() error {
+	return realDCClose(odriverConn472.r, odriverConn472.extra)
+}
+func (odriverConn472 *tdriverConn472) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn472.r, odriverConn472.extra, query)
+}
+func (odriverConn472 *tdriverConn472) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn472.r, odriverConn472.extra, ctx, opts)
+}
+func (odriverConn472 *tdriverConn472) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn472.r, odriverConn472.extra, ctx, query)
+}
+func (odriverConn472 *tdriverConn472) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn472.r, odriverConn472.extra, query, args)
+}
+func (odriverConn472 *tdriverConn472) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn472.r, odriverConn472.extra, ctx, query, args)
+}
+func (odriverConn472 *tdriverConn472) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn472.r, odriverConn472.extra, ctx)
+}
+func (odriverConn472 *tdriverConn472) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn472.r, odriverConn472.extra, query, args)
+}
+func (odriverConn472 *tdriverConn472) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn472.r, odriverConn472.extra, ctx, query, args)
+}
+
+func (odriverConn473 *tdriverConn473) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn473.r, odriverConn473.extra)
+}
+func (odriverConn473 *tdriverConn473) Close() error {
+	return realDCClose(odriverConn473.r, odriverConn473.extra)
+}
+func (odriverConn473 *tdriverConn473) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn473.r, odriverConn473.extra, query)
+}
+func (odriverConn473 *tdriverConn473) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn473.r, odriverConn473.extra, ctx, opts)
+}
+func (odriverConn473 *tdriverConn473) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn473.r, odriverConn473.extra, ctx, query)
+}
+func (odriverConn473 *tdriverConn473) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn473.r, odriverConn473.extra, query, args)
+}
+func (odriverConn473 *tdriverConn473) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn473.r, odriverConn473.extra, ctx, query, args)
+}
+func (odriverConn473 *tdriverConn473) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn473.r, odriverConn473.extra, ctx)
+}
+func (odriverConn473 *tdriverConn473) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn473.r, odriverConn473.extra, query, args)
+}
+func (odriverConn473 *tdriverConn473) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn473.r, odriverConn473.extra, ctx)
+}
+
+func (odriverConn474 *tdriverConn474) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn474.r, odriverConn474.extra)
+}
+func (odriverConn474 *tdriverConn474) Close() error {
+	return realDCClose(odriverConn474.r, odriverConn474.extra)
+}
+func (odriverConn474 *tdriverConn474) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn474.r, odriverConn474.extra, query)
+}
+func (odriverConn474 *tdriverConn474) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn474.r, odriverConn474.extra, ctx, opts)
+}
+func (odriverConn474 *tdriverConn474) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn474.r, odriverConn474.extra, ctx, query)
+}
+func (odriverConn474 *tdriverConn474) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn474.r, odriverConn474.extra, query, args)
+}
+func (odriverConn474 *tdriverConn474) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn474.r, odriverConn474.extra, ctx, query, args)
+}
+func (odriverConn474 *tdriverConn474) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn474.r, odriverConn474.extra, ctx)
+}
+func (odriverConn474 *tdriverConn474) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn474.r, odriverConn474.extra, ctx, query, args)
+}
+func (odriverConn474 *tdriverConn474) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn474.r, odriverConn474.extra, ctx)
+}
+
+func (odriverConn475 *tdriverConn475) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn475.r, odriverConn475.extra)
+}
+func (odriverConn475 *tdriverConn475) Close() error {
+	return realDCClose(odriverConn475.r, odriverConn475.extra)
+}
+func (odriverConn475 *tdriverConn475) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn475.r, odriverConn475.extra, query)
+}
+func (odriverConn475 *tdriverConn475) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn475.r, odriverConn475.extra, ctx, opts)
+}
+func (odriverConn475 *tdriverConn475) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn475.r, odriverConn475.extra, ctx, query)
+}
+func (odriverConn475 *tdriverConn475) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn475.r, odriverConn475.extra, query, args)
+}
+func (odriverConn475 *tdriverConn475) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn475.r, odriverConn475.extra, ctx, query, args)
+}
+func (odriverConn475 *tdriverConn475) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn475.r, odriverConn475.extra, query, args)
+}
+func (odriverConn475 *tdriverConn475) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn475.r, odriverConn475.extra, ctx, query, args)
+}
+func (odriverConn475 *tdriverConn475) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn475.r, odriverConn475.extra, ctx)
+}
+
+func (odriverConn476 *tdriverConn476) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn476.r, odriverConn476.extra)
+}
+func (odriverConn476 *tdriverConn476) Close() error {
+	return realDCClose(odriverConn476.r, odriverConn476.extra)
+}
+func (odriverConn476 *tdriverConn476) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn476.r, odriverConn476.extra, query)
+}
+func (odriverConn476 *tdriverConn476) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn476.r, odriverConn476.extra, ctx, opts)
+}
+func (odriverConn476 *tdriverConn476) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn476.r, odriverConn476.extra, ctx, query)
+}
+func (odriverConn476 *tdriverConn476) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn476.r, odriverConn476.extra, query, args)
+}
+func (odriverConn476 *tdriverConn476) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn476.r, odriverConn476.extra, param0)
+}
+func (odriverConn476 *tdriverConn476) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn476.r, odriverConn476.extra, ctx)
+}
+func (odriverConn476 *tdriverConn476) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn476.r, odriverConn476.extra, query, args)
+}
+func (odriverConn476 *tdriverConn476) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn476.r, odriverConn476.extra, ctx, query, args)
+}
+
+func (odriverConn477 *tdriverConn477) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn477.r, odriverConn477.extra)
+}
+func (odriverConn477 *tdriverConn477) Close() error {
+	return realDCClose(odriverConn477.r, odriverConn477.extra)
+}
+func (odriverConn477 *tdriverConn477) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn477.r, odriverConn477.extra, query)
+}
+func (odriverConn477 *tdriverConn477) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn477.r, odriverConn477.extra, ctx, opts)
+}
+func (odriverConn477 *tdriverConn477) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn477.r, odriverConn477.extra, ctx, query)
+}
+func (odriverConn477 *tdriverConn477) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn477.r, odriverConn477.extra, query, args)
+}
+func (odriverConn477 *tdriverConn477) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn477.r, odriverConn477.extra, param0)
+}
+func (odriverConn477 *tdriverConn477) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn477.r, odriverConn477.extra, ctx)
+}
+func (odriverConn477 *tdriverConn477) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn477.r, odriverConn477.extra, query, args)
+}
+func (odriverConn477 *tdriverConn477) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn477.r, odriverConn477.extra, ctx)
+}
+
+func (odriverConn478 *tdriverConn478) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn478.r, odriverConn478.extra)
+}
+func (odriverConn478 *tdriverConn478) Close() error {
+	return realDCClose(odriverConn478.r, odriverConn478.extra)
+}
+func (odriverConn478 *tdriverConn478) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn478.r, odriverConn478.extra, query)
+}
+func (odriverConn478 *tdriverConn478) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn478.r, odriverConn478.extra, ctx, opts)
+}
+func (odriverConn478 *tdriverConn478) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn478.r, odriverConn478.extra, ctx, query)
+}
+func (odriverConn478 *tdriverConn478) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn478.r, odriverConn478.extra, query, args)
+}
+func (odriverConn478 *tdriverConn478) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn478.r, odriverConn478.extra, param0)
+}
+func (odriverConn478 *tdriverConn478) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn478.r, odriverConn478.extra, ctx)
+}
+func (odriverConn478 *tdriverConn478) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn478.r, odriverConn478.extra, ctx, query, args)
+}
+func (odriverConn478 *tdriverConn478) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn478.r, odriverConn478.extra, ctx)
+}
+
+func (odriverConn479 *tdriverConn479) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn479.r, odriverConn479.extra)
+}
+func (odriverConn479 *tdriverConn479) Close() error {
+	return realDCClose(odriverConn479.r, odriverConn479.extra)
+}
+func (odriverConn479 *tdriverConn479) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn479.r, odriverConn479.extra, query)
+}
+func (odriverConn479 *tdriverConn479) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn479.r, odriverConn479.extra, ctx, opts)
+}
+func (odriverConn479 *tdriverConn479) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn479.r, odriverConn479.extra, ctx, query)
+}
+func (odriverConn479 *tdriverConn479) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn479.r, odriverConn479.extra, query, args)
+}
+func (odriverConn479 *tdriverConn479) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn479.r, odriverConn479.extra, param0)
+}
+func (odriverConn479 *tdriverConn479) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn479.r, odriverConn479.extra, query, args)
+}
+func (odriverConn479 *tdriverConn479) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn479.r, odriverConn479.extra, ctx, query, args)
+}
+func (odriverConn479 *tdriverConn479) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn479.r, odriverConn479.extra, ctx)
+}
+
+func (odriverConn480 *tdriverConn480) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn480.r, odriverConn480.extra)
+}
+func (odriverConn480 *tdriverConn480) Close() error {
+	return realDCClose(odriverConn480.r, odriverConn480.extra)
+}
+func (odriverConn480 *tdriverConn480) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn480.r, odriverConn480.extra, query)
+}
+func (odriverConn480 *tdriverConn480) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn480.r, odriverConn480.extra, ctx, opts)
+}
+func (odriverConn480 *tdriverConn480) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn480.r, odriverConn480.extra, ctx, query)
+}
+func (odriverConn480 *tdriverConn480) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn480.r, odriverConn480.extra, query, args)
+}
+func (odriverConn480 *tdriverConn480) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn480.r, odriverConn480.extra, ctx)
+}
+func (odriverConn480 *tdriverConn480) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn480.r, odriverConn480.extra, query, args)
+}
+func (odriverConn480 *tdriverConn480) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn480.r, odriverConn480.extra, ctx, query, args)
+}
+func (odriverConn480 *tdriverConn480) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn480.r, odriverConn480.extra, ctx)
+}
+
+func (odriverConn481 *tdriverConn481) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn481.r, odriverConn481.extra)
+}
+func (odriverConn481 *tdriverConn481) Close() error {
+	return realDCClose(odriverConn481.r, odriverConn481.extra)
+}
+func (odriverConn481 *tdriverConn481) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn481.r, odriverConn481.extra, query)
+}
+func (odriverConn481 *tdriverConn481) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn481.r, odriverConn481.extra, ctx, opts)
+}
+func (odriverConn481 *tdriverConn481) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn481.r, odriverConn481.extra, ctx, query)
+}
+func (odriverConn481 *tdriverConn481) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn481.r, odriverConn481.extra, ctx, query, args)
+}
+func (odriverConn481 *tdriverConn481) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn481.r, odriverConn481.extra, param0)
+}
+func (odriverConn481 *tdriverConn481) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn481.r, odriverConn481.extra, ctx)
+}
+func (odriverConn481 *tdriverConn481) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn481.r, odriverConn481.extra, query, args)
+}
+func (odriverConn481 *tdriverConn481) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn481.r, odriverConn481.extra, ctx, query, args)
+}
+
+func (odriverConn482 *tdriverConn482) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn482.r, odriverConn482.extra)
+}
+func (odriverConn482 *tdriverConn482) Close() error {
+	return realDCClose(odriverConn482.r, odriverConn482.extra)
+}
+func (odriverConn482 *tdriverConn482) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn482.r, odriverConn482.extra, query)
+}
+func (odriverConn482 *tdriverConn482) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn482.r, odriverConn482.extra, ctx, opts)
+}
+func (odriverConn482 *tdriverConn482) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn482.r, odriverConn482.extra, ctx, query)
+}
+func (odriverConn482 *tdriverConn482) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn482.r, odriverConn482.extra, ctx, query, args)
+}
+func (odriverConn482 *tdriverConn482) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn482.r, odriverConn482.extra, param0)
+}
+func (odriverConn482 *tdriverConn482) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn482.r, odriverConn482.extra, ctx)
+}
+func (odriverConn482 *tdriverConn482) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn482.r, odriverConn482.extra, query, args)
+}
+func (odriverConn482 *tdriverConn482) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn482.r, odriverConn482.extra, ctx)
+}
+
+func (odriverConn483 *tdriverConn483) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn483.r, odriverConn483.extra)
+}
+func (odriverConn483 *tdriverConn483) Close() error {
+	return realDCClose(odriverConn483.r, odriverConn483.extra)
+}
+func (odriverConn483 *tdriverConn483) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn483.r, odriverConn483.extra, query)
+}
+func (odriverConn483 *tdriverConn483) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn483.r, odriverConn483.extra, ctx, opts)
+}
+func (odriverConn483 *tdriverConn483) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn483.r, odriverConn483.extra, ctx, query)
+}
+func (odriverConn483 *tdriverConn483) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn483.r, odriverConn483.extra, ctx, query, args)
+}
+func (odriverConn483 *tdriverConn483) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn483.r, odriverConn483.extra, param0)
+}
+func (odriverConn483 *tdriverConn483) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn483.r, odriverConn483.extra, ctx)
+}
+func (odriverConn483 *tdriverConn483) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn483.r, odriverConn483.extra, ctx, query, args)
+}
+func (odriverConn483 *tdriverConn483) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn483.r, odriverConn483.extra, ctx)
+}
+
+func (odriverConn484 *tdriverConn484) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn484.r, odriverConn484.extra)
+}
+func (odriverConn484 *tdriverConn484) Close() error {
+	return realDCClose(odriverConn484.r, odriverConn484.extra)
+}
+func (odriverConn484 *tdriverConn484) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn484.r, odriverConn484.extra, query)
+}
+func (odriverConn484 *tdriverConn484) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn484.r, odriverConn484.extra, ctx, opts)
+}
+func (odriverConn484 *tdriverConn484) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn484.r, odriverConn484.extra, ctx, query)
+}
+func (odriverConn484 *tdriverConn484) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn484.r, odriverConn484.extra, ctx, query, args)
+}
+func (odriverConn484 *tdriverConn484) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn484.r, odriverConn484.extra, param0)
+}
+func (odriverConn484 *tdriverConn484) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn484.r, odriverConn484.extra, query, args)
+}
+func (odriverConn484 *tdriverConn484) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn484.r, odriverConn484.extra, ctx, query, args)
+}
+func (odriverConn484 *tdriverConn484) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn484.r, odriverConn484.extra, ctx)
+}
+
+func (odriverConn485 *tdriverConn485) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn485.r, odriverConn485.extra)
+}
+func (odriverConn485 *tdriverConn485) Close() error {
+	return realDCClose(odriverConn485.r, odriverConn485.extra)
+}
+func (odriverConn485 *tdriverConn485) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn485.r, odriverConn485.extra, query)
+}
+func (odriverConn485 *tdriverConn485) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn485.r, odriverConn485.extra, ctx, opts)
+}
+func (odriverConn485 *tdriverConn485) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn485.r, odriverConn485.extra, ctx, query)
+}
+func (odriverConn485 *tdriverConn485) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn485.r, odriverConn485.extra, ctx, query, args)
+}
+func (odriverConn485 *tdriverConn485) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn485.r, odriverConn485.extra, ctx)
+}
+func (odriverConn485 *tdriverConn485) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn485.r, odriverConn485.extra, query, args)
+}
+func (odriverConn485 *tdriverConn485) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn485.r, odriverConn485.extra, ctx, query, args)
+}
+func (odriverConn485 *tdriverConn485) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn485.r, odriverConn485.extra, ctx)
+}
+
+func (odriverConn486 *tdriverConn486) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn486.r, odriverConn486.extra)
+}
+func (odriverConn486 *tdriverConn486) Close() error {
+	return realDCClose(odriverConn486.r, odriverConn486.extra)
+}
+func (odriverConn486 *tdriverConn486) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn486.r, odriverConn486.extra, query)
+}
+func (odriverConn486 *tdriverConn486) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn486.r, odriverConn486.extra, ctx, opts)
+}
+func (odriverConn486 *tdriverConn486) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn486.r, odriverConn486.extra, ctx, query)
+}
+func (odriverConn486 *tdriverConn486) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn486.r, odriverConn486.extra, param0)
+}
+func (odriverConn486 *tdriverConn486) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn486.r, odriverConn486.extra, ctx)
+}
+func (odriverConn486 *tdriverConn486) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn486.r, odriverConn486.extra, query, args)
+}
+func (odriverConn486 *tdriverConn486) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn486.r, odriverConn486.extra, ctx, query, args)
+}
+func (odriverConn486 *tdriverConn486) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn486.r, odriverConn486.extra, ctx)
+}
+
+func (odriverConn487 *tdriverConn487) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn487.r, odriverConn487.extra)
+}
+func (odriverConn487 *tdriverConn487) Close() error {
+	return realDCClose(odriverConn487.r, odriverConn487.extra)
+}
+func (odriverConn487 *tdriverConn487) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn487.r, odriverConn487.extra, query)
+}
+func (odriverConn487 *tdriverConn487) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn487.r, odriverConn487.extra, ctx, opts)
+}
+func (odriverConn487 *tdriverConn487) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn487.r, odriverConn487.extra, query, args)
+}
+func (odriverConn487 *tdriverConn487) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn487.r, odriverConn487.extra, ctx, query, args)
+}
+func (odriverConn487 *tdriverConn487) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn487.r, odriverConn487.extra, param0)
+}
+func (odriverConn487 *tdriverConn487) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn487.r, odriverConn487.extra, ctx)
+}
+func (odriverConn487 *tdriverConn487) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn487.r, odriverConn487.extra, query, args)
+}
+func (odriverConn487 *tdriverConn487) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn487.r, odriverConn487.extra, ctx, query, args)
+}
+
+func (odriverConn488 *tdriverConn488) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn488.r, odriverConn488.extra)
+}
+func (odriverConn488 *tdriverConn488) Close() error {
+	return realDCClose(odriverConn488.r, odriverConn488.extra)
+}
+func (odriverConn488 *tdriverConn488) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn488.r, odriverConn488.extra, query)
+}
+func (odriverConn488 *tdriverConn488) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn488.r, odriverConn488.extra, ctx, opts)
+}
+func (odriverConn488 *tdriverConn488) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn488.r, odriverConn488.extra, query, args)
+}
+func (odriverConn488 *tdriverConn488) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn488.r, odriverConn488.extra, ctx, query, args)
+}
+func (odriverConn488 *tdriverConn488) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn488.r, odriverConn488.extra, param0)
+}
+func (odriverConn488 *tdriverConn488) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn488.r, odriverConn488.extra, ctx)
+}
+func (odriverConn488 *tdriverConn488) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn488.r, odriverConn488.extra, query, args)
+}
+func (odriverConn488 *tdriverConn488) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn488.r, odriverConn488.extra, ctx)
+}
+
+func (odriverConn489 *tdriverConn489) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn489.r, odriverConn489.extra)
+}
+func (odriverConn489 *tdriverConn489) Close() error {
+	return realDCClose(odriverConn489.r, odriverConn489.extra)
+}
+func (odriverConn489 *tdriverConn489) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn489.r, odriverConn489.extra, query)
+}
+func (odriverConn489 *tdriverConn489) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn489.r, odriverConn489.extra, ctx, opts)
+}
+func (odriverConn489 *tdriverConn489) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn489.r, odriverConn489.extra, query, args)
+}
+func (odriverConn489 *tdriverConn489) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn489.r, odriverConn489.extra, ctx, query, args)
+}
+func (odriverConn489 *tdriverConn489) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn489.r, odriverConn489.extra, param0)
+}
+func (odriverConn489 *tdriverConn489) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn489.r, odriverConn489.extra, ctx)
+}
+func (odriverConn489 *tdriverConn489) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn489.r, odriverConn489.extra, ctx, query, args)
+}
+func (odriverConn489 *tdriverConn489) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn489.r, odriverConn489.extra, ctx)
+}
+
+func (odriverConn490 *tdriverConn490) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn490.r, odriverConn490.extra)
+}
+func (odriverConn490 *tdriverConn490) Close() error {
+	return realDCClose(odriverConn490.r, odriverConn490.extra)
+}
+func (odriverConn490 *tdriverConn490) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn490.r, odriverConn490.extra, query)
+}
+func (odriverConn490 *tdriverConn490) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn490.r, odriverConn490.extra, ctx, opts)
+}
+func (odriverConn490 *tdriverConn490) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn490.r, odriverConn490.extra, query, args)
+}
+func (odriverConn490 *tdriverConn490) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn490.r, odriverConn490.extra, ctx, query, args)
+}
+func (odriverConn490 *tdriverConn490) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn490.r, odriverConn490.extra, param0)
+}
+func (odriverConn490 *tdriverConn490) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn490.r, odriverConn490.extra, query, args)
+}
+func (odriverConn490 *tdriverConn490) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn490.r, odriverConn490.extra, ctx, query, args)
+}
+func (odriverConn490 *tdriverConn490) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn490.r, odriverConn490.extra, ctx)
+}
+
+func (odriverConn491 *tdriverConn491) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn491.r, odriverConn491.extra)
+}
+func (odriverConn491 *tdriverConn491) Close() error {
+	return realDCClose(odriverConn491.r, odriverConn491.extra)
+}
+func (odriverConn491 *tdriverConn491) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn491.r, odriverConn491.extra, query)
+}
+func (odriverConn491 *tdriverConn491) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn491.r, odriverConn491.extra, ctx, opts)
+}
+func (odriverConn491 *tdriverConn491) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn491.r, odriverConn491.extra, query, args)
+}
+func (odriverConn491 *tdriverConn491) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn491.r, odriverConn491.extra, ctx, query, args)
+}
+func (odriverConn491 *tdriverConn491) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn491.r, odriverConn491.extra, ctx)
+}
+func (odriverConn491 *tdriverConn491) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn491.r, odriverConn491.extra, query, args)
+}
+func (odriverConn491 *tdriverConn491) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn491.r, odriverConn491.extra, ctx, query, args)
+}
+func (odriverConn491 *tdriverConn491) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn491.r, odriverConn491.extra, ctx)
+}
+
+func (odriverConn492 *tdriverConn492) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn492.r, odriverConn492.extra)
+}
+func (odriverConn492 *tdriverConn492) Close() error {
+	return realDCClose(odriverConn492.r, odriverConn492.extra)
+}
+func (odriverConn492 *tdriverConn492) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn492.r, odriverConn492.extra, query)
+}
+func (odriverConn492 *tdriverConn492) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn492.r, odriverConn492.extra, ctx, opts)
+}
+func (odriverConn492 *tdriverConn492) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn492.r, odriverConn492.extra, query, args)
+}
+func (odriverConn492 *tdriverConn492) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn492.r, odriverConn492.extra, param0)
+}
+func (odriverConn492 *tdriverConn492) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn492.r, odriverConn492.extra, ctx)
+}
+func (odriverConn492 *tdriverConn492) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn492.r, odriverConn492.extra, query, args)
+}
+func (odriverConn492 *tdriverConn492) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn492.r, odriverConn492.extra, ctx, query, args)
+}
+func (odriverConn492 *tdriverConn492) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn492.r, odriverConn492.extra, ctx)
+}
+
+func (odriverConn493 *tdriverConn493) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn493.r, odriverConn493.extra)
+}
+func (odriverConn493 *tdriverConn493) Close() error {
+	return realDCClose(odriverConn493.r, odriverConn493.extra)
+}
+func (odriverConn493 *tdriverConn493) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn493.r, odriverConn493.extra, query)
+}
+func (odriverConn493 *tdriverConn493) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn493.r, odriverConn493.extra, ctx, opts)
+}
+func (odriverConn493 *tdriverConn493) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn493.r, odriverConn493.extra, ctx, query, args)
+}
+func (odriverConn493 *tdriverConn493) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn493.r, odriverConn493.extra, param0)
+}
+func (odriverConn493 *tdriverConn493) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn493.r, odriverConn493.extra, ctx)
+}
+func (odriverConn493 *tdriverConn493) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn493.r, odriverConn493.extra, query, args)
+}
+func (odriverConn493 *tdriverConn493) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn493.r, odriverConn493.extra, ctx, query, args)
+}
+func (odriverConn493 *tdriverConn493) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn493.r, odriverConn493.extra, ctx)
+}
+
+func (odriverConn494 *tdriverConn494) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn494.r, odriverConn494.extra)
+}
+func (odriverConn494 *tdriverConn494) Close() error {
+	return realDCClose(odriverConn494.r, odriverConn494.extra)
+}
+func (odriverConn494 *tdriverConn494) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn494.r, odriverConn494.extra, query)
+}
+func (odriverConn494 *tdriverConn494) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn494.r, odriverConn494.extra, ctx, query)
+}
+func (odriverConn494 *tdriverConn494) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn494.r, odriverConn494.extra, query, args)
+}
+func (odriverConn494 *tdriverConn494) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn494.r, odriverConn494.extra, ctx, query, args)
+}
+func (odriverConn494 *tdriverConn494) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn494.r, odriverConn494.extra, param0)
+}
+func (odriverConn494 *tdriverConn494) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn494.r, odriverConn494.extra, ctx)
+}
+func (odriverConn494 *tdriverConn494) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn494.r, odriverConn494.extra, query, args)
+}
+func (odriverConn494 *tdriverConn494) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn494.r, odriverConn494.extra, ctx, query, args)
+}
+
+func (odriverConn495 *tdriverConn495) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn495.r, odriverConn495.extra)
+}
+func (odriverConn495 *tdriverConn495) Close() error {
+	return realDCClose(odriverConn495.r, odriverConn495.extra)
+}
+func (odriverConn495 *tdriverConn495) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn495.r, odriverConn495.extra, query)
+}
+func (odriverConn495 *tdriverConn495) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn495.r, odriverConn495.extra, ctx, query)
+}
+func (odriverConn495 *tdriverConn495) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn495.r, odriverConn495.extra, query, args)
+}
+func (odriverConn495 *tdriverConn495) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn495.r, odriverConn495.extra, ctx, query, args)
+}
+func (odriverConn495 *tdriverConn495) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn495.r, odriverConn495.extra, param0)
+}
+func (odriverConn495 *tdriverConn495) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn495.r, odriverConn495.extra, ctx)
+}
+func (odriverConn495 *tdriverConn495) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn495.r, odriverConn495.extra, query, args)
+}
+func (odriverConn495 *tdriverConn495) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn495.r, odriverConn495.extra, ctx)
+}
+
+func (odriverConn496 *tdriverConn496) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn496.r, odriverConn496.extra)
+}
+func (odriverConn496 *tdriverConn496) Close() error {
+	return realDCClose(odriverConn496.r, odriverConn496.extra)
+}
+func (odriverConn496 *tdriverConn496) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn496.r, odriverConn496.extra, query)
+}
+func (odriverConn496 *tdriverConn496) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn496.r, odriverConn496.extra, ctx, query)
+}
+func (odriverConn496 *tdriverConn496) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn496.r, odriverConn496.extra, query, args)
+}
+func (odriverConn496 *tdriverConn496) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn496.r, odriverConn496.extra, ctx, query, args)
+}
+func (odriverConn496 *tdriverConn496) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn496.r, odriverConn496.extra, param0)
+}
+func (odriverConn496 *tdriverConn496) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn496.r, odriverConn496.extra, ctx)
+}
+func (odriverConn496 *tdriverConn496) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn496.r, odriverConn496.extra, ctx, query, args)
+}
+func (odriverConn496 *tdriverConn496) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn496.r, odriverConn496.extra, ctx)
+}
+
+func (odriverConn497 *tdriverConn497) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn497.r, odriverConn497.extra)
+}
+func (odriverConn497 *tdriverConn497) Close() error {
+	return realDCClose(odriverConn497.r, odriverConn497.extra)
+}
+func (odriverConn497 *tdriverConn497) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn497.r, odriverConn497.extra, query)
+}
+func (odriverConn497 *tdriverConn497) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn497.r, odriverConn497.extra, ctx, query)
+}
+func (odriverConn497 *tdriverConn497) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn497.r, odriverConn497.extra, query, args)
+}
+func (odriverConn497 *tdriverConn497) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn497.r, odriverConn497.extra, ctx, query, args)
+}
+func (odriverConn497 *tdriverConn497) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn497.r, odriverConn497.extra, param0)
+}
+func (odriverConn497 *tdriverConn497) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn497.r, odriverConn497.extra, query, args)
+}
+func (odriverConn497 *tdriverConn497) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn497.r, odriverConn497.extra, ctx, query, args)
+}
+func (odriverConn497 *tdriverConn497) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn497.r, odriverConn497.extra, ctx)
+}
+
+func (odriverConn498 *tdriverConn498) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn498.r, odriverConn498.extra)
+}
+func (odriverConn498 *tdriverConn498) Close() error {
+	return realDCClose(odriverConn498.r, odriverConn498.extra)
+}
+func (odriverConn498 *tdriverConn498) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn498.r, odriverConn498.extra, query)
+}
+func (odriverConn498 *tdriverConn498) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn498.r, odriverConn498.extra, ctx, query)
+}
+func (odriverConn498 *tdriverConn498) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn498.r, odriverConn498.extra, query, args)
+}
+func (odriverConn498 *tdriverConn498) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn498.r, odriverConn498.extra, ctx, query, args)
+}
+func (odriverConn498 *tdriverConn498) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn498.r, odriverConn498.extra, ctx)
+}
+func (odriverConn498 *tdriverConn498) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn498.r, odriverConn498.extra, query, args)
+}
+func (odriverConn498 *tdriverConn498) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn498.r, odriverConn498.extra, ctx, query, args)
+}
+func (odriverConn498 *tdriverConn498) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn498.r, odriverConn498.extra, ctx)
+}
+
+func (odriverConn499 *tdriverConn499) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn499.r, odriverConn499.extra)
+}
+func (odriverConn499 *tdriverConn499) Close() error {
+	return realDCClose(odriverConn499.r, odriverConn499.extra)
+}
+func (odriverConn499 *tdriverConn499) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn499.r, odriverConn499.extra, query)
+}
+func (odriverConn499 *tdriverConn499) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn499.r, odriverConn499.extra, ctx, query)
+}
+func (odriverConn499 *tdriverConn499) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn499.r, odriverConn499.extra, query, args)
+}
+func (odriverConn499 *tdriverConn499) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn499.r, odriverConn499.extra, param0)
+}
+func (odriverConn499 *tdriverConn499) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn499.r, odriverConn499.extra, ctx)
+}
+func (odriverConn499 *tdriverConn499) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn499.r, odriverConn499.extra, query, args)
+}
+func (odriverConn499 *tdriverConn499) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn499.r, odriverConn499.extra, ctx, query, args)
+}
+func (odriverConn499 *tdriverConn499) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn499.r, odriverConn499.extra, ctx)
+}
+
+func (odriverConn500 *tdriverConn500) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn500.r, odriverConn500.extra)
+}
+func (odriverConn500 *tdriverConn500) Close() error {
+	return realDCClose(odriverConn500.r, odriverConn500.extra)
+}
+func (odriverConn500 *tdriverConn500) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn500.r, odriverConn500.extra, query)
+}
+func (odriverConn500 *tdriverConn500) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn500.r, odriverConn500.extra, ctx, query)
+}
+func (odriverConn500 *tdriverConn500) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn500.r, odriverConn500.extra, ctx, query, args)
+}
+func (odriverConn500 *tdriverConn500) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn500.r, odriverConn500.extra, param0)
+}
+func (odriverConn500 *tdriverConn500) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn500.r, odriverConn500.extra, ctx)
+}
+func (odriverConn500 *tdriverConn500) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn500.r, odriverConn500.extra, query, args)
+}
+func (odriverConn500 *tdriverConn500) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn500.r, odriverConn500.extra, ctx, query, args)
+}
+func (odriverConn500 *tdriverConn500) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn500.r, odriverConn500.extra, ctx)
+}
+
+func (odriverConn501 *tdriverConn501) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn501.r, odriverConn501.extra)
+}
+func (odriverConn501 *tdriverConn501) Close() error {
+	return realDCClose(odriverConn501.r, odriverConn501.extra)
+}
+func (odriverConn501 *tdriverConn501) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn501.r, odriverConn501.extra, query)
+}
+func (odriverConn501 *tdriverConn501) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn501.r, odriverConn501.extra, query, args)
+}
+func (odriverConn501 *tdriverConn501) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn501.r, odriverConn501.extra, ctx, query, args)
+}
+func (odriverConn501 *tdriverConn501) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn501.r, odriverConn501.extra, param0)
+}
+func (odriverConn501 *tdriverConn501) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn501.r, odriverConn501.extra, ctx)
+}
+func (odriverConn501 *tdriverConn501) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn501.r, odriverConn501.extra, query, args)
+}
+func (odriverConn501 *tdriverConn501) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn501.r, odriverConn501.extra, ctx, query, args)
+}
+func (odriverConn501 *tdriverConn501) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn501.r, odriverConn501.extra, ctx)
+}
+
+func (odriverConn502 *tdriverConn502) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn502.r, odriverConn502.extra)
+}
+func (odriverConn502 *tdriverConn502) Close() error {
+	return realDCClose(odriverConn502.r, odriverConn502.extra)
+}
+func (odriverConn502 *tdriverConn502) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn502.r, odriverConn502.extra, query)
+}
+func (odriverConn502 *tdriverConn502) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn502.r, odriverConn502.extra, ctx, opts)
+}
+func (odriverConn502 *tdriverConn502) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn502.r, odriverConn502.extra, ctx, query)
+}
+func (odriverConn502 *tdriverConn502) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn502.r, odriverConn502.extra, query, args)
+}
+func (odriverConn502 *tdriverConn502) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn502.r, odriverConn502.extra, ctx, query, args)
+}
+func (odriverConn502 *tdriverConn502) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn502.r, odriverConn502.extra, param0)
+}
+func (odriverConn502 *tdriverConn502) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn502.r, odriverConn502.extra, ctx)
+}
+func (odriverConn502 *tdriverConn502) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn502.r, odriverConn502.extra, query, args)
+}
+func (odriverConn502 *tdriverConn502) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn502.r, odriverConn502.extra, ctx, query, args)
+}
+
+func (odriverConn503 *tdriverConn503) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn503.r, odriverConn503.extra)
+}
+func (odriverConn503 *tdriverConn503) Close() error {
+	return realDCClose(odriverConn503.r, odriverConn503.extra)
+}
+func (odriverConn503 *tdriverConn503) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn503.r, odriverConn503.extra, query)
+}
+func (odriverConn503 *tdriverConn503) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn503.r, odriverConn503.extra, ctx, opts)
+}
+func (odriverConn503 *tdriverConn503) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn503.r, odriverConn503.extra, ctx, query)
+}
+func (odriverConn503 *tdriverConn503) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn503.r, odriverConn503.extra, query, args)
+}
+func (odriverConn503 *tdriverConn503) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn503.r, odriverConn503.extra, ctx, query, args)
+}
+func (odriverConn503 *tdriverConn503) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn503.r, odriverConn503.extra, param0)
+}
+func (odriverConn503 *tdriverConn503) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn503.r, odriverConn503.extra, ctx)
+}
+func (odriverConn503 *tdriverConn503) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn503.r, odriverConn503.extra, query, args)
+}
+func (odriverConn503 *tdriverConn503) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn503.r, odriverConn503.extra, ctx)
+}
+
+func (odriverConn504 *tdriverConn504) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn504.r, odriverConn504.extra)
+}
+func (odriverConn504 *tdriverConn504) Close() error {
+	return realDCClose(odriverConn504.r, odriverConn504.extra)
+}
+func (odriverConn504 *tdriverConn504) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn504.r, odriverConn504.extra, query)
+}
+func (odriverConn504 *tdriverConn504) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn504.r, odriverConn504.extra, ctx, opts)
+}
+func (odriverConn504 *tdriverConn504) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn504.r, odriverConn504.extra, ctx, query)
+}
+func (odriverConn504 *tdriverConn504) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn504.r, odriverConn504.extra, query, args)
+}
+func (odriverConn504 *tdriverConn504) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn504.r, odriverConn504.extra, ctx, query, args)
+}
+func (odriverConn504 *tdriverConn504) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn504.r, odriverConn504.extra, param0)
+}
+func (odriverConn504 *tdriverConn504) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn504.r, odriverConn504.extra, ctx)
+}
+func (odriverConn504 *tdriverConn504) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn504.r, odriverConn504.extra, ctx, query, args)
+}
+func (odriverConn504 *tdriverConn504) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn504.r, odriverConn504.extra, ctx)
+}
+
+func (odriverConn505 *tdriverConn505) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn505.r, odriverConn505.extra)
+}
+func (odriverConn505 *tdriverConn505) Close() error {
+	return realDCClose(odriverConn505.r, odriverConn505.extra)
+}
+func (odriverConn505 *tdriverConn505) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn505.r, odriverConn505.extra, query)
+}
+func (odriverConn505 *tdriverConn505) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn505.r, odriverConn505.extra, ctx, opts)
+}
+func (odriverConn505 *tdriverConn505) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn505.r, odriverConn505.extra, ctx, query)
+}
+func (odriverConn505 *tdriverConn505) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn505.r, odriverConn505.extra, query, args)
+}
+func (odriverConn505 *tdriverConn505) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn505.r, odriverConn505.extra, ctx, query, args)
+}
+func (odriverConn505 *tdriverConn505) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn505.r, odriverConn505.extra, param0)
+}
+func (odriverConn505 *tdriverConn505) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn505.r, odriverConn505.extra, query, args)
+}
+func (odriverConn505 *tdriverConn505) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn505.r, odriverConn505.extra, ctx, query, args)
+}
+func (odriverConn505 *tdriverConn505) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn505.r, odriverConn505.extra, ctx)
+}
+
+func (odriverConn506 *tdriverConn506) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn506.r, odriverConn506.extra)
+}
+func (odriverConn506 *tdriverConn506) Close() error {
+	return realDCClose(odriverConn506.r, odriverConn506.extra)
+}
+func (odriverConn506 *tdriverConn506) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn506.r, odriverConn506.extra, query)
+}
+func (odriverConn506 *tdriverConn506) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn506.r, odriverConn506.extra, ctx, opts)
+}
+func (odriverConn506 *tdriverConn506) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn506.r, odriverConn506.extra, ctx, query)
+}
+func (odriverConn506 *tdriverConn506) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn506.r, odriverConn506.extra, query, args)
+}
+func (odriverConn506 *tdriverConn506) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn506.r, odriverConn506.extra, ctx, query, args)
+}
+func (odriverConn506 *tdriverConn506) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn506.r, odriverConn506.extra, ctx)
+}
+func (odriverConn506 *tdriverConn506) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn506.r, odriverConn506.extra, query, args)
+}
+func (odriverConn506 *tdriverConn506) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn506.r, odriverConn506.extra, ctx, query, args)
+}
+func (odriverConn506 *tdriverConn506) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn506.r, odriverConn506.extra, ctx)
+}
+
+func (odriverConn507 *tdriverConn507) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn507.r, odriverConn507.extra)
+}
+func (odriverConn507 *tdriverConn507) Close() error {
+	return realDCClose(odriverConn507.r, odriverConn507.extra)
+}
+func (odriverConn507 *tdriverConn507) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn507.r, odriverConn507.extra, query)
+}
+func (odriverConn507 *tdriverConn507) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn507.r, odriverConn507.extra, ctx, opts)
+}
+func (odriverConn507 *tdriverConn507) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn507.r, odriverConn507.extra, ctx, query)
+}
+func (odriverConn507 *tdriverConn507) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn507.r, odriverConn507.extra, query, args)
+}
+func (odriverConn507 *tdriverConn507) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn507.r, odriverConn507.extra, param0)
+}
+func (odriverConn507 *tdriverConn507) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn507.r, odriverConn507.extra, ctx)
+}
+func (odriverConn507 *tdriverConn507) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn507.r, odriverConn507.extra, query, args)
+}
+func (odriverConn507 *tdriverConn507) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn507.r, odriverConn507.extra, ctx, query, args)
+}
+func (odriverConn507 *tdriverConn507) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn507.r, odriverConn507.extra, ctx)
+}
+
+func (odriverConn508 *tdriverConn508) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn508.r, odriverConn508.extra)
+}
+func (odriverConn508 *tdriverConn508) Close() error {
+	return realDCClose(odriverConn508.r, odriverConn508.extra)
+}
+func (odriverConn508 *tdriverConn508) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn508.r, odriverConn508.extra, query)
+}
+func (odriverConn508 *tdriverConn508) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn508.r, odriverConn508.extra, ctx, opts)
+}
+func (odriverConn508 *tdriverConn508) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn508.r, odriverConn508.extra, ctx, query)
+}
+func (odriverConn508 *tdriverConn508) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn508.r, odriverConn508.extra, ctx, query, args)
+}
+func (odriverConn508 *tdriverConn508) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn508.r, odriverConn508.extra, param0)
+}
+func (odriverConn508 *tdriverConn508) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn508.r, odriverConn508.extra, ctx)
+}
+func (odriverConn508 *tdriverConn508) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn508.r, odriverConn508.extra, query, args)
+}
+func (odriverConn508 *tdriverConn508) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn508.r, odriverConn508.extra, ctx, query, args)
+}
+func (odriverConn508 *tdriverConn508) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn508.r, odriverConn508.extra, ctx)
+}
+
+func (odriverConn509 *tdriverConn509) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn509.r, odriverConn509.extra)
+}
+func (odriverConn509 *tdriverConn509) Close() error {
+	return realDCClose(odriverConn509.r, odriverConn509.extra)
+}
+func (odriverConn509 *tdriverConn509) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn509.r, odriverConn509.extra, query)
+}
+func (odriverConn509 *tdriverConn509) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn509.r, odriverConn509.extra, ctx, opts)
+}
+func (odriverConn509 *tdriverConn509) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn509.r, odriverConn509.extra, query, args)
+}
+func (odriverConn509 *tdriverConn509) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn509.r, odriverConn509.extra, ctx, query, args)
+}
+func (odriverConn509 *tdriverConn509) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn509.r, odriverConn509.extra, param0)
+}
+func (odriverConn509 *tdriverConn509) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn509.r, odriverConn509.extra, ctx)
+}
+func (odriverConn509 *tdriverConn509) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn509.r, odriverConn509.extra, query, args)
+}
+func (odriverConn509 *tdriverConn509) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn509.r, odriverConn509.extra, ctx, query, args)
+}
+func (odriverConn509 *tdriverConn509) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn509.r, odriverConn509.extra, ctx)
+}
+
+func (odriverConn510 *tdriverConn510) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn510.r, odriverConn510.extra)
+}
+func (odriverConn510 *tdriverConn510) Close() error {
+	return realDCClose(odriverConn510.r, odriverConn510.extra)
+}
+func (odriverConn510 *tdriverConn510) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn510.r, odriverConn510.extra, query)
+}
+func (odriverConn510 *tdriverConn510) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn510.r, odriverConn510.extra, ctx, query)
+}
+func (odriverConn510 *tdriverConn510) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn510.r, odriverConn510.extra, query, args)
+}
+func (odriverConn510 *tdriverConn510) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn510.r, odriverConn510.extra, ctx, query, args)
+}
+func (odriverConn510 *tdriverConn510) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn510.r, odriverConn510.extra, param0)
+}
+func (odriverConn510 *tdriverConn510) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn510.r, odriverConn510.extra, ctx)
+}
+func (odriverConn510 *tdriverConn510) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn510.r, odriverConn510.extra, query, args)
+}
+func (odriverConn510 *tdriverConn510) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn510.r, odriverConn510.extra, ctx, query, args)
+}
+func (odriverConn510 *tdriverConn510) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn510.r, odriverConn510.extra, ctx)
+}
+
+func (odriverConn511 *tdriverConn511) Begin() (driver.Tx, error) {
+	return realDCBegin(odriverConn511.r, odriverConn511.extra)
+}
+func (odriverConn511 *tdriverConn511) Close() error {
+	return realDCClose(odriverConn511.r, odriverConn511.extra)
+}
+func (odriverConn511 *tdriverConn511) Prepare(query string) (driver.Stmt, error) {
+	return realDCPrepare(odriverConn511.r, odriverConn511.extra, query)
+}
+func (odriverConn511 *tdriverConn511) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return realDCBeginTx(odriverConn511.r, odriverConn511.extra, ctx, opts)
+}
+func (odriverConn511 *tdriverConn511) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return realDCPrepareContext(odriverConn511.r, odriverConn511.extra, ctx, query)
+}
+func (odriverConn511 *tdriverConn511) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return realDCExec(odriverConn511.r, odriverConn511.extra, query, args)
+}
+func (odriverConn511 *tdriverConn511) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return realDCExecContext(odriverConn511.r, odriverConn511.extra, ctx, query, args)
+}
+func (odriverConn511 *tdriverConn511) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDCCheckNamedValue(odriverConn511.r, odriverConn511.extra, param0)
+}
+func (odriverConn511 *tdriverConn511) Ping(ctx context.Context) error {
+	return realDCPing(odriverConn511.r, odriverConn511.extra, ctx)
+}
+func (odriverConn511 *tdriverConn511) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return realDCQuery(odriverConn511.r, odriverConn511.extra, query, args)
+}
+func (odriverConn511 *tdriverConn511) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return realDCQueryContext(odriverConn511.r, odriverConn511.extra, ctx, query, args)
+}
+func (odriverConn511 *tdriverConn511) ResetSession(ctx context.Context) error {
+	return realDCResetSession(odriverConn511.r, odriverConn511.extra, ctx)
+}
+
+func newConn(realDCConn driver.Conn, extra interface{}) driver.Conn {
+	switch r := realDCConn.(type) {
+	case idriverConn511:
+		return &tdriverConn511{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn510:
+		return &tdriverConn510{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn509:
+		return &tdriverConn509{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn508:
+		return &tdriverConn508{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn507:
+		return &tdriverConn507{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn506:
+		return &tdriverConn506{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn505:
+		return &tdriverConn505{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn504:
+		return &tdriverConn504{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn503:
+		return &tdriverConn503{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn502:
+		return &tdriverConn502{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn501:
+		return &tdriverConn501{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn500:
+		return &tdriverConn500{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn499:
+		return &tdriverConn499{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn498:
+		return &tdriverConn498{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn497:
+		return &tdriverConn497{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn496:
+		return &tdriverConn496{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn495:
+		return &tdriverConn495{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn494:
+		return &tdriverConn494{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn493:
+		return &tdriverConn493{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn492:
+		return &tdriverConn492{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn491:
+		return &tdriverConn491{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn490:
+		return &tdriverConn490{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn489:
+		return &tdriverConn489{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn488:
+		return &tdriverConn488{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn487:
+		return &tdriverConn487{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn486:
+		return &tdriverConn486{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn485:
+		return &tdriverConn485{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn484:
+		return &tdriverConn484{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn483:
+		return &tdriverConn483{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn482:
+		return &tdriverConn482{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn481:
+		return &tdriverConn481{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn480:
+		return &tdriverConn480{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn479:
+		return &tdriverConn479{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn478:
+		return &tdriverConn478{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn477:
+		return &tdriverConn477{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn476:
+		return &tdriverConn476{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn475:
+		return &tdriverConn475{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn474:
+		return &tdriverConn474{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn473:
+		return &tdriverConn473{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn472:
+		return &tdriverConn472{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn471:
+		return &tdriverConn471{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn470:
+		return &tdriverConn470{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn469:
+		return &tdriverConn469{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn468:
+		return &tdriverConn468{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn467:
+		return &tdriverConn467{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn466:
+		return &tdriverConn466{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn465:
+		return &tdriverConn465{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn464:
+		return &tdriverConn464{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn463:
+		return &tdriverConn463{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn462:
+		return &tdriverConn462{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn461:
+		return &tdriverConn461{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn460:
+		return &tdriverConn460{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn459:
+		return &tdriverConn459{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn458:
+		return &tdriverConn458{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn457:
+		return &tdriverConn457{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn456:
+		return &tdriverConn456{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn455:
+		return &tdriverConn455{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn454:
+		return &tdriverConn454{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn453:
+		return &tdriverConn453{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn452:
+		return &tdriverConn452{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn451:
+		return &tdriverConn451{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn450:
+		return &tdriverConn450{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn449:
+		return &tdriverConn449{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn448:
+		return &tdriverConn448{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn447:
+		return &tdriverConn447{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn446:
+		return &tdriverConn446{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn445:
+		return &tdriverConn445{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn444:
+		return &tdriverConn444{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn443:
+		return &tdriverConn443{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn442:
+		return &tdriverConn442{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn441:
+		return &tdriverConn441{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn440:
+		return &tdriverConn440{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn439:
+		return &tdriverConn439{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn438:
+		return &tdriverConn438{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn437:
+		return &tdriverConn437{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn436:
+		return &tdriverConn436{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn435:
+		return &tdriverConn435{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn434:
+		return &tdriverConn434{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn433:
+		return &tdriverConn433{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn432:
+		return &tdriverConn432{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn431:
+		return &tdriverConn431{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn430:
+		return &tdriverConn430{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn429:
+		return &tdriverConn429{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn428:
+		return &tdriverConn428{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn427:
+		return &tdriverConn427{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn426:
+		return &tdriverConn426{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn425:
+		return &tdriverConn425{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn424:
+		return &tdriverConn424{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn423:
+		return &tdriverConn423{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn422:
+		return &tdriverConn422{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn421:
+		return &tdriverConn421{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn420:
+		return &tdriverConn420{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn419:
+		return &tdriverConn419{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn418:
+		return &tdriverConn418{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn417:
+		return &tdriverConn417{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn416:
+		return &tdriverConn416{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn415:
+		return &tdriverConn415{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn414:
+		return &tdriverConn414{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn413:
+		return &tdriverConn413{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn412:
+		return &tdriverConn412{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn411:
+		return &tdriverConn411{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn410:
+		return &tdriverConn410{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn409:
+		return &tdriverConn409{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn408:
+		return &tdriverConn408{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn407:
+		return &tdriverConn407{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn406:
+		return &tdriverConn406{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn405:
+		return &tdriverConn405{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn404:
+		return &tdriverConn404{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn403:
+		return &tdriverConn403{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn402:
+		return &tdriverConn402{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn401:
+		return &tdriverConn401{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn400:
+		return &tdriverConn400{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn399:
+		return &tdriverConn399{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn398:
+		return &tdriverConn398{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn397:
+		return &tdriverConn397{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn396:
+		return &tdriverConn396{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn395:
+		return &tdriverConn395{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn394:
+		return &tdriverConn394{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn393:
+		return &tdriverConn393{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn392:
+		return &tdriverConn392{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn391:
+		return &tdriverConn391{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn390:
+		return &tdriverConn390{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn389:
+		return &tdriverConn389{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn388:
+		return &tdriverConn388{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn387:
+		return &tdriverConn387{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn386:
+		return &tdriverConn386{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn385:
+		return &tdriverConn385{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn384:
+		return &tdriverConn384{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn383:
+		return &tdriverConn383{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn382:
+		return &tdriverConn382{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn381:
+		return &tdriverConn381{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn380:
+		return &tdriverConn380{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn379:
+		return &tdriverConn379{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn378:
+		return &tdriverConn378{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn377:
+		return &tdriverConn377{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn376:
+		return &tdriverConn376{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn375:
+		return &tdriverConn375{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn374:
+		return &tdriverConn374{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn373:
+		return &tdriverConn373{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn372:
+		return &tdriverConn372{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn371:
+		return &tdriverConn371{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn370:
+		return &tdriverConn370{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn369:
+		return &tdriverConn369{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn368:
+		return &tdriverConn368{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn367:
+		return &tdriverConn367{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn366:
+		return &tdriverConn366{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn365:
+		return &tdriverConn365{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn364:
+		return &tdriverConn364{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn363:
+		return &tdriverConn363{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn362:
+		return &tdriverConn362{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn361:
+		return &tdriverConn361{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn360:
+		return &tdriverConn360{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn359:
+		return &tdriverConn359{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn358:
+		return &tdriverConn358{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn357:
+		return &tdriverConn357{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn356:
+		return &tdriverConn356{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn355:
+		return &tdriverConn355{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn354:
+		return &tdriverConn354{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn353:
+		return &tdriverConn353{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn352:
+		return &tdriverConn352{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn351:
+		return &tdriverConn351{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn350:
+		return &tdriverConn350{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn349:
+		return &tdriverConn349{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn348:
+		return &tdriverConn348{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn347:
+		return &tdriverConn347{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn346:
+		return &tdriverConn346{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn345:
+		return &tdriverConn345{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn344:
+		return &tdriverConn344{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn343:
+		return &tdriverConn343{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn342:
+		return &tdriverConn342{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn341:
+		return &tdriverConn341{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn340:
+		return &tdriverConn340{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn339:
+		return &tdriverConn339{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn338:
+		return &tdriverConn338{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn337:
+		return &tdriverConn337{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn336:
+		return &tdriverConn336{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn335:
+		return &tdriverConn335{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn334:
+		return &tdriverConn334{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn333:
+		return &tdriverConn333{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn332:
+		return &tdriverConn332{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn331:
+		return &tdriverConn331{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn330:
+		return &tdriverConn330{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn329:
+		return &tdriverConn329{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn328:
+		return &tdriverConn328{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn327:
+		return &tdriverConn327{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn326:
+		return &tdriverConn326{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn325:
+		return &tdriverConn325{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn324:
+		return &tdriverConn324{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn323:
+		return &tdriverConn323{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn322:
+		return &tdriverConn322{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn321:
+		return &tdriverConn321{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn320:
+		return &tdriverConn320{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn319:
+		return &tdriverConn319{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn318:
+		return &tdriverConn318{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn317:
+		return &tdriverConn317{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn316:
+		return &tdriverConn316{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn315:
+		return &tdriverConn315{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn314:
+		return &tdriverConn314{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn313:
+		return &tdriverConn313{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn312:
+		return &tdriverConn312{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn311:
+		return &tdriverConn311{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn310:
+		return &tdriverConn310{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn309:
+		return &tdriverConn309{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn308:
+		return &tdriverConn308{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn307:
+		return &tdriverConn307{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn306:
+		return &tdriverConn306{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn305:
+		return &tdriverConn305{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn304:
+		return &tdriverConn304{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn303:
+		return &tdriverConn303{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn302:
+		return &tdriverConn302{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn301:
+		return &tdriverConn301{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn300:
+		return &tdriverConn300{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn299:
+		return &tdriverConn299{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn298:
+		return &tdriverConn298{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn297:
+		return &tdriverConn297{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn296:
+		return &tdriverConn296{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn295:
+		return &tdriverConn295{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn294:
+		return &tdriverConn294{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn293:
+		return &tdriverConn293{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn292:
+		return &tdriverConn292{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn291:
+		return &tdriverConn291{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn290:
+		return &tdriverConn290{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn289:
+		return &tdriverConn289{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn288:
+		return &tdriverConn288{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn287:
+		return &tdriverConn287{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn286:
+		return &tdriverConn286{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn285:
+		return &tdriverConn285{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn284:
+		return &tdriverConn284{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn283:
+		return &tdriverConn283{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn282:
+		return &tdriverConn282{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn281:
+		return &tdriverConn281{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn280:
+		return &tdriverConn280{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn279:
+		return &tdriverConn279{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn278:
+		return &tdriverConn278{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn277:
+		return &tdriverConn277{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn276:
+		return &tdriverConn276{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn275:
+		return &tdriverConn275{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn274:
+		return &tdriverConn274{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn273:
+		return &tdriverConn273{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn272:
+		return &tdriverConn272{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn271:
+		return &tdriverConn271{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn270:
+		return &tdriverConn270{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn269:
+		return &tdriverConn269{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn268:
+		return &tdriverConn268{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn267:
+		return &tdriverConn267{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn266:
+		return &tdriverConn266{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn265:
+		return &tdriverConn265{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn264:
+		return &tdriverConn264{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn263:
+		return &tdriverConn263{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn262:
+		return &tdriverConn262{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn261:
+		return &tdriverConn261{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn260:
+		return &tdriverConn260{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn259:
+		return &tdriverConn259{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn258:
+		return &tdriverConn258{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn257:
+		return &tdriverConn257{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn256:
+		return &tdriverConn256{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn255:
+		return &tdriverConn255{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn254:
+		return &tdriverConn254{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn253:
+		return &tdriverConn253{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn252:
+		return &tdriverConn252{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn251:
+		return &tdriverConn251{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn250:
+		return &tdriverConn250{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn249:
+		return &tdriverConn249{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn248:
+		return &tdriverConn248{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn247:
+		return &tdriverConn247{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn246:
+		return &tdriverConn246{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn245:
+		return &tdriverConn245{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn244:
+		return &tdriverConn244{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn243:
+		return &tdriverConn243{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn242:
+		return &tdriverConn242{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn241:
+		return &tdriverConn241{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn240:
+		return &tdriverConn240{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn239:
+		return &tdriverConn239{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn238:
+		return &tdriverConn238{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn237:
+		return &tdriverConn237{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn236:
+		return &tdriverConn236{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn235:
+		return &tdriverConn235{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn234:
+		return &tdriverConn234{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn233:
+		return &tdriverConn233{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn232:
+		return &tdriverConn232{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn231:
+		return &tdriverConn231{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn230:
+		return &tdriverConn230{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn229:
+		return &tdriverConn229{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn228:
+		return &tdriverConn228{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn227:
+		return &tdriverConn227{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn226:
+		return &tdriverConn226{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn225:
+		return &tdriverConn225{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn224:
+		return &tdriverConn224{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn223:
+		return &tdriverConn223{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn222:
+		return &tdriverConn222{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn221:
+		return &tdriverConn221{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn220:
+		return &tdriverConn220{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn219:
+		return &tdriverConn219{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn218:
+		return &tdriverConn218{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn217:
+		return &tdriverConn217{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn216:
+		return &tdriverConn216{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn215:
+		return &tdriverConn215{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn214:
+		return &tdriverConn214{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn213:
+		return &tdriverConn213{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn212:
+		return &tdriverConn212{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn211:
+		return &tdriverConn211{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn210:
+		return &tdriverConn210{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn209:
+		return &tdriverConn209{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn208:
+		return &tdriverConn208{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn207:
+		return &tdriverConn207{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn206:
+		return &tdriverConn206{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn205:
+		return &tdriverConn205{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn204:
+		return &tdriverConn204{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn203:
+		return &tdriverConn203{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn202:
+		return &tdriverConn202{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn201:
+		return &tdriverConn201{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn200:
+		return &tdriverConn200{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn199:
+		return &tdriverConn199{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn198:
+		return &tdriverConn198{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn197:
+		return &tdriverConn197{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn196:
+		return &tdriverConn196{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn195:
+		return &tdriverConn195{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn194:
+		return &tdriverConn194{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn193:
+		return &tdriverConn193{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn192:
+		return &tdriverConn192{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn191:
+		return &tdriverConn191{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn190:
+		return &tdriverConn190{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn189:
+		return &tdriverConn189{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn188:
+		return &tdriverConn188{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn187:
+		return &tdriverConn187{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn186:
+		return &tdriverConn186{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn185:
+		return &tdriverConn185{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn184:
+		return &tdriverConn184{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn183:
+		return &tdriverConn183{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn182:
+		return &tdriverConn182{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn181:
+		return &tdriverConn181{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn180:
+		return &tdriverConn180{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn179:
+		return &tdriverConn179{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn178:
+		return &tdriverConn178{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn177:
+		return &tdriverConn177{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn176:
+		return &tdriverConn176{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn175:
+		return &tdriverConn175{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn174:
+		return &tdriverConn174{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn173:
+		return &tdriverConn173{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn172:
+		return &tdriverConn172{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn171:
+		return &tdriverConn171{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn170:
+		return &tdriverConn170{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn169:
+		return &tdriverConn169{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn168:
+		return &tdriverConn168{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn167:
+		return &tdriverConn167{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn166:
+		return &tdriverConn166{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn165:
+		return &tdriverConn165{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn164:
+		return &tdriverConn164{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn163:
+		return &tdriverConn163{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn162:
+		return &tdriverConn162{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn161:
+		return &tdriverConn161{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn160:
+		return &tdriverConn160{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn159:
+		return &tdriverConn159{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn158:
+		return &tdriverConn158{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn157:
+		return &tdriverConn157{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn156:
+		return &tdriverConn156{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn155:
+		return &tdriverConn155{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn154:
+		return &tdriverConn154{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn153:
+		return &tdriverConn153{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn152:
+		return &tdriverConn152{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn151:
+		return &tdriverConn151{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn150:
+		return &tdriverConn150{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn149:
+		return &tdriverConn149{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn148:
+		return &tdriverConn148{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn147:
+		return &tdriverConn147{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn146:
+		return &tdriverConn146{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn145:
+		return &tdriverConn145{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn144:
+		return &tdriverConn144{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn143:
+		return &tdriverConn143{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn142:
+		return &tdriverConn142{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn141:
+		return &tdriverConn141{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn140:
+		return &tdriverConn140{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn139:
+		return &tdriverConn139{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn138:
+		return &tdriverConn138{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn137:
+		return &tdriverConn137{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn136:
+		return &tdriverConn136{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn135:
+		return &tdriverConn135{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn134:
+		return &tdriverConn134{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn133:
+		return &tdriverConn133{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn132:
+		return &tdriverConn132{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn131:
+		return &tdriverConn131{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn130:
+		return &tdriverConn130{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn129:
+		return &tdriverConn129{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn128:
+		return &tdriverConn128{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn127:
+		return &tdriverConn127{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn126:
+		return &tdriverConn126{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn125:
+		return &tdriverConn125{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn124:
+		return &tdriverConn124{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn123:
+		return &tdriverConn123{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn122:
+		return &tdriverConn122{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn121:
+		return &tdriverConn121{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn120:
+		return &tdriverConn120{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn119:
+		return &tdriverConn119{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn118:
+		return &tdriverConn118{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn117:
+		return &tdriverConn117{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn116:
+		return &tdriverConn116{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn115:
+		return &tdriverConn115{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn114:
+		return &tdriverConn114{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn113:
+		return &tdriverConn113{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn112:
+		return &tdriverConn112{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn111:
+		return &tdriverConn111{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn110:
+		return &tdriverConn110{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn109:
+		return &tdriverConn109{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn108:
+		return &tdriverConn108{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn107:
+		return &tdriverConn107{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn106:
+		return &tdriverConn106{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn105:
+		return &tdriverConn105{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn104:
+		return &tdriverConn104{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn103:
+		return &tdriverConn103{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn102:
+		return &tdriverConn102{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn101:
+		return &tdriverConn101{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn100:
+		return &tdriverConn100{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn99:
+		return &tdriverConn99{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn98:
+		return &tdriverConn98{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn97:
+		return &tdriverConn97{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn96:
+		return &tdriverConn96{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn95:
+		return &tdriverConn95{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn94:
+		return &tdriverConn94{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn93:
+		return &tdriverConn93{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn92:
+		return &tdriverConn92{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn91:
+		return &tdriverConn91{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn90:
+		return &tdriverConn90{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn89:
+		return &tdriverConn89{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn88:
+		return &tdriverConn88{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn87:
+		return &tdriverConn87{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn86:
+		return &tdriverConn86{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn85:
+		return &tdriverConn85{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn84:
+		return &tdriverConn84{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn83:
+		return &tdriverConn83{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn82:
+		return &tdriverConn82{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn81:
+		return &tdriverConn81{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn80:
+		return &tdriverConn80{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn79:
+		return &tdriverConn79{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn78:
+		return &tdriverConn78{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn77:
+		return &tdriverConn77{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn76:
+		return &tdriverConn76{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn75:
+		return &tdriverConn75{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn74:
+		return &tdriverConn74{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn73:
+		return &tdriverConn73{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn72:
+		return &tdriverConn72{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn71:
+		return &tdriverConn71{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn70:
+		return &tdriverConn70{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn69:
+		return &tdriverConn69{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn68:
+		return &tdriverConn68{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn67:
+		return &tdriverConn67{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn66:
+		return &tdriverConn66{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn65:
+		return &tdriverConn65{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn64:
+		return &tdriverConn64{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn63:
+		return &tdriverConn63{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn62:
+		return &tdriverConn62{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn61:
+		return &tdriverConn61{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn60:
+		return &tdriverConn60{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn59:
+		return &tdriverConn59{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn58:
+		return &tdriverConn58{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn57:
+		return &tdriverConn57{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn56:
+		return &tdriverConn56{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn55:
+		return &tdriverConn55{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn54:
+		return &tdriverConn54{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn53:
+		return &tdriverConn53{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn52:
+		return &tdriverConn52{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn51:
+		return &tdriverConn51{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn50:
+		return &tdriverConn50{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn49:
+		return &tdriverConn49{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn48:
+		return &tdriverConn48{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn47:
+		return &tdriverConn47{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn46:
+		return &tdriverConn46{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn45:
+		return &tdriverConn45{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn44:
+		return &tdriverConn44{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn43:
+		return &tdriverConn43{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn42:
+		return &tdriverConn42{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn41:
+		return &tdriverConn41{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn40:
+		return &tdriverConn40{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn39:
+		return &tdriverConn39{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn38:
+		return &tdriverConn38{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn37:
+		return &tdriverConn37{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn36:
+		return &tdriverConn36{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn35:
+		return &tdriverConn35{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn34:
+		return &tdriverConn34{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn33:
+		return &tdriverConn33{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn32:
+		return &tdriverConn32{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn31:
+		return &tdriverConn31{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn30:
+		return &tdriverConn30{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn29:
+		return &tdriverConn29{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn28:
+		return &tdriverConn28{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn27:
+		return &tdriverConn27{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn26:
+		return &tdriverConn26{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn25:
+		return &tdriverConn25{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn24:
+		return &tdriverConn24{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn23:
+		return &tdriverConn23{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn22:
+		return &tdriverConn22{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn21:
+		return &tdriverConn21{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn20:
+		return &tdriverConn20{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn19:
+		return &tdriverConn19{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn18:
+		return &tdriverConn18{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn17:
+		return &tdriverConn17{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn16:
+		return &tdriverConn16{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn15:
+		return &tdriverConn15{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn14:
+		return &tdriverConn14{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn13:
+		return &tdriverConn13{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn12:
+		return &tdriverConn12{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn11:
+		return &tdriverConn11{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn10:
+		return &tdriverConn10{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn9:
+		return &tdriverConn9{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn8:
+		return &tdriverConn8{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn7:
+		return &tdriverConn7{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn6:
+		return &tdriverConn6{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn5:
+		return &tdriverConn5{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn4:
+		return &tdriverConn4{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn3:
+		return &tdriverConn3{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn2:
+		return &tdriverConn2{
+			r:     r,
+			extra: extra,
+		}
+	case idriverConn1:
+		return &tdriverConn1{
+			r:     r,
+			extra: extra,
+		}
+	}
+	return &tdriverConn0{
+		r:     realDCConn,
+		extra: extra,
+	}
+}
@@ -0,0 +1,59 @@
+// Code generated by "wrappergen -basetype=driver.Driver -exttypes=driver.DriverContext -extrafields=extra,interface{} -prefix=realDD -newfuncname=newDriver"; DO NOT EDIT.
+
+package test
+
+import (
+	"database/sql/driver"
+)
+
+type (
+	idriverDriver0 interface {
+		driver.Driver
+	}
+
+	tdriverDriver0 struct {
+		r     idriverDriver0
+		extra interface{}
+	}
+
+	idriverDriver1 interface {
+		driver.Driver
+		driver.DriverContext
+	}
+
+	tdriverDriver1 struct {
+		r     idriverDriver1
+		extra interface{}
+	}
+)
+
+var (
+	_ driver.Driver        = &tdriverDriver0{}
+	_ driver.Driver        = &tdriverDriver1{}
+	_ driver.DriverContext = &tdriverDriver1{}
+)
+
+func (odriverDriver0 *tdriverDriver0) Open(name string) (driver.Conn, error) {
+	return realDDOpen(odriverDriver0.r, odriverDriver0.extra, name)
+}
+
+func (odriverDriver1 *tdriverDriver1) Open(name string) (driver.Conn, error) {
+	return realDDOpen(odriverDriver1.r, odriverDriver1.extra, name)
+}
+func (odriverDriver1 *tdriverDriver1) OpenConnector(name string) (driver.Connector, error) {
+	return realDDOpenConnector(odriverDriver1.r, odriverDriver1.extra, name)
+}
+
+func newDriver(realDDDriver driver.Driver, extra interface{}) driver.Driver {
+	switch r := realDDDriver.(type) {
+	case idriverDriver1:
+		return &tdriverDriver1{
+			r:     r,
+			extra: extra,
+		}
+	}
+	return &tdriverDriver0{
+		r:     realDDDriver,
+		extra: extra,
+	}
+}
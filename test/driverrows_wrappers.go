@@ -0,0 +1,2673 @@
+// Code generated by "wrappergen -basetype=driver.Rows -exttypes=driver.RowsColumnTypeDatabaseTypeName;driver.RowsColumnTypeLength;driver.RowsColumnTypeNullable;driver.RowsColumnTypePrecisionScale;driver.RowsColumnTypeScanType;driver.RowsNextResultSet -extrafields=extra,interface{} -prefix=realDR -newfuncname=newRows"; DO NOT EDIT.
+
+package test
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+type (
+	idriverRows0 interface {
+		driver.Rows
+	}
+
+	tdriverRows0 struct {
+		r     idriverRows0
+		extra interface{}
+	}
+
+	idriverRows1 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+	}
+
+	tdriverRows1 struct {
+		r     idriverRows1
+		extra interface{}
+	}
+
+	idriverRows2 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+	}
+
+	tdriverRows2 struct {
+		r     idriverRows2
+		extra interface{}
+	}
+
+	idriverRows3 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+	}
+
+	tdriverRows3 struct {
+		r     idriverRows3
+		extra interface{}
+	}
+
+	idriverRows4 interface {
+		driver.Rows
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows4 struct {
+		r     idriverRows4
+		extra interface{}
+	}
+
+	idriverRows5 interface {
+		driver.Rows
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows5 struct {
+		r     idriverRows5
+		extra interface{}
+	}
+
+	idriverRows6 interface {
+		driver.Rows
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows6 struct {
+		r     idriverRows6
+		extra interface{}
+	}
+
+	idriverRows7 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+	}
+
+	tdriverRows7 struct {
+		r     idriverRows7
+		extra interface{}
+	}
+
+	idriverRows8 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+	}
+
+	tdriverRows8 struct {
+		r     idriverRows8
+		extra interface{}
+	}
+
+	idriverRows9 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows9 struct {
+		r     idriverRows9
+		extra interface{}
+	}
+
+	idriverRows10 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows10 struct {
+		r     idriverRows10
+		extra interface{}
+	}
+
+	idriverRows11 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows11 struct {
+		r     idriverRows11
+		extra interface{}
+	}
+
+	idriverRows12 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+	}
+
+	tdriverRows12 struct {
+		r     idriverRows12
+		extra interface{}
+	}
+
+	idriverRows13 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows13 struct {
+		r     idriverRows13
+		extra interface{}
+	}
+
+	idriverRows14 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows14 struct {
+		r     idriverRows14
+		extra interface{}
+	}
+
+	idriverRows15 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows15 struct {
+		r     idriverRows15
+		extra interface{}
+	}
+
+	idriverRows16 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows16 struct {
+		r     idriverRows16
+		extra interface{}
+	}
+
+	idriverRows17 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows17 struct {
+		r     idriverRows17
+		extra interface{}
+	}
+
+	idriverRows18 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows18 struct {
+		r     idriverRows18
+		extra interface{}
+	}
+
+	idriverRows19 interface {
+		driver.Rows
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows19 struct {
+		r     idriverRows19
+		extra interface{}
+	}
+
+	idriverRows20 interface {
+		driver.Rows
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows20 struct {
+		r     idriverRows20
+		extra interface{}
+	}
+
+	idriverRows21 interface {
+		driver.Rows
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows21 struct {
+		r     idriverRows21
+		extra interface{}
+	}
+
+	idriverRows22 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+	}
+
+	tdriverRows22 struct {
+		r     idriverRows22
+		extra interface{}
+	}
+
+	idriverRows23 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows23 struct {
+		r     idriverRows23
+		extra interface{}
+	}
+
+	idriverRows24 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows24 struct {
+		r     idriverRows24
+		extra interface{}
+	}
+
+	idriverRows25 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows25 struct {
+		r     idriverRows25
+		extra interface{}
+	}
+
+	idriverRows26 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows26 struct {
+		r     idriverRows26
+		extra interface{}
+	}
+
+	idriverRows27 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows27 struct {
+		r     idriverRows27
+		extra interface{}
+	}
+
+	idriverRows28 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows28 struct {
+		r     idriverRows28
+		extra interface{}
+	}
+
+	idriverRows29 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows29 struct {
+		r     idriverRows29
+		extra interface{}
+	}
+
+	idriverRows30 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows30 struct {
+		r     idriverRows30
+		extra interface{}
+	}
+
+	idriverRows31 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows31 struct {
+		r     idriverRows31
+		extra interface{}
+	}
+
+	idriverRows32 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows32 struct {
+		r     idriverRows32
+		extra interface{}
+	}
+
+	idriverRows33 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows33 struct {
+		r     idriverRows33
+		extra interface{}
+	}
+
+	idriverRows34 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows34 struct {
+		r     idriverRows34
+		extra interface{}
+	}
+
+	idriverRows35 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows35 struct {
+		r     idriverRows35
+		extra interface{}
+	}
+
+	idriverRows36 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows36 struct {
+		r     idriverRows36
+		extra interface{}
+	}
+
+	idriverRows37 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows37 struct {
+		r     idriverRows37
+		extra interface{}
+	}
+
+	idriverRows38 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows38 struct {
+		r     idriverRows38
+		extra interface{}
+	}
+
+	idriverRows39 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows39 struct {
+		r     idriverRows39
+		extra interface{}
+	}
+
+	idriverRows40 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows40 struct {
+		r     idriverRows40
+		extra interface{}
+	}
+
+	idriverRows41 interface {
+		driver.Rows
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows41 struct {
+		r     idriverRows41
+		extra interface{}
+	}
+
+	idriverRows42 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+	}
+
+	tdriverRows42 struct {
+		r     idriverRows42
+		extra interface{}
+	}
+
+	idriverRows43 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows43 struct {
+		r     idriverRows43
+		extra interface{}
+	}
+
+	idriverRows44 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows44 struct {
+		r     idriverRows44
+		extra interface{}
+	}
+
+	idriverRows45 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows45 struct {
+		r     idriverRows45
+		extra interface{}
+	}
+
+	idriverRows46 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows46 struct {
+		r     idriverRows46
+		extra interface{}
+	}
+
+	idriverRows47 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows47 struct {
+		r     idriverRows47
+		extra interface{}
+	}
+
+	idriverRows48 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows48 struct {
+		r     idriverRows48
+		extra interface{}
+	}
+
+	idriverRows49 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows49 struct {
+		r     idriverRows49
+		extra interface{}
+	}
+
+	idriverRows50 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows50 struct {
+		r     idriverRows50
+		extra interface{}
+	}
+
+	idriverRows51 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows51 struct {
+		r     idriverRows51
+		extra interface{}
+	}
+
+	idriverRows52 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows52 struct {
+		r     idriverRows52
+		extra interface{}
+	}
+
+	idriverRows53 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows53 struct {
+		r     idriverRows53
+		extra interface{}
+	}
+
+	idriverRows54 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows54 struct {
+		r     idriverRows54
+		extra interface{}
+	}
+
+	idriverRows55 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows55 struct {
+		r     idriverRows55
+		extra interface{}
+	}
+
+	idriverRows56 interface {
+		driver.Rows
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows56 struct {
+		r     idriverRows56
+		extra interface{}
+	}
+
+	idriverRows57 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+	}
+
+	tdriverRows57 struct {
+		r     idriverRows57
+		extra interface{}
+	}
+
+	idriverRows58 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows58 struct {
+		r     idriverRows58
+		extra interface{}
+	}
+
+	idriverRows59 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows59 struct {
+		r     idriverRows59
+		extra interface{}
+	}
+
+	idriverRows60 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows60 struct {
+		r     idriverRows60
+		extra interface{}
+	}
+
+	idriverRows61 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows61 struct {
+		r     idriverRows61
+		extra interface{}
+	}
+
+	idriverRows62 interface {
+		driver.Rows
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows62 struct {
+		r     idriverRows62
+		extra interface{}
+	}
+
+	idriverRows63 interface {
+		driver.Rows
+		driver.RowsColumnTypeDatabaseTypeName
+		driver.RowsColumnTypeLength
+		driver.RowsColumnTypeNullable
+		driver.RowsColumnTypePrecisionScale
+		driver.RowsColumnTypeScanType
+		driver.RowsNextResultSet
+	}
+
+	tdriverRows63 struct {
+		r     idriverRows63
+		extra interface{}
+	}
+)
+
+var (
+	_ driver.Rows                           = &tdriverRows0{}
+	_ driver.Rows                           = &tdriverRows1{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows1{}
+	_ driver.Rows                           = &tdriverRows2{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows2{}
+	_ driver.Rows                           = &tdriverRows3{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows3{}
+	_ driver.Rows                           = &tdriverRows4{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows4{}
+	_ driver.Rows                           = &tdriverRows5{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows5{}
+	_ driver.Rows                           = &tdriverRows6{}
+	_ driver.RowsNextResultSet              = &tdriverRows6{}
+	_ driver.Rows                           = &tdriverRows7{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows7{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows7{}
+	_ driver.Rows                           = &tdriverRows8{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows8{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows8{}
+	_ driver.Rows                           = &tdriverRows9{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows9{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows9{}
+	_ driver.Rows                           = &tdriverRows10{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows10{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows10{}
+	_ driver.Rows                           = &tdriverRows11{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows11{}
+	_ driver.RowsNextResultSet              = &tdriverRows11{}
+	_ driver.Rows                           = &tdriverRows12{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows12{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows12{}
+	_ driver.Rows                           = &tdriverRows13{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows13{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows13{}
+	_ driver.Rows                           = &tdriverRows14{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows14{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows14{}
+	_ driver.Rows                           = &tdriverRows15{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows15{}
+	_ driver.RowsNextResultSet              = &tdriverRows15{}
+	_ driver.Rows                           = &tdriverRows16{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows16{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows16{}
+	_ driver.Rows                           = &tdriverRows17{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows17{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows17{}
+	_ driver.Rows                           = &tdriverRows18{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows18{}
+	_ driver.RowsNextResultSet              = &tdriverRows18{}
+	_ driver.Rows                           = &tdriverRows19{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows19{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows19{}
+	_ driver.Rows                           = &tdriverRows20{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows20{}
+	_ driver.RowsNextResultSet              = &tdriverRows20{}
+	_ driver.Rows                           = &tdriverRows21{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows21{}
+	_ driver.RowsNextResultSet              = &tdriverRows21{}
+	_ driver.Rows                           = &tdriverRows22{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows22{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows22{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows22{}
+	_ driver.Rows                           = &tdriverRows23{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows23{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows23{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows23{}
+	_ driver.Rows                           = &tdriverRows24{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows24{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows24{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows24{}
+	_ driver.Rows                           = &tdriverRows25{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows25{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows25{}
+	_ driver.RowsNextResultSet              = &tdriverRows25{}
+	_ driver.Rows                           = &tdriverRows26{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows26{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows26{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows26{}
+	_ driver.Rows                           = &tdriverRows27{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows27{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows27{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows27{}
+	_ driver.Rows                           = &tdriverRows28{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows28{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows28{}
+	_ driver.RowsNextResultSet              = &tdriverRows28{}
+	_ driver.Rows                           = &tdriverRows29{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows29{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows29{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows29{}
+	_ driver.Rows                           = &tdriverRows30{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows30{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows30{}
+	_ driver.RowsNextResultSet              = &tdriverRows30{}
+	_ driver.Rows                           = &tdriverRows31{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows31{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows31{}
+	_ driver.RowsNextResultSet              = &tdriverRows31{}
+	_ driver.Rows                           = &tdriverRows32{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows32{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows32{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows32{}
+	_ driver.Rows                           = &tdriverRows33{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows33{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows33{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows33{}
+	_ driver.Rows                           = &tdriverRows34{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows34{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows34{}
+	_ driver.RowsNextResultSet              = &tdriverRows34{}
+	_ driver.Rows                           = &tdriverRows35{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows35{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows35{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows35{}
+	_ driver.Rows                           = &tdriverRows36{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows36{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows36{}
+	_ driver.RowsNextResultSet              = &tdriverRows36{}
+	_ driver.Rows                           = &tdriverRows37{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows37{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows37{}
+	_ driver.RowsNextResultSet              = &tdriverRows37{}
+	_ driver.Rows                           = &tdriverRows38{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows38{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows38{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows38{}
+	_ driver.Rows                           = &tdriverRows39{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows39{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows39{}
+	_ driver.RowsNextResultSet              = &tdriverRows39{}
+	_ driver.Rows                           = &tdriverRows40{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows40{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows40{}
+	_ driver.RowsNextResultSet              = &tdriverRows40{}
+	_ driver.Rows                           = &tdriverRows41{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows41{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows41{}
+	_ driver.RowsNextResultSet              = &tdriverRows41{}
+	_ driver.Rows                           = &tdriverRows42{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows42{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows42{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows42{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows42{}
+	_ driver.Rows                           = &tdriverRows43{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows43{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows43{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows43{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows43{}
+	_ driver.Rows                           = &tdriverRows44{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows44{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows44{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows44{}
+	_ driver.RowsNextResultSet              = &tdriverRows44{}
+	_ driver.Rows                           = &tdriverRows45{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows45{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows45{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows45{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows45{}
+	_ driver.Rows                           = &tdriverRows46{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows46{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows46{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows46{}
+	_ driver.RowsNextResultSet              = &tdriverRows46{}
+	_ driver.Rows                           = &tdriverRows47{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows47{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows47{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows47{}
+	_ driver.RowsNextResultSet              = &tdriverRows47{}
+	_ driver.Rows                           = &tdriverRows48{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows48{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows48{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows48{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows48{}
+	_ driver.Rows                           = &tdriverRows49{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows49{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows49{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows49{}
+	_ driver.RowsNextResultSet              = &tdriverRows49{}
+	_ driver.Rows                           = &tdriverRows50{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows50{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows50{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows50{}
+	_ driver.RowsNextResultSet              = &tdriverRows50{}
+	_ driver.Rows                           = &tdriverRows51{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows51{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows51{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows51{}
+	_ driver.RowsNextResultSet              = &tdriverRows51{}
+	_ driver.Rows                           = &tdriverRows52{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows52{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows52{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows52{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows52{}
+	_ driver.Rows                           = &tdriverRows53{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows53{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows53{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows53{}
+	_ driver.RowsNextResultSet              = &tdriverRows53{}
+	_ driver.Rows                           = &tdriverRows54{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows54{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows54{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows54{}
+	_ driver.RowsNextResultSet              = &tdriverRows54{}
+	_ driver.Rows                           = &tdriverRows55{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows55{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows55{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows55{}
+	_ driver.RowsNextResultSet              = &tdriverRows55{}
+	_ driver.Rows                           = &tdriverRows56{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows56{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows56{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows56{}
+	_ driver.RowsNextResultSet              = &tdriverRows56{}
+	_ driver.Rows                           = &tdriverRows57{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows57{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows57{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows57{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows57{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows57{}
+	_ driver.Rows                           = &tdriverRows58{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows58{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows58{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows58{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows58{}
+	_ driver.RowsNextResultSet              = &tdriverRows58{}
+	_ driver.Rows                           = &tdriverRows59{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows59{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows59{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows59{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows59{}
+	_ driver.RowsNextResultSet              = &tdriverRows59{}
+	_ driver.Rows                           = &tdriverRows60{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows60{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows60{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows60{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows60{}
+	_ driver.RowsNextResultSet              = &tdriverRows60{}
+	_ driver.Rows                           = &tdriverRows61{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows61{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows61{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows61{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows61{}
+	_ driver.RowsNextResultSet              = &tdriverRows61{}
+	_ driver.Rows                           = &tdriverRows62{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows62{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows62{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows62{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows62{}
+	_ driver.RowsNextResultSet              = &tdriverRows62{}
+	_ driver.Rows                           = &tdriverRows63{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &tdriverRows63{}
+	_ driver.RowsColumnTypeLength           = &tdriverRows63{}
+	_ driver.RowsColumnTypeNullable         = &tdriverRows63{}
+	_ driver.RowsColumnTypePrecisionScale   = &tdriverRows63{}
+	_ driver.RowsColumnTypeScanType         = &tdriverRows63{}
+	_ driver.RowsNextResultSet              = &tdriverRows63{}
+)
+
+func (odriverRows0 *tdriverRows0) Close() error {
+	return realDRClose(odriverRows0.r, odriverRows0.extra)
+}
+func (odriverRows0 *tdriverRows0) Columns() []string {
+	return realDRColumns(odriverRows0.r, odriverRows0.extra)
+}
+func (odriverRows0 *tdriverRows0) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows0.r, odriverRows0.extra, dest)
+}
+
+func (odriverRows1 *tdriverRows1) Close() error {
+	return realDRClose(odriverRows1.r, odriverRows1.extra)
+}
+func (odriverRows1 *tdriverRows1) Columns() []string {
+	return realDRColumns(odriverRows1.r, odriverRows1.extra)
+}
+func (odriverRows1 *tdriverRows1) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows1.r, odriverRows1.extra, dest)
+}
+func (odriverRows1 *tdriverRows1) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows1.r, odriverRows1.extra, index)
+}
+
+func (odriverRows2 *tdriverRows2) Close() error {
+	return realDRClose(odriverRows2.r, odriverRows2.extra)
+}
+func (odriverRows2 *tdriverRows2) Columns() []string {
+	return realDRColumns(odriverRows2.r, odriverRows2.extra)
+}
+func (odriverRows2 *tdriverRows2) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows2.r, odriverRows2.extra, dest)
+}
+func (odriverRows2 *tdriverRows2) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows2.r, odriverRows2.extra, index)
+}
+
+func (odriverRows3 *tdriverRows3) Close() error {
+	return realDRClose(odriverRows3.r, odriverRows3.extra)
+}
+func (odriverRows3 *tdriverRows3) Columns() []string {
+	return realDRColumns(odriverRows3.r, odriverRows3.extra)
+}
+func (odriverRows3 *tdriverRows3) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows3.r, odriverRows3.extra, dest)
+}
+func (odriverRows3 *tdriverRows3) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows3.r, odriverRows3.extra, index)
+}
+
+func (odriverRows4 *tdriverRows4) Close() error {
+	return realDRClose(odriverRows4.r, odriverRows4.extra)
+}
+func (odriverRows4 *tdriverRows4) Columns() []string {
+	return realDRColumns(odriverRows4.r, odriverRows4.extra)
+}
+func (odriverRows4 *tdriverRows4) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows4.r, odriverRows4.extra, dest)
+}
+func (odriverRows4 *tdriverRows4) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows4.r, odriverRows4.extra, index)
+}
+
+func (odriverRows5 *tdriverRows5) Close() error {
+	return realDRClose(odriverRows5.r, odriverRows5.extra)
+}
+func (odriverRows5 *tdriverRows5) Columns() []string {
+	return realDRColumns(odriverRows5.r, odriverRows5.extra)
+}
+func (odriverRows5 *tdriverRows5) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows5.r, odriverRows5.extra, dest)
+}
+func (odriverRows5 *tdriverRows5) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows5.r, odriverRows5.extra, index)
+}
+
+func (odriverRows6 *tdriverRows6) Close() error {
+	return realDRClose(odriverRows6.r, odriverRows6.extra)
+}
+func (odriverRows6 *tdriverRows6) Columns() []string {
+	return realDRColumns(odriverRows6.r, odriverRows6.extra)
+}
+func (odriverRows6 *tdriverRows6) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows6.r, odriverRows6.extra, dest)
+}
+func (odriverRows6 *tdriverRows6) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows6.r, odriverRows6.extra)
+}
+func (odriverRows6 *tdriverRows6) NextResultSet() error {
+	return realDRNextResultSet(odriverRows6.r, odriverRows6.extra)
+}
+
+func (odriverRows7 *tdriverRows7) Close() error {
+	return realDRClose(odriverRows7.r, odriverRows7.extra)
+}
+func (odriverRows7 *tdriverRows7) Columns() []string {
+	return realDRColumns(odriverRows7.r, odriverRows7.extra)
+}
+func (odriverRows7 *tdriverRows7) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows7.r, odriverRows7.extra, dest)
+}
+func (odriverRows7 *tdriverRows7) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows7.r, odriverRows7.extra, index)
+}
+func (odriverRows7 *tdriverRows7) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows7.r, odriverRows7.extra, index)
+}
+
+func (odriverRows8 *tdriverRows8) Close() error {
+	return realDRClose(odriverRows8.r, odriverRows8.extra)
+}
+func (odriverRows8 *tdriverRows8) Columns() []string {
+	return realDRColumns(odriverRows8.r, odriverRows8.extra)
+}
+func (odriverRows8 *tdriverRows8) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows8.r, odriverRows8.extra, dest)
+}
+func (odriverRows8 *tdriverRows8) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows8.r, odriverRows8.extra, index)
+}
+func (odriverRows8 *tdriverRows8) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows8.r, odriverRows8.extra, index)
+}
+
+func (odriverRows9 *tdriverRows9) Close() error {
+	return realDRClose(odriverRows9.r, odriverRows9.extra)
+}
+func (odriverRows9 *tdriverRows9) Columns() []string {
+	return realDRColumns(odriverRows9.r, odriverRows9.extra)
+}
+func (odriverRows9 *tdriverRows9) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows9.r, odriverRows9.extra, dest)
+}
+func (odriverRows9 *tdriverRows9) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows9.r, odriverRows9.extra, index)
+}
+func (odriverRows9 *tdriverRows9) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows9.r, odriverRows9.extra, index)
+}
+
+func (odriverRows10 *tdriverRows10) Close() error {
+	return realDRClose(odriverRows10.r, odriverRows10.extra)
+}
+func (odriverRows10 *tdriverRows10) Columns() []string {
+	return realDRColumns(odriverRows10.r, odriverRows10.extra)
+}
+func (odriverRows10 *tdriverRows10) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows10.r, odriverRows10.extra, dest)
+}
+func (odriverRows10 *tdriverRows10) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows10.r, odriverRows10.extra, index)
+}
+func (odriverRows10 *tdriverRows10) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows10.r, odriverRows10.extra, index)
+}
+
+func (odriverRows11 *tdriverRows11) Close() error {
+	return realDRClose(odriverRows11.r, odriverRows11.extra)
+}
+func (odriverRows11 *tdriverRows11) Columns() []string {
+	return realDRColumns(odriverRows11.r, odriverRows11.extra)
+}
+func (odriverRows11 *tdriverRows11) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows11.r, odriverRows11.extra, dest)
+}
+func (odriverRows11 *tdriverRows11) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows11.r, odriverRows11.extra, index)
+}
+func (odriverRows11 *tdriverRows11) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows11.r, odriverRows11.extra)
+}
+func (odriverRows11 *tdriverRows11) NextResultSet() error {
+	return realDRNextResultSet(odriverRows11.r, odriverRows11.extra)
+}
+
+func (odriverRows12 *tdriverRows12) Close() error {
+	return realDRClose(odriverRows12.r, odriverRows12.extra)
+}
+func (odriverRows12 *tdriverRows12) Columns() []string {
+	return realDRColumns(odriverRows12.r, odriverRows12.extra)
+}
+func (odriverRows12 *tdriverRows12) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows12.r, odriverRows12.extra, dest)
+}
+func (odriverRows12 *tdriverRows12) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows12.r, odriverRows12.extra, index)
+}
+func (odriverRows12 *tdriverRows12) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows12.r, odriverRows12.extra, index)
+}
+
+func (odriverRows13 *tdriverRows13) Close() error {
+	return realDRClose(odriverRows13.r, odriverRows13.extra)
+}
+func (odriverRows13 *tdriverRows13) Columns() []string {
+	return realDRColumns(odriverRows13.r, odriverRows13.extra)
+}
+func (odriverRows13 *tdriverRows13) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows13.r, odriverRows13.extra, dest)
+}
+func (odriverRows13 *tdriverRows13) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows13.r, odriverRows13.extra, index)
+}
+func (odriverRows13 *tdriverRows13) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows13.r, odriverRows13.extra, index)
+}
+
+func (odriverRows14 *tdriverRows14) Close() error {
+	return realDRClose(odriverRows14.r, odriverRows14.extra)
+}
+func (odriverRows14 *tdriverRows14) Columns() []string {
+	return realDRColumns(odriverRows14.r, odriverRows14.extra)
+}
+func (odriverRows14 *tdriverRows14) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows14.r, odriverRows14.extra, dest)
+}
+func (odriverRows14 *tdriverRows14) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows14.r, odriverRows14.extra, index)
+}
+func (odriverRows14 *tdriverRows14) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows14.r, odriverRows14.extra, index)
+}
+
+func (odriverRows15 *tdriverRows15) Close() error {
+	return realDRClose(odriverRows15.r, odriverRows15.extra)
+}
+func (odriverRows15 *tdriverRows15) Columns() []string {
+	return realDRColumns(odriverRows15.r, odriverRows15.extra)
+}
+func (odriverRows15 *tdriverRows15) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows15.r, odriverRows15.extra, dest)
+}
+func (odriverRows15 *tdriverRows15) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows15.r, odriverRows15.extra, index)
+}
+func (odriverRows15 *tdriverRows15) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows15.r, odriverRows15.extra)
+}
+func (odriverRows15 *tdriverRows15) NextResultSet() error {
+	return realDRNextResultSet(odriverRows15.r, odriverRows15.extra)
+}
+
+func (odriverRows16 *tdriverRows16) Close() error {
+	return realDRClose(odriverRows16.r, odriverRows16.extra)
+}
+func (odriverRows16 *tdriverRows16) Columns() []string {
+	return realDRColumns(odriverRows16.r, odriverRows16.extra)
+}
+func (odriverRows16 *tdriverRows16) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows16.r, odriverRows16.extra, dest)
+}
+func (odriverRows16 *tdriverRows16) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows16.r, odriverRows16.extra, index)
+}
+func (odriverRows16 *tdriverRows16) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows16.r, odriverRows16.extra, index)
+}
+
+func (odriverRows17 *tdriverRows17) Close() error {
+	return realDRClose(odriverRows17.r, odriverRows17.extra)
+}
+func (odriverRows17 *tdriverRows17) Columns() []string {
+	return realDRColumns(odriverRows17.r, odriverRows17.extra)
+}
+func (odriverRows17 *tdriverRows17) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows17.r, odriverRows17.extra, dest)
+}
+func (odriverRows17 *tdriverRows17) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows17.r, odriverRows17.extra, index)
+}
+func (odriverRows17 *tdriverRows17) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows17.r, odriverRows17.extra, index)
+}
+
+func (odriverRows18 *tdriverRows18) Close() error {
+	return realDRClose(odriverRows18.r, odriverRows18.extra)
+}
+func (odriverRows18 *tdriverRows18) Columns() []string {
+	return realDRColumns(odriverRows18.r, odriverRows18.extra)
+}
+func (odriverRows18 *tdriverRows18) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows18.r, odriverRows18.extra, dest)
+}
+func (odriverRows18 *tdriverRows18) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows18.r, odriverRows18.extra, index)
+}
+func (odriverRows18 *tdriverRows18) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows18.r, odriverRows18.extra)
+}
+func (odriverRows18 *tdriverRows18) NextResultSet() error {
+	return realDRNextResultSet(odriverRows18.r, odriverRows18.extra)
+}
+
+func (odriverRows19 *tdriverRows19) Close() error {
+	return realDRClose(odriverRows19.r, odriverRows19.extra)
+}
+func (odriverRows19 *tdriverRows19) Columns() []string {
+	return realDRColumns(odriverRows19.r, odriverRows19.extra)
+}
+func (odriverRows19 *tdriverRows19) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows19.r, odriverRows19.extra, dest)
+}
+func (odriverRows19 *tdriverRows19) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows19.r, odriverRows19.extra, index)
+}
+func (odriverRows19 *tdriverRows19) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows19.r, odriverRows19.extra, index)
+}
+
+func (odriverRows20 *tdriverRows20) Close() error {
+	return realDRClose(odriverRows20.r, odriverRows20.extra)
+}
+func (odriverRows20 *tdriverRows20) Columns() []string {
+	return realDRColumns(odriverRows20.r, odriverRows20.extra)
+}
+func (odriverRows20 *tdriverRows20) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows20.r, odriverRows20.extra, dest)
+}
+func (odriverRows20 *tdriverRows20) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows20.r, odriverRows20.extra, index)
+}
+func (odriverRows20 *tdriverRows20) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows20.r, odriverRows20.extra)
+}
+func (odriverRows20 *tdriverRows20) NextResultSet() error {
+	return realDRNextResultSet(odriverRows20.r, odriverRows20.extra)
+}
+
+func (odriverRows21 *tdriverRows21) Close() error {
+	return realDRClose(odriverRows21.r, odriverRows21.extra)
+}
+func (odriverRows21 *tdriverRows21) Columns() []string {
+	return realDRColumns(odriverRows21.r, odriverRows21.extra)
+}
+func (odriverRows21 *tdriverRows21) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows21.r, odriverRows21.extra, dest)
+}
+func (odriverRows21 *tdriverRows21) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows21.r, odriverRows21.extra, index)
+}
+func (odriverRows21 *tdriverRows21) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows21.r, odriverRows21.extra)
+}
+func (odriverRows21 *tdriverRows21) NextResultSet() error {
+	return realDRNextResultSet(odriverRows21.r, odriverRows21.extra)
+}
+
+func (odriverRows22 *tdriverRows22) Close() error {
+	return realDRClose(odriverRows22.r, odriverRows22.extra)
+}
+func (odriverRows22 *tdriverRows22) Columns() []string {
+	return realDRColumns(odriverRows22.r, odriverRows22.extra)
+}
+func (odriverRows22 *tdriverRows22) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows22.r, odriverRows22.extra, dest)
+}
+func (odriverRows22 *tdriverRows22) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows22.r, odriverRows22.extra, index)
+}
+func (odriverRows22 *tdriverRows22) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows22.r, odriverRows22.extra, index)
+}
+func (odriverRows22 *tdriverRows22) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows22.r, odriverRows22.extra, index)
+}
+
+func (odriverRows23 *tdriverRows23) Close() error {
+	return realDRClose(odriverRows23.r, odriverRows23.extra)
+}
+func (odriverRows23 *tdriverRows23) Columns() []string {
+	return realDRColumns(odriverRows23.r, odriverRows23.extra)
+}
+func (odriverRows23 *tdriverRows23) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows23.r, odriverRows23.extra, dest)
+}
+func (odriverRows23 *tdriverRows23) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows23.r, odriverRows23.extra, index)
+}
+func (odriverRows23 *tdriverRows23) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows23.r, odriverRows23.extra, index)
+}
+func (odriverRows23 *tdriverRows23) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows23.r, odriverRows23.extra, index)
+}
+
+func (odriverRows24 *tdriverRows24) Close() error {
+	return realDRClose(odriverRows24.r, odriverRows24.extra)
+}
+func (odriverRows24 *tdriverRows24) Columns() []string {
+	return realDRColumns(odriverRows24.r, odriverRows24.extra)
+}
+func (odriverRows24 *tdriverRows24) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows24.r, odriverRows24.extra, dest)
+}
+func (odriverRows24 *tdriverRows24) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows24.r, odriverRows24.extra, index)
+}
+func (odriverRows24 *tdriverRows24) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows24.r, odriverRows24.extra, index)
+}
+func (odriverRows24 *tdriverRows24) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows24.r, odriverRows24.extra, index)
+}
+
+func (odriverRows25 *tdriverRows25) Close() error {
+	return realDRClose(odriverRows25.r, odriverRows25.extra)
+}
+func (odriverRows25 *tdriverRows25) Columns() []string {
+	return realDRColumns(odriverRows25.r, odriverRows25.extra)
+}
+func (odriverRows25 *tdriverRows25) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows25.r, odriverRows25.extra, dest)
+}
+func (odriverRows25 *tdriverRows25) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows25.r, odriverRows25.extra, index)
+}
+func (odriverRows25 *tdriverRows25) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows25.r, odriverRows25.extra, index)
+}
+func (odriverRows25 *tdriverRows25) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows25.r, odriverRows25.extra)
+}
+func (odriverRows25 *tdriverRows25) NextResultSet() error {
+	return realDRNextResultSet(odriverRows25.r, odriverRows25.extra)
+}
+
+func (odriverRows26 *tdriverRows26) Close() error {
+	return realDRClose(odriverRows26.r, odriverRows26.extra)
+}
+func (odriverRows26 *tdriverRows26) Columns() []string {
+	return realDRColumns(odriverRows26.r, odriverRows26.extra)
+}
+func (odriverRows26 *tdriverRows26) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows26.r, odriverRows26.extra, dest)
+}
+func (odriverRows26 *tdriverRows26) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows26.r, odriverRows26.extra, index)
+}
+func (odriverRows26 *tdriverRows26) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows26.r, odriverRows26.extra, index)
+}
+func (odriverRows26 *tdriverRows26) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows26.r, odriverRows26.extra, index)
+}
+
+func (odriverRows27 *tdriverRows27) Close() error {
+	return realDRClose(odriverRows27.r, odriverRows27.extra)
+}
+func (odriverRows27 *tdriverRows27) Columns() []string {
+	return realDRColumns(odriverRows27.r, odriverRows27.extra)
+}
+func (odriverRows27 *tdriverRows27) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows27.r, odriverRows27.extra, dest)
+}
+func (odriverRows27 *tdriverRows27) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows27.r, odriverRows27.extra, index)
+}
+func (odriverRows27 *tdriverRows27) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows27.r, odriverRows27.extra, index)
+}
+func (odriverRows27 *tdriverRows27) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows27.r, odriverRows27.extra, index)
+}
+
+func (odriverRows28 *tdriverRows28) Close() error {
+	return realDRClose(odriverRows28.r, odriverRows28.extra)
+}
+func (odriverRows28 *tdriverRows28) Columns() []string {
+	return realDRColumns(odriverRows28.r, odriverRows28.extra)
+}
+func (odriverRows28 *tdriverRows28) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows28.r, odriverRows28.extra, dest)
+}
+func (odriverRows28 *tdriverRows28) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows28.r, odriverRows28.extra, index)
+}
+func (odriverRows28 *tdriverRows28) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows28.r, odriverRows28.extra, index)
+}
+func (odriverRows28 *tdriverRows28) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows28.r, odriverRows28.extra)
+}
+func (odriverRows28 *tdriverRows28) NextResultSet() error {
+	return realDRNextResultSet(odriverRows28.r, odriverRows28.extra)
+}
+
+func (odriverRows29 *tdriverRows29) Close() error {
+	return realDRClose(odriverRows29.r, odriverRows29.extra)
+}
+func (odriverRows29 *tdriverRows29) Columns() []string {
+	return realDRColumns(odriverRows29.r, odriverRows29.extra)
+}
+func (odriverRows29 *tdriverRows29) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows29.r, odriverRows29.extra, dest)
+}
+func (odriverRows29 *tdriverRows29) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows29.r, odriverRows29.extra, index)
+}
+func (odriverRows29 *tdriverRows29) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows29.r, odriverRows29.extra, index)
+}
+func (odriverRows29 *tdriverRows29) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows29.r, odriverRows29.extra, index)
+}
+
+func (odriverRows30 *tdriverRows30) Close() error {
+	return realDRClose(odriverRows30.r, odriverRows30.extra)
+}
+func (odriverRows30 *tdriverRows30) Columns() []string {
+	return realDRColumns(odriverRows30.r, odriverRows30.extra)
+}
+func (odriverRows30 *tdriverRows30) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows30.r, odriverRows30.extra, dest)
+}
+func (odriverRows30 *tdriverRows30) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows30.r, odriverRows30.extra, index)
+}
+func (odriverRows30 *tdriverRows30) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows30.r, odriverRows30.extra, index)
+}
+func (odriverRows30 *tdriverRows30) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows30.r, odriverRows30.extra)
+}
+func (odriverRows30 *tdriverRows30) NextResultSet() error {
+	return realDRNextResultSet(odriverRows30.r, odriverRows30.extra)
+}
+
+func (odriverRows31 *tdriverRows31) Close() error {
+	return realDRClose(odriverRows31.r, odriverRows31.extra)
+}
+func (odriverRows31 *tdriverRows31) Columns() []string {
+	return realDRColumns(odriverRows31.r, odriverRows31.extra)
+}
+func (odriverRows31 *tdriverRows31) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows31.r, odriverRows31.extra, dest)
+}
+func (odriverRows31 *tdriverRows31) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows31.r, odriverRows31.extra, index)
+}
+func (odriverRows31 *tdriverRows31) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows31.r, odriverRows31.extra, index)
+}
+func (odriverRows31 *tdriverRows31) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows31.r, odriverRows31.extra)
+}
+func (odriverRows31 *tdriverRows31) NextResultSet() error {
+	return realDRNextResultSet(odriverRows31.r, odriverRows31.extra)
+}
+
+func (odriverRows32 *tdriverRows32) Close() error {
+	return realDRClose(odriverRows32.r, odriverRows32.extra)
+}
+func (odriverRows32 *tdriverRows32) Columns() []string {
+	return realDRColumns(odriverRows32.r, odriverRows32.extra)
+}
+func (odriverRows32 *tdriverRows32) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows32.r, odriverRows32.extra, dest)
+}
+func (odriverRows32 *tdriverRows32) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows32.r, odriverRows32.extra, index)
+}
+func (odriverRows32 *tdriverRows32) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows32.r, odriverRows32.extra, index)
+}
+func (odriverRows32 *tdriverRows32) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows32.r, odriverRows32.extra, index)
+}
+
+func (odriverRows33 *tdriverRows33) Close() error {
+	return realDRClose(odriverRows33.r, odriverRows33.extra)
+}
+func (odriverRows33 *tdriverRows33) Columns() []string {
+	return realDRColumns(odriverRows33.r, odriverRows33.extra)
+}
+func (odriverRows33 *tdriverRows33) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows33.r, odriverRows33.extra, dest)
+}
+func (odriverRows33 *tdriverRows33) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows33.r, odriverRows33.extra, index)
+}
+func (odriverRows33 *tdriverRows33) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows33.r, odriverRows33.extra, index)
+}
+func (odriverRows33 *tdriverRows33) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows33.r, odriverRows33.extra, index)
+}
+
+func (odriverRows34 *tdriverRows34) Close() error {
+	return realDRClose(odriverRows34.r, odriverRows34.extra)
+}
+func (odriverRows34 *tdriverRows34) Columns() []string {
+	return realDRColumns(odriverRows34.r, odriverRows34.extra)
+}
+func (odriverRows34 *tdriverRows34) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows34.r, odriverRows34.extra, dest)
+}
+func (odriverRows34 *tdriverRows34) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows34.r, odriverRows34.extra, index)
+}
+func (odriverRows34 *tdriverRows34) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows34.r, odriverRows34.extra, index)
+}
+func (odriverRows34 *tdriverRows34) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows34.r, odriverRows34.extra)
+}
+func (odriverRows34 *tdriverRows34) NextResultSet() error {
+	return realDRNextResultSet(odriverRows34.r, odriverRows34.extra)
+}
+
+func (odriverRows35 *tdriverRows35) Close() error {
+	return realDRClose(odriverRows35.r, odriverRows35.extra)
+}
+func (odriverRows35 *tdriverRows35) Columns() []string {
+	return realDRColumns(odriverRows35.r, odriverRows35.extra)
+}
+func (odriverRows35 *tdriverRows35) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows35.r, odriverRows35.extra, dest)
+}
+func (odriverRows35 *tdriverRows35) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows35.r, odriverRows35.extra, index)
+}
+func (odriverRows35 *tdriverRows35) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows35.r, odriverRows35.extra, index)
+}
+func (odriverRows35 *tdriverRows35) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows35.r, odriverRows35.extra, index)
+}
+
+func (odriverRows36 *tdriverRows36) Close() error {
+	return realDRClose(odriverRows36.r, odriverRows36.extra)
+}
+func (odriverRows36 *tdriverRows36) Columns() []string {
+	return realDRColumns(odriverRows36.r, odriverRows36.extra)
+}
+func (odriverRows36 *tdriverRows36) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows36.r, odriverRows36.extra, dest)
+}
+func (odriverRows36 *tdriverRows36) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows36.r, odriverRows36.extra, index)
+}
+func (odriverRows36 *tdriverRows36) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows36.r, odriverRows36.extra, index)
+}
+func (odriverRows36 *tdriverRows36) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows36.r, odriverRows36.extra)
+}
+func (odriverRows36 *tdriverRows36) NextResultSet() error {
+	return realDRNextResultSet(odriverRows36.r, odriverRows36.extra)
+}
+
+func (odriverRows37 *tdriverRows37) Close() error {
+	return realDRClose(odriverRows37.r, odriverRows37.extra)
+}
+func (odriverRows37 *tdriverRows37) Columns() []string {
+	return realDRColumns(odriverRows37.r, odriverRows37.extra)
+}
+func (odriverRows37 *tdriverRows37) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows37.r, odriverRows37.extra, dest)
+}
+func (odriverRows37 *tdriverRows37) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows37.r, odriverRows37.extra, index)
+}
+func (odriverRows37 *tdriverRows37) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows37.r, odriverRows37.extra, index)
+}
+func (odriverRows37 *tdriverRows37) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows37.r, odriverRows37.extra)
+}
+func (odriverRows37 *tdriverRows37) NextResultSet() error {
+	return realDRNextResultSet(odriverRows37.r, odriverRows37.extra)
+}
+
+func (odriverRows38 *tdriverRows38) Close() error {
+	return realDRClose(odriverRows38.r, odriverRows38.extra)
+}
+func (odriverRows38 *tdriverRows38) Columns() []string {
+	return realDRColumns(odriverRows38.r, odriverRows38.extra)
+}
+func (odriverRows38 *tdriverRows38) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows38.r, odriverRows38.extra, dest)
+}
+func (odriverRows38 *tdriverRows38) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows38.r, odriverRows38.extra, index)
+}
+func (odriverRows38 *tdriverRows38) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows38.r, odriverRows38.extra, index)
+}
+func (odriverRows38 *tdriverRows38) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows38.r, odriverRows38.extra, index)
+}
+
+func (odriverRows39 *tdriverRows39) Close() error {
+	return realDRClose(odriverRows39.r, odriverRows39.extra)
+}
+func (odriverRows39 *tdriverRows39) Columns() []string {
+	return realDRColumns(odriverRows39.r, odriverRows39.extra)
+}
+func (odriverRows39 *tdriverRows39) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows39.r, odriverRows39.extra, dest)
+}
+func (odriverRows39 *tdriverRows39) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows39.r, odriverRows39.extra, index)
+}
+func (odriverRows39 *tdriverRows39) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows39.r, odriverRows39.extra, index)
+}
+func (odriverRows39 *tdriverRows39) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows39.r, odriverRows39.extra)
+}
+func (odriverRows39 *tdriverRows39) NextResultSet() error {
+	return realDRNextResultSet(odriverRows39.r, odriverRows39.extra)
+}
+
+func (odriverRows40 *tdriverRows40) Close() error {
+	return realDRClose(odriverRows40.r, odriverRows40.extra)
+}
+func (odriverRows40 *tdriverRows40) Columns() []string {
+	return realDRColumns(odriverRows40.r, odriverRows40.extra)
+}
+func (odriverRows40 *tdriverRows40) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows40.r, odriverRows40.extra, dest)
+}
+func (odriverRows40 *tdriverRows40) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows40.r, odriverRows40.extra, index)
+}
+func (odriverRows40 *tdriverRows40) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows40.r, odriverRows40.extra, index)
+}
+func (odriverRows40 *tdriverRows40) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows40.r, odriverRows40.extra)
+}
+func (odriverRows40 *tdriverRows40) NextResultSet() error {
+	return realDRNextResultSet(odriverRows40.r, odriverRows40.extra)
+}
+
+func (odriverRows41 *tdriverRows41) Close() error {
+	return realDRClose(odriverRows41.r, odriverRows41.extra)
+}
+func (odriverRows41 *tdriverRows41) Columns() []string {
+	return realDRColumns(odriverRows41.r, odriverRows41.extra)
+}
+func (odriverRows41 *tdriverRows41) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows41.r, odriverRows41.extra, dest)
+}
+func (odriverRows41 *tdriverRows41) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows41.r, odriverRows41.extra, index)
+}
+func (odriverRows41 *tdriverRows41) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows41.r, odriverRows41.extra, index)
+}
+func (odriverRows41 *tdriverRows41) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows41.r, odriverRows41.extra)
+}
+func (odriverRows41 *tdriverRows41) NextResultSet() error {
+	return realDRNextResultSet(odriverRows41.r, odriverRows41.extra)
+}
+
+func (odriverRows42 *tdriverRows42) Close() error {
+	return realDRClose(odriverRows42.r, odriverRows42.extra)
+}
+func (odriverRows42 *tdriverRows42) Columns() []string {
+	return realDRColumns(odriverRows42.r, odriverRows42.extra)
+}
+func (odriverRows42 *tdriverRows42) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows42.r, odriverRows42.extra, dest)
+}
+func (odriverRows42 *tdriverRows42) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows42.r, odriverRows42.extra, index)
+}
+func (odriverRows42 *tdriverRows42) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows42.r, odriverRows42.extra, index)
+}
+func (odriverRows42 *tdriverRows42) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows42.r, odriverRows42.extra, index)
+}
+func (odriverRows42 *tdriverRows42) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows42.r, odriverRows42.extra, index)
+}
+
+func (odriverRows43 *tdriverRows43) Close() error {
+	return realDRClose(odriverRows43.r, odriverRows43.extra)
+}
+func (odriverRows43 *tdriverRows43) Columns() []string {
+	return realDRColumns(odriverRows43.r, odriverRows43.extra)
+}
+func (odriverRows43 *tdriverRows43) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows43.r, odriverRows43.extra, dest)
+}
+func (odriverRows43 *tdriverRows43) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows43.r, odriverRows43.extra, index)
+}
+func (odriverRows43 *tdriverRows43) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows43.r, odriverRows43.extra, index)
+}
+func (odriverRows43 *tdriverRows43) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows43.r, odriverRows43.extra, index)
+}
+func (odriverRows43 *tdriverRows43) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows43.r, odriverRows43.extra, index)
+}
+
+func (odriverRows44 *tdriverRows44) Close() error {
+	return realDRClose(odriverRows44.r, odriverRows44.extra)
+}
+func (odriverRows44 *tdriverRows44) Columns() []string {
+	return realDRColumns(odriverRows44.r, odriverRows44.extra)
+}
+func (odriverRows44 *tdriverRows44) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows44.r, odriverRows44.extra, dest)
+}
+func (odriverRows44 *tdriverRows44) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows44.r, odriverRows44.extra, index)
+}
+func (odriverRows44 *tdriverRows44) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows44.r, odriverRows44.extra, index)
+}
+func (odriverRows44 *tdriverRows44) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows44.r, odriverRows44.extra, index)
+}
+func (odriverRows44 *tdriverRows44) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows44.r, odriverRows44.extra)
+}
+func (odriverRows44 *tdriverRows44) NextResultSet() error {
+	return realDRNextResultSet(odriverRows44.r, odriverRows44.extra)
+}
+
+func (odriverRows45 *tdriverRows45) Close() error {
+	return realDRClose(odriverRows45.r, odriverRows45.extra)
+}
+func (odriverRows45 *tdriverRows45) Columns() []string {
+	return realDRColumns(odriverRows45.r, odriverRows45.extra)
+}
+func (odriverRows45 *tdriverRows45) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows45.r, odriverRows45.extra, dest)
+}
+func (odriverRows45 *tdriverRows45) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows45.r, odriverRows45.extra, index)
+}
+func (odriverRows45 *tdriverRows45) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows45.r, odriverRows45.extra, index)
+}
+func (odriverRows45 *tdriverRows45) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows45.r, odriverRows45.extra, index)
+}
+func (odriverRows45 *tdriverRows45) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows45.r, odriverRows45.extra, index)
+}
+
+func (odriverRows46 *tdriverRows46) Close() error {
+	return realDRClose(odriverRows46.r, odriverRows46.extra)
+}
+func (odriverRows46 *tdriverRows46) Columns() []string {
+	return realDRColumns(odriverRows46.r, odriverRows46.extra)
+}
+func (odriverRows46 *tdriverRows46) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows46.r, odriverRows46.extra, dest)
+}
+func (odriverRows46 *tdriverRows46) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows46.r, odriverRows46.extra, index)
+}
+func (odriverRows46 *tdriverRows46) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows46.r, odriverRows46.extra, index)
+}
+func (odriverRows46 *tdriverRows46) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows46.r, odriverRows46.extra, index)
+}
+func (odriverRows46 *tdriverRows46) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows46.r, odriverRows46.extra)
+}
+func (odriverRows46 *tdriverRows46) NextResultSet() error {
+	return realDRNextResultSet(odriverRows46.r, odriverRows46.extra)
+}
+
+func (odriverRows47 *tdriverRows47) Close() error {
+	return realDRClose(odriverRows47.r, odriverRows47.extra)
+}
+func (odriverRows47 *tdriverRows47) Columns() []string {
+	return realDRColumns(odriverRows47.r, odriverRows47.extra)
+}
+func (odriverRows47 *tdriverRows47) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows47.r, odriverRows47.extra, dest)
+}
+func (odriverRows47 *tdriverRows47) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows47.r, odriverRows47.extra, index)
+}
+func (odriverRows47 *tdriverRows47) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows47.r, odriverRows47.extra, index)
+}
+func (odriverRows47 *tdriverRows47) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows47.r, odriverRows47.extra, index)
+}
+func (odriverRows47 *tdriverRows47) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows47.r, odriverRows47.extra)
+}
+func (odriverRows47 *tdriverRows47) NextResultSet() error {
+	return realDRNextResultSet(odriverRows47.r, odriverRows47.extra)
+}
+
+func (odriverRows48 *tdriverRows48) Close() error {
+	return realDRClose(odriverRows48.r, odriverRows48.extra)
+}
+func (odriverRows48 *tdriverRows48) Columns() []string {
+	return realDRColumns(odriverRows48.r, odriverRows48.extra)
+}
+func (odriverRows48 *tdriverRows48) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows48.r, odriverRows48.extra, dest)
+}
+func (odriverRows48 *tdriverRows48) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows48.r, odriverRows48.extra, index)
+}
+func (odriverRows48 *tdriverRows48) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows48.r, odriverRows48.extra, index)
+}
+func (odriverRows48 *tdriverRows48) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows48.r, odriverRows48.extra, index)
+}
+func (odriverRows48 *tdriverRows48) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows48.r, odriverRows48.extra, index)
+}
+
+func (odriverRows49 *tdriverRows49) Close() error {
+	return realDRClose(odriverRows49.r, odriverRows49.extra)
+}
+func (odriverRows49 *tdriverRows49) Columns() []string {
+	return realDRColumns(odriverRows49.r, odriverRows49.extra)
+}
+func (odriverRows49 *tdriverRows49) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows49.r, odriverRows49.extra, dest)
+}
+func (odriverRows49 *tdriverRows49) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows49.r, odriverRows49.extra, index)
+}
+func (odriverRows49 *tdriverRows49) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows49.r, odriverRows49.extra, index)
+}
+func (odriverRows49 *tdriverRows49) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows49.r, odriverRows49.extra, index)
+}
+func (odriverRows49 *tdriverRows49) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows49.r, odriverRows49.extra)
+}
+func (odriverRows49 *tdriverRows49) NextResultSet() error {
+	return realDRNextResultSet(odriverRows49.r, odriverRows49.extra)
+}
+
+func (odriverRows50 *tdriverRows50) Close() error {
+	return realDRClose(odriverRows50.r, odriverRows50.extra)
+}
+func (odriverRows50 *tdriverRows50) Columns() []string {
+	return realDRColumns(odriverRows50.r, odriverRows50.extra)
+}
+func (odriverRows50 *tdriverRows50) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows50.r, odriverRows50.extra, dest)
+}
+func (odriverRows50 *tdriverRows50) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows50.r, odriverRows50.extra, index)
+}
+func (odriverRows50 *tdriverRows50) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows50.r, odriverRows50.extra, index)
+}
+func (odriverRows50 *tdriverRows50) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows50.r, odriverRows50.extra, index)
+}
+func (odriverRows50 *tdriverRows50) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows50.r, odriverRows50.extra)
+}
+func (odriverRows50 *tdriverRows50) NextResultSet() error {
+	return realDRNextResultSet(odriverRows50.r, odriverRows50.extra)
+}
+
+func (odriverRows51 *tdriverRows51) Close() error {
+	return realDRClose(odriverRows51.r, odriverRows51.extra)
+}
+func (odriverRows51 *tdriverRows51) Columns() []string {
+	return realDRColumns(odriverRows51.r, odriverRows51.extra)
+}
+func (odriverRows51 *tdriverRows51) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows51.r, odriverRows51.extra, dest)
+}
+func (odriverRows51 *tdriverRows51) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows51.r, odriverRows51.extra, index)
+}
+func (odriverRows51 *tdriverRows51) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows51.r, odriverRows51.extra, index)
+}
+func (odriverRows51 *tdriverRows51) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows51.r, odriverRows51.extra, index)
+}
+func (odriverRows51 *tdriverRows51) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows51.r, odriverRows51.extra)
+}
+func (odriverRows51 *tdriverRows51) NextResultSet() error {
+	return realDRNextResultSet(odriverRows51.r, odriverRows51.extra)
+}
+
+func (odriverRows52 *tdriverRows52) Close() error {
+	return realDRClose(odriverRows52.r, odriverRows52.extra)
+}
+func (odriverRows52 *tdriverRows52) Columns() []string {
+	return realDRColumns(odriverRows52.r, odriverRows52.extra)
+}
+func (odriverRows52 *tdriverRows52) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows52.r, odriverRows52.extra, dest)
+}
+func (odriverRows52 *tdriverRows52) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows52.r, odriverRows52.extra, index)
+}
+func (odriverRows52 *tdriverRows52) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows52.r, odriverRows52.extra, index)
+}
+func (odriverRows52 *tdriverRows52) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows52.r, odriverRows52.extra, index)
+}
+func (odriverRows52 *tdriverRows52) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows52.r, odriverRows52.extra, index)
+}
+
+func (odriverRows53 *tdriverRows53) Close() error {
+	return realDRClose(odriverRows53.r, odriverRows53.extra)
+}
+func (odriverRows53 *tdriverRows53) Columns() []string {
+	return realDRColumns(odriverRows53.r, odriverRows53.extra)
+}
+func (odriverRows53 *tdriverRows53) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows53.r, odriverRows53.extra, dest)
+}
+func (odriverRows53 *tdriverRows53) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows53.r, odriverRows53.extra, index)
+}
+func (odriverRows53 *tdriverRows53) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows53.r, odriverRows53.extra, index)
+}
+func (odriverRows53 *tdriverRows53) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows53.r, odriverRows53.extra, index)
+}
+func (odriverRows53 *tdriverRows53) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows53.r, odriverRows53.extra)
+}
+func (odriverRows53 *tdriverRows53) NextResultSet() error {
+	return realDRNextResultSet(odriverRows53.r, odriverRows53.extra)
+}
+
+func (odriverRows54 *tdriverRows54) Close() error {
+	return realDRClose(odriverRows54.r, odriverRows54.extra)
+}
+func (odriverRows54 *tdriverRows54) Columns() []string {
+	return realDRColumns(odriverRows54.r, odriverRows54.extra)
+}
+func (odriverRows54 *tdriverRows54) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows54.r, odriverRows54.extra, dest)
+}
+func (odriverRows54 *tdriverRows54) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows54.r, odriverRows54.extra, index)
+}
+func (odriverRows54 *tdriverRows54) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows54.r, odriverRows54.extra, index)
+}
+func (odriverRows54 *tdriverRows54) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows54.r, odriverRows54.extra, index)
+}
+func (odriverRows54 *tdriverRows54) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows54.r, odriverRows54.extra)
+}
+func (odriverRows54 *tdriverRows54) NextResultSet() error {
+	return realDRNextResultSet(odriverRows54.r, odriverRows54.extra)
+}
+
+func (odriverRows55 *tdriverRows55) Close() error {
+	return realDRClose(odriverRows55.r, odriverRows55.extra)
+}
+func (odriverRows55 *tdriverRows55) Columns() []string {
+	return realDRColumns(odriverRows55.r, odriverRows55.extra)
+}
+func (odriverRows55 *tdriverRows55) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows55.r, odriverRows55.extra, dest)
+}
+func (odriverRows55 *tdriverRows55) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows55.r, odriverRows55.extra, index)
+}
+func (odriverRows55 *tdriverRows55) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows55.r, odriverRows55.extra, index)
+}
+func (odriverRows55 *tdriverRows55) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows55.r, odriverRows55.extra, index)
+}
+func (odriverRows55 *tdriverRows55) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows55.r, odriverRows55.extra)
+}
+func (odriverRows55 *tdriverRows55) NextResultSet() error {
+	return realDRNextResultSet(odriverRows55.r, odriverRows55.extra)
+}
+
+func (odriverRows56 *tdriverRows56) Close() error {
+	return realDRClose(odriverRows56.r, odriverRows56.extra)
+}
+func (odriverRows56 *tdriverRows56) Columns() []string {
+	return realDRColumns(odriverRows56.r, odriverRows56.extra)
+}
+func (odriverRows56 *tdriverRows56) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows56.r, odriverRows56.extra, dest)
+}
+func (odriverRows56 *tdriverRows56) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows56.r, odriverRows56.extra, index)
+}
+func (odriverRows56 *tdriverRows56) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows56.r, odriverRows56.extra, index)
+}
+func (odriverRows56 *tdriverRows56) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows56.r, odriverRows56.extra, index)
+}
+func (odriverRows56 *tdriverRows56) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows56.r, odriverRows56.extra)
+}
+func (odriverRows56 *tdriverRows56) NextResultSet() error {
+	return realDRNextResultSet(odriverRows56.r, odriverRows56.extra)
+}
+
+func (odriverRows57 *tdriverRows57) Close() error {
+	return realDRClose(odriverRows57.r, odriverRows57.extra)
+}
+func (odriverRows57 *tdriverRows57) Columns() []string {
+	return realDRColumns(odriverRows57.r, odriverRows57.extra)
+}
+func (odriverRows57 *tdriverRows57) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows57.r, odriverRows57.extra, dest)
+}
+func (odriverRows57 *tdriverRows57) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows57.r, odriverRows57.extra, index)
+}
+func (odriverRows57 *tdriverRows57) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows57.r, odriverRows57.extra, index)
+}
+func (odriverRows57 *tdriverRows57) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows57.r, odriverRows57.extra, index)
+}
+func (odriverRows57 *tdriverRows57) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows57.r, odriverRows57.extra, index)
+}
+func (odriverRows57 *tdriverRows57) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows57.r, odriverRows57.extra, index)
+}
+
+func (odriverRows58 *tdriverRows58) Close() error {
+	return realDRClose(odriverRows58.r, odriverRows58.extra)
+}
+func (odriverRows58 *tdriverRows58) Columns() []string {
+	return realDRColumns(odriverRows58.r, odriverRows58.extra)
+}
+func (odriverRows58 *tdriverRows58) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows58.r, odriverRows58.extra, dest)
+}
+func (odriverRows58 *tdriverRows58) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows58.r, odriverRows58.extra, index)
+}
+func (odriverRows58 *tdriverRows58) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows58.r, odriverRows58.extra, index)
+}
+func (odriverRows58 *tdriverRows58) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows58.r, odriverRows58.extra, index)
+}
+func (odriverRows58 *tdriverRows58) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows58.r, odriverRows58.extra, index)
+}
+func (odriverRows58 *tdriverRows58) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows58.r, odriverRows58.extra)
+}
+func (odriverRows58 *tdriverRows58) NextResultSet() error {
+	return realDRNextResultSet(odriverRows58.r, odriverRows58.extra)
+}
+
+func (odriverRows59 *tdriverRows59) Close() error {
+	return realDRClose(odriverRows59.r, odriverRows59.extra)
+}
+func (odriverRows59 *tdriverRows59) Columns() []string {
+	return realDRColumns(odriverRows59.r, odriverRows59.extra)
+}
+func (odriverRows59 *tdriverRows59) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows59.r, odriverRows59.extra, dest)
+}
+func (odriverRows59 *tdriverRows59) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows59.r, odriverRows59.extra, index)
+}
+func (odriverRows59 *tdriverRows59) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows59.r, odriverRows59.extra, index)
+}
+func (odriverRows59 *tdriverRows59) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows59.r, odriverRows59.extra, index)
+}
+func (odriverRows59 *tdriverRows59) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows59.r, odriverRows59.extra, index)
+}
+func (odriverRows59 *tdriverRows59) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows59.r, odriverRows59.extra)
+}
+func (odriverRows59 *tdriverRows59) NextResultSet() error {
+	return realDRNextResultSet(odriverRows59.r, odriverRows59.extra)
+}
+
+func (odriverRows60 *tdriverRows60) Close() error {
+	return realDRClose(odriverRows60.r, odriverRows60.extra)
+}
+func (odriverRows60 *tdriverRows60) Columns() []string {
+	return realDRColumns(odriverRows60.r, odriverRows60.extra)
+}
+func (odriverRows60 *tdriverRows60) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows60.r, odriverRows60.extra, dest)
+}
+func (odriverRows60 *tdriverRows60) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows60.r, odriverRows60.extra, index)
+}
+func (odriverRows60 *tdriverRows60) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows60.r, odriverRows60.extra, index)
+}
+func (odriverRows60 *tdriverRows60) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows60.r, odriverRows60.extra, index)
+}
+func (odriverRows60 *tdriverRows60) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows60.r, odriverRows60.extra, index)
+}
+func (odriverRows60 *tdriverRows60) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows60.r, odriverRows60.extra)
+}
+func (odriverRows60 *tdriverRows60) NextResultSet() error {
+	return realDRNextResultSet(odriverRows60.r, odriverRows60.extra)
+}
+
+func (odriverRows61 *tdriverRows61) Close() error {
+	return realDRClose(odriverRows61.r, odriverRows61.extra)
+}
+func (odriverRows61 *tdriverRows61) Columns() []string {
+	return realDRColumns(odriverRows61.r, odriverRows61.extra)
+}
+func (odriverRows61 *tdriverRows61) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows61.r, odriverRows61.extra, dest)
+}
+func (odriverRows61 *tdriverRows61) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows61.r, odriverRows61.extra, index)
+}
+func (odriverRows61 *tdriverRows61) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows61.r, odriverRows61.extra, index)
+}
+func (odriverRows61 *tdriverRows61) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows61.r, odriverRows61.extra, index)
+}
+func (odriverRows61 *tdriverRows61) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows61.r, odriverRows61.extra, index)
+}
+func (odriverRows61 *tdriverRows61) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows61.r, odriverRows61.extra)
+}
+func (odriverRows61 *tdriverRows61) NextResultSet() error {
+	return realDRNextResultSet(odriverRows61.r, odriverRows61.extra)
+}
+
+func (odriverRows62 *tdriverRows62) Close() error {
+	return realDRClose(odriverRows62.r, odriverRows62.extra)
+}
+func (odriverRows62 *tdriverRows62) Columns() []string {
+	return realDRColumns(odriverRows62.r, odriverRows62.extra)
+}
+func (odriverRows62 *tdriverRows62) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows62.r, odriverRows62.extra, dest)
+}
+func (odriverRows62 *tdriverRows62) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows62.r, odriverRows62.extra, index)
+}
+func (odriverRows62 *tdriverRows62) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows62.r, odriverRows62.extra, index)
+}
+func (odriverRows62 *tdriverRows62) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows62.r, odriverRows62.extra, index)
+}
+func (odriverRows62 *tdriverRows62) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows62.r, odriverRows62.extra, index)
+}
+func (odriverRows62 *tdriverRows62) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows62.r, odriverRows62.extra)
+}
+func (odriverRows62 *tdriverRows62) NextResultSet() error {
+	return realDRNextResultSet(odriverRows62.r, odriverRows62.extra)
+}
+
+func (odriverRows63 *tdriverRows63) Close() error {
+	return realDRClose(odriverRows63.r, odriverRows63.extra)
+}
+func (odriverRows63 *tdriverRows63) Columns() []string {
+	return realDRColumns(odriverRows63.r, odriverRows63.extra)
+}
+func (odriverRows63 *tdriverRows63) Next(dest []driver.Value) error {
+	return realDRNext(odriverRows63.r, odriverRows63.extra, dest)
+}
+func (odriverRows63 *tdriverRows63) ColumnTypeDatabaseTypeName(index int) string {
+	return realDRColumnTypeDatabaseTypeName(odriverRows63.r, odriverRows63.extra, index)
+}
+func (odriverRows63 *tdriverRows63) ColumnTypeLength(index int) (int64, bool) {
+	return realDRColumnTypeLength(odriverRows63.r, odriverRows63.extra, index)
+}
+func (odriverRows63 *tdriverRows63) ColumnTypeNullable(index int) (bool, bool) {
+	return realDRColumnTypeNullable(odriverRows63.r, odriverRows63.extra, index)
+}
+func (odriverRows63 *tdriverRows63) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	return realDRColumnTypePrecisionScale(odriverRows63.r, odriverRows63.extra, index)
+}
+func (odriverRows63 *tdriverRows63) ColumnTypeScanType(index int) reflect.Type {
+	return realDRColumnTypeScanType(odriverRows63.r, odriverRows63.extra, index)
+}
+func (odriverRows63 *tdriverRows63) HasNextResultSet() bool {
+	return realDRHasNextResultSet(odriverRows63.r, odriverRows63.extra)
+}
+func (odriverRows63 *tdriverRows63) NextResultSet() error {
+	return realDRNextResultSet(odriverRows63.r, odriverRows63.extra)
+}
+
+func newRows(realDRRows driver.Rows, extra interface{}) driver.Rows {
+	switch r := realDRRows.(type) {
+	case idriverRows63:
+		return &tdriverRows63{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows62:
+		return &tdriverRows62{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows61:
+		return &tdriverRows61{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows60:
+		return &tdriverRows60{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows59:
+		return &tdriverRows59{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows58:
+		return &tdriverRows58{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows57:
+		return &tdriverRows57{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows56:
+		return &tdriverRows56{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows55:
+		return &tdriverRows55{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows54:
+		return &tdriverRows54{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows53:
+		return &tdriverRows53{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows52:
+		return &tdriverRows52{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows51:
+		return &tdriverRows51{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows50:
+		return &tdriverRows50{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows49:
+		return &tdriverRows49{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows48:
+		return &tdriverRows48{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows47:
+		return &tdriverRows47{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows46:
+		return &tdriverRows46{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows45:
+		return &tdriverRows45{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows44:
+		return &tdriverRows44{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows43:
+		return &tdriverRows43{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows42:
+		return &tdriverRows42{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows41:
+		return &tdriverRows41{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows40:
+		return &tdriverRows40{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows39:
+		return &tdriverRows39{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows38:
+		return &tdriverRows38{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows37:
+		return &tdriverRows37{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows36:
+		return &tdriverRows36{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows35:
+		return &tdriverRows35{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows34:
+		return &tdriverRows34{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows33:
+		return &tdriverRows33{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows32:
+		return &tdriverRows32{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows31:
+		return &tdriverRows31{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows30:
+		return &tdriverRows30{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows29:
+		return &tdriverRows29{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows28:
+		return &tdriverRows28{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows27:
+		return &tdriverRows27{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows26:
+		return &tdriverRows26{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows25:
+		return &tdriverRows25{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows24:
+		return &tdriverRows24{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows23:
+		return &tdriverRows23{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows22:
+		return &tdriverRows22{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows21:
+		return &tdriverRows21{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows20:
+		return &tdriverRows20{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows19:
+		return &tdriverRows19{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows18:
+		return &tdriverRows18{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows17:
+		return &tdriverRows17{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows16:
+		return &tdriverRows16{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows15:
+		return &tdriverRows15{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows14:
+		return &tdriverRows14{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows13:
+		return &tdriverRows13{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows12:
+		return &tdriverRows12{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows11:
+		return &tdriverRows11{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows10:
+		return &tdriverRows10{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows9:
+		return &tdriverRows9{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows8:
+		return &tdriverRows8{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows7:
+		return &tdriverRows7{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows6:
+		return &tdriverRows6{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows5:
+		return &tdriverRows5{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows4:
+		return &tdriverRows4{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows3:
+		return &tdriverRows3{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows2:
+		return &tdriverRows2{
+			r:     r,
+			extra: extra,
+		}
+	case idriverRows1:
+		return &tdriverRows1{
+			r:     r,
+			extra: extra,
+		}
+	}
+	return &tdriverRows0{
+		r:     realDRRows,
+		extra: extra,
+	}
+}
@@ -0,0 +1,625 @@
+// Code generated by "wrappergen -basetype=driver.Stmt -exttypes=driver.ColumnConverter;driver.NamedValueChecker;driver.StmtExecContext;driver.StmtQueryContext -extrafields=extra,interface{} -prefix=realDS -newfuncname=newStmt"; DO NOT EDIT.
+
+package test
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+type (
+	idriverStmt0 interface {
+		driver.Stmt
+	}
+
+	tdriverStmt0 struct {
+		r     idriverStmt0
+		extra interface{}
+	}
+
+	idriverStmt1 interface {
+		driver.Stmt
+		driver.ColumnConverter
+	}
+
+	tdriverStmt1 struct {
+		r     idriverStmt1
+		extra interface{}
+	}
+
+	idriverStmt2 interface {
+		driver.Stmt
+		driver.NamedValueChecker
+	}
+
+	tdriverStmt2 struct {
+		r     idriverStmt2
+		extra interface{}
+	}
+
+	idriverStmt3 interface {
+		driver.Stmt
+		driver.StmtExecContext
+	}
+
+	tdriverStmt3 struct {
+		r     idriverStmt3
+		extra interface{}
+	}
+
+	idriverStmt4 interface {
+		driver.Stmt
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt4 struct {
+		r     idriverStmt4
+		extra interface{}
+	}
+
+	idriverStmt5 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.NamedValueChecker
+	}
+
+	tdriverStmt5 struct {
+		r     idriverStmt5
+		extra interface{}
+	}
+
+	idriverStmt6 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.StmtExecContext
+	}
+
+	tdriverStmt6 struct {
+		r     idriverStmt6
+		extra interface{}
+	}
+
+	idriverStmt7 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt7 struct {
+		r     idriverStmt7
+		extra interface{}
+	}
+
+	idriverStmt8 interface {
+		driver.Stmt
+		driver.NamedValueChecker
+		driver.StmtExecContext
+	}
+
+	tdriverStmt8 struct {
+		r     idriverStmt8
+		extra interface{}
+	}
+
+	idriverStmt9 interface {
+		driver.Stmt
+		driver.NamedValueChecker
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt9 struct {
+		r     idriverStmt9
+		extra interface{}
+	}
+
+	idriverStmt10 interface {
+		driver.Stmt
+		driver.StmtExecContext
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt10 struct {
+		r     idriverStmt10
+		extra interface{}
+	}
+
+	idriverStmt11 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.NamedValueChecker
+		driver.StmtExecContext
+	}
+
+	tdriverStmt11 struct {
+		r     idriverStmt11
+		extra interface{}
+	}
+
+	idriverStmt12 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.NamedValueChecker
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt12 struct {
+		r     idriverStmt12
+		extra interface{}
+	}
+
+	idriverStmt13 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.StmtExecContext
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt13 struct {
+		r     idriverStmt13
+		extra interface{}
+	}
+
+	idriverStmt14 interface {
+		driver.Stmt
+		driver.NamedValueChecker
+		driver.StmtExecContext
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt14 struct {
+		r     idriverStmt14
+		extra interface{}
+	}
+
+	idriverStmt15 interface {
+		driver.Stmt
+		driver.ColumnConverter
+		driver.NamedValueChecker
+		driver.StmtExecContext
+		driver.StmtQueryContext
+	}
+
+	tdriverStmt15 struct {
+		r     idriverStmt15
+		extra interface{}
+	}
+)
+
+var (
+	_ driver.Stmt              = &tdriverStmt0{}
+	_ driver.Stmt              = &tdriverStmt1{}
+	_ driver.ColumnConverter   = &tdriverStmt1{}
+	_ driver.Stmt              = &tdriverStmt2{}
+	_ driver.NamedValueChecker = &tdriverStmt2{}
+	_ driver.Stmt              = &tdriverStmt3{}
+	_ driver.StmtExecContext   = &tdriverStmt3{}
+	_ driver.Stmt              = &tdriverStmt4{}
+	_ driver.StmtQueryContext  = &tdriverStmt4{}
+	_ driver.Stmt              = &tdriverStmt5{}
+	_ driver.ColumnConverter   = &tdriverStmt5{}
+	_ driver.NamedValueChecker = &tdriverStmt5{}
+	_ driver.Stmt              = &tdriverStmt6{}
+	_ driver.ColumnConverter   = &tdriverStmt6{}
+	_ driver.StmtExecContext   = &tdriverStmt6{}
+	_ driver.Stmt              = &tdriverStmt7{}
+	_ driver.ColumnConverter   = &tdriverStmt7{}
+	_ driver.StmtQueryContext  = &tdriverStmt7{}
+	_ driver.Stmt              = &tdriverStmt8{}
+	_ driver.NamedValueChecker = &tdriverStmt8{}
+	_ driver.StmtExecContext   = &tdriverStmt8{}
+	_ driver.Stmt              = &tdriverStmt9{}
+	_ driver.NamedValueChecker = &tdriverStmt9{}
+	_ driver.StmtQueryContext  = &tdriverStmt9{}
+	_ driver.Stmt              = &tdriverStmt10{}
+	_ driver.StmtExecContext   = &tdriverStmt10{}
+	_ driver.StmtQueryContext  = &tdriverStmt10{}
+	_ driver.Stmt              = &tdriverStmt11{}
+	_ driver.ColumnConverter   = &tdriverStmt11{}
+	_ driver.NamedValueChecker = &tdriverStmt11{}
+	_ driver.StmtExecContext   = &tdriverStmt11{}
+	_ driver.Stmt              = &tdriverStmt12{}
+	_ driver.ColumnConverter   = &tdriverStmt12{}
+	_ driver.NamedValueChecker = &tdriverStmt12{}
+	_ driver.StmtQueryContext  = &tdriverStmt12{}
+	_ driver.Stmt              = &tdriverStmt13{}
+	_ driver.ColumnConverter   = &tdriverStmt13{}
+	_ driver.StmtExecContext   = &tdriverStmt13{}
+	_ driver.StmtQueryContext  = &tdriverStmt13{}
+	_ driver.Stmt              = &tdriverStmt14{}
+	_ driver.NamedValueChecker = &tdriverStmt14{}
+	_ driver.StmtExecContext   = &tdriverStmt14{}
+	_ driver.StmtQueryContext  = &tdriverStmt14{}
+	_ driver.Stmt              = &tdriverStmt15{}
+	_ driver.ColumnConverter   = &tdriverStmt15{}
+	_ driver.NamedValueChecker = &tdriverStmt15{}
+	_ driver.StmtExecContext   = &tdriverStmt15{}
+	_ driver.StmtQueryContext  = &tdriverStmt15{}
+)
+
+func (odriverStmt0 *tdriverStmt0) Close() error {
+	return realDSClose(odriverStmt0.r, odriverStmt0.extra)
+}
+func (odriverStmt0 *tdriverStmt0) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt0.r, odriverStmt0.extra, args)
+}
+func (odriverStmt0 *tdriverStmt0) NumInput() int {
+	return realDSNumInput(odriverStmt0.r, odriverStmt0.extra)
+}
+func (odriverStmt0 *tdriverStmt0) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt0.r, odriverStmt0.extra, args)
+}
+
+func (odriverStmt1 *tdriverStmt1) Close() error {
+	return realDSClose(odriverStmt1.r, odriverStmt1.extra)
+}
+func (odriverStmt1 *tdriverStmt1) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt1.r, odriverStmt1.extra, args)
+}
+func (odriverStmt1 *tdriverStmt1) NumInput() int {
+	return realDSNumInput(odriverStmt1.r, odriverStmt1.extra)
+}
+func (odriverStmt1 *tdriverStmt1) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt1.r, odriverStmt1.extra, args)
+}
+func (odriverStmt1 *tdriverStmt1) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt1.r, odriverStmt1.extra, idx)
+}
+
+func (odriverStmt2 *tdriverStmt2) Close() error {
+	return realDSClose(odriverStmt2.r, odriverStmt2.extra)
+}
+func (odriverStmt2 *tdriverStmt2) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt2.r, odriverStmt2.extra, args)
+}
+func (odriverStmt2 *tdriverStmt2) NumInput() int {
+	return realDSNumInput(odriverStmt2.r, odriverStmt2.extra)
+}
+func (odriverStmt2 *tdriverStmt2) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt2.r, odriverStmt2.extra, args)
+}
+func (odriverStmt2 *tdriverStmt2) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt2.r, odriverStmt2.extra, param0)
+}
+
+func (odriverStmt3 *tdriverStmt3) Close() error {
+	return realDSClose(odriverStmt3.r, odriverStmt3.extra)
+}
+func (odriverStmt3 *tdriverStmt3) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt3.r, odriverStmt3.extra, args)
+}
+func (odriverStmt3 *tdriverStmt3) NumInput() int {
+	return realDSNumInput(odriverStmt3.r, odriverStmt3.extra)
+}
+func (odriverStmt3 *tdriverStmt3) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt3.r, odriverStmt3.extra, args)
+}
+func (odriverStmt3 *tdriverStmt3) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt3.r, odriverStmt3.extra, ctx, args)
+}
+
+func (odriverStmt4 *tdriverStmt4) Close() error {
+	return realDSClose(odriverStmt4.r, odriverStmt4.extra)
+}
+func (odriverStmt4 *tdriverStmt4) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt4.r, odriverStmt4.extra, args)
+}
+func (odriverStmt4 *tdriverStmt4) NumInput() int {
+	return realDSNumInput(odriverStmt4.r, odriverStmt4.extra)
+}
+func (odriverStmt4 *tdriverStmt4) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt4.r, odriverStmt4.extra, args)
+}
+func (odriverStmt4 *tdriverStmt4) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt4.r, odriverStmt4.extra, ctx, args)
+}
+
+func (odriverStmt5 *tdriverStmt5) Close() error {
+	return realDSClose(odriverStmt5.r, odriverStmt5.extra)
+}
+func (odriverStmt5 *tdriverStmt5) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt5.r, odriverStmt5.extra, args)
+}
+func (odriverStmt5 *tdriverStmt5) NumInput() int {
+	return realDSNumInput(odriverStmt5.r, odriverStmt5.extra)
+}
+func (odriverStmt5 *tdriverStmt5) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt5.r, odriverStmt5.extra, args)
+}
+func (odriverStmt5 *tdriverStmt5) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt5.r, odriverStmt5.extra, idx)
+}
+func (odriverStmt5 *tdriverStmt5) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt5.r, odriverStmt5.extra, param0)
+}
+
+func (odriverStmt6 *tdriverStmt6) Close() error {
+	return realDSClose(odriverStmt6.r, odriverStmt6.extra)
+}
+func (odriverStmt6 *tdriverStmt6) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt6.r, odriverStmt6.extra, args)
+}
+func (odriverStmt6 *tdriverStmt6) NumInput() int {
+	return realDSNumInput(odriverStmt6.r, odriverStmt6.extra)
+}
+func (odriverStmt6 *tdriverStmt6) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt6.r, odriverStmt6.extra, args)
+}
+func (odriverStmt6 *tdriverStmt6) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt6.r, odriverStmt6.extra, idx)
+}
+func (odriverStmt6 *tdriverStmt6) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt6.r, odriverStmt6.extra, ctx, args)
+}
+
+func (odriverStmt7 *tdriverStmt7) Close() error {
+	return realDSClose(odriverStmt7.r, odriverStmt7.extra)
+}
+func (odriverStmt7 *tdriverStmt7) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt7.r, odriverStmt7.extra, args)
+}
+func (odriverStmt7 *tdriverStmt7) NumInput() int {
+	return realDSNumInput(odriverStmt7.r, odriverStmt7.extra)
+}
+func (odriverStmt7 *tdriverStmt7) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt7.r, odriverStmt7.extra, args)
+}
+func (odriverStmt7 *tdriverStmt7) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt7.r, odriverStmt7.extra, idx)
+}
+func (odriverStmt7 *tdriverStmt7) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt7.r, odriverStmt7.extra, ctx, args)
+}
+
+func (odriverStmt8 *tdriverStmt8) Close() error {
+	return realDSClose(odriverStmt8.r, odriverStmt8.extra)
+}
+func (odriverStmt8 *tdriverStmt8) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt8.r, odriverStmt8.extra, args)
+}
+func (odriverStmt8 *tdriverStmt8) NumInput() int {
+	return realDSNumInput(odriverStmt8.r, odriverStmt8.extra)
+}
+func (odriverStmt8 *tdriverStmt8) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt8.r, odriverStmt8.extra, args)
+}
+func (odriverStmt8 *tdriverStmt8) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt8.r, odriverStmt8.extra, param0)
+}
+func (odriverStmt8 *tdriverStmt8) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt8.r, odriverStmt8.extra, ctx, args)
+}
+
+func (odriverStmt9 *tdriverStmt9) Close() error {
+	return realDSClose(odriverStmt9.r, odriverStmt9.extra)
+}
+func (odriverStmt9 *tdriverStmt9) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt9.r, odriverStmt9.extra, args)
+}
+func (odriverStmt9 *tdriverStmt9) NumInput() int {
+	return realDSNumInput(odriverStmt9.r, odriverStmt9.extra)
+}
+func (odriverStmt9 *tdriverStmt9) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt9.r, odriverStmt9.extra, args)
+}
+func (odriverStmt9 *tdriverStmt9) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt9.r, odriverStmt9.extra, param0)
+}
+func (odriverStmt9 *tdriverStmt9) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt9.r, odriverStmt9.extra, ctx, args)
+}
+
+func (odriverStmt10 *tdriverStmt10) Close() error {
+	return realDSClose(odriverStmt10.r, odriverStmt10.extra)
+}
+func (odriverStmt10 *tdriverStmt10) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt10.r, odriverStmt10.extra, args)
+}
+func (odriverStmt10 *tdriverStmt10) NumInput() int {
+	return realDSNumInput(odriverStmt10.r, odriverStmt10.extra)
+}
+func (odriverStmt10 *tdriverStmt10) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt10.r, odriverStmt10.extra, args)
+}
+func (odriverStmt10 *tdriverStmt10) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt10.r, odriverStmt10.extra, ctx, args)
+}
+func (odriverStmt10 *tdriverStmt10) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt10.r, odriverStmt10.extra, ctx, args)
+}
+
+func (odriverStmt11 *tdriverStmt11) Close() error {
+	return realDSClose(odriverStmt11.r, odriverStmt11.extra)
+}
+func (odriverStmt11 *tdriverStmt11) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt11.r, odriverStmt11.extra, args)
+}
+func (odriverStmt11 *tdriverStmt11) NumInput() int {
+	return realDSNumInput(odriverStmt11.r, odriverStmt11.extra)
+}
+func (odriverStmt11 *tdriverStmt11) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt11.r, odriverStmt11.extra, args)
+}
+func (odriverStmt11 *tdriverStmt11) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt11.r, odriverStmt11.extra, idx)
+}
+func (odriverStmt11 *tdriverStmt11) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt11.r, odriverStmt11.extra, param0)
+}
+func (odriverStmt11 *tdriverStmt11) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt11.r, odriverStmt11.extra, ctx, args)
+}
+
+func (odriverStmt12 *tdriverStmt12) Close() error {
+	return realDSClose(odriverStmt12.r, odriverStmt12.extra)
+}
+func (odriverStmt12 *tdriverStmt12) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt12.r, odriverStmt12.extra, args)
+}
+func (odriverStmt12 *tdriverStmt12) NumInput() int {
+	return realDSNumInput(odriverStmt12.r, odriverStmt12.extra)
+}
+func (odriverStmt12 *tdriverStmt12) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt12.r, odriverStmt12.extra, args)
+}
+func (odriverStmt12 *tdriverStmt12) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt12.r, odriverStmt12.extra, idx)
+}
+func (odriverStmt12 *tdriverStmt12) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt12.r, odriverStmt12.extra, param0)
+}
+func (odriverStmt12 *tdriverStmt12) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt12.r, odriverStmt12.extra, ctx, args)
+}
+
+func (odriverStmt13 *tdriverStmt13) Close() error {
+	return realDSClose(odriverStmt13.r, odriverStmt13.extra)
+}
+func (odriverStmt13 *tdriverStmt13) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt13.r, odriverStmt13.extra, args)
+}
+func (odriverStmt13 *tdriverStmt13) NumInput() int {
+	return realDSNumInput(odriverStmt13.r, odriverStmt13.extra)
+}
+func (odriverStmt13 *tdriverStmt13) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt13.r, odriverStmt13.extra, args)
+}
+func (odriverStmt13 *tdriverStmt13) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt13.r, odriverStmt13.extra, idx)
+}
+func (odriverStmt13 *tdriverStmt13) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt13.r, odriverStmt13.extra, ctx, args)
+}
+func (odriverStmt13 *tdriverStmt13) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt13.r, odriverStmt13.extra, ctx, args)
+}
+
+func (odriverStmt14 *tdriverStmt14) Close() error {
+	return realDSClose(odriverStmt14.r, odriverStmt14.extra)
+}
+func (odriverStmt14 *tdriverStmt14) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt14.r, odriverStmt14.extra, args)
+}
+func (odriverStmt14 *tdriverStmt14) NumInput() int {
+	return realDSNumInput(odriverStmt14.r, odriverStmt14.extra)
+}
+func (odriverStmt14 *tdriverStmt14) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt14.r, odriverStmt14.extra, args)
+}
+func (odriverStmt14 *tdriverStmt14) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt14.r, odriverStmt14.extra, param0)
+}
+func (odriverStmt14 *tdriverStmt14) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt14.r, odriverStmt14.extra, ctx, args)
+}
+func (odriverStmt14 *tdriverStmt14) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt14.r, odriverStmt14.extra, ctx, args)
+}
+
+func (odriverStmt15 *tdriverStmt15) Close() error {
+	return realDSClose(odriverStmt15.r, odriverStmt15.extra)
+}
+func (odriverStmt15 *tdriverStmt15) Exec(args []driver.Value) (driver.Result, error) {
+	return realDSExec(odriverStmt15.r, odriverStmt15.extra, args)
+}
+func (odriverStmt15 *tdriverStmt15) NumInput() int {
+	return realDSNumInput(odriverStmt15.r, odriverStmt15.extra)
+}
+func (odriverStmt15 *tdriverStmt15) Query(args []driver.Value) (driver.Rows, error) {
+	return realDSQuery(odriverStmt15.r, odriverStmt15.extra, args)
+}
+func (odriverStmt15 *tdriverStmt15) ColumnConverter(idx int) driver.ValueConverter {
+	return realDSColumnConverter(odriverStmt15.r, odriverStmt15.extra, idx)
+}
+func (odriverStmt15 *tdriverStmt15) CheckNamedValue(param0 *driver.NamedValue) error {
+	return realDSCheckNamedValue(odriverStmt15.r, odriverStmt15.extra, param0)
+}
+func (odriverStmt15 *tdriverStmt15) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return realDSExecContext(odriverStmt15.r, odriverStmt15.extra, ctx, args)
+}
+func (odriverStmt15 *tdriverStmt15) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return realDSQueryContext(odriverStmt15.r, odriverStmt15.extra, ctx, args)
+}
+
+func newStmt(realDSStmt driver.Stmt, extra interface{}) driver.Stmt {
+	switch r := realDSStmt.(type) {
+	case idriverStmt15:
+		return &tdriverStmt15{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt14:
+		return &tdriverStmt14{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt13:
+		return &tdriverStmt13{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt12:
+		return &tdriverStmt12{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt11:
+		return &tdriverStmt11{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt10:
+		return &tdriverStmt10{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt9:
+		return &tdriverStmt9{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt8:
+		return &tdriverStmt8{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt7:
+		return &tdriverStmt7{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt6:
+		return &tdriverStmt6{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt5:
+		return &tdriverStmt5{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt4:
+		return &tdriverStmt4{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt3:
+		return &tdriverStmt3{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt2:
+		return &tdriverStmt2{
+			r:     r,
+			extra: extra,
+		}
+	case idriverStmt1:
+		return &tdriverStmt1{
+			r:     r,
+			extra: extra,
+		}
+	}
+	return &tdriverStmt0{
+		r:     realDSStmt,
+		extra: extra,
+	}
+}
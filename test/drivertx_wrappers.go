@@ -0,0 +1,36 @@
+// Code generated by "wrappergen -basetype=driver.Tx -prefix=realDT -newfuncname=newTx -extrafields extra,interface{}"; DO NOT EDIT.
+
+package test
+
+import (
+	"database/sql/driver"
+)
+
+type (
+	idriverTx0 interface {
+		driver.Tx
+	}
+
+	tdriverTx0 struct {
+		r     idriverTx0
+		extra interface{}
+	}
+)
+
+var (
+	_ driver.Tx = &tdriverTx0{}
+)
+
+func (odriverTx0 *tdriverTx0) Commit() error {
+	return realDTCommit(odriverTx0.r, odriverTx0.extra)
+}
+func (odriverTx0 *tdriverTx0) Rollback() error {
+	return realDTRollback(odriverTx0.r, odriverTx0.extra)
+}
+
+func newTx(realDTTx driver.Tx, extra interface{}) driver.Tx {
+	return &tdriverTx0{
+		r:     realDTTx,
+		extra: extra,
+	}
+}